@@ -1,19 +1,27 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
-
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
-	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 
 	"github.com/aws/aws-lambda-go/lambda"
 
-	"github.com/aws/aws-sdk-go/service/rds"
-
 	"github.com/auto-staging/scheduler/model"
 	"github.com/auto-staging/scheduler/types"
 )
@@ -23,16 +31,65 @@ var commitHash string
 var branch string
 var buildTime string
 
+// statusTableConfig is loaded from the environment variables and validated at cold start
+var statusTableConfig types.StatusTableConfig
+
+// tagConfig is loaded from the environment variables and validated at cold start
+var tagConfig types.TagConfig
+
+// accountRoles maps AWS account IDs to the roles assumed to change the resources in these accounts, it is loaded from the environment variables
+// and validated at cold start
+var accountRoles map[string]string
+
+// accountIDPattern matches valid AWS account IDs
+var accountIDPattern = regexp.MustCompile(`^[0-9]{12}$`)
+
+// regionPattern matches valid AWS region names
+var regionPattern = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-[0-9]+$`)
+
+// maxConcurrentHandlers limits the number of resource types which get changed at the same time
+var maxConcurrentHandlers = 3
+
+// defaultPhases is the order in which the resource types of an Environment get started, the resource types of one phase are changed concurrently.
+// The databases are started first, so the applications don't crash-loop while the databases aren't available. Stopping uses the reverse order.
+var defaultPhases = [][]string{{"rds", "redshift", "docdb", "elasticache"}, {"asg", "ec2", "ecs", "eks"}}
+
+// excludedReason is the skipped reason of resources, which are excluded from scheduling by the exclude tag
+const excludedReason = "excluded by tag"
+
+// waitFailedReason is the skipped reason of the resources of the phases following a phase whose resources didn't reach their final state
+const waitFailedReason = "waiting for the previous phase failed"
+
+// defaultWaitTimeout is the maximum time the scheduler waits for the resources of all phases, if no timeout is set in the event
+var defaultWaitTimeout = 10 * time.Minute
+
+// phaseHeadroom is the time reserved before the deadline of the invocation for changing the resources of every phase following a wait
+var phaseHeadroom = 30 * time.Second
+
+// statusHeadroom is the time reserved before the deadline of the invocation for writing the status and the history of the Environment
+var statusHeadroom = 10 * time.Second
+
 type services struct {
-	model.RDSModelAPI
 	model.StatusModelAPI
-	model.EC2ModelAPI
-	model.ASGModelAPI
+	// schedulers contains the resource schedulers of all registered resource types in the order of the schedulerRegistry
+	schedulers []ResourceScheduler
+	// regions contains the resource services of every region, if the Environment is spread over multiple regions.
+	// The resource services of the struct itself are only used, if no regions are set.
+	regions []regionalServices
+}
+
+// regionalServices contains the resource services of one region of the Environment
+type regionalServices struct {
+	region string
+	*services
 }
 
-// Handler is the main function called by lambda.Start, it starts / stops EC2 Instances and RDS Clusters based on the information in the eventJSON.
+// Handler is the main function called by lambda.Start, it starts / stops the resources of all registered resource types based on the information in the eventJSON.
 // Since the Lambda function is invoked by CloudWatchEvents rules it uses json.RawMessage as parameter.
-func Handler(eventJSON json.RawMessage) (string, error) {
+// The result lists the state change of every resource of the Environment. If dryRun is set in the event, no resource or status gets changed and the result
+// contains the changes which would be made instead.
+// The resource types are changed concurrently, resource types which weren't changed before the context is cancelled get listed as errors in the result.
+func Handler(ctx context.Context, eventJSON json.RawMessage) (string, error) {
 	sess := session.Must(session.NewSessionWithOptions(session.Options{
 		SharedConfigState: session.SharedConfigEnable,
 	}))
@@ -49,42 +106,223 @@ func Handler(eventJSON json.RawMessage) (string, error) {
 		return returnVersionInformation()
 	}
 
-	svcEC2 := ec2.New(sess)
-	svcRDS := rds.New(sess)
-	svcASG := autoscaling.New(sess)
-	svcDynamoDB := dynamodb.New(sess)
-
-	svcBase := services{
-		RDSModelAPI:    model.NewRDSModel(svcRDS),
-		EC2ModelAPI:    model.NewEC2Model(svcEC2),
-		StatusModelAPI: model.NewStatusModel(svcDynamoDB),
-		ASGModelAPI:    model.NewASGModel(svcASG),
-	}
-
-	err = svcBase.changeASGState(cwEvent)
+	resourceConfig, err := resourceConfigForEvent(sts.New(sess), cwEvent)
 	if err != nil {
+		log.Println(err)
 		return "", err
 	}
 
-	err = svcBase.changeEC2State(cwEvent)
+	regions, err := regionsForEvent(cwEvent)
 	if err != nil {
+		log.Println(err)
 		return "", err
 	}
 
-	err = svcBase.changeRDSState(cwEvent)
-	if err != nil {
-		return "", err
+	svcBase := newResourceServices(sess, resourceConfig)
+	svcBase.StatusModelAPI = model.NewStatusModel(dynamodb.New(sess), statusTableConfig)
+	for _, region := range regions {
+		svcBase.regions = append(svcBase.regions, regionalServices{
+			region:   region,
+			services: newResourceServices(sess, resourceConfig.Copy(&aws.Config{Region: aws.String(region)})),
+		})
+	}
+
+	return svcBase.changeState(ctx, cwEvent)
+}
+
+// newResourceServices returns the services changing the resources of all registered resource types with clients using the given AWS config.
+func newResourceServices(sess *session.Session, config *aws.Config) *services {
+	return &services{
+		schedulers: newSchedulers(sess, config),
+	}
+}
+
+// regionsForEvent returns the regions of the Environment set in the event, duplicate regions are removed.
+// An error is returned, if a region name is invalid.
+func regionsForEvent(cwEvent types.Event) ([]string, error) {
+	candidates := cwEvent.Regions
+	if cwEvent.Region != "" {
+		candidates = append([]string{cwEvent.Region}, candidates...)
 	}
 
-	return "{ \"message\": \"success\" }", nil
+	regions := []string{}
+	found := map[string]bool{}
+	for _, region := range candidates {
+		if !regionPattern.MatchString(region) {
+			return nil, fmt.Errorf("invalid region %q", region)
+		}
+		if !found[region] {
+			found[region] = true
+			regions = append(regions, region)
+		}
+	}
+	return regions, nil
 }
 
 func main() {
 	log.Printf("version - %s | branch - %s | commit hash - %s | build time - %s \n", version, branch, commitHash, buildTime)
 
+	config, err := loadStatusTableConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
+	statusTableConfig = config
+
+	tags, err := loadTagConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
+	tagConfig = tags
+
+	roles, err := loadAccountRoles()
+	if err != nil {
+		log.Fatal(err)
+	}
+	accountRoles = roles
+
 	lambda.Start(Handler)
 }
 
+// resourceConfigForEvent returns the AWS config of the EC2, RDS, ASG and Resource Groups Tagging clients. If a role is set for the event,
+// the clients use the credentials of the assumed role, otherwise the credentials of the Lambda function.
+// If the role can't be determined, an error is returned.
+func resourceConfigForEvent(svcSTS stsiface.STSAPI, cwEvent types.Event) (*aws.Config, error) {
+	roleARN, err := roleForEvent(cwEvent)
+	if err != nil {
+		return nil, err
+	}
+	if roleARN == "" {
+		return &aws.Config{}, nil
+	}
+
+	log.Printf("Assuming role %s \n", roleARN)
+	return &aws.Config{
+		Credentials: stscreds.NewCredentialsWithClient(svcSTS, roleARN, func(provider *stscreds.AssumeRoleProvider) {
+			provider.RoleSessionName = "auto-staging-scheduler"
+		}),
+	}, nil
+}
+
+// roleForEvent returns the ARN of the role assumed for the Environment of the event. The role ARN of the event takes precedence over the role of the account,
+// which is looked up in the accountRoles. If neither is set, an empty string is returned.
+func roleForEvent(cwEvent types.Event) (string, error) {
+	if cwEvent.RoleArn != "" {
+		if _, err := arn.Parse(cwEvent.RoleArn); err != nil {
+			return "", fmt.Errorf("invalid role ARN %q, %s", cwEvent.RoleArn, err)
+		}
+		return cwEvent.RoleArn, nil
+	}
+	if cwEvent.Account != "" {
+		roleARN, ok := accountRoles[cwEvent.Account]
+		if !ok {
+			return "", fmt.Errorf("no role configured for account %q", cwEvent.Account)
+		}
+		return roleARN, nil
+	}
+	return "", nil
+}
+
+// loadAccountRoles reads the roles assumed for the Environments in other AWS accounts from the environment variable ACCOUNT_ROLES. The variable contains
+// a comma separated list of account=roleArn pairs, e.g. "123456789012=arn:aws:iam::123456789012:role/auto-staging-scheduler".
+// An error is returned, if an account ID or role ARN is invalid.
+func loadAccountRoles() (map[string]string, error) {
+	roles := map[string]string{}
+	for _, pair := range strings.Split(getEnv("ACCOUNT_ROLES", ""), ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		accountRole := strings.SplitN(pair, "=", 2)
+		if len(accountRole) != 2 {
+			return nil, fmt.Errorf("invalid account role %q, expected account=roleArn", pair)
+		}
+		account := strings.TrimSpace(accountRole[0])
+		roleARN := strings.TrimSpace(accountRole[1])
+		if !accountIDPattern.MatchString(account) {
+			return nil, fmt.Errorf("invalid account ID %q", account)
+		}
+		if _, err := arn.Parse(roleARN); err != nil {
+			return nil, fmt.Errorf("invalid role ARN %q for account %s, %s", roleARN, account, err)
+		}
+		roles[account] = roleARN
+	}
+	return roles, nil
+}
+
+// loadStatusTableConfig reads the status table name and key attribute names from the environment variables STATUS_TABLE_NAME, STATUS_TABLE_REPOSITORY_KEY
+// and STATUS_TABLE_BRANCH_KEY. Variables which aren't set default to the table of the auto-staging installation ("auto-staging-environments" with the keys
+// "repository" and "branch"). STATUS_HISTORY_SIZE limits the number of transitions kept in the history of an Environment (default 20, 0 disables the history).
+// An error is returned, if the history size isn't a number or the resulting config is invalid.
+func loadStatusTableConfig() (types.StatusTableConfig, error) {
+	config := types.StatusTableConfig{
+		TableName:     getEnv("STATUS_TABLE_NAME", "auto-staging-environments"),
+		RepositoryKey: getEnv("STATUS_TABLE_REPOSITORY_KEY", "repository"),
+		BranchKey:     getEnv("STATUS_TABLE_BRANCH_KEY", "branch"),
+	}
+	historySize, err := strconv.Atoi(getEnv("STATUS_HISTORY_SIZE", "20"))
+	if err != nil {
+		return config, fmt.Errorf("invalid status history size, %s", err)
+	}
+	config.HistorySize = historySize
+	return config, config.Validate()
+}
+
+// loadTagConfig reads the tag keys used to find the resources of an Environment from the environment variables TAG_REPOSITORY_KEY and TAG_BRANCH_KEY
+// (default "repository" and "branch_raw"). REQUIRED_TAGS optionally contains additional tags every resource must have as comma separated list of
+// key=value pairs, e.g. "environment=staging,team=platform". EXCLUDE_TAG contains the key=value pair of the tag which excludes resources from
+// scheduling (default "auto-staging:schedule=ignore"), an empty value disables the exclusion.
+// An error is returned, if a tag can't be parsed or the resulting config is invalid.
+func loadTagConfig() (types.TagConfig, error) {
+	config := types.TagConfig{
+		RepositoryKey: getEnv("TAG_REPOSITORY_KEY", "repository"),
+		BranchKey:     getEnv("TAG_BRANCH_KEY", "branch_raw"),
+		RequiredTags:  map[string]string{},
+	}
+	var err error
+	config.ExcludeTagKey, config.ExcludeTagValue, err = parseTag("exclude", getEnv("EXCLUDE_TAG", "auto-staging:schedule=ignore"))
+	if err != nil {
+		return config, err
+	}
+	config.SnapshotTagKey, config.SnapshotTagValue, err = parseTag("snapshot", getEnv("SNAPSHOT_TAG", "auto-staging:schedule=snapshot"))
+	if err != nil {
+		return config, err
+	}
+	for _, pair := range strings.Split(getEnv("REQUIRED_TAGS", ""), ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		keyValue := strings.SplitN(pair, "=", 2)
+		if len(keyValue) != 2 {
+			return config, fmt.Errorf("invalid required tag %q, expected key=value", pair)
+		}
+		config.RequiredTags[strings.TrimSpace(keyValue[0])] = strings.TrimSpace(keyValue[1])
+	}
+	return config, config.Validate()
+}
+
+// parseTag returns the key and value of the given tag in the format key=value, an empty tag returns an empty key and value.
+// The name of the tag is used in the error message, if the format is invalid.
+func parseTag(name, tag string) (string, string, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return "", "", nil
+	}
+	keyValue := strings.SplitN(tag, "=", 2)
+	if len(keyValue) != 2 || strings.TrimSpace(keyValue[0]) == "" {
+		return "", "", fmt.Errorf("invalid %s tag %q, expected key=value", name, tag)
+	}
+	return strings.TrimSpace(keyValue[0]), strings.TrimSpace(keyValue[1]), nil
+}
+
+// getEnv returns the value of the environment variable or the fallback, if the variable isn't set
+func getEnv(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+	return fallback
+}
+
 func returnVersionInformation() (string, error) {
 	componentVersion := types.SingleComponentVersion{
 		Name:       "scheduler",
@@ -103,113 +341,399 @@ func returnVersionInformation() (string, error) {
 	return string(body), nil
 }
 
-func (base *services) changeASGState(cwEvent types.Event) error {
-	autoscalingGroup, err := base.ASGModelAPI.DescribeAutoScalingGroupForTagsAndAction(cwEvent.Repository, cwEvent.Branch, cwEvent.Action)
+// changeState starts / stops all ASGs, EC2 Instances and RDS resources of the Environment and returns the result as JSON. For dry runs the result contains
+// the changes which would be made.
+// The resource types are changed in the phases configured in the event (defaultPhases if not set). On start the scheduler waits until the started
+// resources are available before the next phase begins, on stop the phases are executed in reverse order. If wait is set in the event, the scheduler also
+// waits for the resources of the last phase. The Environment has the status "starting" / "stopping" until all phases are done, the statuses are only
+// written if at least one resource gets changed.
+// The wait timeout limits the time of all waits together and must fit into the time left until the deadline of the context. If the scheduler waits,
+// the time left must at least cover the time reserved for changing the phases and writing the status. Every wait ends early enough to change the
+// remaining phases and write the status before the deadline of the context. If the resources of a phase don't reach their final state, the resources of
+// the following phases aren't changed and are listed as skipped.
+// A failing resource type doesn't stop the remaining ones, the errors get collected and are listed in the result. The Environment then gets the status
+// "start failed" / "stop failed" together with the error message.
+func (base *services) changeState(ctx context.Context, cwEvent types.Event) (string, error) {
+	regions := base.servicesPerRegion()
+	phases, err := phasesForRegions(regions, cwEvent, func(scheduler ResourceScheduler) resourceHandler {
+		return handlerForAction(scheduler, cwEvent.Action)
+	})
+	if err != nil {
+		log.Println(err)
+		return "", err
+	}
+	skippedPhases, err := phasesForRegions(regions, cwEvent, func(scheduler ResourceScheduler) resourceHandler {
+		return skipHandler(scheduler, waitFailedReason)
+	})
 	if err != nil {
-		return err
+		log.Println(err)
+		return "", err
 	}
 
-	if autoscalingGroup != nil {
-		switch cwEvent.Action {
-		case "stop":
-			err = base.ASGModelAPI.SetASGMinToZero(autoscalingGroup)
-			if err != nil {
-				return err
-			}
-			err = base.StatusModelAPI.SetStatusForEnvironment(cwEvent.Repository, cwEvent.Branch, "stopped")
-			if err != nil {
-				return err
-			}
+	result := types.Result{
+		DryRun:    cwEvent.DryRun,
+		Action:    cwEvent.Action,
+		Resources: []types.ResourceChange{},
+	}
+	var errs types.MultiError
 
-		case "start":
-			err = base.ASGModelAPI.SetASGMinToPreviousValue(autoscalingGroup)
-			if err != nil {
-				return err
-			}
-			err = base.StatusModelAPI.SetStatusForEnvironment(cwEvent.Repository, cwEvent.Branch, "running")
-			if err != nil {
-				return err
-			}
+	waits := !cwEvent.DryRun && (cwEvent.Wait || (cwEvent.Action == "start" && len(phases) > 1))
+	if waits {
+		if err := validateTimeLeft(ctx, len(phases)); err != nil {
+			log.Println(err)
+			return "", err
+		}
+	}
+	waitTimeout := defaultWaitTimeout
+	if cwEvent.WaitTimeout > 0 {
+		waitTimeout = time.Duration(cwEvent.WaitTimeout) * time.Second
+		if err := validateWaitTimeout(ctx, waitTimeout, len(phases)); err != nil {
+			log.Println(err)
+			return "", err
+		}
+	}
+	waitDeadline := time.Now().Add(waitTimeout)
+	// The statuses are only written if at least one resource of the Environment will be changed, otherwise a status set by Tower would be overwritten
+	transition := !cwEvent.DryRun && (cwEvent.Action == "start" || cwEvent.Action == "stop") && willChangeResources(ctx, cwEvent, phases)
+	if transition {
+		errs = errs.Append(base.StatusModelAPI.SetStatusForEnvironment(cwEvent.Repository, cwEvent.Branch, transitionStateForAction(cwEvent.Action)))
+	}
 
+	waitFailed := false
+	for i, phase := range phases {
+		if waitFailed {
+			phase = skippedPhases[i]
+		}
+		phaseChanges := []types.ResourceChange{}
+		for _, handlerResult := range runHandlers(ctx, cwEvent, phase) {
+			phaseChanges = append(phaseChanges, handlerResult.changes...)
+			errs = errs.Append(handlerResult.err)
 		}
-	} else {
-		log.Println("ASG - No action required")
+
+		lastPhase := i == len(phases)-1
+		if !waitFailed && !cwEvent.DryRun && (cwEvent.Wait || (cwEvent.Action == "start" && !lastPhase)) {
+			waitCtx, cancel := waitContext(ctx, waitDeadline, len(phases)-i-1)
+			err := waitForRegions(waitCtx, regions, cwEvent.Action, phaseChanges)
+			cancel()
+			errs = errs.Append(err)
+			waitFailed = err != nil
+		}
+		result.Resources = append(result.Resources, phaseChanges...)
 	}
 
-	return nil
-}
+	changed := false
+	for _, change := range result.Resources {
+		if !change.Skipped() {
+			changed = true
+			break
+		}
+	}
+	if changed || transition {
+		// The transition status was written already, so the final status has to be written even if no resource was changed
+		result.Status = statusForAction(cwEvent.Action)
+	}
+
+	switch {
+	case len(errs) > 0:
+		log.Println(errs)
+		result.Status = failedStatusForAction(cwEvent.Action)
+		if !cwEvent.DryRun {
+			errs = errs.Append(base.StatusModelAPI.SetFailedStatusForEnvironment(cwEvent.Repository, cwEvent.Branch, result.Status, errs.Error()))
+		}
+	case result.Status != "" && !cwEvent.DryRun:
+		errs = errs.Append(base.StatusModelAPI.SetStatusForEnvironment(cwEvent.Repository, cwEvent.Branch, result.Status))
+	}
+	if (changed || len(errs) > 0) && !cwEvent.DryRun {
+		errs = errs.Append(base.StatusModelAPI.AddHistoryEntry(cwEvent.Repository, cwEvent.Branch, historyEntryForResult(cwEvent, result, errs)))
+	}
+	if len(errs) > 0 {
+		result.Errors = errs.Strings()
+	}
 
-func (base *services) changeEC2State(cwEvent types.Event) error {
-	instanceIDs, err := base.EC2ModelAPI.DescribeInstancesForTagsAndAction(cwEvent.Repository, cwEvent.Branch, cwEvent.Action)
+	body, err := json.Marshal(result)
 	if err != nil {
-		return err
+		log.Println("Error marshaling result, " + err.Error())
+		return "", err
 	}
+	return string(body), nil
+}
 
-	if len(instanceIDs) > 0 {
-		switch cwEvent.Action {
-		case "stop":
-			err = base.EC2ModelAPI.StopEC2Instances(instanceIDs)
-			if err != nil {
-				return err
-			}
-			err = base.StatusModelAPI.SetStatusForEnvironment(cwEvent.Repository, cwEvent.Branch, "stopped")
-			if err != nil {
-				return err
+// willChangeResources returns true, if a dry run of the phases would change at least one resource. Errors of the dry run are ignored, they are
+// reported again when the phases are run.
+func willChangeResources(ctx context.Context, cwEvent types.Event, phases [][]resourceHandler) bool {
+	cwEvent.DryRun = true
+	for _, phase := range phases {
+		for _, handlerResult := range runHandlers(ctx, cwEvent, phase) {
+			for _, change := range handlerResult.changes {
+				if !change.Skipped() {
+					return true
+				}
 			}
+		}
+	}
+	return false
+}
 
-		case "start":
-			err = base.EC2ModelAPI.StartEC2Instances(instanceIDs)
-			if err != nil {
-				return err
-			}
-			err = base.StatusModelAPI.SetStatusForEnvironment(cwEvent.Repository, cwEvent.Branch, "running")
-			if err != nil {
-				return err
-			}
+// historyEntryForResult returns the history entry recording the transition of the Environment described by the result.
+// The entry lists the identifiers of all changed resources and the error message, if the transition failed.
+func historyEntryForResult(cwEvent types.Event, result types.Result, errs types.MultiError) types.HistoryEntry {
+	entry := types.HistoryEntry{
+		Action:    cwEvent.Action,
+		Source:    cwEvent.Source,
+		Resources: []string{},
+		Result:    result.Status,
+	}
+	if entry.Source == "" {
+		entry.Source = "cloudwatch"
+	}
+	for _, change := range result.Resources {
+		if !change.Skipped() {
+			entry.Resources = append(entry.Resources, change.Identifier)
+		}
+	}
+	if len(errs) > 0 {
+		entry.ErrorMessage = errs.Error()
+	}
+	return entry
+}
+
+// servicesPerRegion returns the resource services of every region of the Environment, if no regions are set the services themselves are returned
+func (base *services) servicesPerRegion() []regionalServices {
+	if len(base.regions) == 0 {
+		return []regionalServices{{services: base}}
+	}
+	return base.regions
+}
 
+// waitContext returns the context for waiting for the resources of a phase, which ends at the wait deadline. If the given context has a deadline, the
+// wait ends at the latest when only the time reserved for changing the remaining phases and writing the status is left.
+func waitContext(ctx context.Context, waitDeadline time.Time, remainingPhases int) (context.Context, context.CancelFunc) {
+	if deadline, ok := ctx.Deadline(); ok {
+		latest := deadline.Add(-reservedTime(remainingPhases))
+		if latest.Before(waitDeadline) {
+			waitDeadline = latest
 		}
-	} else {
-		log.Println("EC2 - No action required")
 	}
+	return context.WithDeadline(ctx, waitDeadline)
+}
 
+// validateTimeLeft returns an error, if the time left until the deadline of the context is below the time reserved for changing the given number of
+// phases and writing the status. Otherwise every wait would end immediately.
+func validateTimeLeft(ctx context.Context, phases int) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil
+	}
+	if left, reserved := time.Until(deadline), reservedTime(phases); left < reserved {
+		return fmt.Errorf("time left of %s is below the %s reserved for changing %d phases and writing the status, increase the timeout of the Lambda function",
+			left.Truncate(time.Second), reserved, phases)
+	}
 	return nil
 }
 
-func (base *services) changeRDSState(cwEvent types.Event) error {
-	clusterARN, clusterStatus, err := base.RDSModelAPI.GetRDSClusterForTags(cwEvent.Repository, cwEvent.Branch)
-	if err != nil {
-		return err
-	}
-	if clusterARN == nil {
-		// No matching cluster found, nothing to do
+// validateWaitTimeout returns an error, if the given wait timeout exceeds the time left until the deadline of the context after reserving the time for
+// changing the given number of phases and writing the status.
+func validateWaitTimeout(ctx context.Context, waitTimeout time.Duration, phases int) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
 		return nil
 	}
+	if available := time.Until(deadline) - reservedTime(phases); waitTimeout > available {
+		return fmt.Errorf("wait timeout of %s exceeds the available time of %s", waitTimeout, available.Truncate(time.Second))
+	}
+	return nil
+}
 
-	switch cwEvent.Action {
-	case "stop":
-		changed, err := base.RDSModelAPI.StopRDSCluster(clusterARN, clusterStatus)
+// reservedTime returns the time reserved before the deadline of the invocation for changing the given number of phases and writing the status
+func reservedTime(phases int) time.Duration {
+	return time.Duration(phases)*phaseHeadroom + statusHeadroom
+}
+
+// phasesForRegions returns the resource handlers returned by handlerFor for the schedulers of all regions grouped in the phases in which they are executed
+// for the action of the event, the handlers of all regions for the same phase are executed together.
+func phasesForRegions(regions []regionalServices, cwEvent types.Event, handlerFor func(scheduler ResourceScheduler) resourceHandler) ([][]resourceHandler, error) {
+	phases := [][]resourceHandler{}
+	for _, regional := range regions {
+		regionPhases, err := regional.phasesForEvent(cwEvent, handlerFor)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		if changed {
-			err := base.StatusModelAPI.SetStatusForEnvironment(cwEvent.Repository, cwEvent.Branch, "stopped")
-			if err != nil {
-				return err
+		for i, phase := range regionPhases {
+			if i == len(phases) {
+				phases = append(phases, []resourceHandler{})
+			}
+			for _, handler := range phase {
+				phases[i] = append(phases[i], regional.inRegion(handler))
 			}
 		}
+	}
+	return phases, nil
+}
 
-	case "start":
-		changed, err := base.RDSModelAPI.StartRDSCluster(clusterARN, clusterStatus)
-		if err != nil {
-			return err
+// inRegion returns a resource handler, which sets the region of the services on all changes of the given handler and prefixes its errors with the region
+func (regional regionalServices) inRegion(handler resourceHandler) resourceHandler {
+	if regional.region == "" {
+		return handler
+	}
+	return func(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+		changes, err := handler(ctx, cwEvent)
+		for i := range changes {
+			changes[i].Region = regional.region
+		}
+
+		var handlerErrs, errs types.MultiError
+		for _, handlerErr := range handlerErrs.Append(err) {
+			errs = errs.Append(fmt.Errorf("%s: %s", regional.region, handlerErr))
 		}
-		if changed {
-			err := base.StatusModelAPI.SetStatusForEnvironment(cwEvent.Repository, cwEvent.Branch, "running")
-			if err != nil {
-				return err
+		return changes, errs.ErrorOrNil()
+	}
+}
+
+// waitForRegions waits until the changed resources of all regions reached their final state for the given action, the changes of every region are
+// passed to the waitForChanges function of the services of the region. The new state of every resource, which reached its final state, gets updated
+// in the given changes.
+func waitForRegions(ctx context.Context, regions []regionalServices, action string, changes []types.ResourceChange) error {
+	var errs types.MultiError
+	for _, regional := range regions {
+		indexes := []int{}
+		regionChanges := []types.ResourceChange{}
+		for i, change := range changes {
+			if change.Region == regional.region {
+				indexes = append(indexes, i)
+				regionChanges = append(regionChanges, change)
 			}
 		}
+		if len(regionChanges) == 0 {
+			continue
+		}
+
+		errs = errs.Append(regional.waitForChanges(ctx, action, regionChanges))
+		for i, index := range indexes {
+			changes[index].NewState = regionChanges[i].NewState
+		}
 	}
+	return errs.ErrorOrNil()
+}
 
-	return nil
+// phasesForEvent returns the resource handlers returned by handlerFor for the schedulers grouped in the phases in which they are executed for the action
+// of the event. Resource types which aren't part of any phase are changed in the last phase.
+func (base *services) phasesForEvent(cwEvent types.Event, handlerFor func(scheduler ResourceScheduler) resourceHandler) ([][]resourceHandler, error) {
+	handlers := map[string]resourceHandler{}
+	for _, scheduler := range base.schedulers {
+		handlers[scheduler.Describe()] = handlerFor(scheduler)
+	}
+
+	resourceTypes := cwEvent.Phases
+	if len(resourceTypes) == 0 {
+		resourceTypes = defaultPhases
+	}
+
+	phases := [][]resourceHandler{}
+	for _, phaseResourceTypes := range resourceTypes {
+		phase := []resourceHandler{}
+		for _, resourceType := range phaseResourceTypes {
+			handler, ok := handlers[resourceType]
+			if !ok {
+				return nil, fmt.Errorf("unknown or duplicate resource type %q in phases", resourceType)
+			}
+			delete(handlers, resourceType)
+			phase = append(phase, handler)
+		}
+		if len(phase) > 0 {
+			phases = append(phases, phase)
+		}
+	}
+	for _, scheduler := range base.schedulers {
+		if handler, ok := handlers[scheduler.Describe()]; ok {
+			if len(phases) == 0 {
+				phases = append(phases, []resourceHandler{})
+			}
+			phases[len(phases)-1] = append(phases[len(phases)-1], handler)
+		}
+	}
+
+	if cwEvent.Action == "stop" {
+		for i, j := 0, len(phases)-1; i < j; i, j = i+1, j-1 {
+			phases[i], phases[j] = phases[j], phases[i]
+		}
+	}
+	return phases, nil
+}
+
+// waitForChanges waits until the changed resources reached their final state for the given action, the changes are passed to the Wait function of every
+// resource scheduler. The new state of every resource, which reached its final state, gets updated in the given changes.
+func (base *services) waitForChanges(ctx context.Context, action string, changes []types.ResourceChange) error {
+	var errs types.MultiError
+	for _, scheduler := range base.schedulers {
+		errs = errs.Append(scheduler.Wait(ctx, action, changes))
+	}
+	return errs.ErrorOrNil()
+}
+
+// resourceHandler changes the state of all resources of one resource type
+type resourceHandler func(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error)
+
+type handlerResult struct {
+	changes []types.ResourceChange
+	err     error
+}
+
+// runHandlers runs the handlers in a pool of at most maxConcurrentHandlers goroutines and returns their results in the order of the handlers.
+// The context is passed to the handlers, handlers which weren't started before the context got cancelled aren't run, their result contains the context error.
+func runHandlers(ctx context.Context, cwEvent types.Event, handlers []resourceHandler) []handlerResult {
+	results := make([]handlerResult, len(handlers))
+	jobs := make(chan int)
+
+	workers := maxConcurrentHandlers
+	if len(handlers) < workers {
+		workers = len(handlers)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				if err := ctx.Err(); err != nil {
+					results[job] = handlerResult{err: err}
+					continue
+				}
+				changes, err := handlers[job](ctx, cwEvent)
+				results[job] = handlerResult{changes: changes, err: err}
+			}
+		}()
+	}
+
+	for job := range handlers {
+		jobs <- job
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// statusForAction returns the Environment status written after the given action was executed.
+func statusForAction(action string) string {
+	if action == "stop" {
+		return "stopped"
+	}
+	return "running"
+}
+
+// failedStatusForAction returns the Environment status written if the given action failed for at least one resource.
+func failedStatusForAction(action string) string {
+	if action == "stop" {
+		return "stop failed"
+	}
+	return "start failed"
+}
+
+// transitionStateForAction returns the state EC2 Instances and RDS resources change to when the given action is executed.
+func transitionStateForAction(action string) string {
+	if action == "stop" {
+		return "stopping"
+	}
+	return "starting"
 }
@@ -1,10 +1,26 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/sts"
 
 	"github.com/auto-staging/scheduler/mocks"
 	"github.com/auto-staging/scheduler/types"
@@ -23,657 +39,1558 @@ func TestVersionReturn(t *testing.T) {
 	assert.Equal(t, "{\"name\":\"scheduler\",\"version\":\"\",\"commitHash\":\"\",\"branch\":\"\",\"buildTime\":\"\"}", result)
 }
 
-//
-// EC2 Tests
-//
+// newECSService returns an ECS service with the given ARN and desired count
+const testECSServiceARN = "arn:aws:ecs:eu-central-1:123456789012:service/test-cluster/test-service"
 
-func TestChangeEC2StateStart(t *testing.T) {
-	instanceIDs := []*string{
-		aws.String("i-1234567890abcdef0"),
-		aws.String("i-1234567890abcdef1"),
-	}
+const testNodegroupARN = "arn:aws:eks:eu-central-1:123456789012:nodegroup/test-cluster/test-nodegroup/1ab2c3d4-5e6f-7a8b-9c0d-1e2f3a4b5c6d"
 
+// newNodegroup returns an EKS node group with the given ARN and scaling config
+func TestChangeStateResult(t *testing.T) {
 	cwEvent := types.Event{
-		Action:     "start",
+		Action:     "stop",
 		Branch:     "branch",
 		Repository: "repo",
 	}
+	instanceIDs := []*string{aws.String("i-1234567890abcdef0")}
+
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{
+		newAutoscalingGroup(aws.String("test-asg"), 0),
+	}, []*autoscaling.Group{}, nil)
 
 	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
-	svcEC2ModelAPI.On("DescribeInstancesForTagsAndAction", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(instanceIDs, nil)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(newEC2Instances("running", instanceIDs), []*ec2.Instance{}, nil)
+	svcEC2ModelAPI.On("StopEC2Instances", mock.Anything, mock.AnythingOfType("[]*string")).Return(newInstanceStateChanges("running", "stopping", instanceIDs), nil)
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, nil)
+
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{}, []*ecs.Service{}, nil)
+
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcEKSModelAPI.On("DescribeNodegroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*eks.Nodegroup{}, []*eks.Nodegroup{}, nil)
+
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcRedshiftModelAPI.On("GetRedshiftClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*redshift.Cluster{}, []*redshift.Cluster{}, nil)
+
+	svcDocDBModelAPI := new(mocks.DocDBModelAPI)
+	svcDocDBModelAPI.On("GetDocDBClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*docdb.DBCluster{}, []*docdb.DBCluster{}, nil)
 
-	svcEC2ModelAPI.On("StartEC2Instances", mock.AnythingOfType("[]*string")).Return(nil)
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
+	svcElastiCacheModelAPI.On("GetReplicationGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, nil)
+	svcElastiCacheModelAPI.On("GetReplicationGroupSnapshotsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.Snapshot{}, nil)
 
 	svcStatusModelAPI := new(mocks.StatusModelAPI)
+	svcStatusModelAPI.On("AddHistoryEntry", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("types.HistoryEntry")).Return(nil)
 	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
 
 	base := services{
-		EC2ModelAPI:    svcEC2ModelAPI,
 		StatusModelAPI: svcStatusModelAPI,
+		schedulers: []ResourceScheduler{
+			&asgScheduler{ASGModelAPI: svcASGModelAPI},
+			&ec2Scheduler{EC2ModelAPI: svcEC2ModelAPI},
+			&ecsScheduler{ECSModelAPI: svcECSModelAPI},
+			&eksScheduler{EKSModelAPI: svcEKSModelAPI},
+			&rdsScheduler{RDSModelAPI: svcRDSModelAPI},
+			&redshiftScheduler{RedshiftModelAPI: svcRedshiftModelAPI},
+			&docdbScheduler{DocDBModelAPI: svcDocDBModelAPI},
+			&elastiCacheScheduler{ElastiCacheModelAPI: svcElastiCacheModelAPI},
+		},
 	}
 
-	err := base.changeEC2State(cwEvent)
+	result, err := base.changeState(context.Background(), cwEvent)
 
 	assert.Nil(t, err, "Expected no error")
-	svcEC2ModelAPI.AssertCalled(t, "StartEC2Instances", instanceIDs)
-	svcStatusModelAPI.AssertCalled(t, "SetStatusForEnvironment", cwEvent.Repository, cwEvent.Branch, "running")
+	assert.JSONEq(t, `{
+		"dryRun": false,
+		"action": "stop",
+		"resources": [
+			{"kind": "autoscaling-group", "identifier": "test-asg", "action": "stop", "previousState": "min=0 max=0 desired=0", "skippedReason": "max size is 0"},
+			{"kind": "ec2-instance", "identifier": "i-1234567890abcdef0", "action": "stop", "previousState": "running", "newState": "stopping"}
+		],
+		"status": "stopped"
+	}`, result)
+	svcASGModelAPI.AssertNotCalled(t, "SetASGSizeToZero", mock.Anything, mock.Anything)
+	svcStatusModelAPI.AssertCalled(t, "SetStatusForEnvironment", cwEvent.Repository, cwEvent.Branch, "stopped")
 }
 
-func TestChangeEC2StateStop(t *testing.T) {
-	instanceIDs := []*string{
-		aws.String("i-1234567890abcdef0"),
-		aws.String("i-1234567890abcdef1"),
-	}
-
-	cwEvent := types.Event{
-		Action:     "stop",
-		Branch:     "branch",
-		Repository: "repo",
-	}
+func TestChangeStateResultNothingMatched(t *testing.T) {
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{}, []*autoscaling.Group{}, nil)
 
 	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
-	svcEC2ModelAPI.On("DescribeInstancesForTagsAndAction", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(instanceIDs, nil)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ec2.Instance{}, []*ec2.Instance{}, nil)
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, nil)
 
-	svcEC2ModelAPI.On("StopEC2Instances", mock.AnythingOfType("[]*string")).Return(nil)
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{}, []*ecs.Service{}, nil)
+
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcEKSModelAPI.On("DescribeNodegroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*eks.Nodegroup{}, []*eks.Nodegroup{}, nil)
+
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcRedshiftModelAPI.On("GetRedshiftClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*redshift.Cluster{}, []*redshift.Cluster{}, nil)
+
+	svcDocDBModelAPI := new(mocks.DocDBModelAPI)
+	svcDocDBModelAPI.On("GetDocDBClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*docdb.DBCluster{}, []*docdb.DBCluster{}, nil)
+
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
+	svcElastiCacheModelAPI.On("GetReplicationGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, nil)
+	svcElastiCacheModelAPI.On("GetReplicationGroupSnapshotsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.Snapshot{}, nil)
 
 	svcStatusModelAPI := new(mocks.StatusModelAPI)
+	svcStatusModelAPI.On("AddHistoryEntry", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("types.HistoryEntry")).Return(nil)
 	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
 
 	base := services{
-		EC2ModelAPI:    svcEC2ModelAPI,
 		StatusModelAPI: svcStatusModelAPI,
+		schedulers: []ResourceScheduler{
+			&asgScheduler{ASGModelAPI: svcASGModelAPI},
+			&ec2Scheduler{EC2ModelAPI: svcEC2ModelAPI},
+			&ecsScheduler{ECSModelAPI: svcECSModelAPI},
+			&eksScheduler{EKSModelAPI: svcEKSModelAPI},
+			&rdsScheduler{RDSModelAPI: svcRDSModelAPI},
+			&redshiftScheduler{RedshiftModelAPI: svcRedshiftModelAPI},
+			&docdbScheduler{DocDBModelAPI: svcDocDBModelAPI},
+			&elastiCacheScheduler{ElastiCacheModelAPI: svcElastiCacheModelAPI},
+		},
 	}
 
-	err := base.changeEC2State(cwEvent)
+	result, err := base.changeState(context.Background(), types.Event{Action: "start"})
 
 	assert.Nil(t, err, "Expected no error")
-	svcEC2ModelAPI.AssertCalled(t, "StopEC2Instances", instanceIDs)
-	svcStatusModelAPI.AssertCalled(t, "SetStatusForEnvironment", cwEvent.Repository, cwEvent.Branch, "stopped")
+	assert.JSONEq(t, `{"dryRun": false, "action": "start", "resources": []}`, result)
+	svcStatusModelAPI.AssertNotCalled(t, "SetStatusForEnvironment", mock.Anything, mock.Anything, mock.Anything)
+	svcStatusModelAPI.AssertNotCalled(t, "AddHistoryEntry", mock.Anything, mock.Anything, mock.Anything)
 }
 
-func TestChangeEC2StateNoInstances(t *testing.T) {
-	cwEvent := types.Event{
-		Action:     "stop",
-		Branch:     "branch",
-		Repository: "repo",
-	}
+func TestChangeStateResultNothingToChange(t *testing.T) {
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{}, []*autoscaling.Group{}, nil)
 
 	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
-	svcEC2ModelAPI.On("DescribeInstancesForTagsAndAction", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*string{}, nil)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(newEC2Instances("running", []*string{aws.String("i-1234567890abcdef0")}), []*ec2.Instance{}, nil)
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, nil)
+
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{}, []*ecs.Service{}, nil)
+
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcEKSModelAPI.On("DescribeNodegroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*eks.Nodegroup{}, []*eks.Nodegroup{}, nil)
+
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcRedshiftModelAPI.On("GetRedshiftClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*redshift.Cluster{}, []*redshift.Cluster{}, nil)
+
+	svcDocDBModelAPI := new(mocks.DocDBModelAPI)
+	svcDocDBModelAPI.On("GetDocDBClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*docdb.DBCluster{}, []*docdb.DBCluster{}, nil)
+
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
+	svcElastiCacheModelAPI.On("GetReplicationGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, nil)
+	svcElastiCacheModelAPI.On("GetReplicationGroupSnapshotsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.Snapshot{}, nil)
+
+	svcStatusModelAPI := new(mocks.StatusModelAPI)
+	svcStatusModelAPI.On("AddHistoryEntry", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("types.HistoryEntry")).Return(nil)
+	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
 
 	base := services{
-		EC2ModelAPI: svcEC2ModelAPI,
+		StatusModelAPI: svcStatusModelAPI,
+		schedulers: []ResourceScheduler{
+			&asgScheduler{ASGModelAPI: svcASGModelAPI},
+			&ec2Scheduler{EC2ModelAPI: svcEC2ModelAPI},
+			&ecsScheduler{ECSModelAPI: svcECSModelAPI},
+			&eksScheduler{EKSModelAPI: svcEKSModelAPI},
+			&rdsScheduler{RDSModelAPI: svcRDSModelAPI},
+			&redshiftScheduler{RedshiftModelAPI: svcRedshiftModelAPI},
+			&docdbScheduler{DocDBModelAPI: svcDocDBModelAPI},
+			&elastiCacheScheduler{ElastiCacheModelAPI: svcElastiCacheModelAPI},
+		},
 	}
 
-	err := base.changeEC2State(cwEvent)
+	result, err := base.changeState(context.Background(), types.Event{Action: "start"})
 
 	assert.Nil(t, err, "Expected no error")
-	svcEC2ModelAPI.AssertCalled(t, "DescribeInstancesForTagsAndAction", cwEvent.Repository, cwEvent.Branch, cwEvent.Action)
+	assert.JSONEq(t, `{
+		"dryRun": false,
+		"action": "start",
+		"resources": [
+			{"kind": "ec2-instance", "identifier": "i-1234567890abcdef0", "action": "start", "previousState": "running", "skippedReason": "instance is running"}
+		]
+	}`, result)
+	svcStatusModelAPI.AssertNotCalled(t, "SetStatusForEnvironment", mock.Anything, mock.Anything, mock.Anything)
+	svcStatusModelAPI.AssertNotCalled(t, "AddHistoryEntry", mock.Anything, mock.Anything, mock.Anything)
 }
 
-func TestChangeEC2StateDescribeError(t *testing.T) {
-	errorMsg := errors.New("Test error")
-	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
-	svcEC2ModelAPI.On("DescribeInstancesForTagsAndAction", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*string{}, errorMsg)
+//
+// Concurrency Tests
+//
 
-	base := services{
-		EC2ModelAPI: svcEC2ModelAPI,
+func TestRunHandlersKeepsOrder(t *testing.T) {
+	errorMsg := errors.New("Test error")
+	handlers := []resourceHandler{
+		func(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+			time.Sleep(20 * time.Millisecond)
+			return []types.ResourceChange{{Kind: "autoscaling-group", Action: cwEvent.Action}}, nil
+		},
+		func(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+			return []types.ResourceChange{}, errorMsg
+		},
+		func(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+			return []types.ResourceChange{{Kind: "rds-cluster", Action: cwEvent.Action}}, nil
+		},
 	}
 
-	err := base.changeEC2State(types.Event{})
+	results := runHandlers(context.Background(), types.Event{Action: "stop"}, handlers)
 
-	assert.Error(t, err, "Expected error")
-	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+	assert.Len(t, results, 3, "Expected a result for every handler")
+	assert.Equal(t, "autoscaling-group", results[0].changes[0].Kind)
+	assert.Equal(t, "stop", results[0].changes[0].Action)
+	assert.Equal(t, errorMsg, results[1].err, "Error didn't match given error")
+	assert.Equal(t, "rds-cluster", results[2].changes[0].Kind)
 }
 
-func TestChangeEC2StateStopError(t *testing.T) {
-	errorMsg := errors.New("Test error")
-
-	instanceIDs := []*string{
-		aws.String("i-1234567890abcdef0"),
-		aws.String("i-1234567890abcdef1"),
+func TestRunHandlersBoundedConcurrency(t *testing.T) {
+	defer func(previous int) { maxConcurrentHandlers = previous }(maxConcurrentHandlers)
+	maxConcurrentHandlers = 2
+
+	var running, maxRunning int32
+	handler := func(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			previous := atomic.LoadInt32(&maxRunning)
+			if current <= previous || atomic.CompareAndSwapInt32(&maxRunning, previous, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return []types.ResourceChange{}, nil
 	}
 
-	cwEvent := types.Event{
-		Action:     "stop",
-		Branch:     "branch",
-		Repository: "repo",
-	}
+	results := runHandlers(context.Background(), types.Event{}, []resourceHandler{handler, handler, handler, handler, handler})
 
-	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
-	svcEC2ModelAPI.On("DescribeInstancesForTagsAndAction", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(instanceIDs, nil)
+	assert.Len(t, results, 5, "Expected a result for every handler")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&maxRunning), "Expected at most two concurrent handlers")
+}
 
-	svcEC2ModelAPI.On("StopEC2Instances", mock.AnythingOfType("[]*string")).Return(errorMsg)
+func TestRunHandlersCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
 
-	base := services{
-		EC2ModelAPI: svcEC2ModelAPI,
+	var calls int32
+	handler := func(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+		atomic.AddInt32(&calls, 1)
+		return []types.ResourceChange{}, nil
 	}
 
-	err := base.changeEC2State(cwEvent)
+	results := runHandlers(ctx, types.Event{}, []resourceHandler{handler, handler})
 
-	assert.Error(t, err, "Expected error")
-	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls), "Expected no handler to run")
+	assert.Equal(t, context.Canceled, results[0].err)
+	assert.Equal(t, context.Canceled, results[1].err)
 }
 
-func TestChangeEC2StateStopStatusError(t *testing.T) {
-	errorMsg := errors.New("Test error")
+func TestRunHandlersPassesContext(t *testing.T) {
+	type contextKey struct{}
+	ctx := context.WithValue(context.Background(), contextKey{}, "invocation")
 
-	instanceIDs := []*string{
-		aws.String("i-1234567890abcdef0"),
-		aws.String("i-1234567890abcdef1"),
+	handler := func(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+		return []types.ResourceChange{{Identifier: ctx.Value(contextKey{}).(string)}}, nil
 	}
 
+	results := runHandlers(ctx, types.Event{}, []resourceHandler{handler})
+
+	assert.Equal(t, "invocation", results[0].changes[0].Identifier, "Expected the handler to get the context")
+}
+
+func TestChangeStateConcurrent(t *testing.T) {
+	instanceIDs := []*string{aws.String("i-1234567890abcdef0")}
+
 	cwEvent := types.Event{
-		Action:     "stop",
+		Action:     "start",
 		Branch:     "branch",
 		Repository: "repo",
 	}
 
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{
+		newAutoscalingGroup(aws.String("test-asg"), 0),
+	}, []*autoscaling.Group{}, nil)
+	svcASGModelAPI.On("SetASGSizeToPreviousValue", mock.Anything, mock.AnythingOfType("*string")).Return(&types.ASGSize{MinSize: 2, MaxSize: 4, DesiredCapacity: 2}, nil)
+
 	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
-	svcEC2ModelAPI.On("DescribeInstancesForTagsAndAction", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(instanceIDs, nil)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(newEC2Instances("stopped", instanceIDs), []*ec2.Instance{}, nil)
+	svcEC2ModelAPI.On("StartEC2Instances", mock.Anything, mock.AnythingOfType("[]*string")).Return(newInstanceStateChanges("stopped", "pending", instanceIDs), nil)
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{
+		&rds.DBCluster{
+			DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db"),
+			Status:       aws.String("stopped"),
+		},
+	}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("StartRDSCluster", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(true, nil)
+	svcRDSModelAPI.On("WaitUntilRDSClusterAvailable", mock.Anything, mock.AnythingOfType("*string")).Return(nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, nil)
+
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{}, []*ecs.Service{}, nil)
+
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcEKSModelAPI.On("DescribeNodegroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*eks.Nodegroup{}, []*eks.Nodegroup{}, nil)
+
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcRedshiftModelAPI.On("GetRedshiftClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*redshift.Cluster{}, []*redshift.Cluster{}, nil)
 
-	svcEC2ModelAPI.On("StopEC2Instances", mock.AnythingOfType("[]*string")).Return(nil)
+	svcDocDBModelAPI := new(mocks.DocDBModelAPI)
+	svcDocDBModelAPI.On("GetDocDBClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*docdb.DBCluster{}, []*docdb.DBCluster{}, nil)
+
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
+	svcElastiCacheModelAPI.On("GetReplicationGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, nil)
+	svcElastiCacheModelAPI.On("GetReplicationGroupSnapshotsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.Snapshot{}, nil)
 
 	svcStatusModelAPI := new(mocks.StatusModelAPI)
-	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(errorMsg)
+	svcStatusModelAPI.On("AddHistoryEntry", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("types.HistoryEntry")).Return(nil)
+	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
 
 	base := services{
-		EC2ModelAPI:    svcEC2ModelAPI,
 		StatusModelAPI: svcStatusModelAPI,
+		schedulers: []ResourceScheduler{
+			&asgScheduler{ASGModelAPI: svcASGModelAPI},
+			&ec2Scheduler{EC2ModelAPI: svcEC2ModelAPI},
+			&ecsScheduler{ECSModelAPI: svcECSModelAPI},
+			&eksScheduler{EKSModelAPI: svcEKSModelAPI},
+			&rdsScheduler{RDSModelAPI: svcRDSModelAPI},
+			&redshiftScheduler{RedshiftModelAPI: svcRedshiftModelAPI},
+			&docdbScheduler{DocDBModelAPI: svcDocDBModelAPI},
+			&elastiCacheScheduler{ElastiCacheModelAPI: svcElastiCacheModelAPI},
+		},
 	}
 
-	err := base.changeEC2State(cwEvent)
+	result, err := base.changeState(context.Background(), cwEvent)
 
-	assert.Error(t, err, "Expected error")
-	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+	assert.Nil(t, err, "Expected no error")
+	assert.JSONEq(t, `{
+		"dryRun": false,
+		"action": "start",
+		"resources": [
+			{"kind": "rds-cluster", "identifier": "arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db", "action": "start", "previousState": "stopped", "newState": "available"},
+			{"kind": "autoscaling-group", "identifier": "test-asg", "action": "start", "previousState": "min=0 max=0 desired=0", "newState": "min=2 max=4 desired=2"},
+			{"kind": "ec2-instance", "identifier": "i-1234567890abcdef0", "action": "start", "previousState": "stopped", "newState": "pending"}
+		],
+		"status": "running"
+	}`, result)
+	svcStatusModelAPI.AssertNumberOfCalls(t, "SetStatusForEnvironment", 2)
+	svcStatusModelAPI.AssertCalled(t, "SetStatusForEnvironment", cwEvent.Repository, cwEvent.Branch, "starting")
+	svcStatusModelAPI.AssertCalled(t, "SetStatusForEnvironment", cwEvent.Repository, cwEvent.Branch, "running")
 }
 
-func TestChangeEC2StateStartError(t *testing.T) {
-	errorMsg := errors.New("Test error")
-
-	instanceIDs := []*string{
-		aws.String("i-1234567890abcdef0"),
-		aws.String("i-1234567890abcdef1"),
-	}
-
-	cwEvent := types.Event{
-		Action:     "start",
-		Branch:     "branch",
-		Repository: "repo",
-	}
+func TestChangeStateCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
 
+	svcASGModelAPI := new(mocks.ASGModelAPI)
 	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
-	svcEC2ModelAPI.On("DescribeInstancesForTagsAndAction", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(instanceIDs, nil)
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcDocDBModelAPI := new(mocks.DocDBModelAPI)
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
 
-	svcEC2ModelAPI.On("StartEC2Instances", mock.AnythingOfType("[]*string")).Return(errorMsg)
+	svcStatusModelAPI := new(mocks.StatusModelAPI)
+	svcStatusModelAPI.On("AddHistoryEntry", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("types.HistoryEntry")).Return(nil)
+	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	svcStatusModelAPI.On("SetFailedStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
 
 	base := services{
-		EC2ModelAPI: svcEC2ModelAPI,
+		StatusModelAPI: svcStatusModelAPI,
+		schedulers: []ResourceScheduler{
+			&asgScheduler{ASGModelAPI: svcASGModelAPI},
+			&ec2Scheduler{EC2ModelAPI: svcEC2ModelAPI},
+			&ecsScheduler{ECSModelAPI: svcECSModelAPI},
+			&eksScheduler{EKSModelAPI: svcEKSModelAPI},
+			&rdsScheduler{RDSModelAPI: svcRDSModelAPI},
+			&redshiftScheduler{RedshiftModelAPI: svcRedshiftModelAPI},
+			&docdbScheduler{DocDBModelAPI: svcDocDBModelAPI},
+			&elastiCacheScheduler{ElastiCacheModelAPI: svcElastiCacheModelAPI},
+		},
 	}
 
-	err := base.changeEC2State(cwEvent)
-
-	assert.Error(t, err, "Expected error")
-	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+	result, err := base.changeState(ctx, types.Event{Action: "stop"})
+
+	assert.Nil(t, err, "Expected error to be part of the result")
+	assert.JSONEq(t, `{"dryRun": false, "action": "stop", "resources": [], "status": "stop failed", "errors": ["context canceled", "context canceled", "context canceled", "context canceled", "context canceled", "context canceled", "context canceled", "context canceled"]}`, result)
+	svcASGModelAPI.AssertNotCalled(t, "DescribeAutoScalingGroupsForTags", mock.Anything, mock.Anything, mock.Anything)
+	svcEC2ModelAPI.AssertNotCalled(t, "DescribeInstancesForTags", mock.Anything, mock.Anything, mock.Anything)
+	svcRDSModelAPI.AssertNotCalled(t, "GetRDSClustersForTags", mock.Anything, mock.Anything, mock.Anything)
+	svcECSModelAPI.AssertNotCalled(t, "DescribeServicesForTags", mock.Anything, mock.Anything, mock.Anything)
+	svcEKSModelAPI.AssertNotCalled(t, "DescribeNodegroupsForTags", mock.Anything, mock.Anything, mock.Anything)
+	svcRedshiftModelAPI.AssertNotCalled(t, "GetRedshiftClustersForTags", mock.Anything, mock.Anything, mock.Anything)
+	svcDocDBModelAPI.AssertNotCalled(t, "GetDocDBClustersForTags", mock.Anything, mock.Anything, mock.Anything)
+	svcElastiCacheModelAPI.AssertNotCalled(t, "GetReplicationGroupsForTags", mock.Anything, mock.Anything, mock.Anything)
+	svcStatusModelAPI.AssertCalled(t, "SetFailedStatusForEnvironment", "", "", "stop failed", "context canceled; context canceled; context canceled; context canceled; context canceled; context canceled; context canceled; context canceled")
 }
 
-func TestChangeEC2StateStartStatusError(t *testing.T) {
-	errorMsg := errors.New("Test error")
+//
+// Phase Tests
+//
 
-	instanceIDs := []*string{
-		aws.String("i-1234567890abcdef0"),
-		aws.String("i-1234567890abcdef1"),
+// callRecorder records the order of mock calls made from concurrent handlers
+type callRecorder struct {
+	sync.Mutex
+	calls []string
+}
+
+func (recorder *callRecorder) record(name string) func(mock.Arguments) {
+	return func(mock.Arguments) {
+		recorder.Lock()
+		defer recorder.Unlock()
+		recorder.calls = append(recorder.calls, name)
 	}
+}
 
-	cwEvent := types.Event{
-		Action:     "start",
-		Branch:     "branch",
-		Repository: "repo",
+// newPhaseServices returns services with one ASG, one EC2 Instance and one RDS Cluster, which need to be changed for the given action.
+// All calls which change resources, wait for them or write the status are recorded. Waiting for resources returns the given error.
+func newPhaseServices(action string, recorder *callRecorder, waitErr error) services {
+	instanceIDs := []*string{aws.String("i-1234567890abcdef0")}
+	minSize, instanceState, clusterStatus := int64(2), "running", "available"
+	if action == "start" {
+		minSize, instanceState, clusterStatus = 0, "stopped", "stopped"
 	}
+	size := &types.ASGSize{MinSize: 2, MaxSize: 4, DesiredCapacity: 2}
+
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{
+		newAutoscalingGroup(aws.String("test-asg"), minSize),
+	}, []*autoscaling.Group{}, nil)
+	svcASGModelAPI.On("SetASGSizeToPreviousValue", mock.Anything, mock.AnythingOfType("*string")).Run(recorder.record("asg")).Return(size, nil)
+	svcASGModelAPI.On("SetASGSizeToZero", mock.Anything, mock.AnythingOfType("*string")).Run(recorder.record("asg")).Return(size, nil)
+	svcASGModelAPI.On("GetPreviousSizeOfASG", mock.Anything, mock.AnythingOfType("*string")).Return(size, nil)
+	svcASGModelAPI.On("WaitUntilASGInService", mock.Anything, mock.AnythingOfType("*string")).Run(recorder.record("wait-asg")).Return(waitErr)
 
 	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
-	svcEC2ModelAPI.On("DescribeInstancesForTagsAndAction", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(instanceIDs, nil)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(newEC2Instances(instanceState, instanceIDs), []*ec2.Instance{}, nil)
+	svcEC2ModelAPI.On("StartEC2Instances", mock.Anything, mock.AnythingOfType("[]*string")).Run(recorder.record("ec2")).Return(newInstanceStateChanges("stopped", "pending", instanceIDs), nil)
+	svcEC2ModelAPI.On("StopEC2Instances", mock.Anything, mock.AnythingOfType("[]*string")).Run(recorder.record("ec2")).Return(newInstanceStateChanges("running", "stopping", instanceIDs), nil)
+	svcEC2ModelAPI.On("WaitUntilEC2InstancesRunning", mock.Anything, mock.AnythingOfType("[]*string")).Run(recorder.record("wait-ec2")).Return(waitErr)
+	svcEC2ModelAPI.On("WaitUntilEC2InstancesStopped", mock.Anything, mock.AnythingOfType("[]*string")).Run(recorder.record("wait-ec2")).Return(waitErr)
 
-	svcEC2ModelAPI.On("StartEC2Instances", mock.AnythingOfType("[]*string")).Return(nil)
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{
+		&rds.DBCluster{
+			DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db"),
+			Status:       aws.String(clusterStatus),
+		},
+	}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, nil)
+	svcRDSModelAPI.On("StartRDSCluster", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Run(recorder.record("rds")).Return(true, nil)
+	svcRDSModelAPI.On("StopRDSCluster", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Run(recorder.record("rds")).Return(true, nil)
+	svcRDSModelAPI.On("WaitUntilRDSClusterAvailable", mock.Anything, mock.AnythingOfType("*string")).Run(recorder.record("wait-rds")).Return(waitErr)
+	svcRDSModelAPI.On("WaitUntilRDSClusterStopped", mock.Anything, mock.AnythingOfType("*string")).Run(recorder.record("wait-rds")).Return(waitErr)
+
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{}, []*ecs.Service{}, nil)
+
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcEKSModelAPI.On("DescribeNodegroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*eks.Nodegroup{}, []*eks.Nodegroup{}, nil)
+
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcRedshiftModelAPI.On("GetRedshiftClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*redshift.Cluster{}, []*redshift.Cluster{}, nil)
+
+	svcDocDBModelAPI := new(mocks.DocDBModelAPI)
+	svcDocDBModelAPI.On("GetDocDBClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*docdb.DBCluster{}, []*docdb.DBCluster{}, nil)
+
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
+	svcElastiCacheModelAPI.On("GetReplicationGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, nil)
+	svcElastiCacheModelAPI.On("GetReplicationGroupSnapshotsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.Snapshot{}, nil)
 
 	svcStatusModelAPI := new(mocks.StatusModelAPI)
-	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(errorMsg)
-
-	base := services{
-		EC2ModelAPI:    svcEC2ModelAPI,
+	svcStatusModelAPI.On("AddHistoryEntry", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("types.HistoryEntry")).Return(nil)
+	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Run(func(args mock.Arguments) {
+		recorder.record("status " + args.String(2))(args)
+	}).Return(nil)
+	svcStatusModelAPI.On("SetFailedStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Run(func(args mock.Arguments) {
+		recorder.record("status " + args.String(2) + ": " + args.String(3))(args)
+	}).Return(nil)
+
+	return services{
 		StatusModelAPI: svcStatusModelAPI,
+		schedulers: []ResourceScheduler{
+			&asgScheduler{ASGModelAPI: svcASGModelAPI},
+			&ec2Scheduler{EC2ModelAPI: svcEC2ModelAPI},
+			&ecsScheduler{ECSModelAPI: svcECSModelAPI},
+			&eksScheduler{EKSModelAPI: svcEKSModelAPI},
+			&rdsScheduler{RDSModelAPI: svcRDSModelAPI},
+			&redshiftScheduler{RedshiftModelAPI: svcRedshiftModelAPI},
+			&docdbScheduler{DocDBModelAPI: svcDocDBModelAPI},
+			&elastiCacheScheduler{ElastiCacheModelAPI: svcElastiCacheModelAPI},
+		},
+	}
+}
+
+// schedulerOfType returns the scheduler of the given services for the given resource type
+func schedulerOfType(base services, resourceType string) ResourceScheduler {
+	for _, scheduler := range base.schedulers {
+		if scheduler.Describe() == resourceType {
+			return scheduler
+		}
 	}
+	return nil
+}
+
+// rdsModelOf returns the RDS model mock of services created by newPhaseServices
+func rdsModelOf(base services) *mocks.RDSModelAPI {
+	return schedulerOfType(base, "rds").(*rdsScheduler).RDSModelAPI.(*mocks.RDSModelAPI)
+}
+
+func TestChangeStateStartWaitsForRDS(t *testing.T) {
+	recorder := &callRecorder{}
+	base := newPhaseServices("start", recorder, nil)
+
+	_, err := base.changeState(context.Background(), types.Event{Action: "start"})
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, recorder.calls, 6, "Expected every resource to be started")
+	assert.Equal(t, []string{"status starting", "rds", "wait-rds"}, recorder.calls[:3], "Expected RDS to be available before the next phase")
+	assert.ElementsMatch(t, []string{"asg", "ec2"}, recorder.calls[3:5])
+	assert.Equal(t, "status running", recorder.calls[5])
+}
+
+func TestChangeStateStopReverseOrder(t *testing.T) {
+	recorder := &callRecorder{}
+	base := newPhaseServices("stop", recorder, nil)
+
+	_, err := base.changeState(context.Background(), types.Event{Action: "stop"})
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, 5, len(recorder.calls), "Expected every resource to be stopped without waiting")
+	assert.Equal(t, "status stopping", recorder.calls[0], "Expected transition status before any change")
+	assert.ElementsMatch(t, []string{"asg", "ec2"}, recorder.calls[1:3])
+	assert.Equal(t, "rds", recorder.calls[3], "Expected RDS to be stopped last")
+	assert.Equal(t, "status stopped", recorder.calls[4])
+}
 
-	err := base.changeEC2State(cwEvent)
+func TestChangeStateCustomPhases(t *testing.T) {
+	recorder := &callRecorder{}
+	base := newPhaseServices("start", recorder, nil)
 
+	_, err := base.changeState(context.Background(), types.Event{
+		Action: "start",
+		Phases: [][]string{{"ec2"}, {"rds"}},
+	})
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []string{"status starting", "ec2", "wait-ec2"}, recorder.calls[:3], "Expected EC2 to be started first")
+	assert.ElementsMatch(t, []string{"rds", "asg", "status running"}, recorder.calls[3:], "Expected unlisted ASG to be started in the last phase without waiting")
+}
+
+func TestChangeStateStartWaitError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+	recorder := &callRecorder{}
+	base := newPhaseServices("start", recorder, errorMsg)
+
+	result, err := base.changeState(context.Background(), types.Event{Action: "start"})
+
+	assert.Nil(t, err, "Expected error to be part of the result")
+	assert.Equal(t, []string{"status starting", "rds", "wait-rds", "status start failed: Test error"}, recorder.calls, "Expected the next phase to be skipped")
+	assert.JSONEq(t, `{
+		"dryRun": false,
+		"action": "start",
+		"resources": [
+			{"kind": "rds-cluster", "identifier": "arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db", "action": "start", "previousState": "stopped", "newState": "starting"},
+			{"kind": "autoscaling-group", "identifier": "test-asg", "action": "start", "previousState": "min=0 max=0 desired=0", "skippedReason": "waiting for the previous phase failed"},
+			{"kind": "ec2-instance", "identifier": "i-1234567890abcdef0", "action": "start", "previousState": "stopped", "skippedReason": "waiting for the previous phase failed"}
+		],
+		"status": "start failed",
+		"errors": ["Test error"]
+	}`, result)
+}
+
+func TestWaitContext(t *testing.T) {
+	deadline := time.Now().Add(5 * time.Minute)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	waitDeadline := time.Now().Add(time.Minute)
+	waitCtx, waitCancel := waitContext(ctx, waitDeadline, 1)
+	actual, _ := waitCtx.Deadline()
+	waitCancel()
+	assert.Equal(t, waitDeadline, actual, "Expected the wait deadline to end the wait")
+
+	waitCtx, waitCancel = waitContext(ctx, time.Now().Add(10*time.Minute), 1)
+	actual, _ = waitCtx.Deadline()
+	waitCancel()
+	assert.Equal(t, deadline.Add(-phaseHeadroom-statusHeadroom), actual, "Expected time to be reserved for the remaining phase and the status")
+
+	waitDeadline = time.Now().Add(10 * time.Minute)
+	waitCtx, waitCancel = waitContext(context.Background(), waitDeadline, 1)
+	actual, _ = waitCtx.Deadline()
+	waitCancel()
+	assert.Equal(t, waitDeadline, actual, "Expected the wait deadline without a deadline of the invocation")
+}
+
+func TestValidateWaitTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Minute)
+	defer cancel()
+
+	assert.Nil(t, validateWaitTimeout(ctx, 10*time.Minute, 2), "Expected no error")
+	assert.Nil(t, validateWaitTimeout(context.Background(), time.Hour, 2), "Expected no error without a deadline")
+
+	err := validateWaitTimeout(ctx, 15*time.Minute, 2)
 	assert.Error(t, err, "Expected error")
-	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+	assert.Contains(t, err.Error(), "wait timeout of 15m0s exceeds the available time of 13m")
 }
 
-//
-// RDS Tests
-//
+func TestValidateTimeLeft(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
 
-func TestChangeRDSStateStart(t *testing.T) {
-	cwEvent := types.Event{
-		Action:     "start",
-		Branch:     "branch",
-		Repository: "repo",
-	}
-	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:db:mysql-db")
-	clusterStauts := aws.String("available")
+	assert.Nil(t, validateTimeLeft(ctx, 1), "Expected no error")
+	assert.Nil(t, validateTimeLeft(context.Background(), 2), "Expected no error without a deadline")
 
-	svcRDSModelAPI := new(mocks.RDSModelAPI)
-	svcRDSModelAPI.On("GetRDSClusterForTags", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(clusterArn, clusterStauts, nil)
-	svcRDSModelAPI.On("StartRDSCluster", mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(true, nil)
+	err := validateTimeLeft(ctx, 2)
+	assert.Error(t, err, "Expected error")
+	assert.Contains(t, err.Error(), "is below the 1m10s reserved for changing 2 phases and writing the status")
+}
 
-	svcStatusModelAPI := new(mocks.StatusModelAPI)
-	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+func TestChangeStateTimeLeftBelowReservedTime(t *testing.T) {
+	recorder := &callRecorder{}
+	base := newPhaseServices("start", recorder, nil)
 
-	base := services{
-		RDSModelAPI:    svcRDSModelAPI,
-		StatusModelAPI: svcStatusModelAPI,
-	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	_, err := base.changeState(ctx, types.Event{Action: "start"})
+
+	assert.Error(t, err, "Expected error without an explicit wait timeout")
+	assert.Empty(t, recorder.calls, "Expected no resource or status to be changed")
+}
+
+func TestChangeStateWaitTimeoutExceedsDeadline(t *testing.T) {
+	recorder := &callRecorder{}
+	base := newPhaseServices("start", recorder, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Minute)
+	defer cancel()
 
-	err := base.changeRDSState(cwEvent)
+	_, err := base.changeState(ctx, types.Event{Action: "start", Wait: true, WaitTimeout: 900})
+
+	assert.Error(t, err, "Expected error")
+	assert.Empty(t, recorder.calls, "Expected no resource or status to be changed")
+}
+
+func TestChangeStateStartWait(t *testing.T) {
+	recorder := &callRecorder{}
+	base := newPhaseServices("start", recorder, nil)
+
+	result, err := base.changeState(context.Background(), types.Event{Action: "start", Wait: true})
 
 	assert.Nil(t, err, "Expected no error")
-	svcRDSModelAPI.AssertCalled(t, "GetRDSClusterForTags", cwEvent.Repository, cwEvent.Branch)
-	svcRDSModelAPI.AssertCalled(t, "StartRDSCluster", clusterArn, clusterStauts)
-	svcStatusModelAPI.AssertCalled(t, "SetStatusForEnvironment", cwEvent.Repository, cwEvent.Branch, "running")
+	assert.Equal(t, []string{"status starting", "rds", "wait-rds"}, recorder.calls[:3], "Expected transition status before any change")
+	assert.ElementsMatch(t, []string{"asg", "ec2", "wait-asg", "wait-ec2"}, recorder.calls[3:7])
+	assert.Equal(t, []string{"status running"}, recorder.calls[7:], "Expected final status after waiting")
+	assert.JSONEq(t, `{
+		"dryRun": false,
+		"action": "start",
+		"resources": [
+			{"kind": "rds-cluster", "identifier": "arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db", "action": "start", "previousState": "stopped", "newState": "available"},
+			{"kind": "autoscaling-group", "identifier": "test-asg", "action": "start", "previousState": "min=0 max=0 desired=0", "newState": "min=2 max=4 desired=2"},
+			{"kind": "ec2-instance", "identifier": "i-1234567890abcdef0", "action": "start", "previousState": "stopped", "newState": "running"}
+		],
+		"status": "running"
+	}`, result)
 }
 
-func TestChangeRDSStateStop(t *testing.T) {
-	cwEvent := types.Event{
-		Action:     "stop",
-		Branch:     "branch",
-		Repository: "repo",
+func TestChangeStateStopWait(t *testing.T) {
+	recorder := &callRecorder{}
+	base := newPhaseServices("stop", recorder, nil)
+
+	result, err := base.changeState(context.Background(), types.Event{Action: "stop", Wait: true})
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, "status stopping", recorder.calls[0], "Expected transition status before any change")
+	assert.ElementsMatch(t, []string{"asg", "ec2", "wait-asg", "wait-ec2"}, recorder.calls[1:5])
+	assert.Equal(t, []string{"rds", "wait-rds", "status stopped"}, recorder.calls[5:], "Expected final status after waiting")
+	assert.Contains(t, result, `"previousState":"running","newState":"stopped"`)
+	assert.Contains(t, result, `"previousState":"available","newState":"stopped"`)
+}
+
+func TestChangeStateWaitTimeout(t *testing.T) {
+	recorder := &callRecorder{}
+	base := newPhaseServices("stop", recorder, nil)
+
+	// The RDS Cluster never stops, so waiting ends with the timeout
+	for _, call := range rdsModelOf(base).ExpectedCalls {
+		if call.Method == "WaitUntilRDSClusterStopped" {
+			call.ReturnArguments = mock.Arguments{func(ctx context.Context, clusterARN *string) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}}
+		}
 	}
-	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:db:mysql-db")
-	clusterStauts := aws.String("stopped")
+
+	result, err := base.changeState(context.Background(), types.Event{Action: "stop", Wait: true, WaitTimeout: 1})
+
+	assert.Nil(t, err, "Expected error to be part of the result")
+	assert.Contains(t, result, `"errors":["context deadline exceeded"]`)
+	assert.Contains(t, result, `"status":"stop failed"`)
+	assert.Contains(t, result, `"previousState":"available","newState":"stopping"`, "Expected cluster to keep its transition state")
+}
+
+func TestChangeStateWaitNothingToDo(t *testing.T) {
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{}, []*autoscaling.Group{}, nil)
+
+	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ec2.Instance{}, []*ec2.Instance{}, nil)
 
 	svcRDSModelAPI := new(mocks.RDSModelAPI)
-	svcRDSModelAPI.On("GetRDSClusterForTags", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(clusterArn, clusterStauts, nil)
-	svcRDSModelAPI.On("StopRDSCluster", mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(true, nil)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, nil)
+
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{}, []*ecs.Service{}, nil)
+
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcEKSModelAPI.On("DescribeNodegroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*eks.Nodegroup{}, []*eks.Nodegroup{}, nil)
+
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcRedshiftModelAPI.On("GetRedshiftClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*redshift.Cluster{}, []*redshift.Cluster{}, nil)
+
+	svcDocDBModelAPI := new(mocks.DocDBModelAPI)
+	svcDocDBModelAPI.On("GetDocDBClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*docdb.DBCluster{}, []*docdb.DBCluster{}, nil)
+
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
+	svcElastiCacheModelAPI.On("GetReplicationGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, nil)
+	svcElastiCacheModelAPI.On("GetReplicationGroupSnapshotsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.Snapshot{}, nil)
 
 	svcStatusModelAPI := new(mocks.StatusModelAPI)
+	svcStatusModelAPI.On("AddHistoryEntry", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("types.HistoryEntry")).Return(nil)
 	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
 
 	base := services{
-		RDSModelAPI:    svcRDSModelAPI,
 		StatusModelAPI: svcStatusModelAPI,
+		schedulers: []ResourceScheduler{
+			&asgScheduler{ASGModelAPI: svcASGModelAPI},
+			&ec2Scheduler{EC2ModelAPI: svcEC2ModelAPI},
+			&ecsScheduler{ECSModelAPI: svcECSModelAPI},
+			&eksScheduler{EKSModelAPI: svcEKSModelAPI},
+			&rdsScheduler{RDSModelAPI: svcRDSModelAPI},
+			&redshiftScheduler{RedshiftModelAPI: svcRedshiftModelAPI},
+			&docdbScheduler{DocDBModelAPI: svcDocDBModelAPI},
+			&elastiCacheScheduler{ElastiCacheModelAPI: svcElastiCacheModelAPI},
+		},
 	}
 
-	err := base.changeRDSState(cwEvent)
+	result, err := base.changeState(context.Background(), types.Event{Action: "stop", Wait: true, Repository: "repo", Branch: "branch"})
 
 	assert.Nil(t, err, "Expected no error")
-	svcRDSModelAPI.AssertCalled(t, "GetRDSClusterForTags", cwEvent.Repository, cwEvent.Branch)
-	svcRDSModelAPI.AssertCalled(t, "StopRDSCluster", clusterArn, clusterStauts)
-	svcStatusModelAPI.AssertCalled(t, "SetStatusForEnvironment", cwEvent.Repository, cwEvent.Branch, "stopped")
+	assert.JSONEq(t, `{"dryRun": false, "action": "stop", "resources": []}`, result)
+	svcStatusModelAPI.AssertNotCalled(t, "SetStatusForEnvironment", mock.Anything, mock.Anything, mock.Anything)
 }
 
-func TestChangeRDSStateNoClusterFound(t *testing.T) {
-	cwEvent := types.Event{
-		Action:     "stop",
-		Branch:     "branch",
-		Repository: "repo",
-	}
+func TestChangeStateStatusError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+	recorder := &callRecorder{}
+	base := newPhaseServices("stop", recorder, nil)
 
-	svcRDSModelAPI := new(mocks.RDSModelAPI)
-	svcRDSModelAPI.On("GetRDSClusterForTags", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil, nil, nil)
+	svcStatusModelAPI := new(mocks.StatusModelAPI)
+	svcStatusModelAPI.On("AddHistoryEntry", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("types.HistoryEntry")).Return(nil)
+	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), "stopping").Return(nil)
+	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), "stopped").Return(errorMsg)
+	base.StatusModelAPI = svcStatusModelAPI
 
-	base := services{
-		RDSModelAPI: svcRDSModelAPI,
-	}
+	result, err := base.changeState(context.Background(), types.Event{Action: "stop"})
+
+	assert.Nil(t, err, "Expected error to be part of the result")
+	assert.Contains(t, result, `"status":"stopped"`)
+	assert.Contains(t, result, `"errors":["Test error"]`)
+}
+
+func TestChangeStateDryRunDoesntWait(t *testing.T) {
+	recorder := &callRecorder{}
+	base := newPhaseServices("start", recorder, nil)
 
-	err := base.changeRDSState(cwEvent)
+	_, err := base.changeState(context.Background(), types.Event{Action: "start", DryRun: true, Wait: true})
 
 	assert.Nil(t, err, "Expected no error")
-	svcRDSModelAPI.AssertCalled(t, "GetRDSClusterForTags", cwEvent.Repository, cwEvent.Branch)
+	assert.Empty(t, recorder.calls, "Expected no resource or status to be changed")
 }
 
-func TestChangeRDSStateGetClusterError(t *testing.T) {
-	cwEvent := types.Event{
-		Action:     "stop",
-		Branch:     "branch",
-		Repository: "repo",
+func TestPhasesForEvent(t *testing.T) {
+	base := *newResourceServices(session.Must(session.NewSession()), aws.NewConfig())
+	handlerFor := func(scheduler ResourceScheduler) resourceHandler {
+		return scheduler.Start
 	}
 
-	errorMsg := errors.New("Test error")
+	phases, err := base.phasesForEvent(types.Event{Action: "start"}, handlerFor)
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, phases, 2)
+	assert.Len(t, phases[0], 4)
+	assert.Len(t, phases[1], 4)
 
-	svcRDSModelAPI := new(mocks.RDSModelAPI)
-	svcRDSModelAPI.On("GetRDSClusterForTags", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil, nil, errorMsg)
+	phases, err = base.phasesForEvent(types.Event{Action: "stop"}, handlerFor)
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, phases, 2)
+	assert.Len(t, phases[0], 4)
+	assert.Len(t, phases[1], 4)
 
-	base := services{
-		RDSModelAPI: svcRDSModelAPI,
+	phases, err = base.phasesForEvent(types.Event{Action: "start", Phases: [][]string{{"asg", "ec2", "rds"}}}, handlerFor)
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, phases, 1)
+	assert.Len(t, phases[0], 8, "Expected resource types missing in the phases to be added to the last phase")
+}
+
+func TestPhasesForEventInvalidResourceType(t *testing.T) {
+	base := *newResourceServices(session.Must(session.NewSession()), aws.NewConfig())
+	handlerFor := func(scheduler ResourceScheduler) resourceHandler {
+		return scheduler.Start
 	}
 
-	err := base.changeRDSState(cwEvent)
+	_, err := base.phasesForEvent(types.Event{Action: "start", Phases: [][]string{{"rds"}, {"lambda"}}}, handlerFor)
+	assert.EqualError(t, err, `unknown or duplicate resource type "lambda" in phases`)
 
+	_, err = base.phasesForEvent(types.Event{Action: "start", Phases: [][]string{{"rds"}, {"rds"}}}, handlerFor)
+	assert.EqualError(t, err, `unknown or duplicate resource type "rds" in phases`)
+
+	_, err = base.changeState(context.Background(), types.Event{Action: "start", Phases: [][]string{{"lambda"}}})
 	assert.Error(t, err, "Expected error")
-	svcRDSModelAPI.AssertCalled(t, "GetRDSClusterForTags", cwEvent.Repository, cwEvent.Branch)
-	assert.Equal(t, errorMsg, err, "Error didn't match given error")
 }
 
-func TestChangeRDSStateStopClusterError(t *testing.T) {
+//
+// Dry Run Tests
+//
+
+func TestChangeStateDryRun(t *testing.T) {
 	cwEvent := types.Event{
 		Action:     "stop",
 		Branch:     "branch",
 		Repository: "repo",
+		DryRun:     true,
 	}
 
-	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:db:mysql-db")
-	clusterStauts := aws.String("stopped")
-	errorMsg := errors.New("Test error")
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{
+		newAutoscalingGroup(aws.String("test-asg"), 2),
+	}, []*autoscaling.Group{}, nil)
+
+	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(
+		newEC2Instances("running", []*string{aws.String("i-1234567890abcdef0")}), []*ec2.Instance{}, nil)
 
 	svcRDSModelAPI := new(mocks.RDSModelAPI)
-	svcRDSModelAPI.On("GetRDSClusterForTags", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(clusterArn, clusterStauts, nil)
-	svcRDSModelAPI.On("StopRDSCluster", mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(false, errorMsg)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{
+		&rds.DBCluster{
+			DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db"),
+			Status:       aws.String("available"),
+		},
+		&rds.DBCluster{
+			DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:stopped-db"),
+			Status:       aws.String("stopped"),
+		},
+	}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{
+		&rds.DBInstance{
+			DBInstanceIdentifier: aws.String("postgres-db"),
+			DBInstanceStatus:     aws.String("available"),
+		},
+	}, []*rds.DBInstance{}, nil)
+
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{}, []*ecs.Service{}, nil)
+
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcEKSModelAPI.On("DescribeNodegroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*eks.Nodegroup{}, []*eks.Nodegroup{}, nil)
+
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcRedshiftModelAPI.On("GetRedshiftClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*redshift.Cluster{}, []*redshift.Cluster{}, nil)
+
+	svcDocDBModelAPI := new(mocks.DocDBModelAPI)
+	svcDocDBModelAPI.On("GetDocDBClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*docdb.DBCluster{}, []*docdb.DBCluster{}, nil)
+
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
+	svcElastiCacheModelAPI.On("GetReplicationGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, nil)
+	svcElastiCacheModelAPI.On("GetReplicationGroupSnapshotsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.Snapshot{}, nil)
+
+	svcStatusModelAPI := new(mocks.StatusModelAPI)
+	svcStatusModelAPI.On("AddHistoryEntry", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("types.HistoryEntry")).Return(nil)
 
 	base := services{
-		RDSModelAPI: svcRDSModelAPI,
+		StatusModelAPI: svcStatusModelAPI,
+		schedulers: []ResourceScheduler{
+			&asgScheduler{ASGModelAPI: svcASGModelAPI},
+			&ec2Scheduler{EC2ModelAPI: svcEC2ModelAPI},
+			&ecsScheduler{ECSModelAPI: svcECSModelAPI},
+			&eksScheduler{EKSModelAPI: svcEKSModelAPI},
+			&rdsScheduler{RDSModelAPI: svcRDSModelAPI},
+			&redshiftScheduler{RedshiftModelAPI: svcRedshiftModelAPI},
+			&docdbScheduler{DocDBModelAPI: svcDocDBModelAPI},
+			&elastiCacheScheduler{ElastiCacheModelAPI: svcElastiCacheModelAPI},
+		},
 	}
 
-	err := base.changeRDSState(cwEvent)
+	result, err := base.changeState(context.Background(), cwEvent)
 
-	assert.Error(t, err, "Expected error")
-	svcRDSModelAPI.AssertCalled(t, "GetRDSClusterForTags", cwEvent.Repository, cwEvent.Branch)
-	svcRDSModelAPI.AssertCalled(t, "StopRDSCluster", clusterArn, clusterStauts)
-	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+	assert.Nil(t, err, "Expected no error")
+	assert.JSONEq(t, `{
+		"dryRun": true,
+		"action": "stop",
+		"resources": [
+			{"kind": "autoscaling-group", "identifier": "test-asg", "action": "stop", "previousState": "min=2 max=4 desired=2", "newState": "min=0 max=0 desired=0"},
+			{"kind": "ec2-instance", "identifier": "i-1234567890abcdef0", "action": "stop", "previousState": "running", "newState": "stopping"},
+			{"kind": "rds-cluster", "identifier": "arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db", "action": "stop", "previousState": "available", "newState": "stopping"},
+			{"kind": "rds-cluster", "identifier": "arn:aws:rds:eu-west-1:123456789012:cluster:stopped-db", "action": "stop", "previousState": "stopped", "skippedReason": "cluster status is stopped"},
+			{"kind": "rds-instance", "identifier": "postgres-db", "action": "stop", "previousState": "available", "newState": "stopping"}
+		],
+		"status": "stopped"
+	}`, result)
+	svcASGModelAPI.AssertNotCalled(t, "SetASGSizeToZero", mock.Anything, mock.Anything)
+	svcEC2ModelAPI.AssertNotCalled(t, "StopEC2Instances", mock.Anything, mock.Anything)
+	svcRDSModelAPI.AssertNotCalled(t, "StopRDSCluster", mock.Anything, mock.Anything, mock.Anything)
+	svcRDSModelAPI.AssertNotCalled(t, "StopRDSInstance", mock.Anything, mock.Anything, mock.Anything)
+	svcStatusModelAPI.AssertNotCalled(t, "SetStatusForEnvironment", mock.Anything, mock.Anything, mock.Anything)
 }
 
-func TestChangeRDSStateStartClusterError(t *testing.T) {
+func TestChangeStateDryRunNothingToDo(t *testing.T) {
 	cwEvent := types.Event{
 		Action:     "start",
 		Branch:     "branch",
 		Repository: "repo",
+		DryRun:     true,
 	}
 
-	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:db:mysql-db")
-	clusterStauts := aws.String("stopped")
-	errorMsg := errors.New("Test error")
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{}, []*autoscaling.Group{}, nil)
+
+	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ec2.Instance{}, []*ec2.Instance{}, nil)
 
 	svcRDSModelAPI := new(mocks.RDSModelAPI)
-	svcRDSModelAPI.On("GetRDSClusterForTags", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(clusterArn, clusterStauts, nil)
-	svcRDSModelAPI.On("StartRDSCluster", mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(false, errorMsg)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, nil)
+
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{}, []*ecs.Service{}, nil)
+
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcEKSModelAPI.On("DescribeNodegroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*eks.Nodegroup{}, []*eks.Nodegroup{}, nil)
+
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcRedshiftModelAPI.On("GetRedshiftClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*redshift.Cluster{}, []*redshift.Cluster{}, nil)
+
+	svcDocDBModelAPI := new(mocks.DocDBModelAPI)
+	svcDocDBModelAPI.On("GetDocDBClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*docdb.DBCluster{}, []*docdb.DBCluster{}, nil)
+
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
+	svcElastiCacheModelAPI.On("GetReplicationGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, nil)
+	svcElastiCacheModelAPI.On("GetReplicationGroupSnapshotsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.Snapshot{}, nil)
 
 	base := services{
-		RDSModelAPI: svcRDSModelAPI,
+		schedulers: []ResourceScheduler{
+			&asgScheduler{ASGModelAPI: svcASGModelAPI},
+			&ec2Scheduler{EC2ModelAPI: svcEC2ModelAPI},
+			&ecsScheduler{ECSModelAPI: svcECSModelAPI},
+			&eksScheduler{EKSModelAPI: svcEKSModelAPI},
+			&rdsScheduler{RDSModelAPI: svcRDSModelAPI},
+			&redshiftScheduler{RedshiftModelAPI: svcRedshiftModelAPI},
+			&docdbScheduler{DocDBModelAPI: svcDocDBModelAPI},
+			&elastiCacheScheduler{ElastiCacheModelAPI: svcElastiCacheModelAPI},
+		},
 	}
 
-	err := base.changeRDSState(cwEvent)
+	result, err := base.changeState(context.Background(), cwEvent)
 
-	assert.Error(t, err, "Expected error")
-	svcRDSModelAPI.AssertCalled(t, "GetRDSClusterForTags", cwEvent.Repository, cwEvent.Branch)
-	svcRDSModelAPI.AssertCalled(t, "StartRDSCluster", clusterArn, clusterStauts)
-	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+	assert.Nil(t, err, "Expected no error")
+	assert.JSONEq(t, `{"dryRun": true, "action": "start", "resources": []}`, result)
 }
 
-func TestChangeRDSStateSetStatusStartError(t *testing.T) {
-	cwEvent := types.Event{
-		Action:     "start",
-		Branch:     "branch",
-		Repository: "repo",
-	}
-	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:db:mysql-db")
-	clusterStauts := aws.String("available")
+func TestChangeStateDescribeError(t *testing.T) {
 	errorMsg := errors.New("Test error")
 
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{}, []*autoscaling.Group{}, errorMsg)
+
+	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ec2.Instance{}, []*ec2.Instance{}, nil)
+
 	svcRDSModelAPI := new(mocks.RDSModelAPI)
-	svcRDSModelAPI.On("GetRDSClusterForTags", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(clusterArn, clusterStauts, nil)
-	svcRDSModelAPI.On("StartRDSCluster", mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(true, nil)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, nil)
 
-	svcStatusModelAPI := new(mocks.StatusModelAPI)
-	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(errorMsg)
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{}, []*ecs.Service{}, nil)
+
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcEKSModelAPI.On("DescribeNodegroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*eks.Nodegroup{}, []*eks.Nodegroup{}, nil)
+
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcRedshiftModelAPI.On("GetRedshiftClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*redshift.Cluster{}, []*redshift.Cluster{}, nil)
+
+	svcDocDBModelAPI := new(mocks.DocDBModelAPI)
+	svcDocDBModelAPI.On("GetDocDBClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*docdb.DBCluster{}, []*docdb.DBCluster{}, nil)
+
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
+	svcElastiCacheModelAPI.On("GetReplicationGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, nil)
+	svcElastiCacheModelAPI.On("GetReplicationGroupSnapshotsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.Snapshot{}, nil)
 
 	base := services{
-		RDSModelAPI:    svcRDSModelAPI,
-		StatusModelAPI: svcStatusModelAPI,
+		schedulers: []ResourceScheduler{
+			&asgScheduler{ASGModelAPI: svcASGModelAPI},
+			&ec2Scheduler{EC2ModelAPI: svcEC2ModelAPI},
+			&ecsScheduler{ECSModelAPI: svcECSModelAPI},
+			&eksScheduler{EKSModelAPI: svcEKSModelAPI},
+			&rdsScheduler{RDSModelAPI: svcRDSModelAPI},
+			&redshiftScheduler{RedshiftModelAPI: svcRedshiftModelAPI},
+			&docdbScheduler{DocDBModelAPI: svcDocDBModelAPI},
+			&elastiCacheScheduler{ElastiCacheModelAPI: svcElastiCacheModelAPI},
+		},
 	}
 
-	err := base.changeRDSState(cwEvent)
+	result, err := base.changeState(context.Background(), types.Event{DryRun: true, Action: "stop"})
 
-	assert.Error(t, err, "Expected error")
-	svcRDSModelAPI.AssertCalled(t, "GetRDSClusterForTags", cwEvent.Repository, cwEvent.Branch)
-	svcRDSModelAPI.AssertCalled(t, "StartRDSCluster", clusterArn, clusterStauts)
-	svcStatusModelAPI.AssertCalled(t, "SetStatusForEnvironment", cwEvent.Repository, cwEvent.Branch, "running")
+	assert.Nil(t, err, "Expected error to be part of the result")
+	assert.JSONEq(t, `{"dryRun": true, "action": "stop", "resources": [], "status": "stop failed", "errors": ["Test error"]}`, result)
+	svcEC2ModelAPI.AssertCalled(t, "DescribeInstancesForTags", mock.Anything, "", "")
+	svcRDSModelAPI.AssertCalled(t, "GetRDSClustersForTags", mock.Anything, "", "")
 }
 
-func TestChangeRDSStateSetStatusStopError(t *testing.T) {
+func TestChangeStatePartialFailure(t *testing.T) {
+	errorMsg := errors.New("Test error")
+	instanceIDs := []*string{aws.String("i-1234567890abcdef0")}
+
 	cwEvent := types.Event{
 		Action:     "stop",
 		Branch:     "branch",
 		Repository: "repo",
 	}
-	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:db:mysql-db")
-	clusterStauts := aws.String("available")
-	errorMsg := errors.New("Test error")
+
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{}, []*autoscaling.Group{}, errorMsg)
+
+	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(newEC2Instances("running", instanceIDs), []*ec2.Instance{}, nil)
+	svcEC2ModelAPI.On("StopEC2Instances", mock.Anything, mock.AnythingOfType("[]*string")).Return(newInstanceStateChanges("running", "stopping", instanceIDs), nil)
 
 	svcRDSModelAPI := new(mocks.RDSModelAPI)
-	svcRDSModelAPI.On("GetRDSClusterForTags", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(clusterArn, clusterStauts, nil)
-	svcRDSModelAPI.On("StopRDSCluster", mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(true, nil)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{
+		&rds.DBCluster{
+			DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db"),
+			Status:       aws.String("available"),
+		},
+	}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("StopRDSCluster", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(true, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, nil)
+
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{}, []*ecs.Service{}, nil)
+
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcEKSModelAPI.On("DescribeNodegroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*eks.Nodegroup{}, []*eks.Nodegroup{}, nil)
+
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcRedshiftModelAPI.On("GetRedshiftClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*redshift.Cluster{}, []*redshift.Cluster{}, nil)
+
+	svcDocDBModelAPI := new(mocks.DocDBModelAPI)
+	svcDocDBModelAPI.On("GetDocDBClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*docdb.DBCluster{}, []*docdb.DBCluster{}, nil)
+
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
+	svcElastiCacheModelAPI.On("GetReplicationGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, nil)
+	svcElastiCacheModelAPI.On("GetReplicationGroupSnapshotsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.Snapshot{}, nil)
 
 	svcStatusModelAPI := new(mocks.StatusModelAPI)
-	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(errorMsg)
+	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	svcStatusModelAPI.On("AddHistoryEntry", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("types.HistoryEntry")).Return(nil)
+	svcStatusModelAPI.On("SetFailedStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
 
 	base := services{
-		RDSModelAPI:    svcRDSModelAPI,
 		StatusModelAPI: svcStatusModelAPI,
+		schedulers: []ResourceScheduler{
+			&asgScheduler{ASGModelAPI: svcASGModelAPI},
+			&ec2Scheduler{EC2ModelAPI: svcEC2ModelAPI},
+			&ecsScheduler{ECSModelAPI: svcECSModelAPI},
+			&eksScheduler{EKSModelAPI: svcEKSModelAPI},
+			&rdsScheduler{RDSModelAPI: svcRDSModelAPI},
+			&redshiftScheduler{RedshiftModelAPI: svcRedshiftModelAPI},
+			&docdbScheduler{DocDBModelAPI: svcDocDBModelAPI},
+			&elastiCacheScheduler{ElastiCacheModelAPI: svcElastiCacheModelAPI},
+		},
 	}
 
-	err := base.changeRDSState(cwEvent)
-
-	assert.Error(t, err, "Expected error")
-	svcRDSModelAPI.AssertCalled(t, "GetRDSClusterForTags", cwEvent.Repository, cwEvent.Branch)
-	svcRDSModelAPI.AssertCalled(t, "StopRDSCluster", clusterArn, clusterStauts)
-	svcStatusModelAPI.AssertCalled(t, "SetStatusForEnvironment", cwEvent.Repository, cwEvent.Branch, "stopped")
+	result, err := base.changeState(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected error to be part of the result")
+	assert.JSONEq(t, `{
+		"dryRun": false,
+		"action": "stop",
+		"resources": [
+			{"kind": "ec2-instance", "identifier": "i-1234567890abcdef0", "action": "stop", "previousState": "running", "newState": "stopping"},
+			{"kind": "rds-cluster", "identifier": "arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db", "action": "stop", "previousState": "available", "newState": "stopping"}
+		],
+		"status": "stop failed",
+		"errors": ["Test error"]
+	}`, result)
+	svcEC2ModelAPI.AssertCalled(t, "StopEC2Instances", mock.Anything, instanceIDs)
+	svcRDSModelAPI.AssertCalled(t, "StopRDSCluster", mock.Anything, mock.Anything, mock.Anything)
+	svcStatusModelAPI.AssertCalled(t, "SetFailedStatusForEnvironment", cwEvent.Repository, cwEvent.Branch, "stop failed", "Test error")
+	svcStatusModelAPI.AssertNotCalled(t, "SetStatusForEnvironment", cwEvent.Repository, cwEvent.Branch, "stopped")
 }
 
-//
-// ASG Tests
-//
+func TestChangeStatePartialFailureStatusError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+	statusErrorMsg := errors.New("Status error")
+	instanceIDs := []*string{aws.String("i-1234567890abcdef0")}
 
-func TestChangeASGStateStart(t *testing.T) {
-	autoscalingGroupName := aws.String("test-asg")
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{}, []*autoscaling.Group{}, errorMsg)
 
-	cwEvent := types.Event{
-		Action:     "start",
-		Branch:     "branch",
-		Repository: "repo",
-	}
+	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(newEC2Instances("stopped", instanceIDs), []*ec2.Instance{}, nil)
+	svcEC2ModelAPI.On("StartEC2Instances", mock.Anything, mock.AnythingOfType("[]*string")).Return(newInstanceStateChanges("stopped", "pending", instanceIDs), nil)
 
-	svcASGModelAPI := new(mocks.ASGModelAPI)
-	svcASGModelAPI.On("DescribeAutoScalingGroupForTagsAndAction", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(autoscalingGroupName, nil)
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, nil)
+
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{}, []*ecs.Service{}, nil)
+
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcEKSModelAPI.On("DescribeNodegroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*eks.Nodegroup{}, []*eks.Nodegroup{}, nil)
+
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcRedshiftModelAPI.On("GetRedshiftClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*redshift.Cluster{}, []*redshift.Cluster{}, nil)
 
-	svcASGModelAPI.On("SetASGMinToPreviousValue", mock.AnythingOfType("*string")).Return(nil)
+	svcDocDBModelAPI := new(mocks.DocDBModelAPI)
+	svcDocDBModelAPI.On("GetDocDBClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*docdb.DBCluster{}, []*docdb.DBCluster{}, nil)
+
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
+	svcElastiCacheModelAPI.On("GetReplicationGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, nil)
+	svcElastiCacheModelAPI.On("GetReplicationGroupSnapshotsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.Snapshot{}, nil)
 
 	svcStatusModelAPI := new(mocks.StatusModelAPI)
 	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	svcStatusModelAPI.On("AddHistoryEntry", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("types.HistoryEntry")).Return(nil)
+	svcStatusModelAPI.On("SetFailedStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), "start failed", "Test error").Return(statusErrorMsg)
 
 	base := services{
-		ASGModelAPI:    svcASGModelAPI,
 		StatusModelAPI: svcStatusModelAPI,
+		schedulers: []ResourceScheduler{
+			&asgScheduler{ASGModelAPI: svcASGModelAPI},
+			&ec2Scheduler{EC2ModelAPI: svcEC2ModelAPI},
+			&ecsScheduler{ECSModelAPI: svcECSModelAPI},
+			&eksScheduler{EKSModelAPI: svcEKSModelAPI},
+			&rdsScheduler{RDSModelAPI: svcRDSModelAPI},
+			&redshiftScheduler{RedshiftModelAPI: svcRedshiftModelAPI},
+			&docdbScheduler{DocDBModelAPI: svcDocDBModelAPI},
+			&elastiCacheScheduler{ElastiCacheModelAPI: svcElastiCacheModelAPI},
+		},
+	}
+
+	result, err := base.changeState(context.Background(), types.Event{Action: "start"})
+
+	assert.Nil(t, err, "Expected error to be part of the result")
+	assert.Contains(t, result, `"status":"start failed"`)
+	assert.Contains(t, result, `"errors":["Test error","Status error"]`)
+}
+
+func TestLoadStatusTableConfigDefaults(t *testing.T) {
+	for _, key := range []string{"STATUS_TABLE_NAME", "STATUS_TABLE_REPOSITORY_KEY", "STATUS_TABLE_BRANCH_KEY", "STATUS_HISTORY_SIZE"} {
+		value, ok := os.LookupEnv(key)
+		os.Unsetenv(key)
+		if ok {
+			defer os.Setenv(key, value)
+		}
 	}
 
-	err := base.changeASGState(cwEvent)
+	config, err := loadStatusTableConfig()
 
 	assert.Nil(t, err, "Expected no error")
-	svcASGModelAPI.AssertCalled(t, "SetASGMinToPreviousValue", autoscalingGroupName)
-	svcStatusModelAPI.AssertCalled(t, "SetStatusForEnvironment", cwEvent.Repository, cwEvent.Branch, "running")
+	assert.Equal(t, types.StatusTableConfig{
+		TableName:     "auto-staging-environments",
+		RepositoryKey: "repository",
+		BranchKey:     "branch",
+		HistorySize:   20,
+	}, config)
 }
 
-func TestChangeASGStateStop(t *testing.T) {
-	autoscalingGroupName := aws.String("test-asg")
+func TestLoadStatusTableConfig(t *testing.T) {
+	os.Setenv("STATUS_TABLE_NAME", "platform-environments")
+	os.Setenv("STATUS_TABLE_REPOSITORY_KEY", "app")
+	os.Setenv("STATUS_TABLE_BRANCH_KEY", "git-branch")
+	os.Setenv("STATUS_HISTORY_SIZE", "0")
+	defer os.Unsetenv("STATUS_TABLE_NAME")
+	defer os.Unsetenv("STATUS_TABLE_REPOSITORY_KEY")
+	defer os.Unsetenv("STATUS_TABLE_BRANCH_KEY")
+	defer os.Unsetenv("STATUS_HISTORY_SIZE")
 
-	cwEvent := types.Event{
-		Action:     "stop",
-		Branch:     "branch",
-		Repository: "repo",
+	config, err := loadStatusTableConfig()
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, types.StatusTableConfig{
+		TableName:     "platform-environments",
+		RepositoryKey: "app",
+		BranchKey:     "git-branch",
+		HistorySize:   0,
+	}, config)
+}
+
+func TestLoadStatusTableConfigInvalid(t *testing.T) {
+	tests := map[string]map[string]string{
+		"invalid table name": {"STATUS_TABLE_NAME": "environments table"},
+		"empty key":          {"STATUS_TABLE_BRANCH_KEY": ""},
+		"equal keys":         {"STATUS_TABLE_REPOSITORY_KEY": "name", "STATUS_TABLE_BRANCH_KEY": "name"},
+		"invalid history":    {"STATUS_HISTORY_SIZE": "ten"},
+		"negative history":   {"STATUS_HISTORY_SIZE": "-1"},
 	}
 
-	svcASGModelAPI := new(mocks.ASGModelAPI)
-	svcASGModelAPI.On("DescribeAutoScalingGroupForTagsAndAction", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(autoscalingGroupName, nil)
+	for name, env := range tests {
+		for key, value := range env {
+			os.Setenv(key, value)
+		}
 
-	svcASGModelAPI.On("SetASGMinToZero", mock.AnythingOfType("*string")).Return(nil)
+		_, err := loadStatusTableConfig()
+		assert.Error(t, err, name)
 
-	svcStatusModelAPI := new(mocks.StatusModelAPI)
-	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+		for key := range env {
+			os.Unsetenv(key)
+		}
+	}
+}
 
-	base := services{
-		ASGModelAPI:    svcASGModelAPI,
-		StatusModelAPI: svcStatusModelAPI,
+func TestLoadTagConfigDefaults(t *testing.T) {
+	for _, key := range []string{"TAG_REPOSITORY_KEY", "TAG_BRANCH_KEY", "REQUIRED_TAGS", "EXCLUDE_TAG", "SNAPSHOT_TAG"} {
+		value, ok := os.LookupEnv(key)
+		os.Unsetenv(key)
+		if ok {
+			defer os.Setenv(key, value)
+		}
 	}
 
-	err := base.changeASGState(cwEvent)
+	config, err := loadTagConfig()
 
 	assert.Nil(t, err, "Expected no error")
-	svcASGModelAPI.AssertCalled(t, "SetASGMinToZero", autoscalingGroupName)
-	svcStatusModelAPI.AssertCalled(t, "SetStatusForEnvironment", cwEvent.Repository, cwEvent.Branch, "stopped")
+	assert.Equal(t, types.TagConfig{
+		RepositoryKey:    "repository",
+		BranchKey:        "branch_raw",
+		RequiredTags:     map[string]string{},
+		ExcludeTagKey:    "auto-staging:schedule",
+		ExcludeTagValue:  "ignore",
+		SnapshotTagKey:   "auto-staging:schedule",
+		SnapshotTagValue: "snapshot",
+	}, config)
 }
 
-func TestChangeASGStateNoGroupFound(t *testing.T) {
-	cwEvent := types.Event{
-		Action:     "stop",
-		Branch:     "branch",
-		Repository: "repo",
+func TestLoadTagConfig(t *testing.T) {
+	os.Setenv("TAG_REPOSITORY_KEY", "app")
+	os.Setenv("TAG_BRANCH_KEY", "git-branch")
+	os.Setenv("REQUIRED_TAGS", "environment=staging, team=platform")
+	os.Setenv("EXCLUDE_TAG", "schedule=off")
+	os.Setenv("SNAPSHOT_TAG", "schedule=snapshot")
+	defer os.Unsetenv("TAG_REPOSITORY_KEY")
+	defer os.Unsetenv("TAG_BRANCH_KEY")
+	defer os.Unsetenv("REQUIRED_TAGS")
+	defer os.Unsetenv("EXCLUDE_TAG")
+	defer os.Unsetenv("SNAPSHOT_TAG")
+
+	config, err := loadTagConfig()
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, types.TagConfig{
+		RepositoryKey: "app",
+		BranchKey:     "git-branch",
+		RequiredTags: map[string]string{
+			"environment": "staging",
+			"team":        "platform",
+		},
+		ExcludeTagKey:    "schedule",
+		ExcludeTagValue:  "off",
+		SnapshotTagKey:   "schedule",
+		SnapshotTagValue: "snapshot",
+	}, config)
+}
+
+func TestLoadTagConfigInvalid(t *testing.T) {
+	tests := map[string]map[string]string{
+		"missing value":         {"REQUIRED_TAGS": "environment"},
+		"empty key":             {"TAG_BRANCH_KEY": ""},
+		"equal keys":            {"TAG_REPOSITORY_KEY": "name", "TAG_BRANCH_KEY": "name"},
+		"conflict with tag key": {"REQUIRED_TAGS": "repository=other"},
+		"invalid exclude tag":   {"EXCLUDE_TAG": "ignore"},
+		"exclude tag conflict":  {"EXCLUDE_TAG": "branch_raw=ignore"},
+		"invalid snapshot tag":  {"SNAPSHOT_TAG": "=snapshot"},
+		"snapshot tag conflict": {"SNAPSHOT_TAG": "repository=snapshot"},
+		"snapshot tag equal":    {"SNAPSHOT_TAG": "auto-staging:schedule=ignore"},
 	}
 
-	svcASGModelAPI := new(mocks.ASGModelAPI)
-	svcASGModelAPI.On("DescribeAutoScalingGroupForTagsAndAction", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil, nil)
+	for name, env := range tests {
+		for key, value := range env {
+			os.Setenv(key, value)
+		}
 
-	base := services{
-		ASGModelAPI: svcASGModelAPI,
+		_, err := loadTagConfig()
+		assert.Error(t, err, name)
+
+		for key := range env {
+			os.Unsetenv(key)
+		}
 	}
+}
+
+func TestLoadTagConfigExcludeTagDisabled(t *testing.T) {
+	os.Setenv("EXCLUDE_TAG", "")
+	defer os.Unsetenv("EXCLUDE_TAG")
 
-	err := base.changeASGState(cwEvent)
+	config, err := loadTagConfig()
 
 	assert.Nil(t, err, "Expected no error")
-	svcASGModelAPI.AssertCalled(t, "DescribeAutoScalingGroupForTagsAndAction", cwEvent.Repository, cwEvent.Branch, cwEvent.Action)
+	assert.Empty(t, config.ExcludeTagKey)
+	assert.False(t, config.IsExcluded(map[string]string{"auto-staging:schedule": "ignore"}))
 }
 
-func TestChangeASGStateDescribeError(t *testing.T) {
-	errorMsg := errors.New("Test error")
-	svcASGModelAPI := new(mocks.ASGModelAPI)
-	svcASGModelAPI.On("DescribeAutoScalingGroupForTagsAndAction", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil, errorMsg)
+func TestLoadTagConfigSnapshotTagDisabled(t *testing.T) {
+	os.Setenv("SNAPSHOT_TAG", "")
+	defer os.Unsetenv("SNAPSHOT_TAG")
 
-	base := services{
-		ASGModelAPI: svcASGModelAPI,
-	}
+	config, err := loadTagConfig()
 
-	err := base.changeASGState(types.Event{})
-
-	assert.Error(t, err, "Expected error")
-	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+	assert.Nil(t, err, "Expected no error")
+	assert.Empty(t, config.SnapshotTagKey)
+	assert.False(t, config.AllowsSnapshot(map[string]string{"auto-staging:schedule": "snapshot"}))
 }
 
-func TestChangeASGStateStopError(t *testing.T) {
-	errorMsg := errors.New("Test error")
+func TestChangeStateHistory(t *testing.T) {
+	recorder := &callRecorder{}
+	base := newPhaseServices("stop", recorder, nil)
 
-	autoscalingGroupName := aws.String("test-asg")
+	svcStatusModelAPI := new(mocks.StatusModelAPI)
+	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	svcStatusModelAPI.On("AddHistoryEntry", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("types.HistoryEntry")).Return(nil)
+	base.StatusModelAPI = svcStatusModelAPI
 
-	cwEvent := types.Event{
-		Action:     "stop",
-		Branch:     "branch",
-		Repository: "repo",
-	}
+	_, err := base.changeState(context.Background(), types.Event{Action: "stop", Repository: "repo", Branch: "branch", Source: "tower"})
 
-	svcASGModelAPI := new(mocks.ASGModelAPI)
-	svcASGModelAPI.On("DescribeAutoScalingGroupForTagsAndAction", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(autoscalingGroupName, nil)
+	assert.Nil(t, err, "Expected no error")
+	entry := svcStatusModelAPI.Calls[2].Arguments.Get(2).(types.HistoryEntry)
+	assert.Equal(t, "stop", entry.Action)
+	assert.Equal(t, "tower", entry.Source)
+	assert.Equal(t, "stopped", entry.Result)
+	assert.Empty(t, entry.ErrorMessage)
+	assert.NotEmpty(t, entry.Resources, "Expected the changed resources to be recorded")
+}
 
-	svcASGModelAPI.On("SetASGMinToZero", mock.AnythingOfType("*string")).Return(errorMsg)
+func TestChangeStateHistoryNotWrittenForDryRun(t *testing.T) {
+	recorder := &callRecorder{}
+	base := newPhaseServices("stop", recorder, nil)
 
-	base := services{
-		ASGModelAPI: svcASGModelAPI,
+	_, err := base.changeState(context.Background(), types.Event{Action: "stop", DryRun: true})
+
+	assert.Nil(t, err, "Expected no error")
+	base.StatusModelAPI.(*mocks.StatusModelAPI).AssertNotCalled(t, "AddHistoryEntry", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHistoryEntryForResult(t *testing.T) {
+	cwEvent := types.Event{Action: "start"}
+	result := types.Result{
+		Status: "start failed",
+		Resources: []types.ResourceChange{
+			{Kind: "ec2-instance", Identifier: "i-1234567890abcdef0", NewState: "pending"},
+			{Kind: "ec2-instance", Identifier: "i-1234567890abcdef1", SkippedReason: "instance is running"},
+		},
 	}
+	errs := types.MultiError{errors.New("Test error")}
 
-	err := base.changeASGState(cwEvent)
+	entry := historyEntryForResult(cwEvent, result, errs)
 
-	assert.Error(t, err, "Expected error")
-	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+	assert.Equal(t, types.HistoryEntry{
+		Action:       "start",
+		Source:       "cloudwatch",
+		Resources:    []string{"i-1234567890abcdef0"},
+		Result:       "start failed",
+		ErrorMessage: "Test error",
+	}, entry)
 }
 
-func TestChangeASGStateStopStatusError(t *testing.T) {
-	errorMsg := errors.New("Test error")
+func TestRoleForEvent(t *testing.T) {
+	defer func(previous map[string]string) { accountRoles = previous }(accountRoles)
+	accountRoles = map[string]string{
+		"123456789012": "arn:aws:iam::123456789012:role/auto-staging-scheduler",
+	}
 
-	autoscalingGroupName := aws.String("test-asg")
+	tests := map[string]struct {
+		event types.Event
+		role  string
+	}{
+		"no role":    {types.Event{}, ""},
+		"role":       {types.Event{RoleArn: "arn:aws:iam::210987654321:role/scheduler"}, "arn:aws:iam::210987654321:role/scheduler"},
+		"account":    {types.Event{Account: "123456789012"}, "arn:aws:iam::123456789012:role/auto-staging-scheduler"},
+		"precedence": {types.Event{Account: "123456789012", RoleArn: "arn:aws:iam::210987654321:role/scheduler"}, "arn:aws:iam::210987654321:role/scheduler"},
+	}
 
-	cwEvent := types.Event{
-		Action:     "stop",
-		Branch:     "branch",
-		Repository: "repo",
+	for name, test := range tests {
+		role, err := roleForEvent(test.event)
+		assert.Nil(t, err, name)
+		assert.Equal(t, test.role, role, name)
 	}
+}
 
-	svcASGModelAPI := new(mocks.ASGModelAPI)
-	svcASGModelAPI.On("DescribeAutoScalingGroupForTagsAndAction", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(autoscalingGroupName, nil)
+func TestRoleForEventInvalid(t *testing.T) {
+	defer func(previous map[string]string) { accountRoles = previous }(accountRoles)
+	accountRoles = map[string]string{}
 
-	svcASGModelAPI.On("SetASGMinToZero", mock.AnythingOfType("*string")).Return(nil)
+	_, err := roleForEvent(types.Event{Account: "123456789012"})
+	assert.EqualError(t, err, `no role configured for account "123456789012"`)
 
-	svcStatusModelAPI := new(mocks.StatusModelAPI)
-	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(errorMsg)
+	_, err = roleForEvent(types.Event{RoleArn: "scheduler"})
+	assert.Error(t, err, "Expected invalid role ARN error")
+}
 
-	base := services{
-		ASGModelAPI:    svcASGModelAPI,
-		StatusModelAPI: svcStatusModelAPI,
-	}
+func TestResourceConfigForEvent(t *testing.T) {
+	svcSTS := new(mocks.STSAPI)
 
-	err := base.changeASGState(cwEvent)
+	config, err := resourceConfigForEvent(svcSTS, types.Event{})
 
-	assert.Error(t, err, "Expected error")
-	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+	assert.Nil(t, err, "Expected no error")
+	assert.Nil(t, config.Credentials, "Expected the credentials of the Lambda function")
+	svcSTS.AssertNotCalled(t, "AssumeRoleWithContext", mock.Anything, mock.Anything)
 }
 
-func TestChangeASGStateStartError(t *testing.T) {
-	errorMsg := errors.New("Test error")
+func TestResourceConfigForEventAssumeRole(t *testing.T) {
+	roleARN := "arn:aws:iam::210987654321:role/scheduler"
+	svcSTS := new(mocks.STSAPI)
+	svcSTS.On("AssumeRoleWithContext", mock.Anything, mock.AnythingOfType("*sts.AssumeRoleInput")).Return(&sts.AssumeRoleOutput{
+		Credentials: &sts.Credentials{
+			AccessKeyId:     aws.String("access-key"),
+			SecretAccessKey: aws.String("secret-key"),
+			SessionToken:    aws.String("session-token"),
+			Expiration:      aws.Time(time.Now().Add(time.Hour)),
+		},
+	}, nil)
+
+	config, err := resourceConfigForEvent(svcSTS, types.Event{RoleArn: roleARN})
+	assert.Nil(t, err, "Expected no error")
+
+	credentials, err := config.Credentials.Get()
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, "access-key", credentials.AccessKeyID)
+	input := svcSTS.Calls[0].Arguments.Get(1).(*sts.AssumeRoleInput)
+	assert.Equal(t, roleARN, *input.RoleArn)
+	assert.Equal(t, "auto-staging-scheduler", *input.RoleSessionName)
+}
 
-	autoscalingGroupName := aws.String("test-asg")
+func TestLoadAccountRoles(t *testing.T) {
+	os.Setenv("ACCOUNT_ROLES", "123456789012=arn:aws:iam::123456789012:role/scheduler, 210987654321=arn:aws:iam::210987654321:role/scheduler")
+	defer os.Unsetenv("ACCOUNT_ROLES")
 
-	cwEvent := types.Event{
-		Action:     "start",
-		Branch:     "branch",
-		Repository: "repo",
+	roles, err := loadAccountRoles()
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, map[string]string{
+		"123456789012": "arn:aws:iam::123456789012:role/scheduler",
+		"210987654321": "arn:aws:iam::210987654321:role/scheduler",
+	}, roles)
+}
+
+func TestLoadAccountRolesInvalid(t *testing.T) {
+	tests := map[string]string{
+		"missing role":       "123456789012",
+		"invalid account ID": "1234=arn:aws:iam::123456789012:role/scheduler",
+		"invalid role ARN":   "123456789012=scheduler",
 	}
 
-	svcASGModelAPI := new(mocks.ASGModelAPI)
-	svcASGModelAPI.On("DescribeAutoScalingGroupForTagsAndAction", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(autoscalingGroupName, nil)
+	for name, value := range tests {
+		os.Setenv("ACCOUNT_ROLES", value)
 
-	svcASGModelAPI.On("SetASGMinToPreviousValue", mock.AnythingOfType("*string")).Return(errorMsg)
+		_, err := loadAccountRoles()
+		assert.Error(t, err, name)
+	}
+	os.Unsetenv("ACCOUNT_ROLES")
+}
 
-	base := services{
-		ASGModelAPI: svcASGModelAPI,
+func TestRegionsForEvent(t *testing.T) {
+	tests := map[string]struct {
+		event   types.Event
+		regions []string
+	}{
+		"no region":  {types.Event{}, []string{}},
+		"region":     {types.Event{Region: "eu-central-1"}, []string{"eu-central-1"}},
+		"regions":    {types.Event{Regions: []string{"eu-central-1", "us-east-1"}}, []string{"eu-central-1", "us-east-1"}},
+		"duplicates": {types.Event{Region: "us-east-1", Regions: []string{"eu-central-1", "us-east-1"}}, []string{"us-east-1", "eu-central-1"}},
+		"gov cloud":  {types.Event{Region: "us-gov-west-1"}, []string{"us-gov-west-1"}},
 	}
 
-	err := base.changeASGState(cwEvent)
+	for name, test := range tests {
+		regions, err := regionsForEvent(test.event)
+		assert.Nil(t, err, name)
+		assert.Equal(t, test.regions, regions, name)
+	}
+}
 
-	assert.Error(t, err, "Expected error")
-	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+func TestRegionsForEventInvalid(t *testing.T) {
+	_, err := regionsForEvent(types.Event{Regions: []string{"eu-central-1", "Frankfurt"}})
+
+	assert.EqualError(t, err, `invalid region "Frankfurt"`)
 }
 
-func TestChangeASGStateStartStatusError(t *testing.T) {
-	errorMsg := errors.New("Test error")
+func TestChangeStateMultipleRegions(t *testing.T) {
+	recorder := &callRecorder{}
+	frankfurt := newPhaseServices("start", recorder, nil)
+	virginia := newPhaseServices("start", recorder, nil)
+	base := services{
+		StatusModelAPI: frankfurt.StatusModelAPI,
+		regions: []regionalServices{
+			{region: "eu-central-1", services: &frankfurt},
+			{region: "us-east-1", services: &virginia},
+		},
+	}
 
-	autoscalingGroupName := aws.String("test-asg")
+	result, err := base.changeState(context.Background(), types.Event{Action: "start", Wait: true})
+	assert.Nil(t, err, "Expected no error")
 
-	cwEvent := types.Event{
-		Action:     "start",
-		Branch:     "branch",
-		Repository: "repo",
+	parsed := types.Result{}
+	assert.Nil(t, json.Unmarshal([]byte(result), &parsed))
+	assert.Equal(t, "running", parsed.Status)
+	assert.Empty(t, parsed.Errors)
+	regions := map[string]int{}
+	for _, change := range parsed.Resources {
+		regions[change.Region]++
+		assert.NotEqual(t, "starting", change.NewState, "Expected waited resources to have their final state")
 	}
+	assert.Equal(t, map[string]int{"eu-central-1": 3, "us-east-1": 3}, regions)
 
-	svcASGModelAPI := new(mocks.ASGModelAPI)
-	svcASGModelAPI.On("DescribeAutoScalingGroupForTagsAndAction", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(autoscalingGroupName, nil)
+	// The databases of both regions are started before the applications
+	assert.Equal(t, []string{"status starting", "rds", "rds", "wait-rds", "wait-rds"}, recorder.calls[:5])
+	assert.Equal(t, "status running", recorder.calls[len(recorder.calls)-1])
+	rdsModelOf(virginia).AssertNumberOfCalls(t, "WaitUntilRDSClusterAvailable", 1)
+	rdsModelOf(frankfurt).AssertNumberOfCalls(t, "WaitUntilRDSClusterAvailable", 1)
+}
 
-	svcASGModelAPI.On("SetASGMinToPreviousValue", mock.AnythingOfType("*string")).Return(nil)
+func TestChangeStateRegionError(t *testing.T) {
+	recorder := &callRecorder{}
+	frankfurt := newPhaseServices("stop", recorder, nil)
 
-	svcStatusModelAPI := new(mocks.StatusModelAPI)
-	svcStatusModelAPI.On("SetStatusForEnvironment", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(errorMsg)
+	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ec2.Instance{}, []*ec2.Instance{}, errors.New("Test error"))
+	schedulerOfType(frankfurt, "ec2").(*ec2Scheduler).EC2ModelAPI = svcEC2ModelAPI
 
 	base := services{
-		ASGModelAPI:    svcASGModelAPI,
-		StatusModelAPI: svcStatusModelAPI,
+		StatusModelAPI: frankfurt.StatusModelAPI,
+		regions: []regionalServices{
+			{region: "eu-central-1", services: &frankfurt},
+		},
 	}
 
-	err := base.changeASGState(cwEvent)
+	result, err := base.changeState(context.Background(), types.Event{Action: "stop"})
 
-	assert.Error(t, err, "Expected error")
-	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+	assert.Nil(t, err, "Expected error to be part of the result")
+	assert.Contains(t, result, `"errors":["eu-central-1: Test error"]`)
+	assert.Contains(t, result, `"region":"eu-central-1"`)
 }
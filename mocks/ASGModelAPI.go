@@ -2,29 +2,68 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	autoscaling "github.com/aws/aws-sdk-go/service/autoscaling"
+	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/auto-staging/scheduler/types"
+)
 
 // ASGModelAPI is an autogenerated mock type for the ASGModelAPI type
 type ASGModelAPI struct {
 	mock.Mock
 }
 
-// DescribeAutoScalingGroupForTagsAndAction provides a mock function with given fields: repository, branch, action
-func (_m *ASGModelAPI) DescribeAutoScalingGroupForTagsAndAction(repository string, branch string, action string) (*string, error) {
-	ret := _m.Called(repository, branch, action)
+// DescribeAutoScalingGroupsForTags provides a mock function with given fields: ctx, repository, branch
+func (_m *ASGModelAPI) DescribeAutoScalingGroupsForTags(ctx context.Context, repository string, branch string) ([]*autoscaling.Group, []*autoscaling.Group, error) {
+	ret := _m.Called(ctx, repository, branch)
+
+	var r0 []*autoscaling.Group
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*autoscaling.Group); ok {
+		r0 = rf(ctx, repository, branch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*autoscaling.Group)
+		}
+	}
+
+	var r1 []*autoscaling.Group
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) []*autoscaling.Group); ok {
+		r1 = rf(ctx, repository, branch)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*autoscaling.Group)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, repository, branch)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetPreviousSizeOfASG provides a mock function with given fields: ctx, asgName
+func (_m *ASGModelAPI) GetPreviousSizeOfASG(ctx context.Context, asgName *string) (*types.ASGSize, error) {
+	ret := _m.Called(ctx, asgName)
 
-	var r0 *string
-	if rf, ok := ret.Get(0).(func(string, string, string) *string); ok {
-		r0 = rf(repository, branch, action)
+	var r0 *types.ASGSize
+	if rf, ok := ret.Get(0).(func(context.Context, *string) *types.ASGSize); ok {
+		r0 = rf(ctx, asgName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*string)
+			r0 = ret.Get(0).(*types.ASGSize)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
-		r1 = rf(repository, branch, action)
+	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+		r1 = rf(ctx, asgName)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -32,20 +71,22 @@ func (_m *ASGModelAPI) DescribeAutoScalingGroupForTagsAndAction(repository strin
 	return r0, r1
 }
 
-// GetPreviousMinValueOfASG provides a mock function with given fields: asgName
-func (_m *ASGModelAPI) GetPreviousMinValueOfASG(asgName *string) (int, error) {
-	ret := _m.Called(asgName)
+// SetASGSizeToPreviousValue provides a mock function with given fields: ctx, asgName
+func (_m *ASGModelAPI) SetASGSizeToPreviousValue(ctx context.Context, asgName *string) (*types.ASGSize, error) {
+	ret := _m.Called(ctx, asgName)
 
-	var r0 int
-	if rf, ok := ret.Get(0).(func(*string) int); ok {
-		r0 = rf(asgName)
+	var r0 *types.ASGSize
+	if rf, ok := ret.Get(0).(func(context.Context, *string) *types.ASGSize); ok {
+		r0 = rf(ctx, asgName)
 	} else {
-		r0 = ret.Get(0).(int)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ASGSize)
+		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*string) error); ok {
-		r1 = rf(asgName)
+	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+		r1 = rf(ctx, asgName)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -53,27 +94,36 @@ func (_m *ASGModelAPI) GetPreviousMinValueOfASG(asgName *string) (int, error) {
 	return r0, r1
 }
 
-// SetASGMinToPreviousValue provides a mock function with given fields: asgName
-func (_m *ASGModelAPI) SetASGMinToPreviousValue(asgName *string) error {
-	ret := _m.Called(asgName)
+// SetASGSizeToZero provides a mock function with given fields: ctx, asgName
+func (_m *ASGModelAPI) SetASGSizeToZero(ctx context.Context, asgName *string) (*types.ASGSize, error) {
+	ret := _m.Called(ctx, asgName)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*string) error); ok {
-		r0 = rf(asgName)
+	var r0 *types.ASGSize
+	if rf, ok := ret.Get(0).(func(context.Context, *string) *types.ASGSize); ok {
+		r0 = rf(ctx, asgName)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ASGSize)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+		r1 = rf(ctx, asgName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// SetASGMinToZero provides a mock function with given fields: asgName
-func (_m *ASGModelAPI) SetASGMinToZero(asgName *string) error {
-	ret := _m.Called(asgName)
+// WaitUntilASGInService provides a mock function with given fields: ctx, asgName
+func (_m *ASGModelAPI) WaitUntilASGInService(ctx context.Context, asgName *string) error {
+	ret := _m.Called(ctx, asgName)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*string) error); ok {
-		r0 = rf(asgName)
+	if rf, ok := ret.Get(0).(func(context.Context, *string) error); ok {
+		r0 = rf(ctx, asgName)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -7,9 +7,8 @@ import (
 
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
-
 	request "github.com/aws/aws-sdk-go/aws/request"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // AutoScalingAPI is an autogenerated mock type for the AutoScalingAPI type
@@ -251,6 +250,84 @@ func (_m *AutoScalingAPI) AttachLoadBalancersWithContext(_a0 context.Context, _a
 	return r0, r1
 }
 
+// AttachTrafficSources provides a mock function with given fields: _a0
+func (_m *AutoScalingAPI) AttachTrafficSources(_a0 *autoscaling.AttachTrafficSourcesInput) (*autoscaling.AttachTrafficSourcesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *autoscaling.AttachTrafficSourcesOutput
+	if rf, ok := ret.Get(0).(func(*autoscaling.AttachTrafficSourcesInput) *autoscaling.AttachTrafficSourcesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autoscaling.AttachTrafficSourcesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*autoscaling.AttachTrafficSourcesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachTrafficSourcesRequest provides a mock function with given fields: _a0
+func (_m *AutoScalingAPI) AttachTrafficSourcesRequest(_a0 *autoscaling.AttachTrafficSourcesInput) (*request.Request, *autoscaling.AttachTrafficSourcesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*autoscaling.AttachTrafficSourcesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *autoscaling.AttachTrafficSourcesOutput
+	if rf, ok := ret.Get(1).(func(*autoscaling.AttachTrafficSourcesInput) *autoscaling.AttachTrafficSourcesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*autoscaling.AttachTrafficSourcesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// AttachTrafficSourcesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *AutoScalingAPI) AttachTrafficSourcesWithContext(_a0 context.Context, _a1 *autoscaling.AttachTrafficSourcesInput, _a2 ...request.Option) (*autoscaling.AttachTrafficSourcesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *autoscaling.AttachTrafficSourcesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *autoscaling.AttachTrafficSourcesInput, ...request.Option) *autoscaling.AttachTrafficSourcesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autoscaling.AttachTrafficSourcesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *autoscaling.AttachTrafficSourcesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BatchDeleteScheduledAction provides a mock function with given fields: _a0
 func (_m *AutoScalingAPI) BatchDeleteScheduledAction(_a0 *autoscaling.BatchDeleteScheduledActionInput) (*autoscaling.BatchDeleteScheduledActionOutput, error) {
 	ret := _m.Called(_a0)
@@ -1343,6 +1420,84 @@ func (_m *AutoScalingAPI) DeleteTagsWithContext(_a0 context.Context, _a1 *autosc
 	return r0, r1
 }
 
+// DeleteWarmPool provides a mock function with given fields: _a0
+func (_m *AutoScalingAPI) DeleteWarmPool(_a0 *autoscaling.DeleteWarmPoolInput) (*autoscaling.DeleteWarmPoolOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *autoscaling.DeleteWarmPoolOutput
+	if rf, ok := ret.Get(0).(func(*autoscaling.DeleteWarmPoolInput) *autoscaling.DeleteWarmPoolOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autoscaling.DeleteWarmPoolOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*autoscaling.DeleteWarmPoolInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteWarmPoolRequest provides a mock function with given fields: _a0
+func (_m *AutoScalingAPI) DeleteWarmPoolRequest(_a0 *autoscaling.DeleteWarmPoolInput) (*request.Request, *autoscaling.DeleteWarmPoolOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*autoscaling.DeleteWarmPoolInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *autoscaling.DeleteWarmPoolOutput
+	if rf, ok := ret.Get(1).(func(*autoscaling.DeleteWarmPoolInput) *autoscaling.DeleteWarmPoolOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*autoscaling.DeleteWarmPoolOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteWarmPoolWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *AutoScalingAPI) DeleteWarmPoolWithContext(_a0 context.Context, _a1 *autoscaling.DeleteWarmPoolInput, _a2 ...request.Option) (*autoscaling.DeleteWarmPoolOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *autoscaling.DeleteWarmPoolOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *autoscaling.DeleteWarmPoolInput, ...request.Option) *autoscaling.DeleteWarmPoolOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autoscaling.DeleteWarmPoolOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *autoscaling.DeleteWarmPoolInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeAccountLimits provides a mock function with given fields: _a0
 func (_m *AutoScalingAPI) DescribeAccountLimits(_a0 *autoscaling.DescribeAccountLimitsInput) (*autoscaling.DescribeAccountLimitsOutput, error) {
 	ret := _m.Called(_a0)
@@ -1826,6 +1981,41 @@ func (_m *AutoScalingAPI) DescribeInstanceRefreshes(_a0 *autoscaling.DescribeIns
 	return r0, r1
 }
 
+// DescribeInstanceRefreshesPages provides a mock function with given fields: _a0, _a1
+func (_m *AutoScalingAPI) DescribeInstanceRefreshesPages(_a0 *autoscaling.DescribeInstanceRefreshesInput, _a1 func(*autoscaling.DescribeInstanceRefreshesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*autoscaling.DescribeInstanceRefreshesInput, func(*autoscaling.DescribeInstanceRefreshesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeInstanceRefreshesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *AutoScalingAPI) DescribeInstanceRefreshesPagesWithContext(_a0 context.Context, _a1 *autoscaling.DescribeInstanceRefreshesInput, _a2 func(*autoscaling.DescribeInstanceRefreshesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *autoscaling.DescribeInstanceRefreshesInput, func(*autoscaling.DescribeInstanceRefreshesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DescribeInstanceRefreshesRequest provides a mock function with given fields: _a0
 func (_m *AutoScalingAPI) DescribeInstanceRefreshesRequest(_a0 *autoscaling.DescribeInstanceRefreshesInput) (*request.Request, *autoscaling.DescribeInstanceRefreshesOutput) {
 	ret := _m.Called(_a0)
@@ -2173,6 +2363,41 @@ func (_m *AutoScalingAPI) DescribeLoadBalancerTargetGroups(_a0 *autoscaling.Desc
 	return r0, r1
 }
 
+// DescribeLoadBalancerTargetGroupsPages provides a mock function with given fields: _a0, _a1
+func (_m *AutoScalingAPI) DescribeLoadBalancerTargetGroupsPages(_a0 *autoscaling.DescribeLoadBalancerTargetGroupsInput, _a1 func(*autoscaling.DescribeLoadBalancerTargetGroupsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*autoscaling.DescribeLoadBalancerTargetGroupsInput, func(*autoscaling.DescribeLoadBalancerTargetGroupsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeLoadBalancerTargetGroupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *AutoScalingAPI) DescribeLoadBalancerTargetGroupsPagesWithContext(_a0 context.Context, _a1 *autoscaling.DescribeLoadBalancerTargetGroupsInput, _a2 func(*autoscaling.DescribeLoadBalancerTargetGroupsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *autoscaling.DescribeLoadBalancerTargetGroupsInput, func(*autoscaling.DescribeLoadBalancerTargetGroupsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DescribeLoadBalancerTargetGroupsRequest provides a mock function with given fields: _a0
 func (_m *AutoScalingAPI) DescribeLoadBalancerTargetGroupsRequest(_a0 *autoscaling.DescribeLoadBalancerTargetGroupsInput) (*request.Request, *autoscaling.DescribeLoadBalancerTargetGroupsOutput) {
 	ret := _m.Called(_a0)
@@ -2251,6 +2476,41 @@ func (_m *AutoScalingAPI) DescribeLoadBalancers(_a0 *autoscaling.DescribeLoadBal
 	return r0, r1
 }
 
+// DescribeLoadBalancersPages provides a mock function with given fields: _a0, _a1
+func (_m *AutoScalingAPI) DescribeLoadBalancersPages(_a0 *autoscaling.DescribeLoadBalancersInput, _a1 func(*autoscaling.DescribeLoadBalancersOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*autoscaling.DescribeLoadBalancersInput, func(*autoscaling.DescribeLoadBalancersOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeLoadBalancersPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *AutoScalingAPI) DescribeLoadBalancersPagesWithContext(_a0 context.Context, _a1 *autoscaling.DescribeLoadBalancersInput, _a2 func(*autoscaling.DescribeLoadBalancersOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *autoscaling.DescribeLoadBalancersInput, func(*autoscaling.DescribeLoadBalancersOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DescribeLoadBalancersRequest provides a mock function with given fields: _a0
 func (_m *AutoScalingAPI) DescribeLoadBalancersRequest(_a0 *autoscaling.DescribeLoadBalancersInput) (*request.Request, *autoscaling.DescribeLoadBalancersOutput) {
 	ret := _m.Called(_a0)
@@ -3105,21 +3365,21 @@ func (_m *AutoScalingAPI) DescribeTerminationPolicyTypesWithContext(_a0 context.
 	return r0, r1
 }
 
-// DetachInstances provides a mock function with given fields: _a0
-func (_m *AutoScalingAPI) DetachInstances(_a0 *autoscaling.DetachInstancesInput) (*autoscaling.DetachInstancesOutput, error) {
+// DescribeTrafficSources provides a mock function with given fields: _a0
+func (_m *AutoScalingAPI) DescribeTrafficSources(_a0 *autoscaling.DescribeTrafficSourcesInput) (*autoscaling.DescribeTrafficSourcesOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *autoscaling.DetachInstancesOutput
-	if rf, ok := ret.Get(0).(func(*autoscaling.DetachInstancesInput) *autoscaling.DetachInstancesOutput); ok {
+	var r0 *autoscaling.DescribeTrafficSourcesOutput
+	if rf, ok := ret.Get(0).(func(*autoscaling.DescribeTrafficSourcesInput) *autoscaling.DescribeTrafficSourcesOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*autoscaling.DetachInstancesOutput)
+			r0 = ret.Get(0).(*autoscaling.DescribeTrafficSourcesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*autoscaling.DetachInstancesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*autoscaling.DescribeTrafficSourcesInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -3128,12 +3388,47 @@ func (_m *AutoScalingAPI) DetachInstances(_a0 *autoscaling.DetachInstancesInput)
 	return r0, r1
 }
 
-// DetachInstancesRequest provides a mock function with given fields: _a0
-func (_m *AutoScalingAPI) DetachInstancesRequest(_a0 *autoscaling.DetachInstancesInput) (*request.Request, *autoscaling.DetachInstancesOutput) {
+// DescribeTrafficSourcesPages provides a mock function with given fields: _a0, _a1
+func (_m *AutoScalingAPI) DescribeTrafficSourcesPages(_a0 *autoscaling.DescribeTrafficSourcesInput, _a1 func(*autoscaling.DescribeTrafficSourcesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*autoscaling.DescribeTrafficSourcesInput, func(*autoscaling.DescribeTrafficSourcesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeTrafficSourcesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *AutoScalingAPI) DescribeTrafficSourcesPagesWithContext(_a0 context.Context, _a1 *autoscaling.DescribeTrafficSourcesInput, _a2 func(*autoscaling.DescribeTrafficSourcesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *autoscaling.DescribeTrafficSourcesInput, func(*autoscaling.DescribeTrafficSourcesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeTrafficSourcesRequest provides a mock function with given fields: _a0
+func (_m *AutoScalingAPI) DescribeTrafficSourcesRequest(_a0 *autoscaling.DescribeTrafficSourcesInput) (*request.Request, *autoscaling.DescribeTrafficSourcesOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*autoscaling.DetachInstancesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*autoscaling.DescribeTrafficSourcesInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -3141,20 +3436,20 @@ func (_m *AutoScalingAPI) DetachInstancesRequest(_a0 *autoscaling.DetachInstance
 		}
 	}
 
-	var r1 *autoscaling.DetachInstancesOutput
-	if rf, ok := ret.Get(1).(func(*autoscaling.DetachInstancesInput) *autoscaling.DetachInstancesOutput); ok {
+	var r1 *autoscaling.DescribeTrafficSourcesOutput
+	if rf, ok := ret.Get(1).(func(*autoscaling.DescribeTrafficSourcesInput) *autoscaling.DescribeTrafficSourcesOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*autoscaling.DetachInstancesOutput)
+			r1 = ret.Get(1).(*autoscaling.DescribeTrafficSourcesOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DetachInstancesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *AutoScalingAPI) DetachInstancesWithContext(_a0 context.Context, _a1 *autoscaling.DetachInstancesInput, _a2 ...request.Option) (*autoscaling.DetachInstancesOutput, error) {
+// DescribeTrafficSourcesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *AutoScalingAPI) DescribeTrafficSourcesWithContext(_a0 context.Context, _a1 *autoscaling.DescribeTrafficSourcesInput, _a2 ...request.Option) (*autoscaling.DescribeTrafficSourcesOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3164,12 +3459,203 @@ func (_m *AutoScalingAPI) DetachInstancesWithContext(_a0 context.Context, _a1 *a
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *autoscaling.DetachInstancesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *autoscaling.DetachInstancesInput, ...request.Option) *autoscaling.DetachInstancesOutput); ok {
+	var r0 *autoscaling.DescribeTrafficSourcesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *autoscaling.DescribeTrafficSourcesInput, ...request.Option) *autoscaling.DescribeTrafficSourcesOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*autoscaling.DetachInstancesOutput)
+			r0 = ret.Get(0).(*autoscaling.DescribeTrafficSourcesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *autoscaling.DescribeTrafficSourcesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeWarmPool provides a mock function with given fields: _a0
+func (_m *AutoScalingAPI) DescribeWarmPool(_a0 *autoscaling.DescribeWarmPoolInput) (*autoscaling.DescribeWarmPoolOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *autoscaling.DescribeWarmPoolOutput
+	if rf, ok := ret.Get(0).(func(*autoscaling.DescribeWarmPoolInput) *autoscaling.DescribeWarmPoolOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autoscaling.DescribeWarmPoolOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*autoscaling.DescribeWarmPoolInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeWarmPoolPages provides a mock function with given fields: _a0, _a1
+func (_m *AutoScalingAPI) DescribeWarmPoolPages(_a0 *autoscaling.DescribeWarmPoolInput, _a1 func(*autoscaling.DescribeWarmPoolOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*autoscaling.DescribeWarmPoolInput, func(*autoscaling.DescribeWarmPoolOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeWarmPoolPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *AutoScalingAPI) DescribeWarmPoolPagesWithContext(_a0 context.Context, _a1 *autoscaling.DescribeWarmPoolInput, _a2 func(*autoscaling.DescribeWarmPoolOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *autoscaling.DescribeWarmPoolInput, func(*autoscaling.DescribeWarmPoolOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeWarmPoolRequest provides a mock function with given fields: _a0
+func (_m *AutoScalingAPI) DescribeWarmPoolRequest(_a0 *autoscaling.DescribeWarmPoolInput) (*request.Request, *autoscaling.DescribeWarmPoolOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*autoscaling.DescribeWarmPoolInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *autoscaling.DescribeWarmPoolOutput
+	if rf, ok := ret.Get(1).(func(*autoscaling.DescribeWarmPoolInput) *autoscaling.DescribeWarmPoolOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*autoscaling.DescribeWarmPoolOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeWarmPoolWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *AutoScalingAPI) DescribeWarmPoolWithContext(_a0 context.Context, _a1 *autoscaling.DescribeWarmPoolInput, _a2 ...request.Option) (*autoscaling.DescribeWarmPoolOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *autoscaling.DescribeWarmPoolOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *autoscaling.DescribeWarmPoolInput, ...request.Option) *autoscaling.DescribeWarmPoolOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autoscaling.DescribeWarmPoolOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *autoscaling.DescribeWarmPoolInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DetachInstances provides a mock function with given fields: _a0
+func (_m *AutoScalingAPI) DetachInstances(_a0 *autoscaling.DetachInstancesInput) (*autoscaling.DetachInstancesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *autoscaling.DetachInstancesOutput
+	if rf, ok := ret.Get(0).(func(*autoscaling.DetachInstancesInput) *autoscaling.DetachInstancesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autoscaling.DetachInstancesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*autoscaling.DetachInstancesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DetachInstancesRequest provides a mock function with given fields: _a0
+func (_m *AutoScalingAPI) DetachInstancesRequest(_a0 *autoscaling.DetachInstancesInput) (*request.Request, *autoscaling.DetachInstancesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*autoscaling.DetachInstancesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *autoscaling.DetachInstancesOutput
+	if rf, ok := ret.Get(1).(func(*autoscaling.DetachInstancesInput) *autoscaling.DetachInstancesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*autoscaling.DetachInstancesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DetachInstancesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *AutoScalingAPI) DetachInstancesWithContext(_a0 context.Context, _a1 *autoscaling.DetachInstancesInput, _a2 ...request.Option) (*autoscaling.DetachInstancesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *autoscaling.DetachInstancesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *autoscaling.DetachInstancesInput, ...request.Option) *autoscaling.DetachInstancesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autoscaling.DetachInstancesOutput)
 		}
 	}
 
@@ -3339,6 +3825,84 @@ func (_m *AutoScalingAPI) DetachLoadBalancersWithContext(_a0 context.Context, _a
 	return r0, r1
 }
 
+// DetachTrafficSources provides a mock function with given fields: _a0
+func (_m *AutoScalingAPI) DetachTrafficSources(_a0 *autoscaling.DetachTrafficSourcesInput) (*autoscaling.DetachTrafficSourcesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *autoscaling.DetachTrafficSourcesOutput
+	if rf, ok := ret.Get(0).(func(*autoscaling.DetachTrafficSourcesInput) *autoscaling.DetachTrafficSourcesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autoscaling.DetachTrafficSourcesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*autoscaling.DetachTrafficSourcesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DetachTrafficSourcesRequest provides a mock function with given fields: _a0
+func (_m *AutoScalingAPI) DetachTrafficSourcesRequest(_a0 *autoscaling.DetachTrafficSourcesInput) (*request.Request, *autoscaling.DetachTrafficSourcesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*autoscaling.DetachTrafficSourcesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *autoscaling.DetachTrafficSourcesOutput
+	if rf, ok := ret.Get(1).(func(*autoscaling.DetachTrafficSourcesInput) *autoscaling.DetachTrafficSourcesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*autoscaling.DetachTrafficSourcesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DetachTrafficSourcesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *AutoScalingAPI) DetachTrafficSourcesWithContext(_a0 context.Context, _a1 *autoscaling.DetachTrafficSourcesInput, _a2 ...request.Option) (*autoscaling.DetachTrafficSourcesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *autoscaling.DetachTrafficSourcesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *autoscaling.DetachTrafficSourcesInput, ...request.Option) *autoscaling.DetachTrafficSourcesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autoscaling.DetachTrafficSourcesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *autoscaling.DetachTrafficSourcesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DisableMetricsCollection provides a mock function with given fields: _a0
 func (_m *AutoScalingAPI) DisableMetricsCollection(_a0 *autoscaling.DisableMetricsCollectionInput) (*autoscaling.DisableMetricsCollectionOutput, error) {
 	ret := _m.Called(_a0)
@@ -3729,6 +4293,84 @@ func (_m *AutoScalingAPI) ExitStandbyWithContext(_a0 context.Context, _a1 *autos
 	return r0, r1
 }
 
+// GetPredictiveScalingForecast provides a mock function with given fields: _a0
+func (_m *AutoScalingAPI) GetPredictiveScalingForecast(_a0 *autoscaling.GetPredictiveScalingForecastInput) (*autoscaling.GetPredictiveScalingForecastOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *autoscaling.GetPredictiveScalingForecastOutput
+	if rf, ok := ret.Get(0).(func(*autoscaling.GetPredictiveScalingForecastInput) *autoscaling.GetPredictiveScalingForecastOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autoscaling.GetPredictiveScalingForecastOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*autoscaling.GetPredictiveScalingForecastInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPredictiveScalingForecastRequest provides a mock function with given fields: _a0
+func (_m *AutoScalingAPI) GetPredictiveScalingForecastRequest(_a0 *autoscaling.GetPredictiveScalingForecastInput) (*request.Request, *autoscaling.GetPredictiveScalingForecastOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*autoscaling.GetPredictiveScalingForecastInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *autoscaling.GetPredictiveScalingForecastOutput
+	if rf, ok := ret.Get(1).(func(*autoscaling.GetPredictiveScalingForecastInput) *autoscaling.GetPredictiveScalingForecastOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*autoscaling.GetPredictiveScalingForecastOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetPredictiveScalingForecastWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *AutoScalingAPI) GetPredictiveScalingForecastWithContext(_a0 context.Context, _a1 *autoscaling.GetPredictiveScalingForecastInput, _a2 ...request.Option) (*autoscaling.GetPredictiveScalingForecastOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *autoscaling.GetPredictiveScalingForecastOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *autoscaling.GetPredictiveScalingForecastInput, ...request.Option) *autoscaling.GetPredictiveScalingForecastOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autoscaling.GetPredictiveScalingForecastOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *autoscaling.GetPredictiveScalingForecastInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PutLifecycleHook provides a mock function with given fields: _a0
 func (_m *AutoScalingAPI) PutLifecycleHook(_a0 *autoscaling.PutLifecycleHookInput) (*autoscaling.PutLifecycleHookOutput, error) {
 	ret := _m.Called(_a0)
@@ -4041,6 +4683,84 @@ func (_m *AutoScalingAPI) PutScheduledUpdateGroupActionWithContext(_a0 context.C
 	return r0, r1
 }
 
+// PutWarmPool provides a mock function with given fields: _a0
+func (_m *AutoScalingAPI) PutWarmPool(_a0 *autoscaling.PutWarmPoolInput) (*autoscaling.PutWarmPoolOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *autoscaling.PutWarmPoolOutput
+	if rf, ok := ret.Get(0).(func(*autoscaling.PutWarmPoolInput) *autoscaling.PutWarmPoolOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autoscaling.PutWarmPoolOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*autoscaling.PutWarmPoolInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutWarmPoolRequest provides a mock function with given fields: _a0
+func (_m *AutoScalingAPI) PutWarmPoolRequest(_a0 *autoscaling.PutWarmPoolInput) (*request.Request, *autoscaling.PutWarmPoolOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*autoscaling.PutWarmPoolInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *autoscaling.PutWarmPoolOutput
+	if rf, ok := ret.Get(1).(func(*autoscaling.PutWarmPoolInput) *autoscaling.PutWarmPoolOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*autoscaling.PutWarmPoolOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PutWarmPoolWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *AutoScalingAPI) PutWarmPoolWithContext(_a0 context.Context, _a1 *autoscaling.PutWarmPoolInput, _a2 ...request.Option) (*autoscaling.PutWarmPoolOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *autoscaling.PutWarmPoolOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *autoscaling.PutWarmPoolInput, ...request.Option) *autoscaling.PutWarmPoolOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autoscaling.PutWarmPoolOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *autoscaling.PutWarmPoolInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RecordLifecycleActionHeartbeat provides a mock function with given fields: _a0
 func (_m *AutoScalingAPI) RecordLifecycleActionHeartbeat(_a0 *autoscaling.RecordLifecycleActionHeartbeatInput) (*autoscaling.RecordLifecycleActionHeartbeatOutput, error) {
 	ret := _m.Called(_a0)
@@ -4197,6 +4917,84 @@ func (_m *AutoScalingAPI) ResumeProcessesWithContext(_a0 context.Context, _a1 *a
 	return r0, r1
 }
 
+// RollbackInstanceRefresh provides a mock function with given fields: _a0
+func (_m *AutoScalingAPI) RollbackInstanceRefresh(_a0 *autoscaling.RollbackInstanceRefreshInput) (*autoscaling.RollbackInstanceRefreshOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *autoscaling.RollbackInstanceRefreshOutput
+	if rf, ok := ret.Get(0).(func(*autoscaling.RollbackInstanceRefreshInput) *autoscaling.RollbackInstanceRefreshOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autoscaling.RollbackInstanceRefreshOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*autoscaling.RollbackInstanceRefreshInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RollbackInstanceRefreshRequest provides a mock function with given fields: _a0
+func (_m *AutoScalingAPI) RollbackInstanceRefreshRequest(_a0 *autoscaling.RollbackInstanceRefreshInput) (*request.Request, *autoscaling.RollbackInstanceRefreshOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*autoscaling.RollbackInstanceRefreshInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *autoscaling.RollbackInstanceRefreshOutput
+	if rf, ok := ret.Get(1).(func(*autoscaling.RollbackInstanceRefreshInput) *autoscaling.RollbackInstanceRefreshOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*autoscaling.RollbackInstanceRefreshOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RollbackInstanceRefreshWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *AutoScalingAPI) RollbackInstanceRefreshWithContext(_a0 context.Context, _a1 *autoscaling.RollbackInstanceRefreshInput, _a2 ...request.Option) (*autoscaling.RollbackInstanceRefreshOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *autoscaling.RollbackInstanceRefreshOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *autoscaling.RollbackInstanceRefreshInput, ...request.Option) *autoscaling.RollbackInstanceRefreshOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autoscaling.RollbackInstanceRefreshOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *autoscaling.RollbackInstanceRefreshInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetDesiredCapacity provides a mock function with given fields: _a0
 func (_m *AutoScalingAPI) SetDesiredCapacity(_a0 *autoscaling.SetDesiredCapacityInput) (*autoscaling.SetDesiredCapacityOutput, error) {
 	ret := _m.Called(_a0)
@@ -0,0 +1,4754 @@
+// Code generated by mockery v2.0.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	docdb "github.com/aws/aws-sdk-go/service/docdb"
+
+	request "github.com/aws/aws-sdk-go/aws/request"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DocDBAPI is an autogenerated mock type for the DocDBAPI type
+type DocDBAPI struct {
+	mock.Mock
+}
+
+// AddSourceIdentifierToSubscription provides a mock function with given fields: _a0
+func (_m *DocDBAPI) AddSourceIdentifierToSubscription(_a0 *docdb.AddSourceIdentifierToSubscriptionInput) (*docdb.AddSourceIdentifierToSubscriptionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.AddSourceIdentifierToSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(*docdb.AddSourceIdentifierToSubscriptionInput) *docdb.AddSourceIdentifierToSubscriptionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.AddSourceIdentifierToSubscriptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.AddSourceIdentifierToSubscriptionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddSourceIdentifierToSubscriptionRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) AddSourceIdentifierToSubscriptionRequest(_a0 *docdb.AddSourceIdentifierToSubscriptionInput) (*request.Request, *docdb.AddSourceIdentifierToSubscriptionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.AddSourceIdentifierToSubscriptionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.AddSourceIdentifierToSubscriptionOutput
+	if rf, ok := ret.Get(1).(func(*docdb.AddSourceIdentifierToSubscriptionInput) *docdb.AddSourceIdentifierToSubscriptionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.AddSourceIdentifierToSubscriptionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddSourceIdentifierToSubscriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) AddSourceIdentifierToSubscriptionWithContext(_a0 context.Context, _a1 *docdb.AddSourceIdentifierToSubscriptionInput, _a2 ...request.Option) (*docdb.AddSourceIdentifierToSubscriptionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.AddSourceIdentifierToSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.AddSourceIdentifierToSubscriptionInput, ...request.Option) *docdb.AddSourceIdentifierToSubscriptionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.AddSourceIdentifierToSubscriptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.AddSourceIdentifierToSubscriptionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddTagsToResource provides a mock function with given fields: _a0
+func (_m *DocDBAPI) AddTagsToResource(_a0 *docdb.AddTagsToResourceInput) (*docdb.AddTagsToResourceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.AddTagsToResourceOutput
+	if rf, ok := ret.Get(0).(func(*docdb.AddTagsToResourceInput) *docdb.AddTagsToResourceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.AddTagsToResourceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.AddTagsToResourceInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddTagsToResourceRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) AddTagsToResourceRequest(_a0 *docdb.AddTagsToResourceInput) (*request.Request, *docdb.AddTagsToResourceOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.AddTagsToResourceInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.AddTagsToResourceOutput
+	if rf, ok := ret.Get(1).(func(*docdb.AddTagsToResourceInput) *docdb.AddTagsToResourceOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.AddTagsToResourceOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddTagsToResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) AddTagsToResourceWithContext(_a0 context.Context, _a1 *docdb.AddTagsToResourceInput, _a2 ...request.Option) (*docdb.AddTagsToResourceOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.AddTagsToResourceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.AddTagsToResourceInput, ...request.Option) *docdb.AddTagsToResourceOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.AddTagsToResourceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.AddTagsToResourceInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyPendingMaintenanceAction provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ApplyPendingMaintenanceAction(_a0 *docdb.ApplyPendingMaintenanceActionInput) (*docdb.ApplyPendingMaintenanceActionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.ApplyPendingMaintenanceActionOutput
+	if rf, ok := ret.Get(0).(func(*docdb.ApplyPendingMaintenanceActionInput) *docdb.ApplyPendingMaintenanceActionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ApplyPendingMaintenanceActionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.ApplyPendingMaintenanceActionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyPendingMaintenanceActionRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ApplyPendingMaintenanceActionRequest(_a0 *docdb.ApplyPendingMaintenanceActionInput) (*request.Request, *docdb.ApplyPendingMaintenanceActionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.ApplyPendingMaintenanceActionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.ApplyPendingMaintenanceActionOutput
+	if rf, ok := ret.Get(1).(func(*docdb.ApplyPendingMaintenanceActionInput) *docdb.ApplyPendingMaintenanceActionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.ApplyPendingMaintenanceActionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ApplyPendingMaintenanceActionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) ApplyPendingMaintenanceActionWithContext(_a0 context.Context, _a1 *docdb.ApplyPendingMaintenanceActionInput, _a2 ...request.Option) (*docdb.ApplyPendingMaintenanceActionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.ApplyPendingMaintenanceActionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.ApplyPendingMaintenanceActionInput, ...request.Option) *docdb.ApplyPendingMaintenanceActionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ApplyPendingMaintenanceActionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.ApplyPendingMaintenanceActionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CopyDBClusterParameterGroup provides a mock function with given fields: _a0
+func (_m *DocDBAPI) CopyDBClusterParameterGroup(_a0 *docdb.CopyDBClusterParameterGroupInput) (*docdb.CopyDBClusterParameterGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.CopyDBClusterParameterGroupOutput
+	if rf, ok := ret.Get(0).(func(*docdb.CopyDBClusterParameterGroupInput) *docdb.CopyDBClusterParameterGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.CopyDBClusterParameterGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.CopyDBClusterParameterGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CopyDBClusterParameterGroupRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) CopyDBClusterParameterGroupRequest(_a0 *docdb.CopyDBClusterParameterGroupInput) (*request.Request, *docdb.CopyDBClusterParameterGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.CopyDBClusterParameterGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.CopyDBClusterParameterGroupOutput
+	if rf, ok := ret.Get(1).(func(*docdb.CopyDBClusterParameterGroupInput) *docdb.CopyDBClusterParameterGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.CopyDBClusterParameterGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CopyDBClusterParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) CopyDBClusterParameterGroupWithContext(_a0 context.Context, _a1 *docdb.CopyDBClusterParameterGroupInput, _a2 ...request.Option) (*docdb.CopyDBClusterParameterGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.CopyDBClusterParameterGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.CopyDBClusterParameterGroupInput, ...request.Option) *docdb.CopyDBClusterParameterGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.CopyDBClusterParameterGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.CopyDBClusterParameterGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CopyDBClusterSnapshot provides a mock function with given fields: _a0
+func (_m *DocDBAPI) CopyDBClusterSnapshot(_a0 *docdb.CopyDBClusterSnapshotInput) (*docdb.CopyDBClusterSnapshotOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.CopyDBClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(*docdb.CopyDBClusterSnapshotInput) *docdb.CopyDBClusterSnapshotOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.CopyDBClusterSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.CopyDBClusterSnapshotInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CopyDBClusterSnapshotRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) CopyDBClusterSnapshotRequest(_a0 *docdb.CopyDBClusterSnapshotInput) (*request.Request, *docdb.CopyDBClusterSnapshotOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.CopyDBClusterSnapshotInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.CopyDBClusterSnapshotOutput
+	if rf, ok := ret.Get(1).(func(*docdb.CopyDBClusterSnapshotInput) *docdb.CopyDBClusterSnapshotOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.CopyDBClusterSnapshotOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CopyDBClusterSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) CopyDBClusterSnapshotWithContext(_a0 context.Context, _a1 *docdb.CopyDBClusterSnapshotInput, _a2 ...request.Option) (*docdb.CopyDBClusterSnapshotOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.CopyDBClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.CopyDBClusterSnapshotInput, ...request.Option) *docdb.CopyDBClusterSnapshotOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.CopyDBClusterSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.CopyDBClusterSnapshotInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateDBCluster provides a mock function with given fields: _a0
+func (_m *DocDBAPI) CreateDBCluster(_a0 *docdb.CreateDBClusterInput) (*docdb.CreateDBClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.CreateDBClusterOutput
+	if rf, ok := ret.Get(0).(func(*docdb.CreateDBClusterInput) *docdb.CreateDBClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.CreateDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.CreateDBClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateDBClusterParameterGroup provides a mock function with given fields: _a0
+func (_m *DocDBAPI) CreateDBClusterParameterGroup(_a0 *docdb.CreateDBClusterParameterGroupInput) (*docdb.CreateDBClusterParameterGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.CreateDBClusterParameterGroupOutput
+	if rf, ok := ret.Get(0).(func(*docdb.CreateDBClusterParameterGroupInput) *docdb.CreateDBClusterParameterGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.CreateDBClusterParameterGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.CreateDBClusterParameterGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateDBClusterParameterGroupRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) CreateDBClusterParameterGroupRequest(_a0 *docdb.CreateDBClusterParameterGroupInput) (*request.Request, *docdb.CreateDBClusterParameterGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.CreateDBClusterParameterGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.CreateDBClusterParameterGroupOutput
+	if rf, ok := ret.Get(1).(func(*docdb.CreateDBClusterParameterGroupInput) *docdb.CreateDBClusterParameterGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.CreateDBClusterParameterGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateDBClusterParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) CreateDBClusterParameterGroupWithContext(_a0 context.Context, _a1 *docdb.CreateDBClusterParameterGroupInput, _a2 ...request.Option) (*docdb.CreateDBClusterParameterGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.CreateDBClusterParameterGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.CreateDBClusterParameterGroupInput, ...request.Option) *docdb.CreateDBClusterParameterGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.CreateDBClusterParameterGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.CreateDBClusterParameterGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateDBClusterRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) CreateDBClusterRequest(_a0 *docdb.CreateDBClusterInput) (*request.Request, *docdb.CreateDBClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.CreateDBClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.CreateDBClusterOutput
+	if rf, ok := ret.Get(1).(func(*docdb.CreateDBClusterInput) *docdb.CreateDBClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.CreateDBClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateDBClusterSnapshot provides a mock function with given fields: _a0
+func (_m *DocDBAPI) CreateDBClusterSnapshot(_a0 *docdb.CreateDBClusterSnapshotInput) (*docdb.CreateDBClusterSnapshotOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.CreateDBClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(*docdb.CreateDBClusterSnapshotInput) *docdb.CreateDBClusterSnapshotOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.CreateDBClusterSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.CreateDBClusterSnapshotInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateDBClusterSnapshotRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) CreateDBClusterSnapshotRequest(_a0 *docdb.CreateDBClusterSnapshotInput) (*request.Request, *docdb.CreateDBClusterSnapshotOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.CreateDBClusterSnapshotInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.CreateDBClusterSnapshotOutput
+	if rf, ok := ret.Get(1).(func(*docdb.CreateDBClusterSnapshotInput) *docdb.CreateDBClusterSnapshotOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.CreateDBClusterSnapshotOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateDBClusterSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) CreateDBClusterSnapshotWithContext(_a0 context.Context, _a1 *docdb.CreateDBClusterSnapshotInput, _a2 ...request.Option) (*docdb.CreateDBClusterSnapshotOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.CreateDBClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.CreateDBClusterSnapshotInput, ...request.Option) *docdb.CreateDBClusterSnapshotOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.CreateDBClusterSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.CreateDBClusterSnapshotInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) CreateDBClusterWithContext(_a0 context.Context, _a1 *docdb.CreateDBClusterInput, _a2 ...request.Option) (*docdb.CreateDBClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.CreateDBClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.CreateDBClusterInput, ...request.Option) *docdb.CreateDBClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.CreateDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.CreateDBClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateDBInstance provides a mock function with given fields: _a0
+func (_m *DocDBAPI) CreateDBInstance(_a0 *docdb.CreateDBInstanceInput) (*docdb.CreateDBInstanceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.CreateDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(*docdb.CreateDBInstanceInput) *docdb.CreateDBInstanceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.CreateDBInstanceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.CreateDBInstanceInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateDBInstanceRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) CreateDBInstanceRequest(_a0 *docdb.CreateDBInstanceInput) (*request.Request, *docdb.CreateDBInstanceOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.CreateDBInstanceInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.CreateDBInstanceOutput
+	if rf, ok := ret.Get(1).(func(*docdb.CreateDBInstanceInput) *docdb.CreateDBInstanceOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.CreateDBInstanceOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateDBInstanceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) CreateDBInstanceWithContext(_a0 context.Context, _a1 *docdb.CreateDBInstanceInput, _a2 ...request.Option) (*docdb.CreateDBInstanceOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.CreateDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.CreateDBInstanceInput, ...request.Option) *docdb.CreateDBInstanceOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.CreateDBInstanceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.CreateDBInstanceInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateDBSubnetGroup provides a mock function with given fields: _a0
+func (_m *DocDBAPI) CreateDBSubnetGroup(_a0 *docdb.CreateDBSubnetGroupInput) (*docdb.CreateDBSubnetGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.CreateDBSubnetGroupOutput
+	if rf, ok := ret.Get(0).(func(*docdb.CreateDBSubnetGroupInput) *docdb.CreateDBSubnetGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.CreateDBSubnetGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.CreateDBSubnetGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateDBSubnetGroupRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) CreateDBSubnetGroupRequest(_a0 *docdb.CreateDBSubnetGroupInput) (*request.Request, *docdb.CreateDBSubnetGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.CreateDBSubnetGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.CreateDBSubnetGroupOutput
+	if rf, ok := ret.Get(1).(func(*docdb.CreateDBSubnetGroupInput) *docdb.CreateDBSubnetGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.CreateDBSubnetGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateDBSubnetGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) CreateDBSubnetGroupWithContext(_a0 context.Context, _a1 *docdb.CreateDBSubnetGroupInput, _a2 ...request.Option) (*docdb.CreateDBSubnetGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.CreateDBSubnetGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.CreateDBSubnetGroupInput, ...request.Option) *docdb.CreateDBSubnetGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.CreateDBSubnetGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.CreateDBSubnetGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateEventSubscription provides a mock function with given fields: _a0
+func (_m *DocDBAPI) CreateEventSubscription(_a0 *docdb.CreateEventSubscriptionInput) (*docdb.CreateEventSubscriptionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.CreateEventSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(*docdb.CreateEventSubscriptionInput) *docdb.CreateEventSubscriptionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.CreateEventSubscriptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.CreateEventSubscriptionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateEventSubscriptionRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) CreateEventSubscriptionRequest(_a0 *docdb.CreateEventSubscriptionInput) (*request.Request, *docdb.CreateEventSubscriptionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.CreateEventSubscriptionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.CreateEventSubscriptionOutput
+	if rf, ok := ret.Get(1).(func(*docdb.CreateEventSubscriptionInput) *docdb.CreateEventSubscriptionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.CreateEventSubscriptionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateEventSubscriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) CreateEventSubscriptionWithContext(_a0 context.Context, _a1 *docdb.CreateEventSubscriptionInput, _a2 ...request.Option) (*docdb.CreateEventSubscriptionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.CreateEventSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.CreateEventSubscriptionInput, ...request.Option) *docdb.CreateEventSubscriptionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.CreateEventSubscriptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.CreateEventSubscriptionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateGlobalCluster provides a mock function with given fields: _a0
+func (_m *DocDBAPI) CreateGlobalCluster(_a0 *docdb.CreateGlobalClusterInput) (*docdb.CreateGlobalClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.CreateGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(*docdb.CreateGlobalClusterInput) *docdb.CreateGlobalClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.CreateGlobalClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.CreateGlobalClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateGlobalClusterRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) CreateGlobalClusterRequest(_a0 *docdb.CreateGlobalClusterInput) (*request.Request, *docdb.CreateGlobalClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.CreateGlobalClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.CreateGlobalClusterOutput
+	if rf, ok := ret.Get(1).(func(*docdb.CreateGlobalClusterInput) *docdb.CreateGlobalClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.CreateGlobalClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateGlobalClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) CreateGlobalClusterWithContext(_a0 context.Context, _a1 *docdb.CreateGlobalClusterInput, _a2 ...request.Option) (*docdb.CreateGlobalClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.CreateGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.CreateGlobalClusterInput, ...request.Option) *docdb.CreateGlobalClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.CreateGlobalClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.CreateGlobalClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDBCluster provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DeleteDBCluster(_a0 *docdb.DeleteDBClusterInput) (*docdb.DeleteDBClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DeleteDBClusterOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DeleteDBClusterInput) *docdb.DeleteDBClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DeleteDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DeleteDBClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDBClusterParameterGroup provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DeleteDBClusterParameterGroup(_a0 *docdb.DeleteDBClusterParameterGroupInput) (*docdb.DeleteDBClusterParameterGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DeleteDBClusterParameterGroupOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DeleteDBClusterParameterGroupInput) *docdb.DeleteDBClusterParameterGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DeleteDBClusterParameterGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DeleteDBClusterParameterGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDBClusterParameterGroupRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DeleteDBClusterParameterGroupRequest(_a0 *docdb.DeleteDBClusterParameterGroupInput) (*request.Request, *docdb.DeleteDBClusterParameterGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DeleteDBClusterParameterGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DeleteDBClusterParameterGroupOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DeleteDBClusterParameterGroupInput) *docdb.DeleteDBClusterParameterGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DeleteDBClusterParameterGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteDBClusterParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DeleteDBClusterParameterGroupWithContext(_a0 context.Context, _a1 *docdb.DeleteDBClusterParameterGroupInput, _a2 ...request.Option) (*docdb.DeleteDBClusterParameterGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DeleteDBClusterParameterGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DeleteDBClusterParameterGroupInput, ...request.Option) *docdb.DeleteDBClusterParameterGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DeleteDBClusterParameterGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DeleteDBClusterParameterGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDBClusterRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DeleteDBClusterRequest(_a0 *docdb.DeleteDBClusterInput) (*request.Request, *docdb.DeleteDBClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DeleteDBClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DeleteDBClusterOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DeleteDBClusterInput) *docdb.DeleteDBClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DeleteDBClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteDBClusterSnapshot provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DeleteDBClusterSnapshot(_a0 *docdb.DeleteDBClusterSnapshotInput) (*docdb.DeleteDBClusterSnapshotOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DeleteDBClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DeleteDBClusterSnapshotInput) *docdb.DeleteDBClusterSnapshotOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DeleteDBClusterSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DeleteDBClusterSnapshotInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDBClusterSnapshotRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DeleteDBClusterSnapshotRequest(_a0 *docdb.DeleteDBClusterSnapshotInput) (*request.Request, *docdb.DeleteDBClusterSnapshotOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DeleteDBClusterSnapshotInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DeleteDBClusterSnapshotOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DeleteDBClusterSnapshotInput) *docdb.DeleteDBClusterSnapshotOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DeleteDBClusterSnapshotOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteDBClusterSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DeleteDBClusterSnapshotWithContext(_a0 context.Context, _a1 *docdb.DeleteDBClusterSnapshotInput, _a2 ...request.Option) (*docdb.DeleteDBClusterSnapshotOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DeleteDBClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DeleteDBClusterSnapshotInput, ...request.Option) *docdb.DeleteDBClusterSnapshotOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DeleteDBClusterSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DeleteDBClusterSnapshotInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DeleteDBClusterWithContext(_a0 context.Context, _a1 *docdb.DeleteDBClusterInput, _a2 ...request.Option) (*docdb.DeleteDBClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DeleteDBClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DeleteDBClusterInput, ...request.Option) *docdb.DeleteDBClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DeleteDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DeleteDBClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDBInstance provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DeleteDBInstance(_a0 *docdb.DeleteDBInstanceInput) (*docdb.DeleteDBInstanceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DeleteDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DeleteDBInstanceInput) *docdb.DeleteDBInstanceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DeleteDBInstanceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DeleteDBInstanceInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDBInstanceRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DeleteDBInstanceRequest(_a0 *docdb.DeleteDBInstanceInput) (*request.Request, *docdb.DeleteDBInstanceOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DeleteDBInstanceInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DeleteDBInstanceOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DeleteDBInstanceInput) *docdb.DeleteDBInstanceOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DeleteDBInstanceOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteDBInstanceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DeleteDBInstanceWithContext(_a0 context.Context, _a1 *docdb.DeleteDBInstanceInput, _a2 ...request.Option) (*docdb.DeleteDBInstanceOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DeleteDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DeleteDBInstanceInput, ...request.Option) *docdb.DeleteDBInstanceOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DeleteDBInstanceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DeleteDBInstanceInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDBSubnetGroup provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DeleteDBSubnetGroup(_a0 *docdb.DeleteDBSubnetGroupInput) (*docdb.DeleteDBSubnetGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DeleteDBSubnetGroupOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DeleteDBSubnetGroupInput) *docdb.DeleteDBSubnetGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DeleteDBSubnetGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DeleteDBSubnetGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDBSubnetGroupRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DeleteDBSubnetGroupRequest(_a0 *docdb.DeleteDBSubnetGroupInput) (*request.Request, *docdb.DeleteDBSubnetGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DeleteDBSubnetGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DeleteDBSubnetGroupOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DeleteDBSubnetGroupInput) *docdb.DeleteDBSubnetGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DeleteDBSubnetGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteDBSubnetGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DeleteDBSubnetGroupWithContext(_a0 context.Context, _a1 *docdb.DeleteDBSubnetGroupInput, _a2 ...request.Option) (*docdb.DeleteDBSubnetGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DeleteDBSubnetGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DeleteDBSubnetGroupInput, ...request.Option) *docdb.DeleteDBSubnetGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DeleteDBSubnetGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DeleteDBSubnetGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteEventSubscription provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DeleteEventSubscription(_a0 *docdb.DeleteEventSubscriptionInput) (*docdb.DeleteEventSubscriptionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DeleteEventSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DeleteEventSubscriptionInput) *docdb.DeleteEventSubscriptionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DeleteEventSubscriptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DeleteEventSubscriptionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteEventSubscriptionRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DeleteEventSubscriptionRequest(_a0 *docdb.DeleteEventSubscriptionInput) (*request.Request, *docdb.DeleteEventSubscriptionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DeleteEventSubscriptionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DeleteEventSubscriptionOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DeleteEventSubscriptionInput) *docdb.DeleteEventSubscriptionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DeleteEventSubscriptionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteEventSubscriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DeleteEventSubscriptionWithContext(_a0 context.Context, _a1 *docdb.DeleteEventSubscriptionInput, _a2 ...request.Option) (*docdb.DeleteEventSubscriptionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DeleteEventSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DeleteEventSubscriptionInput, ...request.Option) *docdb.DeleteEventSubscriptionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DeleteEventSubscriptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DeleteEventSubscriptionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteGlobalCluster provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DeleteGlobalCluster(_a0 *docdb.DeleteGlobalClusterInput) (*docdb.DeleteGlobalClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DeleteGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DeleteGlobalClusterInput) *docdb.DeleteGlobalClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DeleteGlobalClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DeleteGlobalClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteGlobalClusterRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DeleteGlobalClusterRequest(_a0 *docdb.DeleteGlobalClusterInput) (*request.Request, *docdb.DeleteGlobalClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DeleteGlobalClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DeleteGlobalClusterOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DeleteGlobalClusterInput) *docdb.DeleteGlobalClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DeleteGlobalClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteGlobalClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DeleteGlobalClusterWithContext(_a0 context.Context, _a1 *docdb.DeleteGlobalClusterInput, _a2 ...request.Option) (*docdb.DeleteGlobalClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DeleteGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DeleteGlobalClusterInput, ...request.Option) *docdb.DeleteGlobalClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DeleteGlobalClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DeleteGlobalClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeCertificates provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeCertificates(_a0 *docdb.DescribeCertificatesInput) (*docdb.DescribeCertificatesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DescribeCertificatesOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeCertificatesInput) *docdb.DescribeCertificatesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeCertificatesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeCertificatesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeCertificatesPages provides a mock function with given fields: _a0, _a1
+func (_m *DocDBAPI) DescribeCertificatesPages(_a0 *docdb.DescribeCertificatesInput, _a1 func(*docdb.DescribeCertificatesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeCertificatesInput, func(*docdb.DescribeCertificatesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeCertificatesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DocDBAPI) DescribeCertificatesPagesWithContext(_a0 context.Context, _a1 *docdb.DescribeCertificatesInput, _a2 func(*docdb.DescribeCertificatesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeCertificatesInput, func(*docdb.DescribeCertificatesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeCertificatesRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeCertificatesRequest(_a0 *docdb.DescribeCertificatesInput) (*request.Request, *docdb.DescribeCertificatesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeCertificatesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DescribeCertificatesOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeCertificatesInput) *docdb.DescribeCertificatesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DescribeCertificatesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeCertificatesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DescribeCertificatesWithContext(_a0 context.Context, _a1 *docdb.DescribeCertificatesInput, _a2 ...request.Option) (*docdb.DescribeCertificatesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DescribeCertificatesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeCertificatesInput, ...request.Option) *docdb.DescribeCertificatesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeCertificatesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DescribeCertificatesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDBClusterParameterGroups provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeDBClusterParameterGroups(_a0 *docdb.DescribeDBClusterParameterGroupsInput) (*docdb.DescribeDBClusterParameterGroupsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DescribeDBClusterParameterGroupsOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBClusterParameterGroupsInput) *docdb.DescribeDBClusterParameterGroupsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeDBClusterParameterGroupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeDBClusterParameterGroupsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDBClusterParameterGroupsPages provides a mock function with given fields: _a0, _a1
+func (_m *DocDBAPI) DescribeDBClusterParameterGroupsPages(_a0 *docdb.DescribeDBClusterParameterGroupsInput, _a1 func(*docdb.DescribeDBClusterParameterGroupsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBClusterParameterGroupsInput, func(*docdb.DescribeDBClusterParameterGroupsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBClusterParameterGroupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DocDBAPI) DescribeDBClusterParameterGroupsPagesWithContext(_a0 context.Context, _a1 *docdb.DescribeDBClusterParameterGroupsInput, _a2 func(*docdb.DescribeDBClusterParameterGroupsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeDBClusterParameterGroupsInput, func(*docdb.DescribeDBClusterParameterGroupsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBClusterParameterGroupsRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeDBClusterParameterGroupsRequest(_a0 *docdb.DescribeDBClusterParameterGroupsInput) (*request.Request, *docdb.DescribeDBClusterParameterGroupsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBClusterParameterGroupsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DescribeDBClusterParameterGroupsOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeDBClusterParameterGroupsInput) *docdb.DescribeDBClusterParameterGroupsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DescribeDBClusterParameterGroupsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeDBClusterParameterGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DescribeDBClusterParameterGroupsWithContext(_a0 context.Context, _a1 *docdb.DescribeDBClusterParameterGroupsInput, _a2 ...request.Option) (*docdb.DescribeDBClusterParameterGroupsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DescribeDBClusterParameterGroupsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeDBClusterParameterGroupsInput, ...request.Option) *docdb.DescribeDBClusterParameterGroupsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeDBClusterParameterGroupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DescribeDBClusterParameterGroupsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDBClusterParameters provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeDBClusterParameters(_a0 *docdb.DescribeDBClusterParametersInput) (*docdb.DescribeDBClusterParametersOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DescribeDBClusterParametersOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBClusterParametersInput) *docdb.DescribeDBClusterParametersOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeDBClusterParametersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeDBClusterParametersInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDBClusterParametersPages provides a mock function with given fields: _a0, _a1
+func (_m *DocDBAPI) DescribeDBClusterParametersPages(_a0 *docdb.DescribeDBClusterParametersInput, _a1 func(*docdb.DescribeDBClusterParametersOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBClusterParametersInput, func(*docdb.DescribeDBClusterParametersOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBClusterParametersPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DocDBAPI) DescribeDBClusterParametersPagesWithContext(_a0 context.Context, _a1 *docdb.DescribeDBClusterParametersInput, _a2 func(*docdb.DescribeDBClusterParametersOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeDBClusterParametersInput, func(*docdb.DescribeDBClusterParametersOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBClusterParametersRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeDBClusterParametersRequest(_a0 *docdb.DescribeDBClusterParametersInput) (*request.Request, *docdb.DescribeDBClusterParametersOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBClusterParametersInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DescribeDBClusterParametersOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeDBClusterParametersInput) *docdb.DescribeDBClusterParametersOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DescribeDBClusterParametersOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeDBClusterParametersWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DescribeDBClusterParametersWithContext(_a0 context.Context, _a1 *docdb.DescribeDBClusterParametersInput, _a2 ...request.Option) (*docdb.DescribeDBClusterParametersOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DescribeDBClusterParametersOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeDBClusterParametersInput, ...request.Option) *docdb.DescribeDBClusterParametersOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeDBClusterParametersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DescribeDBClusterParametersInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDBClusterSnapshotAttributes provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeDBClusterSnapshotAttributes(_a0 *docdb.DescribeDBClusterSnapshotAttributesInput) (*docdb.DescribeDBClusterSnapshotAttributesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DescribeDBClusterSnapshotAttributesOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBClusterSnapshotAttributesInput) *docdb.DescribeDBClusterSnapshotAttributesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeDBClusterSnapshotAttributesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeDBClusterSnapshotAttributesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDBClusterSnapshotAttributesRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeDBClusterSnapshotAttributesRequest(_a0 *docdb.DescribeDBClusterSnapshotAttributesInput) (*request.Request, *docdb.DescribeDBClusterSnapshotAttributesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBClusterSnapshotAttributesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DescribeDBClusterSnapshotAttributesOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeDBClusterSnapshotAttributesInput) *docdb.DescribeDBClusterSnapshotAttributesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DescribeDBClusterSnapshotAttributesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeDBClusterSnapshotAttributesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DescribeDBClusterSnapshotAttributesWithContext(_a0 context.Context, _a1 *docdb.DescribeDBClusterSnapshotAttributesInput, _a2 ...request.Option) (*docdb.DescribeDBClusterSnapshotAttributesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DescribeDBClusterSnapshotAttributesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeDBClusterSnapshotAttributesInput, ...request.Option) *docdb.DescribeDBClusterSnapshotAttributesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeDBClusterSnapshotAttributesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DescribeDBClusterSnapshotAttributesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDBClusterSnapshots provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeDBClusterSnapshots(_a0 *docdb.DescribeDBClusterSnapshotsInput) (*docdb.DescribeDBClusterSnapshotsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DescribeDBClusterSnapshotsOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBClusterSnapshotsInput) *docdb.DescribeDBClusterSnapshotsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeDBClusterSnapshotsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeDBClusterSnapshotsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDBClusterSnapshotsPages provides a mock function with given fields: _a0, _a1
+func (_m *DocDBAPI) DescribeDBClusterSnapshotsPages(_a0 *docdb.DescribeDBClusterSnapshotsInput, _a1 func(*docdb.DescribeDBClusterSnapshotsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBClusterSnapshotsInput, func(*docdb.DescribeDBClusterSnapshotsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBClusterSnapshotsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DocDBAPI) DescribeDBClusterSnapshotsPagesWithContext(_a0 context.Context, _a1 *docdb.DescribeDBClusterSnapshotsInput, _a2 func(*docdb.DescribeDBClusterSnapshotsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeDBClusterSnapshotsInput, func(*docdb.DescribeDBClusterSnapshotsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBClusterSnapshotsRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeDBClusterSnapshotsRequest(_a0 *docdb.DescribeDBClusterSnapshotsInput) (*request.Request, *docdb.DescribeDBClusterSnapshotsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBClusterSnapshotsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DescribeDBClusterSnapshotsOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeDBClusterSnapshotsInput) *docdb.DescribeDBClusterSnapshotsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DescribeDBClusterSnapshotsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeDBClusterSnapshotsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DescribeDBClusterSnapshotsWithContext(_a0 context.Context, _a1 *docdb.DescribeDBClusterSnapshotsInput, _a2 ...request.Option) (*docdb.DescribeDBClusterSnapshotsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DescribeDBClusterSnapshotsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeDBClusterSnapshotsInput, ...request.Option) *docdb.DescribeDBClusterSnapshotsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeDBClusterSnapshotsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DescribeDBClusterSnapshotsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDBClusters provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeDBClusters(_a0 *docdb.DescribeDBClustersInput) (*docdb.DescribeDBClustersOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DescribeDBClustersOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBClustersInput) *docdb.DescribeDBClustersOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeDBClustersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeDBClustersInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDBClustersPages provides a mock function with given fields: _a0, _a1
+func (_m *DocDBAPI) DescribeDBClustersPages(_a0 *docdb.DescribeDBClustersInput, _a1 func(*docdb.DescribeDBClustersOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBClustersInput, func(*docdb.DescribeDBClustersOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBClustersPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DocDBAPI) DescribeDBClustersPagesWithContext(_a0 context.Context, _a1 *docdb.DescribeDBClustersInput, _a2 func(*docdb.DescribeDBClustersOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeDBClustersInput, func(*docdb.DescribeDBClustersOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBClustersRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeDBClustersRequest(_a0 *docdb.DescribeDBClustersInput) (*request.Request, *docdb.DescribeDBClustersOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBClustersInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DescribeDBClustersOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeDBClustersInput) *docdb.DescribeDBClustersOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DescribeDBClustersOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeDBClustersWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DescribeDBClustersWithContext(_a0 context.Context, _a1 *docdb.DescribeDBClustersInput, _a2 ...request.Option) (*docdb.DescribeDBClustersOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DescribeDBClustersOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeDBClustersInput, ...request.Option) *docdb.DescribeDBClustersOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeDBClustersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DescribeDBClustersInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDBEngineVersions provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeDBEngineVersions(_a0 *docdb.DescribeDBEngineVersionsInput) (*docdb.DescribeDBEngineVersionsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DescribeDBEngineVersionsOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBEngineVersionsInput) *docdb.DescribeDBEngineVersionsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeDBEngineVersionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeDBEngineVersionsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDBEngineVersionsPages provides a mock function with given fields: _a0, _a1
+func (_m *DocDBAPI) DescribeDBEngineVersionsPages(_a0 *docdb.DescribeDBEngineVersionsInput, _a1 func(*docdb.DescribeDBEngineVersionsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBEngineVersionsInput, func(*docdb.DescribeDBEngineVersionsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBEngineVersionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DocDBAPI) DescribeDBEngineVersionsPagesWithContext(_a0 context.Context, _a1 *docdb.DescribeDBEngineVersionsInput, _a2 func(*docdb.DescribeDBEngineVersionsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeDBEngineVersionsInput, func(*docdb.DescribeDBEngineVersionsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBEngineVersionsRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeDBEngineVersionsRequest(_a0 *docdb.DescribeDBEngineVersionsInput) (*request.Request, *docdb.DescribeDBEngineVersionsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBEngineVersionsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DescribeDBEngineVersionsOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeDBEngineVersionsInput) *docdb.DescribeDBEngineVersionsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DescribeDBEngineVersionsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeDBEngineVersionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DescribeDBEngineVersionsWithContext(_a0 context.Context, _a1 *docdb.DescribeDBEngineVersionsInput, _a2 ...request.Option) (*docdb.DescribeDBEngineVersionsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DescribeDBEngineVersionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeDBEngineVersionsInput, ...request.Option) *docdb.DescribeDBEngineVersionsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeDBEngineVersionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DescribeDBEngineVersionsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDBInstances provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeDBInstances(_a0 *docdb.DescribeDBInstancesInput) (*docdb.DescribeDBInstancesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DescribeDBInstancesOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBInstancesInput) *docdb.DescribeDBInstancesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeDBInstancesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeDBInstancesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDBInstancesPages provides a mock function with given fields: _a0, _a1
+func (_m *DocDBAPI) DescribeDBInstancesPages(_a0 *docdb.DescribeDBInstancesInput, _a1 func(*docdb.DescribeDBInstancesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBInstancesInput, func(*docdb.DescribeDBInstancesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBInstancesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DocDBAPI) DescribeDBInstancesPagesWithContext(_a0 context.Context, _a1 *docdb.DescribeDBInstancesInput, _a2 func(*docdb.DescribeDBInstancesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeDBInstancesInput, func(*docdb.DescribeDBInstancesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBInstancesRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeDBInstancesRequest(_a0 *docdb.DescribeDBInstancesInput) (*request.Request, *docdb.DescribeDBInstancesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBInstancesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DescribeDBInstancesOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeDBInstancesInput) *docdb.DescribeDBInstancesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DescribeDBInstancesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeDBInstancesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DescribeDBInstancesWithContext(_a0 context.Context, _a1 *docdb.DescribeDBInstancesInput, _a2 ...request.Option) (*docdb.DescribeDBInstancesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DescribeDBInstancesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeDBInstancesInput, ...request.Option) *docdb.DescribeDBInstancesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeDBInstancesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DescribeDBInstancesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDBSubnetGroups provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeDBSubnetGroups(_a0 *docdb.DescribeDBSubnetGroupsInput) (*docdb.DescribeDBSubnetGroupsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DescribeDBSubnetGroupsOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBSubnetGroupsInput) *docdb.DescribeDBSubnetGroupsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeDBSubnetGroupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeDBSubnetGroupsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDBSubnetGroupsPages provides a mock function with given fields: _a0, _a1
+func (_m *DocDBAPI) DescribeDBSubnetGroupsPages(_a0 *docdb.DescribeDBSubnetGroupsInput, _a1 func(*docdb.DescribeDBSubnetGroupsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBSubnetGroupsInput, func(*docdb.DescribeDBSubnetGroupsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBSubnetGroupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DocDBAPI) DescribeDBSubnetGroupsPagesWithContext(_a0 context.Context, _a1 *docdb.DescribeDBSubnetGroupsInput, _a2 func(*docdb.DescribeDBSubnetGroupsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeDBSubnetGroupsInput, func(*docdb.DescribeDBSubnetGroupsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBSubnetGroupsRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeDBSubnetGroupsRequest(_a0 *docdb.DescribeDBSubnetGroupsInput) (*request.Request, *docdb.DescribeDBSubnetGroupsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBSubnetGroupsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DescribeDBSubnetGroupsOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeDBSubnetGroupsInput) *docdb.DescribeDBSubnetGroupsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DescribeDBSubnetGroupsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeDBSubnetGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DescribeDBSubnetGroupsWithContext(_a0 context.Context, _a1 *docdb.DescribeDBSubnetGroupsInput, _a2 ...request.Option) (*docdb.DescribeDBSubnetGroupsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DescribeDBSubnetGroupsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeDBSubnetGroupsInput, ...request.Option) *docdb.DescribeDBSubnetGroupsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeDBSubnetGroupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DescribeDBSubnetGroupsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEngineDefaultClusterParameters provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeEngineDefaultClusterParameters(_a0 *docdb.DescribeEngineDefaultClusterParametersInput) (*docdb.DescribeEngineDefaultClusterParametersOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DescribeEngineDefaultClusterParametersOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeEngineDefaultClusterParametersInput) *docdb.DescribeEngineDefaultClusterParametersOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeEngineDefaultClusterParametersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeEngineDefaultClusterParametersInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEngineDefaultClusterParametersRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeEngineDefaultClusterParametersRequest(_a0 *docdb.DescribeEngineDefaultClusterParametersInput) (*request.Request, *docdb.DescribeEngineDefaultClusterParametersOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeEngineDefaultClusterParametersInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DescribeEngineDefaultClusterParametersOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeEngineDefaultClusterParametersInput) *docdb.DescribeEngineDefaultClusterParametersOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DescribeEngineDefaultClusterParametersOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeEngineDefaultClusterParametersWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DescribeEngineDefaultClusterParametersWithContext(_a0 context.Context, _a1 *docdb.DescribeEngineDefaultClusterParametersInput, _a2 ...request.Option) (*docdb.DescribeEngineDefaultClusterParametersOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DescribeEngineDefaultClusterParametersOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeEngineDefaultClusterParametersInput, ...request.Option) *docdb.DescribeEngineDefaultClusterParametersOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeEngineDefaultClusterParametersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DescribeEngineDefaultClusterParametersInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEventCategories provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeEventCategories(_a0 *docdb.DescribeEventCategoriesInput) (*docdb.DescribeEventCategoriesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DescribeEventCategoriesOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeEventCategoriesInput) *docdb.DescribeEventCategoriesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeEventCategoriesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeEventCategoriesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEventCategoriesRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeEventCategoriesRequest(_a0 *docdb.DescribeEventCategoriesInput) (*request.Request, *docdb.DescribeEventCategoriesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeEventCategoriesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DescribeEventCategoriesOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeEventCategoriesInput) *docdb.DescribeEventCategoriesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DescribeEventCategoriesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeEventCategoriesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DescribeEventCategoriesWithContext(_a0 context.Context, _a1 *docdb.DescribeEventCategoriesInput, _a2 ...request.Option) (*docdb.DescribeEventCategoriesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DescribeEventCategoriesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeEventCategoriesInput, ...request.Option) *docdb.DescribeEventCategoriesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeEventCategoriesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DescribeEventCategoriesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEventSubscriptions provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeEventSubscriptions(_a0 *docdb.DescribeEventSubscriptionsInput) (*docdb.DescribeEventSubscriptionsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DescribeEventSubscriptionsOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeEventSubscriptionsInput) *docdb.DescribeEventSubscriptionsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeEventSubscriptionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeEventSubscriptionsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEventSubscriptionsPages provides a mock function with given fields: _a0, _a1
+func (_m *DocDBAPI) DescribeEventSubscriptionsPages(_a0 *docdb.DescribeEventSubscriptionsInput, _a1 func(*docdb.DescribeEventSubscriptionsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeEventSubscriptionsInput, func(*docdb.DescribeEventSubscriptionsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeEventSubscriptionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DocDBAPI) DescribeEventSubscriptionsPagesWithContext(_a0 context.Context, _a1 *docdb.DescribeEventSubscriptionsInput, _a2 func(*docdb.DescribeEventSubscriptionsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeEventSubscriptionsInput, func(*docdb.DescribeEventSubscriptionsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeEventSubscriptionsRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeEventSubscriptionsRequest(_a0 *docdb.DescribeEventSubscriptionsInput) (*request.Request, *docdb.DescribeEventSubscriptionsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeEventSubscriptionsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DescribeEventSubscriptionsOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeEventSubscriptionsInput) *docdb.DescribeEventSubscriptionsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DescribeEventSubscriptionsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeEventSubscriptionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DescribeEventSubscriptionsWithContext(_a0 context.Context, _a1 *docdb.DescribeEventSubscriptionsInput, _a2 ...request.Option) (*docdb.DescribeEventSubscriptionsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DescribeEventSubscriptionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeEventSubscriptionsInput, ...request.Option) *docdb.DescribeEventSubscriptionsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeEventSubscriptionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DescribeEventSubscriptionsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEvents provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeEvents(_a0 *docdb.DescribeEventsInput) (*docdb.DescribeEventsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DescribeEventsOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeEventsInput) *docdb.DescribeEventsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeEventsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeEventsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEventsPages provides a mock function with given fields: _a0, _a1
+func (_m *DocDBAPI) DescribeEventsPages(_a0 *docdb.DescribeEventsInput, _a1 func(*docdb.DescribeEventsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeEventsInput, func(*docdb.DescribeEventsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeEventsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DocDBAPI) DescribeEventsPagesWithContext(_a0 context.Context, _a1 *docdb.DescribeEventsInput, _a2 func(*docdb.DescribeEventsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeEventsInput, func(*docdb.DescribeEventsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeEventsRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeEventsRequest(_a0 *docdb.DescribeEventsInput) (*request.Request, *docdb.DescribeEventsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeEventsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DescribeEventsOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeEventsInput) *docdb.DescribeEventsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DescribeEventsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeEventsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DescribeEventsWithContext(_a0 context.Context, _a1 *docdb.DescribeEventsInput, _a2 ...request.Option) (*docdb.DescribeEventsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DescribeEventsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeEventsInput, ...request.Option) *docdb.DescribeEventsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeEventsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DescribeEventsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeGlobalClusters provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeGlobalClusters(_a0 *docdb.DescribeGlobalClustersInput) (*docdb.DescribeGlobalClustersOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DescribeGlobalClustersOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeGlobalClustersInput) *docdb.DescribeGlobalClustersOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeGlobalClustersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeGlobalClustersInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeGlobalClustersPages provides a mock function with given fields: _a0, _a1
+func (_m *DocDBAPI) DescribeGlobalClustersPages(_a0 *docdb.DescribeGlobalClustersInput, _a1 func(*docdb.DescribeGlobalClustersOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeGlobalClustersInput, func(*docdb.DescribeGlobalClustersOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeGlobalClustersPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DocDBAPI) DescribeGlobalClustersPagesWithContext(_a0 context.Context, _a1 *docdb.DescribeGlobalClustersInput, _a2 func(*docdb.DescribeGlobalClustersOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeGlobalClustersInput, func(*docdb.DescribeGlobalClustersOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeGlobalClustersRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeGlobalClustersRequest(_a0 *docdb.DescribeGlobalClustersInput) (*request.Request, *docdb.DescribeGlobalClustersOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeGlobalClustersInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DescribeGlobalClustersOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeGlobalClustersInput) *docdb.DescribeGlobalClustersOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DescribeGlobalClustersOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeGlobalClustersWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DescribeGlobalClustersWithContext(_a0 context.Context, _a1 *docdb.DescribeGlobalClustersInput, _a2 ...request.Option) (*docdb.DescribeGlobalClustersOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DescribeGlobalClustersOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeGlobalClustersInput, ...request.Option) *docdb.DescribeGlobalClustersOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeGlobalClustersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DescribeGlobalClustersInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeOrderableDBInstanceOptions provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeOrderableDBInstanceOptions(_a0 *docdb.DescribeOrderableDBInstanceOptionsInput) (*docdb.DescribeOrderableDBInstanceOptionsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DescribeOrderableDBInstanceOptionsOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeOrderableDBInstanceOptionsInput) *docdb.DescribeOrderableDBInstanceOptionsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeOrderableDBInstanceOptionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeOrderableDBInstanceOptionsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeOrderableDBInstanceOptionsPages provides a mock function with given fields: _a0, _a1
+func (_m *DocDBAPI) DescribeOrderableDBInstanceOptionsPages(_a0 *docdb.DescribeOrderableDBInstanceOptionsInput, _a1 func(*docdb.DescribeOrderableDBInstanceOptionsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeOrderableDBInstanceOptionsInput, func(*docdb.DescribeOrderableDBInstanceOptionsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeOrderableDBInstanceOptionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DocDBAPI) DescribeOrderableDBInstanceOptionsPagesWithContext(_a0 context.Context, _a1 *docdb.DescribeOrderableDBInstanceOptionsInput, _a2 func(*docdb.DescribeOrderableDBInstanceOptionsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeOrderableDBInstanceOptionsInput, func(*docdb.DescribeOrderableDBInstanceOptionsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeOrderableDBInstanceOptionsRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribeOrderableDBInstanceOptionsRequest(_a0 *docdb.DescribeOrderableDBInstanceOptionsInput) (*request.Request, *docdb.DescribeOrderableDBInstanceOptionsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeOrderableDBInstanceOptionsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DescribeOrderableDBInstanceOptionsOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DescribeOrderableDBInstanceOptionsInput) *docdb.DescribeOrderableDBInstanceOptionsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DescribeOrderableDBInstanceOptionsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeOrderableDBInstanceOptionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DescribeOrderableDBInstanceOptionsWithContext(_a0 context.Context, _a1 *docdb.DescribeOrderableDBInstanceOptionsInput, _a2 ...request.Option) (*docdb.DescribeOrderableDBInstanceOptionsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DescribeOrderableDBInstanceOptionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeOrderableDBInstanceOptionsInput, ...request.Option) *docdb.DescribeOrderableDBInstanceOptionsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribeOrderableDBInstanceOptionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DescribeOrderableDBInstanceOptionsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribePendingMaintenanceActions provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribePendingMaintenanceActions(_a0 *docdb.DescribePendingMaintenanceActionsInput) (*docdb.DescribePendingMaintenanceActionsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.DescribePendingMaintenanceActionsOutput
+	if rf, ok := ret.Get(0).(func(*docdb.DescribePendingMaintenanceActionsInput) *docdb.DescribePendingMaintenanceActionsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribePendingMaintenanceActionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.DescribePendingMaintenanceActionsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribePendingMaintenanceActionsPages provides a mock function with given fields: _a0, _a1
+func (_m *DocDBAPI) DescribePendingMaintenanceActionsPages(_a0 *docdb.DescribePendingMaintenanceActionsInput, _a1 func(*docdb.DescribePendingMaintenanceActionsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*docdb.DescribePendingMaintenanceActionsInput, func(*docdb.DescribePendingMaintenanceActionsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribePendingMaintenanceActionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DocDBAPI) DescribePendingMaintenanceActionsPagesWithContext(_a0 context.Context, _a1 *docdb.DescribePendingMaintenanceActionsInput, _a2 func(*docdb.DescribePendingMaintenanceActionsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribePendingMaintenanceActionsInput, func(*docdb.DescribePendingMaintenanceActionsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribePendingMaintenanceActionsRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) DescribePendingMaintenanceActionsRequest(_a0 *docdb.DescribePendingMaintenanceActionsInput) (*request.Request, *docdb.DescribePendingMaintenanceActionsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.DescribePendingMaintenanceActionsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.DescribePendingMaintenanceActionsOutput
+	if rf, ok := ret.Get(1).(func(*docdb.DescribePendingMaintenanceActionsInput) *docdb.DescribePendingMaintenanceActionsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.DescribePendingMaintenanceActionsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribePendingMaintenanceActionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) DescribePendingMaintenanceActionsWithContext(_a0 context.Context, _a1 *docdb.DescribePendingMaintenanceActionsInput, _a2 ...request.Option) (*docdb.DescribePendingMaintenanceActionsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.DescribePendingMaintenanceActionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribePendingMaintenanceActionsInput, ...request.Option) *docdb.DescribePendingMaintenanceActionsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.DescribePendingMaintenanceActionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.DescribePendingMaintenanceActionsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FailoverDBCluster provides a mock function with given fields: _a0
+func (_m *DocDBAPI) FailoverDBCluster(_a0 *docdb.FailoverDBClusterInput) (*docdb.FailoverDBClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.FailoverDBClusterOutput
+	if rf, ok := ret.Get(0).(func(*docdb.FailoverDBClusterInput) *docdb.FailoverDBClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.FailoverDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.FailoverDBClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FailoverDBClusterRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) FailoverDBClusterRequest(_a0 *docdb.FailoverDBClusterInput) (*request.Request, *docdb.FailoverDBClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.FailoverDBClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.FailoverDBClusterOutput
+	if rf, ok := ret.Get(1).(func(*docdb.FailoverDBClusterInput) *docdb.FailoverDBClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.FailoverDBClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// FailoverDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) FailoverDBClusterWithContext(_a0 context.Context, _a1 *docdb.FailoverDBClusterInput, _a2 ...request.Option) (*docdb.FailoverDBClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.FailoverDBClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.FailoverDBClusterInput, ...request.Option) *docdb.FailoverDBClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.FailoverDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.FailoverDBClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTagsForResource provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ListTagsForResource(_a0 *docdb.ListTagsForResourceInput) (*docdb.ListTagsForResourceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.ListTagsForResourceOutput
+	if rf, ok := ret.Get(0).(func(*docdb.ListTagsForResourceInput) *docdb.ListTagsForResourceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ListTagsForResourceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.ListTagsForResourceInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTagsForResourceRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ListTagsForResourceRequest(_a0 *docdb.ListTagsForResourceInput) (*request.Request, *docdb.ListTagsForResourceOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.ListTagsForResourceInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.ListTagsForResourceOutput
+	if rf, ok := ret.Get(1).(func(*docdb.ListTagsForResourceInput) *docdb.ListTagsForResourceOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.ListTagsForResourceOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListTagsForResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) ListTagsForResourceWithContext(_a0 context.Context, _a1 *docdb.ListTagsForResourceInput, _a2 ...request.Option) (*docdb.ListTagsForResourceOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.ListTagsForResourceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.ListTagsForResourceInput, ...request.Option) *docdb.ListTagsForResourceOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ListTagsForResourceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.ListTagsForResourceInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBCluster provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ModifyDBCluster(_a0 *docdb.ModifyDBClusterInput) (*docdb.ModifyDBClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.ModifyDBClusterOutput
+	if rf, ok := ret.Get(0).(func(*docdb.ModifyDBClusterInput) *docdb.ModifyDBClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ModifyDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.ModifyDBClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterParameterGroup provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ModifyDBClusterParameterGroup(_a0 *docdb.ModifyDBClusterParameterGroupInput) (*docdb.ModifyDBClusterParameterGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.ModifyDBClusterParameterGroupOutput
+	if rf, ok := ret.Get(0).(func(*docdb.ModifyDBClusterParameterGroupInput) *docdb.ModifyDBClusterParameterGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ModifyDBClusterParameterGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.ModifyDBClusterParameterGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterParameterGroupRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ModifyDBClusterParameterGroupRequest(_a0 *docdb.ModifyDBClusterParameterGroupInput) (*request.Request, *docdb.ModifyDBClusterParameterGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.ModifyDBClusterParameterGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.ModifyDBClusterParameterGroupOutput
+	if rf, ok := ret.Get(1).(func(*docdb.ModifyDBClusterParameterGroupInput) *docdb.ModifyDBClusterParameterGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.ModifyDBClusterParameterGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) ModifyDBClusterParameterGroupWithContext(_a0 context.Context, _a1 *docdb.ModifyDBClusterParameterGroupInput, _a2 ...request.Option) (*docdb.ModifyDBClusterParameterGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.ModifyDBClusterParameterGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.ModifyDBClusterParameterGroupInput, ...request.Option) *docdb.ModifyDBClusterParameterGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ModifyDBClusterParameterGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.ModifyDBClusterParameterGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ModifyDBClusterRequest(_a0 *docdb.ModifyDBClusterInput) (*request.Request, *docdb.ModifyDBClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.ModifyDBClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.ModifyDBClusterOutput
+	if rf, ok := ret.Get(1).(func(*docdb.ModifyDBClusterInput) *docdb.ModifyDBClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.ModifyDBClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterSnapshotAttribute provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ModifyDBClusterSnapshotAttribute(_a0 *docdb.ModifyDBClusterSnapshotAttributeInput) (*docdb.ModifyDBClusterSnapshotAttributeOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.ModifyDBClusterSnapshotAttributeOutput
+	if rf, ok := ret.Get(0).(func(*docdb.ModifyDBClusterSnapshotAttributeInput) *docdb.ModifyDBClusterSnapshotAttributeOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ModifyDBClusterSnapshotAttributeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.ModifyDBClusterSnapshotAttributeInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterSnapshotAttributeRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ModifyDBClusterSnapshotAttributeRequest(_a0 *docdb.ModifyDBClusterSnapshotAttributeInput) (*request.Request, *docdb.ModifyDBClusterSnapshotAttributeOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.ModifyDBClusterSnapshotAttributeInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.ModifyDBClusterSnapshotAttributeOutput
+	if rf, ok := ret.Get(1).(func(*docdb.ModifyDBClusterSnapshotAttributeInput) *docdb.ModifyDBClusterSnapshotAttributeOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.ModifyDBClusterSnapshotAttributeOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterSnapshotAttributeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) ModifyDBClusterSnapshotAttributeWithContext(_a0 context.Context, _a1 *docdb.ModifyDBClusterSnapshotAttributeInput, _a2 ...request.Option) (*docdb.ModifyDBClusterSnapshotAttributeOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.ModifyDBClusterSnapshotAttributeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.ModifyDBClusterSnapshotAttributeInput, ...request.Option) *docdb.ModifyDBClusterSnapshotAttributeOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ModifyDBClusterSnapshotAttributeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.ModifyDBClusterSnapshotAttributeInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) ModifyDBClusterWithContext(_a0 context.Context, _a1 *docdb.ModifyDBClusterInput, _a2 ...request.Option) (*docdb.ModifyDBClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.ModifyDBClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.ModifyDBClusterInput, ...request.Option) *docdb.ModifyDBClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ModifyDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.ModifyDBClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBInstance provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ModifyDBInstance(_a0 *docdb.ModifyDBInstanceInput) (*docdb.ModifyDBInstanceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.ModifyDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(*docdb.ModifyDBInstanceInput) *docdb.ModifyDBInstanceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ModifyDBInstanceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.ModifyDBInstanceInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBInstanceRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ModifyDBInstanceRequest(_a0 *docdb.ModifyDBInstanceInput) (*request.Request, *docdb.ModifyDBInstanceOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.ModifyDBInstanceInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.ModifyDBInstanceOutput
+	if rf, ok := ret.Get(1).(func(*docdb.ModifyDBInstanceInput) *docdb.ModifyDBInstanceOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.ModifyDBInstanceOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyDBInstanceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) ModifyDBInstanceWithContext(_a0 context.Context, _a1 *docdb.ModifyDBInstanceInput, _a2 ...request.Option) (*docdb.ModifyDBInstanceOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.ModifyDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.ModifyDBInstanceInput, ...request.Option) *docdb.ModifyDBInstanceOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ModifyDBInstanceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.ModifyDBInstanceInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBSubnetGroup provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ModifyDBSubnetGroup(_a0 *docdb.ModifyDBSubnetGroupInput) (*docdb.ModifyDBSubnetGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.ModifyDBSubnetGroupOutput
+	if rf, ok := ret.Get(0).(func(*docdb.ModifyDBSubnetGroupInput) *docdb.ModifyDBSubnetGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ModifyDBSubnetGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.ModifyDBSubnetGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBSubnetGroupRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ModifyDBSubnetGroupRequest(_a0 *docdb.ModifyDBSubnetGroupInput) (*request.Request, *docdb.ModifyDBSubnetGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.ModifyDBSubnetGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.ModifyDBSubnetGroupOutput
+	if rf, ok := ret.Get(1).(func(*docdb.ModifyDBSubnetGroupInput) *docdb.ModifyDBSubnetGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.ModifyDBSubnetGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyDBSubnetGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) ModifyDBSubnetGroupWithContext(_a0 context.Context, _a1 *docdb.ModifyDBSubnetGroupInput, _a2 ...request.Option) (*docdb.ModifyDBSubnetGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.ModifyDBSubnetGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.ModifyDBSubnetGroupInput, ...request.Option) *docdb.ModifyDBSubnetGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ModifyDBSubnetGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.ModifyDBSubnetGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyEventSubscription provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ModifyEventSubscription(_a0 *docdb.ModifyEventSubscriptionInput) (*docdb.ModifyEventSubscriptionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.ModifyEventSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(*docdb.ModifyEventSubscriptionInput) *docdb.ModifyEventSubscriptionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ModifyEventSubscriptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.ModifyEventSubscriptionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyEventSubscriptionRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ModifyEventSubscriptionRequest(_a0 *docdb.ModifyEventSubscriptionInput) (*request.Request, *docdb.ModifyEventSubscriptionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.ModifyEventSubscriptionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.ModifyEventSubscriptionOutput
+	if rf, ok := ret.Get(1).(func(*docdb.ModifyEventSubscriptionInput) *docdb.ModifyEventSubscriptionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.ModifyEventSubscriptionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyEventSubscriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) ModifyEventSubscriptionWithContext(_a0 context.Context, _a1 *docdb.ModifyEventSubscriptionInput, _a2 ...request.Option) (*docdb.ModifyEventSubscriptionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.ModifyEventSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.ModifyEventSubscriptionInput, ...request.Option) *docdb.ModifyEventSubscriptionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ModifyEventSubscriptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.ModifyEventSubscriptionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyGlobalCluster provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ModifyGlobalCluster(_a0 *docdb.ModifyGlobalClusterInput) (*docdb.ModifyGlobalClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.ModifyGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(*docdb.ModifyGlobalClusterInput) *docdb.ModifyGlobalClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ModifyGlobalClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.ModifyGlobalClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyGlobalClusterRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ModifyGlobalClusterRequest(_a0 *docdb.ModifyGlobalClusterInput) (*request.Request, *docdb.ModifyGlobalClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.ModifyGlobalClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.ModifyGlobalClusterOutput
+	if rf, ok := ret.Get(1).(func(*docdb.ModifyGlobalClusterInput) *docdb.ModifyGlobalClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.ModifyGlobalClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyGlobalClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) ModifyGlobalClusterWithContext(_a0 context.Context, _a1 *docdb.ModifyGlobalClusterInput, _a2 ...request.Option) (*docdb.ModifyGlobalClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.ModifyGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.ModifyGlobalClusterInput, ...request.Option) *docdb.ModifyGlobalClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ModifyGlobalClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.ModifyGlobalClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RebootDBInstance provides a mock function with given fields: _a0
+func (_m *DocDBAPI) RebootDBInstance(_a0 *docdb.RebootDBInstanceInput) (*docdb.RebootDBInstanceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.RebootDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(*docdb.RebootDBInstanceInput) *docdb.RebootDBInstanceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.RebootDBInstanceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.RebootDBInstanceInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RebootDBInstanceRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) RebootDBInstanceRequest(_a0 *docdb.RebootDBInstanceInput) (*request.Request, *docdb.RebootDBInstanceOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.RebootDBInstanceInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.RebootDBInstanceOutput
+	if rf, ok := ret.Get(1).(func(*docdb.RebootDBInstanceInput) *docdb.RebootDBInstanceOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.RebootDBInstanceOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RebootDBInstanceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) RebootDBInstanceWithContext(_a0 context.Context, _a1 *docdb.RebootDBInstanceInput, _a2 ...request.Option) (*docdb.RebootDBInstanceOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.RebootDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.RebootDBInstanceInput, ...request.Option) *docdb.RebootDBInstanceOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.RebootDBInstanceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.RebootDBInstanceInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveFromGlobalCluster provides a mock function with given fields: _a0
+func (_m *DocDBAPI) RemoveFromGlobalCluster(_a0 *docdb.RemoveFromGlobalClusterInput) (*docdb.RemoveFromGlobalClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.RemoveFromGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(*docdb.RemoveFromGlobalClusterInput) *docdb.RemoveFromGlobalClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.RemoveFromGlobalClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.RemoveFromGlobalClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveFromGlobalClusterRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) RemoveFromGlobalClusterRequest(_a0 *docdb.RemoveFromGlobalClusterInput) (*request.Request, *docdb.RemoveFromGlobalClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.RemoveFromGlobalClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.RemoveFromGlobalClusterOutput
+	if rf, ok := ret.Get(1).(func(*docdb.RemoveFromGlobalClusterInput) *docdb.RemoveFromGlobalClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.RemoveFromGlobalClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RemoveFromGlobalClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) RemoveFromGlobalClusterWithContext(_a0 context.Context, _a1 *docdb.RemoveFromGlobalClusterInput, _a2 ...request.Option) (*docdb.RemoveFromGlobalClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.RemoveFromGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.RemoveFromGlobalClusterInput, ...request.Option) *docdb.RemoveFromGlobalClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.RemoveFromGlobalClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.RemoveFromGlobalClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveSourceIdentifierFromSubscription provides a mock function with given fields: _a0
+func (_m *DocDBAPI) RemoveSourceIdentifierFromSubscription(_a0 *docdb.RemoveSourceIdentifierFromSubscriptionInput) (*docdb.RemoveSourceIdentifierFromSubscriptionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.RemoveSourceIdentifierFromSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(*docdb.RemoveSourceIdentifierFromSubscriptionInput) *docdb.RemoveSourceIdentifierFromSubscriptionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.RemoveSourceIdentifierFromSubscriptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.RemoveSourceIdentifierFromSubscriptionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveSourceIdentifierFromSubscriptionRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) RemoveSourceIdentifierFromSubscriptionRequest(_a0 *docdb.RemoveSourceIdentifierFromSubscriptionInput) (*request.Request, *docdb.RemoveSourceIdentifierFromSubscriptionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.RemoveSourceIdentifierFromSubscriptionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.RemoveSourceIdentifierFromSubscriptionOutput
+	if rf, ok := ret.Get(1).(func(*docdb.RemoveSourceIdentifierFromSubscriptionInput) *docdb.RemoveSourceIdentifierFromSubscriptionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.RemoveSourceIdentifierFromSubscriptionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RemoveSourceIdentifierFromSubscriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) RemoveSourceIdentifierFromSubscriptionWithContext(_a0 context.Context, _a1 *docdb.RemoveSourceIdentifierFromSubscriptionInput, _a2 ...request.Option) (*docdb.RemoveSourceIdentifierFromSubscriptionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.RemoveSourceIdentifierFromSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.RemoveSourceIdentifierFromSubscriptionInput, ...request.Option) *docdb.RemoveSourceIdentifierFromSubscriptionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.RemoveSourceIdentifierFromSubscriptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.RemoveSourceIdentifierFromSubscriptionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveTagsFromResource provides a mock function with given fields: _a0
+func (_m *DocDBAPI) RemoveTagsFromResource(_a0 *docdb.RemoveTagsFromResourceInput) (*docdb.RemoveTagsFromResourceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.RemoveTagsFromResourceOutput
+	if rf, ok := ret.Get(0).(func(*docdb.RemoveTagsFromResourceInput) *docdb.RemoveTagsFromResourceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.RemoveTagsFromResourceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.RemoveTagsFromResourceInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveTagsFromResourceRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) RemoveTagsFromResourceRequest(_a0 *docdb.RemoveTagsFromResourceInput) (*request.Request, *docdb.RemoveTagsFromResourceOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.RemoveTagsFromResourceInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.RemoveTagsFromResourceOutput
+	if rf, ok := ret.Get(1).(func(*docdb.RemoveTagsFromResourceInput) *docdb.RemoveTagsFromResourceOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.RemoveTagsFromResourceOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RemoveTagsFromResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) RemoveTagsFromResourceWithContext(_a0 context.Context, _a1 *docdb.RemoveTagsFromResourceInput, _a2 ...request.Option) (*docdb.RemoveTagsFromResourceOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.RemoveTagsFromResourceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.RemoveTagsFromResourceInput, ...request.Option) *docdb.RemoveTagsFromResourceOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.RemoveTagsFromResourceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.RemoveTagsFromResourceInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetDBClusterParameterGroup provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ResetDBClusterParameterGroup(_a0 *docdb.ResetDBClusterParameterGroupInput) (*docdb.ResetDBClusterParameterGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.ResetDBClusterParameterGroupOutput
+	if rf, ok := ret.Get(0).(func(*docdb.ResetDBClusterParameterGroupInput) *docdb.ResetDBClusterParameterGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ResetDBClusterParameterGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.ResetDBClusterParameterGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetDBClusterParameterGroupRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) ResetDBClusterParameterGroupRequest(_a0 *docdb.ResetDBClusterParameterGroupInput) (*request.Request, *docdb.ResetDBClusterParameterGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.ResetDBClusterParameterGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.ResetDBClusterParameterGroupOutput
+	if rf, ok := ret.Get(1).(func(*docdb.ResetDBClusterParameterGroupInput) *docdb.ResetDBClusterParameterGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.ResetDBClusterParameterGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ResetDBClusterParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) ResetDBClusterParameterGroupWithContext(_a0 context.Context, _a1 *docdb.ResetDBClusterParameterGroupInput, _a2 ...request.Option) (*docdb.ResetDBClusterParameterGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.ResetDBClusterParameterGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.ResetDBClusterParameterGroupInput, ...request.Option) *docdb.ResetDBClusterParameterGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.ResetDBClusterParameterGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.ResetDBClusterParameterGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreDBClusterFromSnapshot provides a mock function with given fields: _a0
+func (_m *DocDBAPI) RestoreDBClusterFromSnapshot(_a0 *docdb.RestoreDBClusterFromSnapshotInput) (*docdb.RestoreDBClusterFromSnapshotOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.RestoreDBClusterFromSnapshotOutput
+	if rf, ok := ret.Get(0).(func(*docdb.RestoreDBClusterFromSnapshotInput) *docdb.RestoreDBClusterFromSnapshotOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.RestoreDBClusterFromSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.RestoreDBClusterFromSnapshotInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreDBClusterFromSnapshotRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) RestoreDBClusterFromSnapshotRequest(_a0 *docdb.RestoreDBClusterFromSnapshotInput) (*request.Request, *docdb.RestoreDBClusterFromSnapshotOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.RestoreDBClusterFromSnapshotInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.RestoreDBClusterFromSnapshotOutput
+	if rf, ok := ret.Get(1).(func(*docdb.RestoreDBClusterFromSnapshotInput) *docdb.RestoreDBClusterFromSnapshotOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.RestoreDBClusterFromSnapshotOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RestoreDBClusterFromSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) RestoreDBClusterFromSnapshotWithContext(_a0 context.Context, _a1 *docdb.RestoreDBClusterFromSnapshotInput, _a2 ...request.Option) (*docdb.RestoreDBClusterFromSnapshotOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.RestoreDBClusterFromSnapshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.RestoreDBClusterFromSnapshotInput, ...request.Option) *docdb.RestoreDBClusterFromSnapshotOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.RestoreDBClusterFromSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.RestoreDBClusterFromSnapshotInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreDBClusterToPointInTime provides a mock function with given fields: _a0
+func (_m *DocDBAPI) RestoreDBClusterToPointInTime(_a0 *docdb.RestoreDBClusterToPointInTimeInput) (*docdb.RestoreDBClusterToPointInTimeOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.RestoreDBClusterToPointInTimeOutput
+	if rf, ok := ret.Get(0).(func(*docdb.RestoreDBClusterToPointInTimeInput) *docdb.RestoreDBClusterToPointInTimeOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.RestoreDBClusterToPointInTimeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.RestoreDBClusterToPointInTimeInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreDBClusterToPointInTimeRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) RestoreDBClusterToPointInTimeRequest(_a0 *docdb.RestoreDBClusterToPointInTimeInput) (*request.Request, *docdb.RestoreDBClusterToPointInTimeOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.RestoreDBClusterToPointInTimeInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.RestoreDBClusterToPointInTimeOutput
+	if rf, ok := ret.Get(1).(func(*docdb.RestoreDBClusterToPointInTimeInput) *docdb.RestoreDBClusterToPointInTimeOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.RestoreDBClusterToPointInTimeOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RestoreDBClusterToPointInTimeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) RestoreDBClusterToPointInTimeWithContext(_a0 context.Context, _a1 *docdb.RestoreDBClusterToPointInTimeInput, _a2 ...request.Option) (*docdb.RestoreDBClusterToPointInTimeOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.RestoreDBClusterToPointInTimeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.RestoreDBClusterToPointInTimeInput, ...request.Option) *docdb.RestoreDBClusterToPointInTimeOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.RestoreDBClusterToPointInTimeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.RestoreDBClusterToPointInTimeInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartDBCluster provides a mock function with given fields: _a0
+func (_m *DocDBAPI) StartDBCluster(_a0 *docdb.StartDBClusterInput) (*docdb.StartDBClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.StartDBClusterOutput
+	if rf, ok := ret.Get(0).(func(*docdb.StartDBClusterInput) *docdb.StartDBClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.StartDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.StartDBClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartDBClusterRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) StartDBClusterRequest(_a0 *docdb.StartDBClusterInput) (*request.Request, *docdb.StartDBClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.StartDBClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.StartDBClusterOutput
+	if rf, ok := ret.Get(1).(func(*docdb.StartDBClusterInput) *docdb.StartDBClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.StartDBClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// StartDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) StartDBClusterWithContext(_a0 context.Context, _a1 *docdb.StartDBClusterInput, _a2 ...request.Option) (*docdb.StartDBClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.StartDBClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.StartDBClusterInput, ...request.Option) *docdb.StartDBClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.StartDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.StartDBClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StopDBCluster provides a mock function with given fields: _a0
+func (_m *DocDBAPI) StopDBCluster(_a0 *docdb.StopDBClusterInput) (*docdb.StopDBClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.StopDBClusterOutput
+	if rf, ok := ret.Get(0).(func(*docdb.StopDBClusterInput) *docdb.StopDBClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.StopDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.StopDBClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StopDBClusterRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) StopDBClusterRequest(_a0 *docdb.StopDBClusterInput) (*request.Request, *docdb.StopDBClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.StopDBClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.StopDBClusterOutput
+	if rf, ok := ret.Get(1).(func(*docdb.StopDBClusterInput) *docdb.StopDBClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.StopDBClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// StopDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) StopDBClusterWithContext(_a0 context.Context, _a1 *docdb.StopDBClusterInput, _a2 ...request.Option) (*docdb.StopDBClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.StopDBClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.StopDBClusterInput, ...request.Option) *docdb.StopDBClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.StopDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.StopDBClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SwitchoverGlobalCluster provides a mock function with given fields: _a0
+func (_m *DocDBAPI) SwitchoverGlobalCluster(_a0 *docdb.SwitchoverGlobalClusterInput) (*docdb.SwitchoverGlobalClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *docdb.SwitchoverGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(*docdb.SwitchoverGlobalClusterInput) *docdb.SwitchoverGlobalClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.SwitchoverGlobalClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*docdb.SwitchoverGlobalClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SwitchoverGlobalClusterRequest provides a mock function with given fields: _a0
+func (_m *DocDBAPI) SwitchoverGlobalClusterRequest(_a0 *docdb.SwitchoverGlobalClusterInput) (*request.Request, *docdb.SwitchoverGlobalClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*docdb.SwitchoverGlobalClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *docdb.SwitchoverGlobalClusterOutput
+	if rf, ok := ret.Get(1).(func(*docdb.SwitchoverGlobalClusterInput) *docdb.SwitchoverGlobalClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*docdb.SwitchoverGlobalClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// SwitchoverGlobalClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) SwitchoverGlobalClusterWithContext(_a0 context.Context, _a1 *docdb.SwitchoverGlobalClusterInput, _a2 ...request.Option) (*docdb.SwitchoverGlobalClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *docdb.SwitchoverGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.SwitchoverGlobalClusterInput, ...request.Option) *docdb.SwitchoverGlobalClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*docdb.SwitchoverGlobalClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *docdb.SwitchoverGlobalClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WaitUntilDBInstanceAvailable provides a mock function with given fields: _a0
+func (_m *DocDBAPI) WaitUntilDBInstanceAvailable(_a0 *docdb.DescribeDBInstancesInput) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBInstancesInput) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilDBInstanceAvailableWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) WaitUntilDBInstanceAvailableWithContext(_a0 context.Context, _a1 *docdb.DescribeDBInstancesInput, _a2 ...request.WaiterOption) error {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeDBInstancesInput, ...request.WaiterOption) error); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilDBInstanceDeleted provides a mock function with given fields: _a0
+func (_m *DocDBAPI) WaitUntilDBInstanceDeleted(_a0 *docdb.DescribeDBInstancesInput) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*docdb.DescribeDBInstancesInput) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilDBInstanceDeletedWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DocDBAPI) WaitUntilDBInstanceDeletedWithContext(_a0 context.Context, _a1 *docdb.DescribeDBInstancesInput, _a2 ...request.WaiterOption) error {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *docdb.DescribeDBInstancesInput, ...request.WaiterOption) error); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
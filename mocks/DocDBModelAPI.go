@@ -0,0 +1,117 @@
+// Code generated by mockery v2.0.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	docdb "github.com/aws/aws-sdk-go/service/docdb"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DocDBModelAPI is an autogenerated mock type for the DocDBModelAPI type
+type DocDBModelAPI struct {
+	mock.Mock
+}
+
+// GetDocDBClustersForTags provides a mock function with given fields: ctx, repository, branch
+func (_m *DocDBModelAPI) GetDocDBClustersForTags(ctx context.Context, repository string, branch string) ([]*docdb.DBCluster, []*docdb.DBCluster, error) {
+	ret := _m.Called(ctx, repository, branch)
+
+	var r0 []*docdb.DBCluster
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*docdb.DBCluster); ok {
+		r0 = rf(ctx, repository, branch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*docdb.DBCluster)
+		}
+	}
+
+	var r1 []*docdb.DBCluster
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) []*docdb.DBCluster); ok {
+		r1 = rf(ctx, repository, branch)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*docdb.DBCluster)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, repository, branch)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// StartDocDBCluster provides a mock function with given fields: ctx, clusterARN, clusterStatus
+func (_m *DocDBModelAPI) StartDocDBCluster(ctx context.Context, clusterARN *string, clusterStatus *string) (bool, error) {
+	ret := _m.Called(ctx, clusterARN, clusterStatus)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *string) bool); ok {
+		r0 = rf(ctx, clusterARN, clusterStatus)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *string) error); ok {
+		r1 = rf(ctx, clusterARN, clusterStatus)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StopDocDBCluster provides a mock function with given fields: ctx, clusterARN, clusterStatus
+func (_m *DocDBModelAPI) StopDocDBCluster(ctx context.Context, clusterARN *string, clusterStatus *string) (bool, error) {
+	ret := _m.Called(ctx, clusterARN, clusterStatus)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *string) bool); ok {
+		r0 = rf(ctx, clusterARN, clusterStatus)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *string) error); ok {
+		r1 = rf(ctx, clusterARN, clusterStatus)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WaitUntilDocDBClusterAvailable provides a mock function with given fields: ctx, clusterARN
+func (_m *DocDBModelAPI) WaitUntilDocDBClusterAvailable(ctx context.Context, clusterARN *string) error {
+	ret := _m.Called(ctx, clusterARN)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) error); ok {
+		r0 = rf(ctx, clusterARN)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilDocDBClusterStopped provides a mock function with given fields: ctx, clusterARN
+func (_m *DocDBModelAPI) WaitUntilDocDBClusterStopped(ctx context.Context, clusterARN *string) error {
+	ret := _m.Called(ctx, clusterARN)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) error); ok {
+		r0 = rf(ctx, clusterARN)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
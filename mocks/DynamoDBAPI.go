@@ -7,9 +7,8 @@ import (
 
 	dynamodb "github.com/aws/aws-sdk-go/service/dynamodb"
 
-	mock "github.com/stretchr/testify/mock"
-
 	request "github.com/aws/aws-sdk-go/aws/request"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // DynamoDBAPI is an autogenerated mock type for the DynamoDBAPI type
@@ -17,6 +16,84 @@ type DynamoDBAPI struct {
 	mock.Mock
 }
 
+// BatchExecuteStatement provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) BatchExecuteStatement(_a0 *dynamodb.BatchExecuteStatementInput) (*dynamodb.BatchExecuteStatementOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.BatchExecuteStatementOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.BatchExecuteStatementInput) *dynamodb.BatchExecuteStatementOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.BatchExecuteStatementOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.BatchExecuteStatementInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchExecuteStatementRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) BatchExecuteStatementRequest(_a0 *dynamodb.BatchExecuteStatementInput) (*request.Request, *dynamodb.BatchExecuteStatementOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.BatchExecuteStatementInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.BatchExecuteStatementOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.BatchExecuteStatementInput) *dynamodb.BatchExecuteStatementOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.BatchExecuteStatementOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// BatchExecuteStatementWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) BatchExecuteStatementWithContext(_a0 context.Context, _a1 *dynamodb.BatchExecuteStatementInput, _a2 ...request.Option) (*dynamodb.BatchExecuteStatementOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.BatchExecuteStatementOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.BatchExecuteStatementInput, ...request.Option) *dynamodb.BatchExecuteStatementOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.BatchExecuteStatementOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.BatchExecuteStatementInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BatchGetItem provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) BatchGetItem(_a0 *dynamodb.BatchGetItemInput) (*dynamodb.BatchGetItemOutput, error) {
 	ret := _m.Called(_a0)
@@ -598,6 +675,84 @@ func (_m *DynamoDBAPI) DeleteItemWithContext(_a0 context.Context, _a1 *dynamodb.
 	return r0, r1
 }
 
+// DeleteResourcePolicy provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DeleteResourcePolicy(_a0 *dynamodb.DeleteResourcePolicyInput) (*dynamodb.DeleteResourcePolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.DeleteResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DeleteResourcePolicyInput) *dynamodb.DeleteResourcePolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DeleteResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DeleteResourcePolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteResourcePolicyRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DeleteResourcePolicyRequest(_a0 *dynamodb.DeleteResourcePolicyInput) (*request.Request, *dynamodb.DeleteResourcePolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.DeleteResourcePolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.DeleteResourcePolicyOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DeleteResourcePolicyInput) *dynamodb.DeleteResourcePolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.DeleteResourcePolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteResourcePolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DeleteResourcePolicyWithContext(_a0 context.Context, _a1 *dynamodb.DeleteResourcePolicyInput, _a2 ...request.Option) (*dynamodb.DeleteResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DeleteResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.DeleteResourcePolicyInput, ...request.Option) *dynamodb.DeleteResourcePolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DeleteResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.DeleteResourcePolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteTable provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) DeleteTable(_a0 *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error) {
 	ret := _m.Called(_a0)
@@ -988,6 +1143,84 @@ func (_m *DynamoDBAPI) DescribeEndpointsWithContext(_a0 context.Context, _a1 *dy
 	return r0, r1
 }
 
+// DescribeExport provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeExport(_a0 *dynamodb.DescribeExportInput) (*dynamodb.DescribeExportOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.DescribeExportOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeExportInput) *dynamodb.DescribeExportOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeExportOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeExportInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeExportRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeExportRequest(_a0 *dynamodb.DescribeExportInput) (*request.Request, *dynamodb.DescribeExportOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeExportInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.DescribeExportOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeExportInput) *dynamodb.DescribeExportOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.DescribeExportOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeExportWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeExportWithContext(_a0 context.Context, _a1 *dynamodb.DescribeExportInput, _a2 ...request.Option) (*dynamodb.DescribeExportOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DescribeExportOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.DescribeExportInput, ...request.Option) *dynamodb.DescribeExportOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeExportOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.DescribeExportInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeGlobalTable provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) DescribeGlobalTable(_a0 *dynamodb.DescribeGlobalTableInput) (*dynamodb.DescribeGlobalTableOutput, error) {
 	ret := _m.Called(_a0)
@@ -1144,21 +1377,21 @@ func (_m *DynamoDBAPI) DescribeGlobalTableWithContext(_a0 context.Context, _a1 *
 	return r0, r1
 }
 
-// DescribeLimits provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) DescribeLimits(_a0 *dynamodb.DescribeLimitsInput) (*dynamodb.DescribeLimitsOutput, error) {
+// DescribeImport provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeImport(_a0 *dynamodb.DescribeImportInput) (*dynamodb.DescribeImportOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.DescribeLimitsOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeLimitsInput) *dynamodb.DescribeLimitsOutput); ok {
+	var r0 *dynamodb.DescribeImportOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeImportInput) *dynamodb.DescribeImportOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.DescribeLimitsOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeImportOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeLimitsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeImportInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1167,12 +1400,12 @@ func (_m *DynamoDBAPI) DescribeLimits(_a0 *dynamodb.DescribeLimitsInput) (*dynam
 	return r0, r1
 }
 
-// DescribeLimitsRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) DescribeLimitsRequest(_a0 *dynamodb.DescribeLimitsInput) (*request.Request, *dynamodb.DescribeLimitsOutput) {
+// DescribeImportRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeImportRequest(_a0 *dynamodb.DescribeImportInput) (*request.Request, *dynamodb.DescribeImportOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeLimitsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeImportInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1180,20 +1413,20 @@ func (_m *DynamoDBAPI) DescribeLimitsRequest(_a0 *dynamodb.DescribeLimitsInput)
 		}
 	}
 
-	var r1 *dynamodb.DescribeLimitsOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeLimitsInput) *dynamodb.DescribeLimitsOutput); ok {
+	var r1 *dynamodb.DescribeImportOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeImportInput) *dynamodb.DescribeImportOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.DescribeLimitsOutput)
+			r1 = ret.Get(1).(*dynamodb.DescribeImportOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeLimitsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) DescribeLimitsWithContext(_a0 context.Context, _a1 *dynamodb.DescribeLimitsInput, _a2 ...request.Option) (*dynamodb.DescribeLimitsOutput, error) {
+// DescribeImportWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeImportWithContext(_a0 context.Context, _a1 *dynamodb.DescribeImportInput, _a2 ...request.Option) (*dynamodb.DescribeImportOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1203,17 +1436,17 @@ func (_m *DynamoDBAPI) DescribeLimitsWithContext(_a0 context.Context, _a1 *dynam
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.DescribeLimitsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.DescribeLimitsInput, ...request.Option) *dynamodb.DescribeLimitsOutput); ok {
+	var r0 *dynamodb.DescribeImportOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.DescribeImportInput, ...request.Option) *dynamodb.DescribeImportOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.DescribeLimitsOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeImportOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.DescribeLimitsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.DescribeImportInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1222,44 +1455,21 @@ func (_m *DynamoDBAPI) DescribeLimitsWithContext(_a0 context.Context, _a1 *dynam
 	return r0, r1
 }
 
-// DescribeTable provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) DescribeTable(_a0 *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *dynamodb.DescribeTableOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTableInput) *dynamodb.DescribeTableOutput); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.DescribeTableOutput)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTableInput) error); ok {
-		r1 = rf(_a0)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// DescribeTableReplicaAutoScaling provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) DescribeTableReplicaAutoScaling(_a0 *dynamodb.DescribeTableReplicaAutoScalingInput) (*dynamodb.DescribeTableReplicaAutoScalingOutput, error) {
+// DescribeKinesisStreamingDestination provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeKinesisStreamingDestination(_a0 *dynamodb.DescribeKinesisStreamingDestinationInput) (*dynamodb.DescribeKinesisStreamingDestinationOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.DescribeTableReplicaAutoScalingOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTableReplicaAutoScalingInput) *dynamodb.DescribeTableReplicaAutoScalingOutput); ok {
+	var r0 *dynamodb.DescribeKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeKinesisStreamingDestinationInput) *dynamodb.DescribeKinesisStreamingDestinationOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.DescribeTableReplicaAutoScalingOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeKinesisStreamingDestinationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTableReplicaAutoScalingInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeKinesisStreamingDestinationInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1268,12 +1478,12 @@ func (_m *DynamoDBAPI) DescribeTableReplicaAutoScaling(_a0 *dynamodb.DescribeTab
 	return r0, r1
 }
 
-// DescribeTableReplicaAutoScalingRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) DescribeTableReplicaAutoScalingRequest(_a0 *dynamodb.DescribeTableReplicaAutoScalingInput) (*request.Request, *dynamodb.DescribeTableReplicaAutoScalingOutput) {
+// DescribeKinesisStreamingDestinationRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeKinesisStreamingDestinationRequest(_a0 *dynamodb.DescribeKinesisStreamingDestinationInput) (*request.Request, *dynamodb.DescribeKinesisStreamingDestinationOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTableReplicaAutoScalingInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeKinesisStreamingDestinationInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1281,20 +1491,20 @@ func (_m *DynamoDBAPI) DescribeTableReplicaAutoScalingRequest(_a0 *dynamodb.Desc
 		}
 	}
 
-	var r1 *dynamodb.DescribeTableReplicaAutoScalingOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTableReplicaAutoScalingInput) *dynamodb.DescribeTableReplicaAutoScalingOutput); ok {
+	var r1 *dynamodb.DescribeKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeKinesisStreamingDestinationInput) *dynamodb.DescribeKinesisStreamingDestinationOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.DescribeTableReplicaAutoScalingOutput)
+			r1 = ret.Get(1).(*dynamodb.DescribeKinesisStreamingDestinationOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeTableReplicaAutoScalingWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) DescribeTableReplicaAutoScalingWithContext(_a0 context.Context, _a1 *dynamodb.DescribeTableReplicaAutoScalingInput, _a2 ...request.Option) (*dynamodb.DescribeTableReplicaAutoScalingOutput, error) {
+// DescribeKinesisStreamingDestinationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeKinesisStreamingDestinationWithContext(_a0 context.Context, _a1 *dynamodb.DescribeKinesisStreamingDestinationInput, _a2 ...request.Option) (*dynamodb.DescribeKinesisStreamingDestinationOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1304,17 +1514,17 @@ func (_m *DynamoDBAPI) DescribeTableReplicaAutoScalingWithContext(_a0 context.Co
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.DescribeTableReplicaAutoScalingOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.DescribeTableReplicaAutoScalingInput, ...request.Option) *dynamodb.DescribeTableReplicaAutoScalingOutput); ok {
+	var r0 *dynamodb.DescribeKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.DescribeKinesisStreamingDestinationInput, ...request.Option) *dynamodb.DescribeKinesisStreamingDestinationOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.DescribeTableReplicaAutoScalingOutput)
+			r0 = ret.Get(0).(*dynamodb.DescribeKinesisStreamingDestinationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.DescribeTableReplicaAutoScalingInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.DescribeKinesisStreamingDestinationInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1323,33 +1533,602 @@ func (_m *DynamoDBAPI) DescribeTableReplicaAutoScalingWithContext(_a0 context.Co
 	return r0, r1
 }
 
-// DescribeTableRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) DescribeTableRequest(_a0 *dynamodb.DescribeTableInput) (*request.Request, *dynamodb.DescribeTableOutput) {
+// DescribeLimits provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeLimits(_a0 *dynamodb.DescribeLimitsInput) (*dynamodb.DescribeLimitsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTableInput) *request.Request); ok {
+	var r0 *dynamodb.DescribeLimitsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeLimitsInput) *dynamodb.DescribeLimitsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*request.Request)
+			r0 = ret.Get(0).(*dynamodb.DescribeLimitsOutput)
 		}
 	}
 
-	var r1 *dynamodb.DescribeTableOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTableInput) *dynamodb.DescribeTableOutput); ok {
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeLimitsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeLimitsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeLimitsRequest(_a0 *dynamodb.DescribeLimitsInput) (*request.Request, *dynamodb.DescribeLimitsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeLimitsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.DescribeLimitsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeLimitsInput) *dynamodb.DescribeLimitsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.DescribeLimitsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeLimitsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeLimitsWithContext(_a0 context.Context, _a1 *dynamodb.DescribeLimitsInput, _a2 ...request.Option) (*dynamodb.DescribeLimitsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DescribeLimitsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.DescribeLimitsInput, ...request.Option) *dynamodb.DescribeLimitsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeLimitsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.DescribeLimitsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTable provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeTable(_a0 *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.DescribeTableOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTableInput) *dynamodb.DescribeTableOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeTableOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTableInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTableReplicaAutoScaling provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeTableReplicaAutoScaling(_a0 *dynamodb.DescribeTableReplicaAutoScalingInput) (*dynamodb.DescribeTableReplicaAutoScalingOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.DescribeTableReplicaAutoScalingOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTableReplicaAutoScalingInput) *dynamodb.DescribeTableReplicaAutoScalingOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeTableReplicaAutoScalingOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTableReplicaAutoScalingInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTableReplicaAutoScalingRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeTableReplicaAutoScalingRequest(_a0 *dynamodb.DescribeTableReplicaAutoScalingInput) (*request.Request, *dynamodb.DescribeTableReplicaAutoScalingOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTableReplicaAutoScalingInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.DescribeTableReplicaAutoScalingOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTableReplicaAutoScalingInput) *dynamodb.DescribeTableReplicaAutoScalingOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.DescribeTableReplicaAutoScalingOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeTableReplicaAutoScalingWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeTableReplicaAutoScalingWithContext(_a0 context.Context, _a1 *dynamodb.DescribeTableReplicaAutoScalingInput, _a2 ...request.Option) (*dynamodb.DescribeTableReplicaAutoScalingOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DescribeTableReplicaAutoScalingOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.DescribeTableReplicaAutoScalingInput, ...request.Option) *dynamodb.DescribeTableReplicaAutoScalingOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeTableReplicaAutoScalingOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.DescribeTableReplicaAutoScalingInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTableRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeTableRequest(_a0 *dynamodb.DescribeTableInput) (*request.Request, *dynamodb.DescribeTableOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTableInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.DescribeTableOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTableInput) *dynamodb.DescribeTableOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.DescribeTableOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeTableWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeTableWithContext(_a0 context.Context, _a1 *dynamodb.DescribeTableInput, _a2 ...request.Option) (*dynamodb.DescribeTableOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DescribeTableOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.DescribeTableInput, ...request.Option) *dynamodb.DescribeTableOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeTableOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.DescribeTableInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTimeToLive provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeTimeToLive(_a0 *dynamodb.DescribeTimeToLiveInput) (*dynamodb.DescribeTimeToLiveOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.DescribeTimeToLiveOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTimeToLiveInput) *dynamodb.DescribeTimeToLiveOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeTimeToLiveOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTimeToLiveInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTimeToLiveRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DescribeTimeToLiveRequest(_a0 *dynamodb.DescribeTimeToLiveInput) (*request.Request, *dynamodb.DescribeTimeToLiveOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTimeToLiveInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.DescribeTimeToLiveOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTimeToLiveInput) *dynamodb.DescribeTimeToLiveOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.DescribeTimeToLiveOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeTimeToLiveWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DescribeTimeToLiveWithContext(_a0 context.Context, _a1 *dynamodb.DescribeTimeToLiveInput, _a2 ...request.Option) (*dynamodb.DescribeTimeToLiveOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DescribeTimeToLiveOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.DescribeTimeToLiveInput, ...request.Option) *dynamodb.DescribeTimeToLiveOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DescribeTimeToLiveOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.DescribeTimeToLiveInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableKinesisStreamingDestination provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DisableKinesisStreamingDestination(_a0 *dynamodb.DisableKinesisStreamingDestinationInput) (*dynamodb.DisableKinesisStreamingDestinationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.DisableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.DisableKinesisStreamingDestinationInput) *dynamodb.DisableKinesisStreamingDestinationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DisableKinesisStreamingDestinationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.DisableKinesisStreamingDestinationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableKinesisStreamingDestinationRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) DisableKinesisStreamingDestinationRequest(_a0 *dynamodb.DisableKinesisStreamingDestinationInput) (*request.Request, *dynamodb.DisableKinesisStreamingDestinationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.DisableKinesisStreamingDestinationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.DisableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.DisableKinesisStreamingDestinationInput) *dynamodb.DisableKinesisStreamingDestinationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.DisableKinesisStreamingDestinationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DisableKinesisStreamingDestinationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) DisableKinesisStreamingDestinationWithContext(_a0 context.Context, _a1 *dynamodb.DisableKinesisStreamingDestinationInput, _a2 ...request.Option) (*dynamodb.DisableKinesisStreamingDestinationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.DisableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.DisableKinesisStreamingDestinationInput, ...request.Option) *dynamodb.DisableKinesisStreamingDestinationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.DisableKinesisStreamingDestinationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.DisableKinesisStreamingDestinationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnableKinesisStreamingDestination provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) EnableKinesisStreamingDestination(_a0 *dynamodb.EnableKinesisStreamingDestinationInput) (*dynamodb.EnableKinesisStreamingDestinationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.EnableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.EnableKinesisStreamingDestinationInput) *dynamodb.EnableKinesisStreamingDestinationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.EnableKinesisStreamingDestinationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.EnableKinesisStreamingDestinationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnableKinesisStreamingDestinationRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) EnableKinesisStreamingDestinationRequest(_a0 *dynamodb.EnableKinesisStreamingDestinationInput) (*request.Request, *dynamodb.EnableKinesisStreamingDestinationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.EnableKinesisStreamingDestinationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.EnableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.EnableKinesisStreamingDestinationInput) *dynamodb.EnableKinesisStreamingDestinationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.EnableKinesisStreamingDestinationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// EnableKinesisStreamingDestinationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) EnableKinesisStreamingDestinationWithContext(_a0 context.Context, _a1 *dynamodb.EnableKinesisStreamingDestinationInput, _a2 ...request.Option) (*dynamodb.EnableKinesisStreamingDestinationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.EnableKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.EnableKinesisStreamingDestinationInput, ...request.Option) *dynamodb.EnableKinesisStreamingDestinationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.EnableKinesisStreamingDestinationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.EnableKinesisStreamingDestinationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteStatement provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ExecuteStatement(_a0 *dynamodb.ExecuteStatementInput) (*dynamodb.ExecuteStatementOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ExecuteStatementOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExecuteStatementInput) *dynamodb.ExecuteStatementOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExecuteStatementOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExecuteStatementInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteStatementRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ExecuteStatementRequest(_a0 *dynamodb.ExecuteStatementInput) (*request.Request, *dynamodb.ExecuteStatementOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExecuteStatementInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ExecuteStatementOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExecuteStatementInput) *dynamodb.ExecuteStatementOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ExecuteStatementOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExecuteStatementWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ExecuteStatementWithContext(_a0 context.Context, _a1 *dynamodb.ExecuteStatementInput, _a2 ...request.Option) (*dynamodb.ExecuteStatementOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ExecuteStatementOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ExecuteStatementInput, ...request.Option) *dynamodb.ExecuteStatementOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExecuteStatementOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.ExecuteStatementInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteTransaction provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ExecuteTransaction(_a0 *dynamodb.ExecuteTransactionInput) (*dynamodb.ExecuteTransactionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ExecuteTransactionOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExecuteTransactionInput) *dynamodb.ExecuteTransactionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ExecuteTransactionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExecuteTransactionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteTransactionRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ExecuteTransactionRequest(_a0 *dynamodb.ExecuteTransactionInput) (*request.Request, *dynamodb.ExecuteTransactionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExecuteTransactionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ExecuteTransactionOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExecuteTransactionInput) *dynamodb.ExecuteTransactionOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.DescribeTableOutput)
+			r1 = ret.Get(1).(*dynamodb.ExecuteTransactionOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeTableWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) DescribeTableWithContext(_a0 context.Context, _a1 *dynamodb.DescribeTableInput, _a2 ...request.Option) (*dynamodb.DescribeTableOutput, error) {
+// ExecuteTransactionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ExecuteTransactionWithContext(_a0 context.Context, _a1 *dynamodb.ExecuteTransactionInput, _a2 ...request.Option) (*dynamodb.ExecuteTransactionOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1359,17 +2138,17 @@ func (_m *DynamoDBAPI) DescribeTableWithContext(_a0 context.Context, _a1 *dynamo
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.DescribeTableOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.DescribeTableInput, ...request.Option) *dynamodb.DescribeTableOutput); ok {
+	var r0 *dynamodb.ExecuteTransactionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ExecuteTransactionInput, ...request.Option) *dynamodb.ExecuteTransactionOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.DescribeTableOutput)
+			r0 = ret.Get(0).(*dynamodb.ExecuteTransactionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.DescribeTableInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.ExecuteTransactionInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1378,21 +2157,21 @@ func (_m *DynamoDBAPI) DescribeTableWithContext(_a0 context.Context, _a1 *dynamo
 	return r0, r1
 }
 
-// DescribeTimeToLive provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) DescribeTimeToLive(_a0 *dynamodb.DescribeTimeToLiveInput) (*dynamodb.DescribeTimeToLiveOutput, error) {
+// ExportTableToPointInTime provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ExportTableToPointInTime(_a0 *dynamodb.ExportTableToPointInTimeInput) (*dynamodb.ExportTableToPointInTimeOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.DescribeTimeToLiveOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTimeToLiveInput) *dynamodb.DescribeTimeToLiveOutput); ok {
+	var r0 *dynamodb.ExportTableToPointInTimeOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExportTableToPointInTimeInput) *dynamodb.ExportTableToPointInTimeOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.DescribeTimeToLiveOutput)
+			r0 = ret.Get(0).(*dynamodb.ExportTableToPointInTimeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTimeToLiveInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExportTableToPointInTimeInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1401,12 +2180,12 @@ func (_m *DynamoDBAPI) DescribeTimeToLive(_a0 *dynamodb.DescribeTimeToLiveInput)
 	return r0, r1
 }
 
-// DescribeTimeToLiveRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) DescribeTimeToLiveRequest(_a0 *dynamodb.DescribeTimeToLiveInput) (*request.Request, *dynamodb.DescribeTimeToLiveOutput) {
+// ExportTableToPointInTimeRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ExportTableToPointInTimeRequest(_a0 *dynamodb.ExportTableToPointInTimeInput) (*request.Request, *dynamodb.ExportTableToPointInTimeOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.DescribeTimeToLiveInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.ExportTableToPointInTimeInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1414,20 +2193,20 @@ func (_m *DynamoDBAPI) DescribeTimeToLiveRequest(_a0 *dynamodb.DescribeTimeToLiv
 		}
 	}
 
-	var r1 *dynamodb.DescribeTimeToLiveOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.DescribeTimeToLiveInput) *dynamodb.DescribeTimeToLiveOutput); ok {
+	var r1 *dynamodb.ExportTableToPointInTimeOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ExportTableToPointInTimeInput) *dynamodb.ExportTableToPointInTimeOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.DescribeTimeToLiveOutput)
+			r1 = ret.Get(1).(*dynamodb.ExportTableToPointInTimeOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeTimeToLiveWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) DescribeTimeToLiveWithContext(_a0 context.Context, _a1 *dynamodb.DescribeTimeToLiveInput, _a2 ...request.Option) (*dynamodb.DescribeTimeToLiveOutput, error) {
+// ExportTableToPointInTimeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ExportTableToPointInTimeWithContext(_a0 context.Context, _a1 *dynamodb.ExportTableToPointInTimeInput, _a2 ...request.Option) (*dynamodb.ExportTableToPointInTimeOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1437,17 +2216,17 @@ func (_m *DynamoDBAPI) DescribeTimeToLiveWithContext(_a0 context.Context, _a1 *d
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.DescribeTimeToLiveOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.DescribeTimeToLiveInput, ...request.Option) *dynamodb.DescribeTimeToLiveOutput); ok {
+	var r0 *dynamodb.ExportTableToPointInTimeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ExportTableToPointInTimeInput, ...request.Option) *dynamodb.ExportTableToPointInTimeOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.DescribeTimeToLiveOutput)
+			r0 = ret.Get(0).(*dynamodb.ExportTableToPointInTimeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.DescribeTimeToLiveInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.ExportTableToPointInTimeInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1534,6 +2313,162 @@ func (_m *DynamoDBAPI) GetItemWithContext(_a0 context.Context, _a1 *dynamodb.Get
 	return r0, r1
 }
 
+// GetResourcePolicy provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) GetResourcePolicy(_a0 *dynamodb.GetResourcePolicyInput) (*dynamodb.GetResourcePolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.GetResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.GetResourcePolicyInput) *dynamodb.GetResourcePolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.GetResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.GetResourcePolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetResourcePolicyRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) GetResourcePolicyRequest(_a0 *dynamodb.GetResourcePolicyInput) (*request.Request, *dynamodb.GetResourcePolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.GetResourcePolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.GetResourcePolicyOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.GetResourcePolicyInput) *dynamodb.GetResourcePolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.GetResourcePolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetResourcePolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) GetResourcePolicyWithContext(_a0 context.Context, _a1 *dynamodb.GetResourcePolicyInput, _a2 ...request.Option) (*dynamodb.GetResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.GetResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.GetResourcePolicyInput, ...request.Option) *dynamodb.GetResourcePolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.GetResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.GetResourcePolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportTable provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ImportTable(_a0 *dynamodb.ImportTableInput) (*dynamodb.ImportTableOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ImportTableOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ImportTableInput) *dynamodb.ImportTableOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ImportTableOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ImportTableInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportTableRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ImportTableRequest(_a0 *dynamodb.ImportTableInput) (*request.Request, *dynamodb.ImportTableOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ImportTableInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ImportTableOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ImportTableInput) *dynamodb.ImportTableOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ImportTableOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ImportTableWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ImportTableWithContext(_a0 context.Context, _a1 *dynamodb.ImportTableInput, _a2 ...request.Option) (*dynamodb.ImportTableOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ImportTableOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ImportTableInput, ...request.Option) *dynamodb.ImportTableOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ImportTableOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.ImportTableInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListBackups provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) ListBackups(_a0 *dynamodb.ListBackupsInput) (*dynamodb.ListBackupsOutput, error) {
 	ret := _m.Called(_a0)
@@ -1562,7 +2497,120 @@ func (_m *DynamoDBAPI) ListBackupsRequest(_a0 *dynamodb.ListBackupsInput) (*requ
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListBackupsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListBackupsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ListBackupsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListBackupsInput) *dynamodb.ListBackupsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ListBackupsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListBackupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ListBackupsWithContext(_a0 context.Context, _a1 *dynamodb.ListBackupsInput, _a2 ...request.Option) (*dynamodb.ListBackupsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ListBackupsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ListBackupsInput, ...request.Option) *dynamodb.ListBackupsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListBackupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.ListBackupsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListContributorInsights provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListContributorInsights(_a0 *dynamodb.ListContributorInsightsInput) (*dynamodb.ListContributorInsightsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ListContributorInsightsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListContributorInsightsInput) *dynamodb.ListContributorInsightsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListContributorInsightsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListContributorInsightsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListContributorInsightsPages provides a mock function with given fields: _a0, _a1
+func (_m *DynamoDBAPI) ListContributorInsightsPages(_a0 *dynamodb.ListContributorInsightsInput, _a1 func(*dynamodb.ListContributorInsightsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListContributorInsightsInput, func(*dynamodb.ListContributorInsightsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListContributorInsightsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DynamoDBAPI) ListContributorInsightsPagesWithContext(_a0 context.Context, _a1 *dynamodb.ListContributorInsightsInput, _a2 func(*dynamodb.ListContributorInsightsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ListContributorInsightsInput, func(*dynamodb.ListContributorInsightsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListContributorInsightsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListContributorInsightsRequest(_a0 *dynamodb.ListContributorInsightsInput) (*request.Request, *dynamodb.ListContributorInsightsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListContributorInsightsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1570,20 +2618,20 @@ func (_m *DynamoDBAPI) ListBackupsRequest(_a0 *dynamodb.ListBackupsInput) (*requ
 		}
 	}
 
-	var r1 *dynamodb.ListBackupsOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.ListBackupsInput) *dynamodb.ListBackupsOutput); ok {
+	var r1 *dynamodb.ListContributorInsightsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListContributorInsightsInput) *dynamodb.ListContributorInsightsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.ListBackupsOutput)
+			r1 = ret.Get(1).(*dynamodb.ListContributorInsightsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListBackupsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) ListBackupsWithContext(_a0 context.Context, _a1 *dynamodb.ListBackupsInput, _a2 ...request.Option) (*dynamodb.ListBackupsOutput, error) {
+// ListContributorInsightsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ListContributorInsightsWithContext(_a0 context.Context, _a1 *dynamodb.ListContributorInsightsInput, _a2 ...request.Option) (*dynamodb.ListContributorInsightsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1593,17 +2641,17 @@ func (_m *DynamoDBAPI) ListBackupsWithContext(_a0 context.Context, _a1 *dynamodb
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.ListBackupsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ListBackupsInput, ...request.Option) *dynamodb.ListBackupsOutput); ok {
+	var r0 *dynamodb.ListContributorInsightsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ListContributorInsightsInput, ...request.Option) *dynamodb.ListContributorInsightsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ListBackupsOutput)
+			r0 = ret.Get(0).(*dynamodb.ListContributorInsightsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.ListBackupsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.ListContributorInsightsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1612,21 +2660,21 @@ func (_m *DynamoDBAPI) ListBackupsWithContext(_a0 context.Context, _a1 *dynamodb
 	return r0, r1
 }
 
-// ListContributorInsights provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) ListContributorInsights(_a0 *dynamodb.ListContributorInsightsInput) (*dynamodb.ListContributorInsightsOutput, error) {
+// ListExports provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListExports(_a0 *dynamodb.ListExportsInput) (*dynamodb.ListExportsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *dynamodb.ListContributorInsightsOutput
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListContributorInsightsInput) *dynamodb.ListContributorInsightsOutput); ok {
+	var r0 *dynamodb.ListExportsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListExportsInput) *dynamodb.ListExportsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ListContributorInsightsOutput)
+			r0 = ret.Get(0).(*dynamodb.ListExportsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*dynamodb.ListContributorInsightsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListExportsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1635,12 +2683,12 @@ func (_m *DynamoDBAPI) ListContributorInsights(_a0 *dynamodb.ListContributorInsi
 	return r0, r1
 }
 
-// ListContributorInsightsPages provides a mock function with given fields: _a0, _a1
-func (_m *DynamoDBAPI) ListContributorInsightsPages(_a0 *dynamodb.ListContributorInsightsInput, _a1 func(*dynamodb.ListContributorInsightsOutput, bool) bool) error {
+// ListExportsPages provides a mock function with given fields: _a0, _a1
+func (_m *DynamoDBAPI) ListExportsPages(_a0 *dynamodb.ListExportsInput, _a1 func(*dynamodb.ListExportsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListContributorInsightsInput, func(*dynamodb.ListContributorInsightsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListExportsInput, func(*dynamodb.ListExportsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -1649,8 +2697,8 @@ func (_m *DynamoDBAPI) ListContributorInsightsPages(_a0 *dynamodb.ListContributo
 	return r0
 }
 
-// ListContributorInsightsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *DynamoDBAPI) ListContributorInsightsPagesWithContext(_a0 context.Context, _a1 *dynamodb.ListContributorInsightsInput, _a2 func(*dynamodb.ListContributorInsightsOutput, bool) bool, _a3 ...request.Option) error {
+// ListExportsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DynamoDBAPI) ListExportsPagesWithContext(_a0 context.Context, _a1 *dynamodb.ListExportsInput, _a2 func(*dynamodb.ListExportsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -1661,7 +2709,7 @@ func (_m *DynamoDBAPI) ListContributorInsightsPagesWithContext(_a0 context.Conte
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ListContributorInsightsInput, func(*dynamodb.ListContributorInsightsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ListExportsInput, func(*dynamodb.ListExportsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -1670,12 +2718,12 @@ func (_m *DynamoDBAPI) ListContributorInsightsPagesWithContext(_a0 context.Conte
 	return r0
 }
 
-// ListContributorInsightsRequest provides a mock function with given fields: _a0
-func (_m *DynamoDBAPI) ListContributorInsightsRequest(_a0 *dynamodb.ListContributorInsightsInput) (*request.Request, *dynamodb.ListContributorInsightsOutput) {
+// ListExportsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListExportsRequest(_a0 *dynamodb.ListExportsInput) (*request.Request, *dynamodb.ListExportsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*dynamodb.ListContributorInsightsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListExportsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1683,20 +2731,20 @@ func (_m *DynamoDBAPI) ListContributorInsightsRequest(_a0 *dynamodb.ListContribu
 		}
 	}
 
-	var r1 *dynamodb.ListContributorInsightsOutput
-	if rf, ok := ret.Get(1).(func(*dynamodb.ListContributorInsightsInput) *dynamodb.ListContributorInsightsOutput); ok {
+	var r1 *dynamodb.ListExportsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListExportsInput) *dynamodb.ListExportsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*dynamodb.ListContributorInsightsOutput)
+			r1 = ret.Get(1).(*dynamodb.ListExportsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListContributorInsightsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *DynamoDBAPI) ListContributorInsightsWithContext(_a0 context.Context, _a1 *dynamodb.ListContributorInsightsInput, _a2 ...request.Option) (*dynamodb.ListContributorInsightsOutput, error) {
+// ListExportsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ListExportsWithContext(_a0 context.Context, _a1 *dynamodb.ListExportsInput, _a2 ...request.Option) (*dynamodb.ListExportsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1706,17 +2754,17 @@ func (_m *DynamoDBAPI) ListContributorInsightsWithContext(_a0 context.Context, _
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *dynamodb.ListContributorInsightsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ListContributorInsightsInput, ...request.Option) *dynamodb.ListContributorInsightsOutput); ok {
+	var r0 *dynamodb.ListExportsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ListExportsInput, ...request.Option) *dynamodb.ListExportsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*dynamodb.ListContributorInsightsOutput)
+			r0 = ret.Get(0).(*dynamodb.ListExportsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.ListContributorInsightsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.ListExportsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1803,6 +2851,119 @@ func (_m *DynamoDBAPI) ListGlobalTablesWithContext(_a0 context.Context, _a1 *dyn
 	return r0, r1
 }
 
+// ListImports provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListImports(_a0 *dynamodb.ListImportsInput) (*dynamodb.ListImportsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.ListImportsOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListImportsInput) *dynamodb.ListImportsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListImportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListImportsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListImportsPages provides a mock function with given fields: _a0, _a1
+func (_m *DynamoDBAPI) ListImportsPages(_a0 *dynamodb.ListImportsInput, _a1 func(*dynamodb.ListImportsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListImportsInput, func(*dynamodb.ListImportsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListImportsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *DynamoDBAPI) ListImportsPagesWithContext(_a0 context.Context, _a1 *dynamodb.ListImportsInput, _a2 func(*dynamodb.ListImportsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ListImportsInput, func(*dynamodb.ListImportsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListImportsRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) ListImportsRequest(_a0 *dynamodb.ListImportsInput) (*request.Request, *dynamodb.ListImportsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.ListImportsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.ListImportsOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.ListImportsInput) *dynamodb.ListImportsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.ListImportsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListImportsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) ListImportsWithContext(_a0 context.Context, _a1 *dynamodb.ListImportsInput, _a2 ...request.Option) (*dynamodb.ListImportsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.ListImportsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.ListImportsInput, ...request.Option) *dynamodb.ListImportsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.ListImportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.ListImportsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListTables provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) ListTables(_a0 *dynamodb.ListTablesInput) (*dynamodb.ListTablesOutput, error) {
 	ret := _m.Called(_a0)
@@ -2072,6 +3233,84 @@ func (_m *DynamoDBAPI) PutItemWithContext(_a0 context.Context, _a1 *dynamodb.Put
 	return r0, r1
 }
 
+// PutResourcePolicy provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) PutResourcePolicy(_a0 *dynamodb.PutResourcePolicyInput) (*dynamodb.PutResourcePolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.PutResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.PutResourcePolicyInput) *dynamodb.PutResourcePolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.PutResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.PutResourcePolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutResourcePolicyRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) PutResourcePolicyRequest(_a0 *dynamodb.PutResourcePolicyInput) (*request.Request, *dynamodb.PutResourcePolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.PutResourcePolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.PutResourcePolicyOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.PutResourcePolicyInput) *dynamodb.PutResourcePolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.PutResourcePolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PutResourcePolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) PutResourcePolicyWithContext(_a0 context.Context, _a1 *dynamodb.PutResourcePolicyInput, _a2 ...request.Option) (*dynamodb.PutResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.PutResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.PutResourcePolicyInput, ...request.Option) *dynamodb.PutResourcePolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.PutResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.PutResourcePolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Query provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) Query(_a0 *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
 	ret := _m.Called(_a0)
@@ -3156,6 +4395,84 @@ func (_m *DynamoDBAPI) UpdateItemWithContext(_a0 context.Context, _a1 *dynamodb.
 	return r0, r1
 }
 
+// UpdateKinesisStreamingDestination provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UpdateKinesisStreamingDestination(_a0 *dynamodb.UpdateKinesisStreamingDestinationInput) (*dynamodb.UpdateKinesisStreamingDestinationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *dynamodb.UpdateKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(*dynamodb.UpdateKinesisStreamingDestinationInput) *dynamodb.UpdateKinesisStreamingDestinationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.UpdateKinesisStreamingDestinationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*dynamodb.UpdateKinesisStreamingDestinationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateKinesisStreamingDestinationRequest provides a mock function with given fields: _a0
+func (_m *DynamoDBAPI) UpdateKinesisStreamingDestinationRequest(_a0 *dynamodb.UpdateKinesisStreamingDestinationInput) (*request.Request, *dynamodb.UpdateKinesisStreamingDestinationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*dynamodb.UpdateKinesisStreamingDestinationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *dynamodb.UpdateKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(1).(func(*dynamodb.UpdateKinesisStreamingDestinationInput) *dynamodb.UpdateKinesisStreamingDestinationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dynamodb.UpdateKinesisStreamingDestinationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateKinesisStreamingDestinationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *DynamoDBAPI) UpdateKinesisStreamingDestinationWithContext(_a0 context.Context, _a1 *dynamodb.UpdateKinesisStreamingDestinationInput, _a2 ...request.Option) (*dynamodb.UpdateKinesisStreamingDestinationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *dynamodb.UpdateKinesisStreamingDestinationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *dynamodb.UpdateKinesisStreamingDestinationInput, ...request.Option) *dynamodb.UpdateKinesisStreamingDestinationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dynamodb.UpdateKinesisStreamingDestinationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *dynamodb.UpdateKinesisStreamingDestinationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateTable provides a mock function with given fields: _a0
 func (_m *DynamoDBAPI) UpdateTable(_a0 *dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error) {
 	ret := _m.Called(_a0)
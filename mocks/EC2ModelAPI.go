@@ -2,29 +2,89 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	ec2 "github.com/aws/aws-sdk-go/service/ec2"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // EC2ModelAPI is an autogenerated mock type for the EC2ModelAPI type
 type EC2ModelAPI struct {
 	mock.Mock
 }
 
-// DescribeInstancesForTagsAndAction provides a mock function with given fields: repository, branch, action
-func (_m *EC2ModelAPI) DescribeInstancesForTagsAndAction(repository string, branch string, action string) ([]*string, error) {
-	ret := _m.Called(repository, branch, action)
+// DescribeInstancesForTags provides a mock function with given fields: ctx, repository, branch
+func (_m *EC2ModelAPI) DescribeInstancesForTags(ctx context.Context, repository string, branch string) ([]*ec2.Instance, []*ec2.Instance, error) {
+	ret := _m.Called(ctx, repository, branch)
+
+	var r0 []*ec2.Instance
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*ec2.Instance); ok {
+		r0 = rf(ctx, repository, branch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.Instance)
+		}
+	}
+
+	var r1 []*ec2.Instance
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) []*ec2.Instance); ok {
+		r1 = rf(ctx, repository, branch)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*ec2.Instance)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, repository, branch)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// StartEC2Instances provides a mock function with given fields: ctx, instanceIDs
+func (_m *EC2ModelAPI) StartEC2Instances(ctx context.Context, instanceIDs []*string) ([]*ec2.InstanceStateChange, error) {
+	ret := _m.Called(ctx, instanceIDs)
+
+	var r0 []*ec2.InstanceStateChange
+	if rf, ok := ret.Get(0).(func(context.Context, []*string) []*ec2.InstanceStateChange); ok {
+		r0 = rf(ctx, instanceIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ec2.InstanceStateChange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []*string) error); ok {
+		r1 = rf(ctx, instanceIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StopEC2Instances provides a mock function with given fields: ctx, instanceIDs
+func (_m *EC2ModelAPI) StopEC2Instances(ctx context.Context, instanceIDs []*string) ([]*ec2.InstanceStateChange, error) {
+	ret := _m.Called(ctx, instanceIDs)
 
-	var r0 []*string
-	if rf, ok := ret.Get(0).(func(string, string, string) []*string); ok {
-		r0 = rf(repository, branch, action)
+	var r0 []*ec2.InstanceStateChange
+	if rf, ok := ret.Get(0).(func(context.Context, []*string) []*ec2.InstanceStateChange); ok {
+		r0 = rf(ctx, instanceIDs)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*string)
+			r0 = ret.Get(0).([]*ec2.InstanceStateChange)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
-		r1 = rf(repository, branch, action)
+	if rf, ok := ret.Get(1).(func(context.Context, []*string) error); ok {
+		r1 = rf(ctx, instanceIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -32,13 +92,13 @@ func (_m *EC2ModelAPI) DescribeInstancesForTagsAndAction(repository string, bran
 	return r0, r1
 }
 
-// StartEC2Instances provides a mock function with given fields: instanceIDs
-func (_m *EC2ModelAPI) StartEC2Instances(instanceIDs []*string) error {
-	ret := _m.Called(instanceIDs)
+// WaitUntilEC2InstancesRunning provides a mock function with given fields: ctx, instanceIDs
+func (_m *EC2ModelAPI) WaitUntilEC2InstancesRunning(ctx context.Context, instanceIDs []*string) error {
+	ret := _m.Called(ctx, instanceIDs)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]*string) error); ok {
-		r0 = rf(instanceIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, []*string) error); ok {
+		r0 = rf(ctx, instanceIDs)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -46,13 +106,13 @@ func (_m *EC2ModelAPI) StartEC2Instances(instanceIDs []*string) error {
 	return r0
 }
 
-// StopEC2Instances provides a mock function with given fields: instanceIDs
-func (_m *EC2ModelAPI) StopEC2Instances(instanceIDs []*string) error {
-	ret := _m.Called(instanceIDs)
+// WaitUntilEC2InstancesStopped provides a mock function with given fields: ctx, instanceIDs
+func (_m *EC2ModelAPI) WaitUntilEC2InstancesStopped(ctx context.Context, instanceIDs []*string) error {
+	ret := _m.Called(ctx, instanceIDs)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]*string) error); ok {
-		r0 = rf(instanceIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, []*string) error); ok {
+		r0 = rf(ctx, instanceIDs)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -0,0 +1,124 @@
+// Code generated by mockery v2.0.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	ecs "github.com/aws/aws-sdk-go/service/ecs"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ECSModelAPI is an autogenerated mock type for the ECSModelAPI type
+type ECSModelAPI struct {
+	mock.Mock
+}
+
+// DescribeServicesForTags provides a mock function with given fields: ctx, repository, branch
+func (_m *ECSModelAPI) DescribeServicesForTags(ctx context.Context, repository string, branch string) ([]*ecs.Service, []*ecs.Service, error) {
+	ret := _m.Called(ctx, repository, branch)
+
+	var r0 []*ecs.Service
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*ecs.Service); ok {
+		r0 = rf(ctx, repository, branch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ecs.Service)
+		}
+	}
+
+	var r1 []*ecs.Service
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) []*ecs.Service); ok {
+		r1 = rf(ctx, repository, branch)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*ecs.Service)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, repository, branch)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetPreviousDesiredCountOfECSService provides a mock function with given fields: ctx, serviceARN
+func (_m *ECSModelAPI) GetPreviousDesiredCountOfECSService(ctx context.Context, serviceARN *string) (int64, error) {
+	ret := _m.Called(ctx, serviceARN)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, *string) int64); ok {
+		r0 = rf(ctx, serviceARN)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+		r1 = rf(ctx, serviceARN)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetECSServiceDesiredCountToPreviousValue provides a mock function with given fields: ctx, serviceARN
+func (_m *ECSModelAPI) SetECSServiceDesiredCountToPreviousValue(ctx context.Context, serviceARN *string) (int64, error) {
+	ret := _m.Called(ctx, serviceARN)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, *string) int64); ok {
+		r0 = rf(ctx, serviceARN)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+		r1 = rf(ctx, serviceARN)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetECSServiceDesiredCountToZero provides a mock function with given fields: ctx, serviceARN
+func (_m *ECSModelAPI) SetECSServiceDesiredCountToZero(ctx context.Context, serviceARN *string) (int64, error) {
+	ret := _m.Called(ctx, serviceARN)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, *string) int64); ok {
+		r0 = rf(ctx, serviceARN)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+		r1 = rf(ctx, serviceARN)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WaitUntilECSServiceStable provides a mock function with given fields: ctx, serviceARN
+func (_m *ECSModelAPI) WaitUntilECSServiceStable(ctx context.Context, serviceARN *string) error {
+	ret := _m.Called(ctx, serviceARN)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) error); ok {
+		r0 = rf(ctx, serviceARN)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
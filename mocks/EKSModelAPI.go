@@ -0,0 +1,132 @@
+// Code generated by mockery v2.0.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	eks "github.com/aws/aws-sdk-go/service/eks"
+	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/auto-staging/scheduler/types"
+)
+
+// EKSModelAPI is an autogenerated mock type for the EKSModelAPI type
+type EKSModelAPI struct {
+	mock.Mock
+}
+
+// DescribeNodegroupsForTags provides a mock function with given fields: ctx, repository, branch
+func (_m *EKSModelAPI) DescribeNodegroupsForTags(ctx context.Context, repository string, branch string) ([]*eks.Nodegroup, []*eks.Nodegroup, error) {
+	ret := _m.Called(ctx, repository, branch)
+
+	var r0 []*eks.Nodegroup
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*eks.Nodegroup); ok {
+		r0 = rf(ctx, repository, branch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*eks.Nodegroup)
+		}
+	}
+
+	var r1 []*eks.Nodegroup
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) []*eks.Nodegroup); ok {
+		r1 = rf(ctx, repository, branch)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*eks.Nodegroup)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, repository, branch)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetPreviousSizeOfNodegroup provides a mock function with given fields: ctx, nodegroupARN
+func (_m *EKSModelAPI) GetPreviousSizeOfNodegroup(ctx context.Context, nodegroupARN *string) (*types.NodegroupSize, error) {
+	ret := _m.Called(ctx, nodegroupARN)
+
+	var r0 *types.NodegroupSize
+	if rf, ok := ret.Get(0).(func(context.Context, *string) *types.NodegroupSize); ok {
+		r0 = rf(ctx, nodegroupARN)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.NodegroupSize)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+		r1 = rf(ctx, nodegroupARN)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetNodegroupSizeToPreviousValue provides a mock function with given fields: ctx, nodegroupARN
+func (_m *EKSModelAPI) SetNodegroupSizeToPreviousValue(ctx context.Context, nodegroupARN *string) (*types.NodegroupSize, error) {
+	ret := _m.Called(ctx, nodegroupARN)
+
+	var r0 *types.NodegroupSize
+	if rf, ok := ret.Get(0).(func(context.Context, *string) *types.NodegroupSize); ok {
+		r0 = rf(ctx, nodegroupARN)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.NodegroupSize)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+		r1 = rf(ctx, nodegroupARN)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetNodegroupSizeToZero provides a mock function with given fields: ctx, nodegroupARN
+func (_m *EKSModelAPI) SetNodegroupSizeToZero(ctx context.Context, nodegroupARN *string) (*types.NodegroupSize, error) {
+	ret := _m.Called(ctx, nodegroupARN)
+
+	var r0 *types.NodegroupSize
+	if rf, ok := ret.Get(0).(func(context.Context, *string) *types.NodegroupSize); ok {
+		r0 = rf(ctx, nodegroupARN)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.NodegroupSize)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+		r1 = rf(ctx, nodegroupARN)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WaitUntilNodegroupActive provides a mock function with given fields: ctx, nodegroupARN
+func (_m *EKSModelAPI) WaitUntilNodegroupActive(ctx context.Context, nodegroupARN *string) error {
+	ret := _m.Called(ctx, nodegroupARN)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) error); ok {
+		r0 = rf(ctx, nodegroupARN)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
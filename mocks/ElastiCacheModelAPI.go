@@ -0,0 +1,144 @@
+// Code generated by mockery v2.0.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	elasticache "github.com/aws/aws-sdk-go/service/elasticache"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ElastiCacheModelAPI is an autogenerated mock type for the ElastiCacheModelAPI type
+type ElastiCacheModelAPI struct {
+	mock.Mock
+}
+
+// DeleteReplicationGroupWithSnapshot provides a mock function with given fields: ctx, replicationGroupID
+func (_m *ElastiCacheModelAPI) DeleteReplicationGroupWithSnapshot(ctx context.Context, replicationGroupID *string) (*string, error) {
+	ret := _m.Called(ctx, replicationGroupID)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, *string) *string); ok {
+		r0 = rf(ctx, replicationGroupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+		r1 = rf(ctx, replicationGroupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReplicationGroupSnapshotsForTags provides a mock function with given fields: ctx, repository, branch
+func (_m *ElastiCacheModelAPI) GetReplicationGroupSnapshotsForTags(ctx context.Context, repository string, branch string) ([]*elasticache.Snapshot, error) {
+	ret := _m.Called(ctx, repository, branch)
+
+	var r0 []*elasticache.Snapshot
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*elasticache.Snapshot); ok {
+		r0 = rf(ctx, repository, branch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*elasticache.Snapshot)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, repository, branch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReplicationGroupsForTags provides a mock function with given fields: ctx, repository, branch
+func (_m *ElastiCacheModelAPI) GetReplicationGroupsForTags(ctx context.Context, repository string, branch string) ([]*elasticache.ReplicationGroup, []*elasticache.ReplicationGroup, []*elasticache.ReplicationGroup, error) {
+	ret := _m.Called(ctx, repository, branch)
+
+	var r0 []*elasticache.ReplicationGroup
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*elasticache.ReplicationGroup); ok {
+		r0 = rf(ctx, repository, branch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*elasticache.ReplicationGroup)
+		}
+	}
+
+	var r1 []*elasticache.ReplicationGroup
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) []*elasticache.ReplicationGroup); ok {
+		r1 = rf(ctx, repository, branch)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*elasticache.ReplicationGroup)
+		}
+	}
+
+	var r2 []*elasticache.ReplicationGroup
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) []*elasticache.ReplicationGroup); ok {
+		r2 = rf(ctx, repository, branch)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).([]*elasticache.ReplicationGroup)
+		}
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(context.Context, string, string) error); ok {
+		r3 = rf(ctx, repository, branch)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// RestoreReplicationGroupFromSnapshot provides a mock function with given fields: ctx, snapshotName
+func (_m *ElastiCacheModelAPI) RestoreReplicationGroupFromSnapshot(ctx context.Context, snapshotName *string) error {
+	ret := _m.Called(ctx, snapshotName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) error); ok {
+		r0 = rf(ctx, snapshotName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilReplicationGroupAvailable provides a mock function with given fields: ctx, replicationGroupID
+func (_m *ElastiCacheModelAPI) WaitUntilReplicationGroupAvailable(ctx context.Context, replicationGroupID *string) error {
+	ret := _m.Called(ctx, replicationGroupID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) error); ok {
+		r0 = rf(ctx, replicationGroupID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilReplicationGroupDeleted provides a mock function with given fields: ctx, replicationGroupID
+func (_m *ElastiCacheModelAPI) WaitUntilReplicationGroupDeleted(ctx context.Context, replicationGroupID *string) error {
+	ret := _m.Called(ctx, replicationGroupID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) error); ok {
+		r0 = rf(ctx, replicationGroupID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -1030,21 +1030,21 @@ func (_m *RDSAPI) CopyOptionGroupWithContext(_a0 context.Context, _a1 *rds.CopyO
 	return r0, r1
 }
 
-// CreateCustomAvailabilityZone provides a mock function with given fields: _a0
-func (_m *RDSAPI) CreateCustomAvailabilityZone(_a0 *rds.CreateCustomAvailabilityZoneInput) (*rds.CreateCustomAvailabilityZoneOutput, error) {
+// CreateBlueGreenDeployment provides a mock function with given fields: _a0
+func (_m *RDSAPI) CreateBlueGreenDeployment(_a0 *rds.CreateBlueGreenDeploymentInput) (*rds.CreateBlueGreenDeploymentOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.CreateCustomAvailabilityZoneOutput
-	if rf, ok := ret.Get(0).(func(*rds.CreateCustomAvailabilityZoneInput) *rds.CreateCustomAvailabilityZoneOutput); ok {
+	var r0 *rds.CreateBlueGreenDeploymentOutput
+	if rf, ok := ret.Get(0).(func(*rds.CreateBlueGreenDeploymentInput) *rds.CreateBlueGreenDeploymentOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.CreateCustomAvailabilityZoneOutput)
+			r0 = ret.Get(0).(*rds.CreateBlueGreenDeploymentOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.CreateCustomAvailabilityZoneInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.CreateBlueGreenDeploymentInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1053,12 +1053,12 @@ func (_m *RDSAPI) CreateCustomAvailabilityZone(_a0 *rds.CreateCustomAvailability
 	return r0, r1
 }
 
-// CreateCustomAvailabilityZoneRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) CreateCustomAvailabilityZoneRequest(_a0 *rds.CreateCustomAvailabilityZoneInput) (*request.Request, *rds.CreateCustomAvailabilityZoneOutput) {
+// CreateBlueGreenDeploymentRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) CreateBlueGreenDeploymentRequest(_a0 *rds.CreateBlueGreenDeploymentInput) (*request.Request, *rds.CreateBlueGreenDeploymentOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.CreateCustomAvailabilityZoneInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.CreateBlueGreenDeploymentInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1066,20 +1066,20 @@ func (_m *RDSAPI) CreateCustomAvailabilityZoneRequest(_a0 *rds.CreateCustomAvail
 		}
 	}
 
-	var r1 *rds.CreateCustomAvailabilityZoneOutput
-	if rf, ok := ret.Get(1).(func(*rds.CreateCustomAvailabilityZoneInput) *rds.CreateCustomAvailabilityZoneOutput); ok {
+	var r1 *rds.CreateBlueGreenDeploymentOutput
+	if rf, ok := ret.Get(1).(func(*rds.CreateBlueGreenDeploymentInput) *rds.CreateBlueGreenDeploymentOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.CreateCustomAvailabilityZoneOutput)
+			r1 = ret.Get(1).(*rds.CreateBlueGreenDeploymentOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// CreateCustomAvailabilityZoneWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) CreateCustomAvailabilityZoneWithContext(_a0 context.Context, _a1 *rds.CreateCustomAvailabilityZoneInput, _a2 ...request.Option) (*rds.CreateCustomAvailabilityZoneOutput, error) {
+// CreateBlueGreenDeploymentWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) CreateBlueGreenDeploymentWithContext(_a0 context.Context, _a1 *rds.CreateBlueGreenDeploymentInput, _a2 ...request.Option) (*rds.CreateBlueGreenDeploymentOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1089,17 +1089,95 @@ func (_m *RDSAPI) CreateCustomAvailabilityZoneWithContext(_a0 context.Context, _
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.CreateCustomAvailabilityZoneOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.CreateCustomAvailabilityZoneInput, ...request.Option) *rds.CreateCustomAvailabilityZoneOutput); ok {
+	var r0 *rds.CreateBlueGreenDeploymentOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.CreateBlueGreenDeploymentInput, ...request.Option) *rds.CreateBlueGreenDeploymentOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.CreateCustomAvailabilityZoneOutput)
+			r0 = ret.Get(0).(*rds.CreateBlueGreenDeploymentOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.CreateCustomAvailabilityZoneInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.CreateBlueGreenDeploymentInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCustomDBEngineVersion provides a mock function with given fields: _a0
+func (_m *RDSAPI) CreateCustomDBEngineVersion(_a0 *rds.CreateCustomDBEngineVersionInput) (*rds.CreateCustomDBEngineVersionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.CreateCustomDBEngineVersionOutput
+	if rf, ok := ret.Get(0).(func(*rds.CreateCustomDBEngineVersionInput) *rds.CreateCustomDBEngineVersionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.CreateCustomDBEngineVersionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.CreateCustomDBEngineVersionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCustomDBEngineVersionRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) CreateCustomDBEngineVersionRequest(_a0 *rds.CreateCustomDBEngineVersionInput) (*request.Request, *rds.CreateCustomDBEngineVersionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.CreateCustomDBEngineVersionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.CreateCustomDBEngineVersionOutput
+	if rf, ok := ret.Get(1).(func(*rds.CreateCustomDBEngineVersionInput) *rds.CreateCustomDBEngineVersionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.CreateCustomDBEngineVersionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateCustomDBEngineVersionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) CreateCustomDBEngineVersionWithContext(_a0 context.Context, _a1 *rds.CreateCustomDBEngineVersionInput, _a2 ...request.Option) (*rds.CreateCustomDBEngineVersionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.CreateCustomDBEngineVersionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.CreateCustomDBEngineVersionInput, ...request.Option) *rds.CreateCustomDBEngineVersionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.CreateCustomDBEngineVersionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.CreateCustomDBEngineVersionInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1677,6 +1755,84 @@ func (_m *RDSAPI) CreateDBProxy(_a0 *rds.CreateDBProxyInput) (*rds.CreateDBProxy
 	return r0, r1
 }
 
+// CreateDBProxyEndpoint provides a mock function with given fields: _a0
+func (_m *RDSAPI) CreateDBProxyEndpoint(_a0 *rds.CreateDBProxyEndpointInput) (*rds.CreateDBProxyEndpointOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.CreateDBProxyEndpointOutput
+	if rf, ok := ret.Get(0).(func(*rds.CreateDBProxyEndpointInput) *rds.CreateDBProxyEndpointOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.CreateDBProxyEndpointOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.CreateDBProxyEndpointInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateDBProxyEndpointRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) CreateDBProxyEndpointRequest(_a0 *rds.CreateDBProxyEndpointInput) (*request.Request, *rds.CreateDBProxyEndpointOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.CreateDBProxyEndpointInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.CreateDBProxyEndpointOutput
+	if rf, ok := ret.Get(1).(func(*rds.CreateDBProxyEndpointInput) *rds.CreateDBProxyEndpointOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.CreateDBProxyEndpointOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateDBProxyEndpointWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) CreateDBProxyEndpointWithContext(_a0 context.Context, _a1 *rds.CreateDBProxyEndpointInput, _a2 ...request.Option) (*rds.CreateDBProxyEndpointOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.CreateDBProxyEndpointOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.CreateDBProxyEndpointInput, ...request.Option) *rds.CreateDBProxyEndpointOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.CreateDBProxyEndpointOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.CreateDBProxyEndpointInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateDBProxyRequest provides a mock function with given fields: _a0
 func (_m *RDSAPI) CreateDBProxyRequest(_a0 *rds.CreateDBProxyInput) (*request.Request, *rds.CreateDBProxyOutput) {
 	ret := _m.Called(_a0)
@@ -1810,6 +1966,84 @@ func (_m *RDSAPI) CreateDBSecurityGroupWithContext(_a0 context.Context, _a1 *rds
 	return r0, r1
 }
 
+// CreateDBShardGroup provides a mock function with given fields: _a0
+func (_m *RDSAPI) CreateDBShardGroup(_a0 *rds.CreateDBShardGroupInput) (*rds.CreateDBShardGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.CreateDBShardGroupOutput
+	if rf, ok := ret.Get(0).(func(*rds.CreateDBShardGroupInput) *rds.CreateDBShardGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.CreateDBShardGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.CreateDBShardGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateDBShardGroupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) CreateDBShardGroupRequest(_a0 *rds.CreateDBShardGroupInput) (*request.Request, *rds.CreateDBShardGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.CreateDBShardGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.CreateDBShardGroupOutput
+	if rf, ok := ret.Get(1).(func(*rds.CreateDBShardGroupInput) *rds.CreateDBShardGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.CreateDBShardGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateDBShardGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) CreateDBShardGroupWithContext(_a0 context.Context, _a1 *rds.CreateDBShardGroupInput, _a2 ...request.Option) (*rds.CreateDBShardGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.CreateDBShardGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.CreateDBShardGroupInput, ...request.Option) *rds.CreateDBShardGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.CreateDBShardGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.CreateDBShardGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateDBSnapshot provides a mock function with given fields: _a0
 func (_m *RDSAPI) CreateDBSnapshot(_a0 *rds.CreateDBSnapshotInput) (*rds.CreateDBSnapshotOutput, error) {
 	ret := _m.Called(_a0)
@@ -2122,21 +2356,21 @@ func (_m *RDSAPI) CreateGlobalClusterWithContext(_a0 context.Context, _a1 *rds.C
 	return r0, r1
 }
 
-// CreateOptionGroup provides a mock function with given fields: _a0
-func (_m *RDSAPI) CreateOptionGroup(_a0 *rds.CreateOptionGroupInput) (*rds.CreateOptionGroupOutput, error) {
+// CreateIntegration provides a mock function with given fields: _a0
+func (_m *RDSAPI) CreateIntegration(_a0 *rds.CreateIntegrationInput) (*rds.CreateIntegrationOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.CreateOptionGroupOutput
-	if rf, ok := ret.Get(0).(func(*rds.CreateOptionGroupInput) *rds.CreateOptionGroupOutput); ok {
+	var r0 *rds.CreateIntegrationOutput
+	if rf, ok := ret.Get(0).(func(*rds.CreateIntegrationInput) *rds.CreateIntegrationOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.CreateOptionGroupOutput)
+			r0 = ret.Get(0).(*rds.CreateIntegrationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.CreateOptionGroupInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.CreateIntegrationInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2145,12 +2379,12 @@ func (_m *RDSAPI) CreateOptionGroup(_a0 *rds.CreateOptionGroupInput) (*rds.Creat
 	return r0, r1
 }
 
-// CreateOptionGroupRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) CreateOptionGroupRequest(_a0 *rds.CreateOptionGroupInput) (*request.Request, *rds.CreateOptionGroupOutput) {
+// CreateIntegrationRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) CreateIntegrationRequest(_a0 *rds.CreateIntegrationInput) (*request.Request, *rds.CreateIntegrationOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.CreateOptionGroupInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.CreateIntegrationInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2158,20 +2392,20 @@ func (_m *RDSAPI) CreateOptionGroupRequest(_a0 *rds.CreateOptionGroupInput) (*re
 		}
 	}
 
-	var r1 *rds.CreateOptionGroupOutput
-	if rf, ok := ret.Get(1).(func(*rds.CreateOptionGroupInput) *rds.CreateOptionGroupOutput); ok {
+	var r1 *rds.CreateIntegrationOutput
+	if rf, ok := ret.Get(1).(func(*rds.CreateIntegrationInput) *rds.CreateIntegrationOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.CreateOptionGroupOutput)
+			r1 = ret.Get(1).(*rds.CreateIntegrationOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// CreateOptionGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) CreateOptionGroupWithContext(_a0 context.Context, _a1 *rds.CreateOptionGroupInput, _a2 ...request.Option) (*rds.CreateOptionGroupOutput, error) {
+// CreateIntegrationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) CreateIntegrationWithContext(_a0 context.Context, _a1 *rds.CreateIntegrationInput, _a2 ...request.Option) (*rds.CreateIntegrationOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2181,17 +2415,17 @@ func (_m *RDSAPI) CreateOptionGroupWithContext(_a0 context.Context, _a1 *rds.Cre
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.CreateOptionGroupOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.CreateOptionGroupInput, ...request.Option) *rds.CreateOptionGroupOutput); ok {
+	var r0 *rds.CreateIntegrationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.CreateIntegrationInput, ...request.Option) *rds.CreateIntegrationOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.CreateOptionGroupOutput)
+			r0 = ret.Get(0).(*rds.CreateIntegrationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.CreateOptionGroupInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.CreateIntegrationInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2200,21 +2434,21 @@ func (_m *RDSAPI) CreateOptionGroupWithContext(_a0 context.Context, _a1 *rds.Cre
 	return r0, r1
 }
 
-// DeleteCustomAvailabilityZone provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteCustomAvailabilityZone(_a0 *rds.DeleteCustomAvailabilityZoneInput) (*rds.DeleteCustomAvailabilityZoneOutput, error) {
+// CreateOptionGroup provides a mock function with given fields: _a0
+func (_m *RDSAPI) CreateOptionGroup(_a0 *rds.CreateOptionGroupInput) (*rds.CreateOptionGroupOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DeleteCustomAvailabilityZoneOutput
-	if rf, ok := ret.Get(0).(func(*rds.DeleteCustomAvailabilityZoneInput) *rds.DeleteCustomAvailabilityZoneOutput); ok {
+	var r0 *rds.CreateOptionGroupOutput
+	if rf, ok := ret.Get(0).(func(*rds.CreateOptionGroupInput) *rds.CreateOptionGroupOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteCustomAvailabilityZoneOutput)
+			r0 = ret.Get(0).(*rds.CreateOptionGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DeleteCustomAvailabilityZoneInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.CreateOptionGroupInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2223,12 +2457,12 @@ func (_m *RDSAPI) DeleteCustomAvailabilityZone(_a0 *rds.DeleteCustomAvailability
 	return r0, r1
 }
 
-// DeleteCustomAvailabilityZoneRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteCustomAvailabilityZoneRequest(_a0 *rds.DeleteCustomAvailabilityZoneInput) (*request.Request, *rds.DeleteCustomAvailabilityZoneOutput) {
+// CreateOptionGroupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) CreateOptionGroupRequest(_a0 *rds.CreateOptionGroupInput) (*request.Request, *rds.CreateOptionGroupOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DeleteCustomAvailabilityZoneInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.CreateOptionGroupInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2236,20 +2470,20 @@ func (_m *RDSAPI) DeleteCustomAvailabilityZoneRequest(_a0 *rds.DeleteCustomAvail
 		}
 	}
 
-	var r1 *rds.DeleteCustomAvailabilityZoneOutput
-	if rf, ok := ret.Get(1).(func(*rds.DeleteCustomAvailabilityZoneInput) *rds.DeleteCustomAvailabilityZoneOutput); ok {
+	var r1 *rds.CreateOptionGroupOutput
+	if rf, ok := ret.Get(1).(func(*rds.CreateOptionGroupInput) *rds.CreateOptionGroupOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DeleteCustomAvailabilityZoneOutput)
+			r1 = ret.Get(1).(*rds.CreateOptionGroupOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteCustomAvailabilityZoneWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DeleteCustomAvailabilityZoneWithContext(_a0 context.Context, _a1 *rds.DeleteCustomAvailabilityZoneInput, _a2 ...request.Option) (*rds.DeleteCustomAvailabilityZoneOutput, error) {
+// CreateOptionGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) CreateOptionGroupWithContext(_a0 context.Context, _a1 *rds.CreateOptionGroupInput, _a2 ...request.Option) (*rds.CreateOptionGroupOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2259,17 +2493,17 @@ func (_m *RDSAPI) DeleteCustomAvailabilityZoneWithContext(_a0 context.Context, _
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DeleteCustomAvailabilityZoneOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteCustomAvailabilityZoneInput, ...request.Option) *rds.DeleteCustomAvailabilityZoneOutput); ok {
+	var r0 *rds.CreateOptionGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.CreateOptionGroupInput, ...request.Option) *rds.CreateOptionGroupOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteCustomAvailabilityZoneOutput)
+			r0 = ret.Get(0).(*rds.CreateOptionGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteCustomAvailabilityZoneInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.CreateOptionGroupInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2278,44 +2512,21 @@ func (_m *RDSAPI) DeleteCustomAvailabilityZoneWithContext(_a0 context.Context, _
 	return r0, r1
 }
 
-// DeleteDBCluster provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBCluster(_a0 *rds.DeleteDBClusterInput) (*rds.DeleteDBClusterOutput, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *rds.DeleteDBClusterOutput
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBClusterInput) *rds.DeleteDBClusterOutput); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBClusterOutput)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBClusterInput) error); ok {
-		r1 = rf(_a0)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// DeleteDBClusterEndpoint provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBClusterEndpoint(_a0 *rds.DeleteDBClusterEndpointInput) (*rds.DeleteDBClusterEndpointOutput, error) {
+// CreateTenantDatabase provides a mock function with given fields: _a0
+func (_m *RDSAPI) CreateTenantDatabase(_a0 *rds.CreateTenantDatabaseInput) (*rds.CreateTenantDatabaseOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DeleteDBClusterEndpointOutput
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBClusterEndpointInput) *rds.DeleteDBClusterEndpointOutput); ok {
+	var r0 *rds.CreateTenantDatabaseOutput
+	if rf, ok := ret.Get(0).(func(*rds.CreateTenantDatabaseInput) *rds.CreateTenantDatabaseOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBClusterEndpointOutput)
+			r0 = ret.Get(0).(*rds.CreateTenantDatabaseOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBClusterEndpointInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.CreateTenantDatabaseInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2324,12 +2535,12 @@ func (_m *RDSAPI) DeleteDBClusterEndpoint(_a0 *rds.DeleteDBClusterEndpointInput)
 	return r0, r1
 }
 
-// DeleteDBClusterEndpointRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBClusterEndpointRequest(_a0 *rds.DeleteDBClusterEndpointInput) (*request.Request, *rds.DeleteDBClusterEndpointOutput) {
+// CreateTenantDatabaseRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) CreateTenantDatabaseRequest(_a0 *rds.CreateTenantDatabaseInput) (*request.Request, *rds.CreateTenantDatabaseOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBClusterEndpointInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.CreateTenantDatabaseInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2337,20 +2548,20 @@ func (_m *RDSAPI) DeleteDBClusterEndpointRequest(_a0 *rds.DeleteDBClusterEndpoin
 		}
 	}
 
-	var r1 *rds.DeleteDBClusterEndpointOutput
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBClusterEndpointInput) *rds.DeleteDBClusterEndpointOutput); ok {
+	var r1 *rds.CreateTenantDatabaseOutput
+	if rf, ok := ret.Get(1).(func(*rds.CreateTenantDatabaseInput) *rds.CreateTenantDatabaseOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DeleteDBClusterEndpointOutput)
+			r1 = ret.Get(1).(*rds.CreateTenantDatabaseOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteDBClusterEndpointWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DeleteDBClusterEndpointWithContext(_a0 context.Context, _a1 *rds.DeleteDBClusterEndpointInput, _a2 ...request.Option) (*rds.DeleteDBClusterEndpointOutput, error) {
+// CreateTenantDatabaseWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) CreateTenantDatabaseWithContext(_a0 context.Context, _a1 *rds.CreateTenantDatabaseInput, _a2 ...request.Option) (*rds.CreateTenantDatabaseOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2360,17 +2571,17 @@ func (_m *RDSAPI) DeleteDBClusterEndpointWithContext(_a0 context.Context, _a1 *r
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DeleteDBClusterEndpointOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBClusterEndpointInput, ...request.Option) *rds.DeleteDBClusterEndpointOutput); ok {
+	var r0 *rds.CreateTenantDatabaseOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.CreateTenantDatabaseInput, ...request.Option) *rds.CreateTenantDatabaseOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBClusterEndpointOutput)
+			r0 = ret.Get(0).(*rds.CreateTenantDatabaseOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBClusterEndpointInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.CreateTenantDatabaseInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2379,21 +2590,21 @@ func (_m *RDSAPI) DeleteDBClusterEndpointWithContext(_a0 context.Context, _a1 *r
 	return r0, r1
 }
 
-// DeleteDBClusterParameterGroup provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBClusterParameterGroup(_a0 *rds.DeleteDBClusterParameterGroupInput) (*rds.DeleteDBClusterParameterGroupOutput, error) {
+// DeleteBlueGreenDeployment provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteBlueGreenDeployment(_a0 *rds.DeleteBlueGreenDeploymentInput) (*rds.DeleteBlueGreenDeploymentOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DeleteDBClusterParameterGroupOutput
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBClusterParameterGroupInput) *rds.DeleteDBClusterParameterGroupOutput); ok {
+	var r0 *rds.DeleteBlueGreenDeploymentOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteBlueGreenDeploymentInput) *rds.DeleteBlueGreenDeploymentOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBClusterParameterGroupOutput)
+			r0 = ret.Get(0).(*rds.DeleteBlueGreenDeploymentOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBClusterParameterGroupInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeleteBlueGreenDeploymentInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2402,12 +2613,12 @@ func (_m *RDSAPI) DeleteDBClusterParameterGroup(_a0 *rds.DeleteDBClusterParamete
 	return r0, r1
 }
 
-// DeleteDBClusterParameterGroupRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBClusterParameterGroupRequest(_a0 *rds.DeleteDBClusterParameterGroupInput) (*request.Request, *rds.DeleteDBClusterParameterGroupOutput) {
+// DeleteBlueGreenDeploymentRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteBlueGreenDeploymentRequest(_a0 *rds.DeleteBlueGreenDeploymentInput) (*request.Request, *rds.DeleteBlueGreenDeploymentOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBClusterParameterGroupInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeleteBlueGreenDeploymentInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2415,20 +2626,20 @@ func (_m *RDSAPI) DeleteDBClusterParameterGroupRequest(_a0 *rds.DeleteDBClusterP
 		}
 	}
 
-	var r1 *rds.DeleteDBClusterParameterGroupOutput
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBClusterParameterGroupInput) *rds.DeleteDBClusterParameterGroupOutput); ok {
+	var r1 *rds.DeleteBlueGreenDeploymentOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteBlueGreenDeploymentInput) *rds.DeleteBlueGreenDeploymentOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DeleteDBClusterParameterGroupOutput)
+			r1 = ret.Get(1).(*rds.DeleteBlueGreenDeploymentOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteDBClusterParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DeleteDBClusterParameterGroupWithContext(_a0 context.Context, _a1 *rds.DeleteDBClusterParameterGroupInput, _a2 ...request.Option) (*rds.DeleteDBClusterParameterGroupOutput, error) {
+// DeleteBlueGreenDeploymentWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteBlueGreenDeploymentWithContext(_a0 context.Context, _a1 *rds.DeleteBlueGreenDeploymentInput, _a2 ...request.Option) (*rds.DeleteBlueGreenDeploymentOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2438,17 +2649,17 @@ func (_m *RDSAPI) DeleteDBClusterParameterGroupWithContext(_a0 context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DeleteDBClusterParameterGroupOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBClusterParameterGroupInput, ...request.Option) *rds.DeleteDBClusterParameterGroupOutput); ok {
+	var r0 *rds.DeleteBlueGreenDeploymentOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteBlueGreenDeploymentInput, ...request.Option) *rds.DeleteBlueGreenDeploymentOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBClusterParameterGroupOutput)
+			r0 = ret.Get(0).(*rds.DeleteBlueGreenDeploymentOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBClusterParameterGroupInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteBlueGreenDeploymentInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2457,46 +2668,21 @@ func (_m *RDSAPI) DeleteDBClusterParameterGroupWithContext(_a0 context.Context,
 	return r0, r1
 }
 
-// DeleteDBClusterRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBClusterRequest(_a0 *rds.DeleteDBClusterInput) (*request.Request, *rds.DeleteDBClusterOutput) {
-	ret := _m.Called(_a0)
-
-	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBClusterInput) *request.Request); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*request.Request)
-		}
-	}
-
-	var r1 *rds.DeleteDBClusterOutput
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBClusterInput) *rds.DeleteDBClusterOutput); ok {
-		r1 = rf(_a0)
-	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DeleteDBClusterOutput)
-		}
-	}
-
-	return r0, r1
-}
-
-// DeleteDBClusterSnapshot provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBClusterSnapshot(_a0 *rds.DeleteDBClusterSnapshotInput) (*rds.DeleteDBClusterSnapshotOutput, error) {
+// DeleteCustomDBEngineVersion provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteCustomDBEngineVersion(_a0 *rds.DeleteCustomDBEngineVersionInput) (*rds.DeleteCustomDBEngineVersionOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DeleteDBClusterSnapshotOutput
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBClusterSnapshotInput) *rds.DeleteDBClusterSnapshotOutput); ok {
+	var r0 *rds.DeleteCustomDBEngineVersionOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteCustomDBEngineVersionInput) *rds.DeleteCustomDBEngineVersionOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBClusterSnapshotOutput)
+			r0 = ret.Get(0).(*rds.DeleteCustomDBEngineVersionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBClusterSnapshotInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeleteCustomDBEngineVersionInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2505,12 +2691,12 @@ func (_m *RDSAPI) DeleteDBClusterSnapshot(_a0 *rds.DeleteDBClusterSnapshotInput)
 	return r0, r1
 }
 
-// DeleteDBClusterSnapshotRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBClusterSnapshotRequest(_a0 *rds.DeleteDBClusterSnapshotInput) (*request.Request, *rds.DeleteDBClusterSnapshotOutput) {
+// DeleteCustomDBEngineVersionRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteCustomDBEngineVersionRequest(_a0 *rds.DeleteCustomDBEngineVersionInput) (*request.Request, *rds.DeleteCustomDBEngineVersionOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBClusterSnapshotInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeleteCustomDBEngineVersionInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2518,50 +2704,20 @@ func (_m *RDSAPI) DeleteDBClusterSnapshotRequest(_a0 *rds.DeleteDBClusterSnapsho
 		}
 	}
 
-	var r1 *rds.DeleteDBClusterSnapshotOutput
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBClusterSnapshotInput) *rds.DeleteDBClusterSnapshotOutput); ok {
+	var r1 *rds.DeleteCustomDBEngineVersionOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteCustomDBEngineVersionInput) *rds.DeleteCustomDBEngineVersionOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DeleteDBClusterSnapshotOutput)
-		}
-	}
-
-	return r0, r1
-}
-
-// DeleteDBClusterSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DeleteDBClusterSnapshotWithContext(_a0 context.Context, _a1 *rds.DeleteDBClusterSnapshotInput, _a2 ...request.Option) (*rds.DeleteDBClusterSnapshotOutput, error) {
-	_va := make([]interface{}, len(_a2))
-	for _i := range _a2 {
-		_va[_i] = _a2[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 *rds.DeleteDBClusterSnapshotOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBClusterSnapshotInput, ...request.Option) *rds.DeleteDBClusterSnapshotOutput); ok {
-		r0 = rf(_a0, _a1, _a2...)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBClusterSnapshotOutput)
+			r1 = ret.Get(1).(*rds.DeleteCustomDBEngineVersionOutput)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBClusterSnapshotInput, ...request.Option) error); ok {
-		r1 = rf(_a0, _a1, _a2...)
-	} else {
-		r1 = ret.Error(1)
-	}
-
 	return r0, r1
 }
 
-// DeleteDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DeleteDBClusterWithContext(_a0 context.Context, _a1 *rds.DeleteDBClusterInput, _a2 ...request.Option) (*rds.DeleteDBClusterOutput, error) {
+// DeleteCustomDBEngineVersionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteCustomDBEngineVersionWithContext(_a0 context.Context, _a1 *rds.DeleteCustomDBEngineVersionInput, _a2 ...request.Option) (*rds.DeleteCustomDBEngineVersionOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2571,17 +2727,17 @@ func (_m *RDSAPI) DeleteDBClusterWithContext(_a0 context.Context, _a1 *rds.Delet
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DeleteDBClusterOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBClusterInput, ...request.Option) *rds.DeleteDBClusterOutput); ok {
+	var r0 *rds.DeleteCustomDBEngineVersionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteCustomDBEngineVersionInput, ...request.Option) *rds.DeleteCustomDBEngineVersionOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBClusterOutput)
+			r0 = ret.Get(0).(*rds.DeleteCustomDBEngineVersionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBClusterInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteCustomDBEngineVersionInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2590,21 +2746,21 @@ func (_m *RDSAPI) DeleteDBClusterWithContext(_a0 context.Context, _a1 *rds.Delet
 	return r0, r1
 }
 
-// DeleteDBInstance provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBInstance(_a0 *rds.DeleteDBInstanceInput) (*rds.DeleteDBInstanceOutput, error) {
+// DeleteDBCluster provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBCluster(_a0 *rds.DeleteDBClusterInput) (*rds.DeleteDBClusterOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DeleteDBInstanceOutput
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBInstanceInput) *rds.DeleteDBInstanceOutput); ok {
+	var r0 *rds.DeleteDBClusterOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBClusterInput) *rds.DeleteDBClusterOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBInstanceOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBClusterOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBInstanceInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBClusterInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2613,21 +2769,21 @@ func (_m *RDSAPI) DeleteDBInstance(_a0 *rds.DeleteDBInstanceInput) (*rds.DeleteD
 	return r0, r1
 }
 
-// DeleteDBInstanceAutomatedBackup provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBInstanceAutomatedBackup(_a0 *rds.DeleteDBInstanceAutomatedBackupInput) (*rds.DeleteDBInstanceAutomatedBackupOutput, error) {
+// DeleteDBClusterAutomatedBackup provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBClusterAutomatedBackup(_a0 *rds.DeleteDBClusterAutomatedBackupInput) (*rds.DeleteDBClusterAutomatedBackupOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DeleteDBInstanceAutomatedBackupOutput
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBInstanceAutomatedBackupInput) *rds.DeleteDBInstanceAutomatedBackupOutput); ok {
+	var r0 *rds.DeleteDBClusterAutomatedBackupOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBClusterAutomatedBackupInput) *rds.DeleteDBClusterAutomatedBackupOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBInstanceAutomatedBackupOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBClusterAutomatedBackupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBInstanceAutomatedBackupInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBClusterAutomatedBackupInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2636,12 +2792,12 @@ func (_m *RDSAPI) DeleteDBInstanceAutomatedBackup(_a0 *rds.DeleteDBInstanceAutom
 	return r0, r1
 }
 
-// DeleteDBInstanceAutomatedBackupRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBInstanceAutomatedBackupRequest(_a0 *rds.DeleteDBInstanceAutomatedBackupInput) (*request.Request, *rds.DeleteDBInstanceAutomatedBackupOutput) {
+// DeleteDBClusterAutomatedBackupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBClusterAutomatedBackupRequest(_a0 *rds.DeleteDBClusterAutomatedBackupInput) (*request.Request, *rds.DeleteDBClusterAutomatedBackupOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBInstanceAutomatedBackupInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBClusterAutomatedBackupInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2649,20 +2805,20 @@ func (_m *RDSAPI) DeleteDBInstanceAutomatedBackupRequest(_a0 *rds.DeleteDBInstan
 		}
 	}
 
-	var r1 *rds.DeleteDBInstanceAutomatedBackupOutput
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBInstanceAutomatedBackupInput) *rds.DeleteDBInstanceAutomatedBackupOutput); ok {
+	var r1 *rds.DeleteDBClusterAutomatedBackupOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBClusterAutomatedBackupInput) *rds.DeleteDBClusterAutomatedBackupOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DeleteDBInstanceAutomatedBackupOutput)
+			r1 = ret.Get(1).(*rds.DeleteDBClusterAutomatedBackupOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteDBInstanceAutomatedBackupWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DeleteDBInstanceAutomatedBackupWithContext(_a0 context.Context, _a1 *rds.DeleteDBInstanceAutomatedBackupInput, _a2 ...request.Option) (*rds.DeleteDBInstanceAutomatedBackupOutput, error) {
+// DeleteDBClusterAutomatedBackupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteDBClusterAutomatedBackupWithContext(_a0 context.Context, _a1 *rds.DeleteDBClusterAutomatedBackupInput, _a2 ...request.Option) (*rds.DeleteDBClusterAutomatedBackupOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2672,17 +2828,17 @@ func (_m *RDSAPI) DeleteDBInstanceAutomatedBackupWithContext(_a0 context.Context
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DeleteDBInstanceAutomatedBackupOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBInstanceAutomatedBackupInput, ...request.Option) *rds.DeleteDBInstanceAutomatedBackupOutput); ok {
+	var r0 *rds.DeleteDBClusterAutomatedBackupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBClusterAutomatedBackupInput, ...request.Option) *rds.DeleteDBClusterAutomatedBackupOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBInstanceAutomatedBackupOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBClusterAutomatedBackupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBInstanceAutomatedBackupInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBClusterAutomatedBackupInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2691,12 +2847,35 @@ func (_m *RDSAPI) DeleteDBInstanceAutomatedBackupWithContext(_a0 context.Context
 	return r0, r1
 }
 
-// DeleteDBInstanceRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBInstanceRequest(_a0 *rds.DeleteDBInstanceInput) (*request.Request, *rds.DeleteDBInstanceOutput) {
+// DeleteDBClusterEndpoint provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBClusterEndpoint(_a0 *rds.DeleteDBClusterEndpointInput) (*rds.DeleteDBClusterEndpointOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DeleteDBClusterEndpointOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBClusterEndpointInput) *rds.DeleteDBClusterEndpointOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DeleteDBClusterEndpointOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBClusterEndpointInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDBClusterEndpointRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBClusterEndpointRequest(_a0 *rds.DeleteDBClusterEndpointInput) (*request.Request, *rds.DeleteDBClusterEndpointOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBInstanceInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBClusterEndpointInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2704,20 +2883,20 @@ func (_m *RDSAPI) DeleteDBInstanceRequest(_a0 *rds.DeleteDBInstanceInput) (*requ
 		}
 	}
 
-	var r1 *rds.DeleteDBInstanceOutput
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBInstanceInput) *rds.DeleteDBInstanceOutput); ok {
+	var r1 *rds.DeleteDBClusterEndpointOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBClusterEndpointInput) *rds.DeleteDBClusterEndpointOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DeleteDBInstanceOutput)
+			r1 = ret.Get(1).(*rds.DeleteDBClusterEndpointOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteDBInstanceWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DeleteDBInstanceWithContext(_a0 context.Context, _a1 *rds.DeleteDBInstanceInput, _a2 ...request.Option) (*rds.DeleteDBInstanceOutput, error) {
+// DeleteDBClusterEndpointWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteDBClusterEndpointWithContext(_a0 context.Context, _a1 *rds.DeleteDBClusterEndpointInput, _a2 ...request.Option) (*rds.DeleteDBClusterEndpointOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2727,17 +2906,17 @@ func (_m *RDSAPI) DeleteDBInstanceWithContext(_a0 context.Context, _a1 *rds.Dele
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DeleteDBInstanceOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBInstanceInput, ...request.Option) *rds.DeleteDBInstanceOutput); ok {
+	var r0 *rds.DeleteDBClusterEndpointOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBClusterEndpointInput, ...request.Option) *rds.DeleteDBClusterEndpointOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBInstanceOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBClusterEndpointOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBInstanceInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBClusterEndpointInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2746,21 +2925,21 @@ func (_m *RDSAPI) DeleteDBInstanceWithContext(_a0 context.Context, _a1 *rds.Dele
 	return r0, r1
 }
 
-// DeleteDBParameterGroup provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBParameterGroup(_a0 *rds.DeleteDBParameterGroupInput) (*rds.DeleteDBParameterGroupOutput, error) {
+// DeleteDBClusterParameterGroup provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBClusterParameterGroup(_a0 *rds.DeleteDBClusterParameterGroupInput) (*rds.DeleteDBClusterParameterGroupOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DeleteDBParameterGroupOutput
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBParameterGroupInput) *rds.DeleteDBParameterGroupOutput); ok {
+	var r0 *rds.DeleteDBClusterParameterGroupOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBClusterParameterGroupInput) *rds.DeleteDBClusterParameterGroupOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBParameterGroupOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBClusterParameterGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBParameterGroupInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBClusterParameterGroupInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2769,12 +2948,12 @@ func (_m *RDSAPI) DeleteDBParameterGroup(_a0 *rds.DeleteDBParameterGroupInput) (
 	return r0, r1
 }
 
-// DeleteDBParameterGroupRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBParameterGroupRequest(_a0 *rds.DeleteDBParameterGroupInput) (*request.Request, *rds.DeleteDBParameterGroupOutput) {
+// DeleteDBClusterParameterGroupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBClusterParameterGroupRequest(_a0 *rds.DeleteDBClusterParameterGroupInput) (*request.Request, *rds.DeleteDBClusterParameterGroupOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBParameterGroupInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBClusterParameterGroupInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2782,20 +2961,20 @@ func (_m *RDSAPI) DeleteDBParameterGroupRequest(_a0 *rds.DeleteDBParameterGroupI
 		}
 	}
 
-	var r1 *rds.DeleteDBParameterGroupOutput
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBParameterGroupInput) *rds.DeleteDBParameterGroupOutput); ok {
+	var r1 *rds.DeleteDBClusterParameterGroupOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBClusterParameterGroupInput) *rds.DeleteDBClusterParameterGroupOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DeleteDBParameterGroupOutput)
+			r1 = ret.Get(1).(*rds.DeleteDBClusterParameterGroupOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteDBParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DeleteDBParameterGroupWithContext(_a0 context.Context, _a1 *rds.DeleteDBParameterGroupInput, _a2 ...request.Option) (*rds.DeleteDBParameterGroupOutput, error) {
+// DeleteDBClusterParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteDBClusterParameterGroupWithContext(_a0 context.Context, _a1 *rds.DeleteDBClusterParameterGroupInput, _a2 ...request.Option) (*rds.DeleteDBClusterParameterGroupOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2805,17 +2984,17 @@ func (_m *RDSAPI) DeleteDBParameterGroupWithContext(_a0 context.Context, _a1 *rd
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DeleteDBParameterGroupOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBParameterGroupInput, ...request.Option) *rds.DeleteDBParameterGroupOutput); ok {
+	var r0 *rds.DeleteDBClusterParameterGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBClusterParameterGroupInput, ...request.Option) *rds.DeleteDBClusterParameterGroupOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBParameterGroupOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBClusterParameterGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBParameterGroupInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBClusterParameterGroupInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2824,21 +3003,46 @@ func (_m *RDSAPI) DeleteDBParameterGroupWithContext(_a0 context.Context, _a1 *rd
 	return r0, r1
 }
 
-// DeleteDBProxy provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBProxy(_a0 *rds.DeleteDBProxyInput) (*rds.DeleteDBProxyOutput, error) {
+// DeleteDBClusterRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBClusterRequest(_a0 *rds.DeleteDBClusterInput) (*request.Request, *rds.DeleteDBClusterOutput) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DeleteDBProxyOutput
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBProxyInput) *rds.DeleteDBProxyOutput); ok {
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBClusterInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBProxyOutput)
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.DeleteDBClusterOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBClusterInput) *rds.DeleteDBClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DeleteDBClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteDBClusterSnapshot provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBClusterSnapshot(_a0 *rds.DeleteDBClusterSnapshotInput) (*rds.DeleteDBClusterSnapshotOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DeleteDBClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBClusterSnapshotInput) *rds.DeleteDBClusterSnapshotOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DeleteDBClusterSnapshotOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBProxyInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBClusterSnapshotInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2847,12 +3051,12 @@ func (_m *RDSAPI) DeleteDBProxy(_a0 *rds.DeleteDBProxyInput) (*rds.DeleteDBProxy
 	return r0, r1
 }
 
-// DeleteDBProxyRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBProxyRequest(_a0 *rds.DeleteDBProxyInput) (*request.Request, *rds.DeleteDBProxyOutput) {
+// DeleteDBClusterSnapshotRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBClusterSnapshotRequest(_a0 *rds.DeleteDBClusterSnapshotInput) (*request.Request, *rds.DeleteDBClusterSnapshotOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBProxyInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBClusterSnapshotInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2860,20 +3064,20 @@ func (_m *RDSAPI) DeleteDBProxyRequest(_a0 *rds.DeleteDBProxyInput) (*request.Re
 		}
 	}
 
-	var r1 *rds.DeleteDBProxyOutput
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBProxyInput) *rds.DeleteDBProxyOutput); ok {
+	var r1 *rds.DeleteDBClusterSnapshotOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBClusterSnapshotInput) *rds.DeleteDBClusterSnapshotOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DeleteDBProxyOutput)
+			r1 = ret.Get(1).(*rds.DeleteDBClusterSnapshotOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteDBProxyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DeleteDBProxyWithContext(_a0 context.Context, _a1 *rds.DeleteDBProxyInput, _a2 ...request.Option) (*rds.DeleteDBProxyOutput, error) {
+// DeleteDBClusterSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteDBClusterSnapshotWithContext(_a0 context.Context, _a1 *rds.DeleteDBClusterSnapshotInput, _a2 ...request.Option) (*rds.DeleteDBClusterSnapshotOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2883,17 +3087,17 @@ func (_m *RDSAPI) DeleteDBProxyWithContext(_a0 context.Context, _a1 *rds.DeleteD
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DeleteDBProxyOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBProxyInput, ...request.Option) *rds.DeleteDBProxyOutput); ok {
+	var r0 *rds.DeleteDBClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBClusterSnapshotInput, ...request.Option) *rds.DeleteDBClusterSnapshotOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBProxyOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBClusterSnapshotOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBProxyInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBClusterSnapshotInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2902,22 +3106,29 @@ func (_m *RDSAPI) DeleteDBProxyWithContext(_a0 context.Context, _a1 *rds.DeleteD
 	return r0, r1
 }
 
-// DeleteDBSecurityGroup provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBSecurityGroup(_a0 *rds.DeleteDBSecurityGroupInput) (*rds.DeleteDBSecurityGroupOutput, error) {
-	ret := _m.Called(_a0)
+// DeleteDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteDBClusterWithContext(_a0 context.Context, _a1 *rds.DeleteDBClusterInput, _a2 ...request.Option) (*rds.DeleteDBClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
-	var r0 *rds.DeleteDBSecurityGroupOutput
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBSecurityGroupInput) *rds.DeleteDBSecurityGroupOutput); ok {
-		r0 = rf(_a0)
+	var r0 *rds.DeleteDBClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBClusterInput, ...request.Option) *rds.DeleteDBClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBSecurityGroupOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBClusterOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBSecurityGroupInput) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2925,54 +3136,22 @@ func (_m *RDSAPI) DeleteDBSecurityGroup(_a0 *rds.DeleteDBSecurityGroupInput) (*r
 	return r0, r1
 }
 
-// DeleteDBSecurityGroupRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBSecurityGroupRequest(_a0 *rds.DeleteDBSecurityGroupInput) (*request.Request, *rds.DeleteDBSecurityGroupOutput) {
+// DeleteDBInstance provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBInstance(_a0 *rds.DeleteDBInstanceInput) (*rds.DeleteDBInstanceOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBSecurityGroupInput) *request.Request); ok {
+	var r0 *rds.DeleteDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBInstanceInput) *rds.DeleteDBInstanceOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*request.Request)
-		}
-	}
-
-	var r1 *rds.DeleteDBSecurityGroupOutput
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBSecurityGroupInput) *rds.DeleteDBSecurityGroupOutput); ok {
-		r1 = rf(_a0)
-	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DeleteDBSecurityGroupOutput)
-		}
-	}
-
-	return r0, r1
-}
-
-// DeleteDBSecurityGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DeleteDBSecurityGroupWithContext(_a0 context.Context, _a1 *rds.DeleteDBSecurityGroupInput, _a2 ...request.Option) (*rds.DeleteDBSecurityGroupOutput, error) {
-	_va := make([]interface{}, len(_a2))
-	for _i := range _a2 {
-		_va[_i] = _a2[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 *rds.DeleteDBSecurityGroupOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBSecurityGroupInput, ...request.Option) *rds.DeleteDBSecurityGroupOutput); ok {
-		r0 = rf(_a0, _a1, _a2...)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBSecurityGroupOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBInstanceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBSecurityGroupInput, ...request.Option) error); ok {
-		r1 = rf(_a0, _a1, _a2...)
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBInstanceInput) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2980,21 +3159,21 @@ func (_m *RDSAPI) DeleteDBSecurityGroupWithContext(_a0 context.Context, _a1 *rds
 	return r0, r1
 }
 
-// DeleteDBSnapshot provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBSnapshot(_a0 *rds.DeleteDBSnapshotInput) (*rds.DeleteDBSnapshotOutput, error) {
+// DeleteDBInstanceAutomatedBackup provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBInstanceAutomatedBackup(_a0 *rds.DeleteDBInstanceAutomatedBackupInput) (*rds.DeleteDBInstanceAutomatedBackupOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DeleteDBSnapshotOutput
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBSnapshotInput) *rds.DeleteDBSnapshotOutput); ok {
+	var r0 *rds.DeleteDBInstanceAutomatedBackupOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBInstanceAutomatedBackupInput) *rds.DeleteDBInstanceAutomatedBackupOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBSnapshotOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBInstanceAutomatedBackupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBSnapshotInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBInstanceAutomatedBackupInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -3003,12 +3182,12 @@ func (_m *RDSAPI) DeleteDBSnapshot(_a0 *rds.DeleteDBSnapshotInput) (*rds.DeleteD
 	return r0, r1
 }
 
-// DeleteDBSnapshotRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBSnapshotRequest(_a0 *rds.DeleteDBSnapshotInput) (*request.Request, *rds.DeleteDBSnapshotOutput) {
+// DeleteDBInstanceAutomatedBackupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBInstanceAutomatedBackupRequest(_a0 *rds.DeleteDBInstanceAutomatedBackupInput) (*request.Request, *rds.DeleteDBInstanceAutomatedBackupOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBSnapshotInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBInstanceAutomatedBackupInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -3016,20 +3195,20 @@ func (_m *RDSAPI) DeleteDBSnapshotRequest(_a0 *rds.DeleteDBSnapshotInput) (*requ
 		}
 	}
 
-	var r1 *rds.DeleteDBSnapshotOutput
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBSnapshotInput) *rds.DeleteDBSnapshotOutput); ok {
+	var r1 *rds.DeleteDBInstanceAutomatedBackupOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBInstanceAutomatedBackupInput) *rds.DeleteDBInstanceAutomatedBackupOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DeleteDBSnapshotOutput)
+			r1 = ret.Get(1).(*rds.DeleteDBInstanceAutomatedBackupOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteDBSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DeleteDBSnapshotWithContext(_a0 context.Context, _a1 *rds.DeleteDBSnapshotInput, _a2 ...request.Option) (*rds.DeleteDBSnapshotOutput, error) {
+// DeleteDBInstanceAutomatedBackupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteDBInstanceAutomatedBackupWithContext(_a0 context.Context, _a1 *rds.DeleteDBInstanceAutomatedBackupInput, _a2 ...request.Option) (*rds.DeleteDBInstanceAutomatedBackupOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3039,17 +3218,17 @@ func (_m *RDSAPI) DeleteDBSnapshotWithContext(_a0 context.Context, _a1 *rds.Dele
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DeleteDBSnapshotOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBSnapshotInput, ...request.Option) *rds.DeleteDBSnapshotOutput); ok {
+	var r0 *rds.DeleteDBInstanceAutomatedBackupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBInstanceAutomatedBackupInput, ...request.Option) *rds.DeleteDBInstanceAutomatedBackupOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBSnapshotOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBInstanceAutomatedBackupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBSnapshotInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBInstanceAutomatedBackupInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -3058,35 +3237,12 @@ func (_m *RDSAPI) DeleteDBSnapshotWithContext(_a0 context.Context, _a1 *rds.Dele
 	return r0, r1
 }
 
-// DeleteDBSubnetGroup provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBSubnetGroup(_a0 *rds.DeleteDBSubnetGroupInput) (*rds.DeleteDBSubnetGroupOutput, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *rds.DeleteDBSubnetGroupOutput
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBSubnetGroupInput) *rds.DeleteDBSubnetGroupOutput); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBSubnetGroupOutput)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBSubnetGroupInput) error); ok {
-		r1 = rf(_a0)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// DeleteDBSubnetGroupRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteDBSubnetGroupRequest(_a0 *rds.DeleteDBSubnetGroupInput) (*request.Request, *rds.DeleteDBSubnetGroupOutput) {
+// DeleteDBInstanceRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBInstanceRequest(_a0 *rds.DeleteDBInstanceInput) (*request.Request, *rds.DeleteDBInstanceOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DeleteDBSubnetGroupInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBInstanceInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -3094,20 +3250,20 @@ func (_m *RDSAPI) DeleteDBSubnetGroupRequest(_a0 *rds.DeleteDBSubnetGroupInput)
 		}
 	}
 
-	var r1 *rds.DeleteDBSubnetGroupOutput
-	if rf, ok := ret.Get(1).(func(*rds.DeleteDBSubnetGroupInput) *rds.DeleteDBSubnetGroupOutput); ok {
+	var r1 *rds.DeleteDBInstanceOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBInstanceInput) *rds.DeleteDBInstanceOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DeleteDBSubnetGroupOutput)
+			r1 = ret.Get(1).(*rds.DeleteDBInstanceOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteDBSubnetGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DeleteDBSubnetGroupWithContext(_a0 context.Context, _a1 *rds.DeleteDBSubnetGroupInput, _a2 ...request.Option) (*rds.DeleteDBSubnetGroupOutput, error) {
+// DeleteDBInstanceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteDBInstanceWithContext(_a0 context.Context, _a1 *rds.DeleteDBInstanceInput, _a2 ...request.Option) (*rds.DeleteDBInstanceOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3117,17 +3273,17 @@ func (_m *RDSAPI) DeleteDBSubnetGroupWithContext(_a0 context.Context, _a1 *rds.D
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DeleteDBSubnetGroupOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBSubnetGroupInput, ...request.Option) *rds.DeleteDBSubnetGroupOutput); ok {
+	var r0 *rds.DeleteDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBInstanceInput, ...request.Option) *rds.DeleteDBInstanceOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteDBSubnetGroupOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBInstanceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBSubnetGroupInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBInstanceInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -3136,21 +3292,21 @@ func (_m *RDSAPI) DeleteDBSubnetGroupWithContext(_a0 context.Context, _a1 *rds.D
 	return r0, r1
 }
 
-// DeleteEventSubscription provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteEventSubscription(_a0 *rds.DeleteEventSubscriptionInput) (*rds.DeleteEventSubscriptionOutput, error) {
+// DeleteDBParameterGroup provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBParameterGroup(_a0 *rds.DeleteDBParameterGroupInput) (*rds.DeleteDBParameterGroupOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DeleteEventSubscriptionOutput
-	if rf, ok := ret.Get(0).(func(*rds.DeleteEventSubscriptionInput) *rds.DeleteEventSubscriptionOutput); ok {
+	var r0 *rds.DeleteDBParameterGroupOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBParameterGroupInput) *rds.DeleteDBParameterGroupOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteEventSubscriptionOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBParameterGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DeleteEventSubscriptionInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBParameterGroupInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -3159,12 +3315,12 @@ func (_m *RDSAPI) DeleteEventSubscription(_a0 *rds.DeleteEventSubscriptionInput)
 	return r0, r1
 }
 
-// DeleteEventSubscriptionRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteEventSubscriptionRequest(_a0 *rds.DeleteEventSubscriptionInput) (*request.Request, *rds.DeleteEventSubscriptionOutput) {
+// DeleteDBParameterGroupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBParameterGroupRequest(_a0 *rds.DeleteDBParameterGroupInput) (*request.Request, *rds.DeleteDBParameterGroupOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DeleteEventSubscriptionInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBParameterGroupInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -3172,20 +3328,20 @@ func (_m *RDSAPI) DeleteEventSubscriptionRequest(_a0 *rds.DeleteEventSubscriptio
 		}
 	}
 
-	var r1 *rds.DeleteEventSubscriptionOutput
-	if rf, ok := ret.Get(1).(func(*rds.DeleteEventSubscriptionInput) *rds.DeleteEventSubscriptionOutput); ok {
+	var r1 *rds.DeleteDBParameterGroupOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBParameterGroupInput) *rds.DeleteDBParameterGroupOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DeleteEventSubscriptionOutput)
+			r1 = ret.Get(1).(*rds.DeleteDBParameterGroupOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteEventSubscriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DeleteEventSubscriptionWithContext(_a0 context.Context, _a1 *rds.DeleteEventSubscriptionInput, _a2 ...request.Option) (*rds.DeleteEventSubscriptionOutput, error) {
+// DeleteDBParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteDBParameterGroupWithContext(_a0 context.Context, _a1 *rds.DeleteDBParameterGroupInput, _a2 ...request.Option) (*rds.DeleteDBParameterGroupOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3195,17 +3351,17 @@ func (_m *RDSAPI) DeleteEventSubscriptionWithContext(_a0 context.Context, _a1 *r
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DeleteEventSubscriptionOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteEventSubscriptionInput, ...request.Option) *rds.DeleteEventSubscriptionOutput); ok {
+	var r0 *rds.DeleteDBParameterGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBParameterGroupInput, ...request.Option) *rds.DeleteDBParameterGroupOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteEventSubscriptionOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBParameterGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteEventSubscriptionInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBParameterGroupInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -3214,21 +3370,21 @@ func (_m *RDSAPI) DeleteEventSubscriptionWithContext(_a0 context.Context, _a1 *r
 	return r0, r1
 }
 
-// DeleteGlobalCluster provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteGlobalCluster(_a0 *rds.DeleteGlobalClusterInput) (*rds.DeleteGlobalClusterOutput, error) {
+// DeleteDBProxy provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBProxy(_a0 *rds.DeleteDBProxyInput) (*rds.DeleteDBProxyOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DeleteGlobalClusterOutput
-	if rf, ok := ret.Get(0).(func(*rds.DeleteGlobalClusterInput) *rds.DeleteGlobalClusterOutput); ok {
+	var r0 *rds.DeleteDBProxyOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBProxyInput) *rds.DeleteDBProxyOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteGlobalClusterOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBProxyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DeleteGlobalClusterInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBProxyInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -3237,12 +3393,35 @@ func (_m *RDSAPI) DeleteGlobalCluster(_a0 *rds.DeleteGlobalClusterInput) (*rds.D
 	return r0, r1
 }
 
-// DeleteGlobalClusterRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteGlobalClusterRequest(_a0 *rds.DeleteGlobalClusterInput) (*request.Request, *rds.DeleteGlobalClusterOutput) {
+// DeleteDBProxyEndpoint provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBProxyEndpoint(_a0 *rds.DeleteDBProxyEndpointInput) (*rds.DeleteDBProxyEndpointOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DeleteDBProxyEndpointOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBProxyEndpointInput) *rds.DeleteDBProxyEndpointOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DeleteDBProxyEndpointOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBProxyEndpointInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDBProxyEndpointRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBProxyEndpointRequest(_a0 *rds.DeleteDBProxyEndpointInput) (*request.Request, *rds.DeleteDBProxyEndpointOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DeleteGlobalClusterInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBProxyEndpointInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -3250,20 +3429,20 @@ func (_m *RDSAPI) DeleteGlobalClusterRequest(_a0 *rds.DeleteGlobalClusterInput)
 		}
 	}
 
-	var r1 *rds.DeleteGlobalClusterOutput
-	if rf, ok := ret.Get(1).(func(*rds.DeleteGlobalClusterInput) *rds.DeleteGlobalClusterOutput); ok {
+	var r1 *rds.DeleteDBProxyEndpointOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBProxyEndpointInput) *rds.DeleteDBProxyEndpointOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DeleteGlobalClusterOutput)
+			r1 = ret.Get(1).(*rds.DeleteDBProxyEndpointOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteGlobalClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DeleteGlobalClusterWithContext(_a0 context.Context, _a1 *rds.DeleteGlobalClusterInput, _a2 ...request.Option) (*rds.DeleteGlobalClusterOutput, error) {
+// DeleteDBProxyEndpointWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteDBProxyEndpointWithContext(_a0 context.Context, _a1 *rds.DeleteDBProxyEndpointInput, _a2 ...request.Option) (*rds.DeleteDBProxyEndpointOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3273,17 +3452,17 @@ func (_m *RDSAPI) DeleteGlobalClusterWithContext(_a0 context.Context, _a1 *rds.D
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DeleteGlobalClusterOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteGlobalClusterInput, ...request.Option) *rds.DeleteGlobalClusterOutput); ok {
+	var r0 *rds.DeleteDBProxyEndpointOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBProxyEndpointInput, ...request.Option) *rds.DeleteDBProxyEndpointOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteGlobalClusterOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBProxyEndpointOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteGlobalClusterInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBProxyEndpointInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -3292,56 +3471,33 @@ func (_m *RDSAPI) DeleteGlobalClusterWithContext(_a0 context.Context, _a1 *rds.D
 	return r0, r1
 }
 
-// DeleteInstallationMedia provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteInstallationMedia(_a0 *rds.DeleteInstallationMediaInput) (*rds.DeleteInstallationMediaOutput, error) {
+// DeleteDBProxyRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBProxyRequest(_a0 *rds.DeleteDBProxyInput) (*request.Request, *rds.DeleteDBProxyOutput) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DeleteInstallationMediaOutput
-	if rf, ok := ret.Get(0).(func(*rds.DeleteInstallationMediaInput) *rds.DeleteInstallationMediaOutput); ok {
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBProxyInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteInstallationMediaOutput)
+			r0 = ret.Get(0).(*request.Request)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DeleteInstallationMediaInput) error); ok {
+	var r1 *rds.DeleteDBProxyOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBProxyInput) *rds.DeleteDBProxyOutput); ok {
 		r1 = rf(_a0)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DeleteDBProxyOutput)
+		}
 	}
 
 	return r0, r1
 }
 
-// DeleteInstallationMediaRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteInstallationMediaRequest(_a0 *rds.DeleteInstallationMediaInput) (*request.Request, *rds.DeleteInstallationMediaOutput) {
-	ret := _m.Called(_a0)
-
-	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DeleteInstallationMediaInput) *request.Request); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*request.Request)
-		}
-	}
-
-	var r1 *rds.DeleteInstallationMediaOutput
-	if rf, ok := ret.Get(1).(func(*rds.DeleteInstallationMediaInput) *rds.DeleteInstallationMediaOutput); ok {
-		r1 = rf(_a0)
-	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DeleteInstallationMediaOutput)
-		}
-	}
-
-	return r0, r1
-}
-
-// DeleteInstallationMediaWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DeleteInstallationMediaWithContext(_a0 context.Context, _a1 *rds.DeleteInstallationMediaInput, _a2 ...request.Option) (*rds.DeleteInstallationMediaOutput, error) {
+// DeleteDBProxyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteDBProxyWithContext(_a0 context.Context, _a1 *rds.DeleteDBProxyInput, _a2 ...request.Option) (*rds.DeleteDBProxyOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3351,17 +3507,17 @@ func (_m *RDSAPI) DeleteInstallationMediaWithContext(_a0 context.Context, _a1 *r
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DeleteInstallationMediaOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteInstallationMediaInput, ...request.Option) *rds.DeleteInstallationMediaOutput); ok {
+	var r0 *rds.DeleteDBProxyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBProxyInput, ...request.Option) *rds.DeleteDBProxyOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteInstallationMediaOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBProxyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteInstallationMediaInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBProxyInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -3370,21 +3526,21 @@ func (_m *RDSAPI) DeleteInstallationMediaWithContext(_a0 context.Context, _a1 *r
 	return r0, r1
 }
 
-// DeleteOptionGroup provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteOptionGroup(_a0 *rds.DeleteOptionGroupInput) (*rds.DeleteOptionGroupOutput, error) {
+// DeleteDBSecurityGroup provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBSecurityGroup(_a0 *rds.DeleteDBSecurityGroupInput) (*rds.DeleteDBSecurityGroupOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DeleteOptionGroupOutput
-	if rf, ok := ret.Get(0).(func(*rds.DeleteOptionGroupInput) *rds.DeleteOptionGroupOutput); ok {
+	var r0 *rds.DeleteDBSecurityGroupOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBSecurityGroupInput) *rds.DeleteDBSecurityGroupOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteOptionGroupOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBSecurityGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DeleteOptionGroupInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBSecurityGroupInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -3393,12 +3549,12 @@ func (_m *RDSAPI) DeleteOptionGroup(_a0 *rds.DeleteOptionGroupInput) (*rds.Delet
 	return r0, r1
 }
 
-// DeleteOptionGroupRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeleteOptionGroupRequest(_a0 *rds.DeleteOptionGroupInput) (*request.Request, *rds.DeleteOptionGroupOutput) {
+// DeleteDBSecurityGroupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBSecurityGroupRequest(_a0 *rds.DeleteDBSecurityGroupInput) (*request.Request, *rds.DeleteDBSecurityGroupOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DeleteOptionGroupInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBSecurityGroupInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -3406,20 +3562,20 @@ func (_m *RDSAPI) DeleteOptionGroupRequest(_a0 *rds.DeleteOptionGroupInput) (*re
 		}
 	}
 
-	var r1 *rds.DeleteOptionGroupOutput
-	if rf, ok := ret.Get(1).(func(*rds.DeleteOptionGroupInput) *rds.DeleteOptionGroupOutput); ok {
+	var r1 *rds.DeleteDBSecurityGroupOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBSecurityGroupInput) *rds.DeleteDBSecurityGroupOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DeleteOptionGroupOutput)
+			r1 = ret.Get(1).(*rds.DeleteDBSecurityGroupOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteOptionGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DeleteOptionGroupWithContext(_a0 context.Context, _a1 *rds.DeleteOptionGroupInput, _a2 ...request.Option) (*rds.DeleteOptionGroupOutput, error) {
+// DeleteDBSecurityGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteDBSecurityGroupWithContext(_a0 context.Context, _a1 *rds.DeleteDBSecurityGroupInput, _a2 ...request.Option) (*rds.DeleteDBSecurityGroupOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3429,17 +3585,17 @@ func (_m *RDSAPI) DeleteOptionGroupWithContext(_a0 context.Context, _a1 *rds.Del
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DeleteOptionGroupOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteOptionGroupInput, ...request.Option) *rds.DeleteOptionGroupOutput); ok {
+	var r0 *rds.DeleteDBSecurityGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBSecurityGroupInput, ...request.Option) *rds.DeleteDBSecurityGroupOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeleteOptionGroupOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBSecurityGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteOptionGroupInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBSecurityGroupInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -3448,21 +3604,21 @@ func (_m *RDSAPI) DeleteOptionGroupWithContext(_a0 context.Context, _a1 *rds.Del
 	return r0, r1
 }
 
-// DeregisterDBProxyTargets provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeregisterDBProxyTargets(_a0 *rds.DeregisterDBProxyTargetsInput) (*rds.DeregisterDBProxyTargetsOutput, error) {
+// DeleteDBShardGroup provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBShardGroup(_a0 *rds.DeleteDBShardGroupInput) (*rds.DeleteDBShardGroupOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DeregisterDBProxyTargetsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DeregisterDBProxyTargetsInput) *rds.DeregisterDBProxyTargetsOutput); ok {
+	var r0 *rds.DeleteDBShardGroupOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBShardGroupInput) *rds.DeleteDBShardGroupOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeregisterDBProxyTargetsOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBShardGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DeregisterDBProxyTargetsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBShardGroupInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -3471,12 +3627,12 @@ func (_m *RDSAPI) DeregisterDBProxyTargets(_a0 *rds.DeregisterDBProxyTargetsInpu
 	return r0, r1
 }
 
-// DeregisterDBProxyTargetsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DeregisterDBProxyTargetsRequest(_a0 *rds.DeregisterDBProxyTargetsInput) (*request.Request, *rds.DeregisterDBProxyTargetsOutput) {
+// DeleteDBShardGroupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBShardGroupRequest(_a0 *rds.DeleteDBShardGroupInput) (*request.Request, *rds.DeleteDBShardGroupOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DeregisterDBProxyTargetsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBShardGroupInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -3484,20 +3640,20 @@ func (_m *RDSAPI) DeregisterDBProxyTargetsRequest(_a0 *rds.DeregisterDBProxyTarg
 		}
 	}
 
-	var r1 *rds.DeregisterDBProxyTargetsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DeregisterDBProxyTargetsInput) *rds.DeregisterDBProxyTargetsOutput); ok {
+	var r1 *rds.DeleteDBShardGroupOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBShardGroupInput) *rds.DeleteDBShardGroupOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DeregisterDBProxyTargetsOutput)
+			r1 = ret.Get(1).(*rds.DeleteDBShardGroupOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeregisterDBProxyTargetsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DeregisterDBProxyTargetsWithContext(_a0 context.Context, _a1 *rds.DeregisterDBProxyTargetsInput, _a2 ...request.Option) (*rds.DeregisterDBProxyTargetsOutput, error) {
+// DeleteDBShardGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteDBShardGroupWithContext(_a0 context.Context, _a1 *rds.DeleteDBShardGroupInput, _a2 ...request.Option) (*rds.DeleteDBShardGroupOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3507,17 +3663,17 @@ func (_m *RDSAPI) DeregisterDBProxyTargetsWithContext(_a0 context.Context, _a1 *
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DeregisterDBProxyTargetsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeregisterDBProxyTargetsInput, ...request.Option) *rds.DeregisterDBProxyTargetsOutput); ok {
+	var r0 *rds.DeleteDBShardGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBShardGroupInput, ...request.Option) *rds.DeleteDBShardGroupOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DeregisterDBProxyTargetsOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBShardGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeregisterDBProxyTargetsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBShardGroupInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -3526,21 +3682,21 @@ func (_m *RDSAPI) DeregisterDBProxyTargetsWithContext(_a0 context.Context, _a1 *
 	return r0, r1
 }
 
-// DescribeAccountAttributes provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeAccountAttributes(_a0 *rds.DescribeAccountAttributesInput) (*rds.DescribeAccountAttributesOutput, error) {
+// DeleteDBSnapshot provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBSnapshot(_a0 *rds.DeleteDBSnapshotInput) (*rds.DeleteDBSnapshotOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeAccountAttributesOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeAccountAttributesInput) *rds.DescribeAccountAttributesOutput); ok {
+	var r0 *rds.DeleteDBSnapshotOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBSnapshotInput) *rds.DeleteDBSnapshotOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeAccountAttributesOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBSnapshotOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeAccountAttributesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBSnapshotInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -3549,12 +3705,12 @@ func (_m *RDSAPI) DescribeAccountAttributes(_a0 *rds.DescribeAccountAttributesIn
 	return r0, r1
 }
 
-// DescribeAccountAttributesRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeAccountAttributesRequest(_a0 *rds.DescribeAccountAttributesInput) (*request.Request, *rds.DescribeAccountAttributesOutput) {
+// DeleteDBSnapshotRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBSnapshotRequest(_a0 *rds.DeleteDBSnapshotInput) (*request.Request, *rds.DeleteDBSnapshotOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeAccountAttributesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBSnapshotInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -3562,20 +3718,20 @@ func (_m *RDSAPI) DescribeAccountAttributesRequest(_a0 *rds.DescribeAccountAttri
 		}
 	}
 
-	var r1 *rds.DescribeAccountAttributesOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeAccountAttributesInput) *rds.DescribeAccountAttributesOutput); ok {
+	var r1 *rds.DeleteDBSnapshotOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBSnapshotInput) *rds.DeleteDBSnapshotOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeAccountAttributesOutput)
+			r1 = ret.Get(1).(*rds.DeleteDBSnapshotOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeAccountAttributesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeAccountAttributesWithContext(_a0 context.Context, _a1 *rds.DescribeAccountAttributesInput, _a2 ...request.Option) (*rds.DescribeAccountAttributesOutput, error) {
+// DeleteDBSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteDBSnapshotWithContext(_a0 context.Context, _a1 *rds.DeleteDBSnapshotInput, _a2 ...request.Option) (*rds.DeleteDBSnapshotOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3585,17 +3741,17 @@ func (_m *RDSAPI) DescribeAccountAttributesWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeAccountAttributesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeAccountAttributesInput, ...request.Option) *rds.DescribeAccountAttributesOutput); ok {
+	var r0 *rds.DeleteDBSnapshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBSnapshotInput, ...request.Option) *rds.DeleteDBSnapshotOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeAccountAttributesOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBSnapshotOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeAccountAttributesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBSnapshotInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -3604,21 +3760,21 @@ func (_m *RDSAPI) DescribeAccountAttributesWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// DescribeCertificates provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeCertificates(_a0 *rds.DescribeCertificatesInput) (*rds.DescribeCertificatesOutput, error) {
+// DeleteDBSubnetGroup provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBSubnetGroup(_a0 *rds.DeleteDBSubnetGroupInput) (*rds.DeleteDBSubnetGroupOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeCertificatesOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeCertificatesInput) *rds.DescribeCertificatesOutput); ok {
+	var r0 *rds.DeleteDBSubnetGroupOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBSubnetGroupInput) *rds.DeleteDBSubnetGroupOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeCertificatesOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBSubnetGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeCertificatesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBSubnetGroupInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -3627,47 +3783,12 @@ func (_m *RDSAPI) DescribeCertificates(_a0 *rds.DescribeCertificatesInput) (*rds
 	return r0, r1
 }
 
-// DescribeCertificatesPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeCertificatesPages(_a0 *rds.DescribeCertificatesInput, _a1 func(*rds.DescribeCertificatesOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeCertificatesInput, func(*rds.DescribeCertificatesOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DescribeCertificatesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeCertificatesPagesWithContext(_a0 context.Context, _a1 *rds.DescribeCertificatesInput, _a2 func(*rds.DescribeCertificatesOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeCertificatesInput, func(*rds.DescribeCertificatesOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DescribeCertificatesRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeCertificatesRequest(_a0 *rds.DescribeCertificatesInput) (*request.Request, *rds.DescribeCertificatesOutput) {
+// DeleteDBSubnetGroupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteDBSubnetGroupRequest(_a0 *rds.DeleteDBSubnetGroupInput) (*request.Request, *rds.DeleteDBSubnetGroupOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeCertificatesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeleteDBSubnetGroupInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -3675,20 +3796,20 @@ func (_m *RDSAPI) DescribeCertificatesRequest(_a0 *rds.DescribeCertificatesInput
 		}
 	}
 
-	var r1 *rds.DescribeCertificatesOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeCertificatesInput) *rds.DescribeCertificatesOutput); ok {
+	var r1 *rds.DeleteDBSubnetGroupOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteDBSubnetGroupInput) *rds.DeleteDBSubnetGroupOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeCertificatesOutput)
+			r1 = ret.Get(1).(*rds.DeleteDBSubnetGroupOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeCertificatesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeCertificatesWithContext(_a0 context.Context, _a1 *rds.DescribeCertificatesInput, _a2 ...request.Option) (*rds.DescribeCertificatesOutput, error) {
+// DeleteDBSubnetGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteDBSubnetGroupWithContext(_a0 context.Context, _a1 *rds.DeleteDBSubnetGroupInput, _a2 ...request.Option) (*rds.DeleteDBSubnetGroupOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3698,17 +3819,17 @@ func (_m *RDSAPI) DescribeCertificatesWithContext(_a0 context.Context, _a1 *rds.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeCertificatesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeCertificatesInput, ...request.Option) *rds.DescribeCertificatesOutput); ok {
+	var r0 *rds.DeleteDBSubnetGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteDBSubnetGroupInput, ...request.Option) *rds.DeleteDBSubnetGroupOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeCertificatesOutput)
+			r0 = ret.Get(0).(*rds.DeleteDBSubnetGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeCertificatesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteDBSubnetGroupInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -3717,21 +3838,21 @@ func (_m *RDSAPI) DescribeCertificatesWithContext(_a0 context.Context, _a1 *rds.
 	return r0, r1
 }
 
-// DescribeCustomAvailabilityZones provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeCustomAvailabilityZones(_a0 *rds.DescribeCustomAvailabilityZonesInput) (*rds.DescribeCustomAvailabilityZonesOutput, error) {
+// DeleteEventSubscription provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteEventSubscription(_a0 *rds.DeleteEventSubscriptionInput) (*rds.DeleteEventSubscriptionOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeCustomAvailabilityZonesOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeCustomAvailabilityZonesInput) *rds.DescribeCustomAvailabilityZonesOutput); ok {
+	var r0 *rds.DeleteEventSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteEventSubscriptionInput) *rds.DeleteEventSubscriptionOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeCustomAvailabilityZonesOutput)
+			r0 = ret.Get(0).(*rds.DeleteEventSubscriptionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeCustomAvailabilityZonesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeleteEventSubscriptionInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -3740,47 +3861,12 @@ func (_m *RDSAPI) DescribeCustomAvailabilityZones(_a0 *rds.DescribeCustomAvailab
 	return r0, r1
 }
 
-// DescribeCustomAvailabilityZonesPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeCustomAvailabilityZonesPages(_a0 *rds.DescribeCustomAvailabilityZonesInput, _a1 func(*rds.DescribeCustomAvailabilityZonesOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeCustomAvailabilityZonesInput, func(*rds.DescribeCustomAvailabilityZonesOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DescribeCustomAvailabilityZonesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeCustomAvailabilityZonesPagesWithContext(_a0 context.Context, _a1 *rds.DescribeCustomAvailabilityZonesInput, _a2 func(*rds.DescribeCustomAvailabilityZonesOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeCustomAvailabilityZonesInput, func(*rds.DescribeCustomAvailabilityZonesOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DescribeCustomAvailabilityZonesRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeCustomAvailabilityZonesRequest(_a0 *rds.DescribeCustomAvailabilityZonesInput) (*request.Request, *rds.DescribeCustomAvailabilityZonesOutput) {
+// DeleteEventSubscriptionRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteEventSubscriptionRequest(_a0 *rds.DeleteEventSubscriptionInput) (*request.Request, *rds.DeleteEventSubscriptionOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeCustomAvailabilityZonesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeleteEventSubscriptionInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -3788,20 +3874,20 @@ func (_m *RDSAPI) DescribeCustomAvailabilityZonesRequest(_a0 *rds.DescribeCustom
 		}
 	}
 
-	var r1 *rds.DescribeCustomAvailabilityZonesOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeCustomAvailabilityZonesInput) *rds.DescribeCustomAvailabilityZonesOutput); ok {
+	var r1 *rds.DeleteEventSubscriptionOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteEventSubscriptionInput) *rds.DeleteEventSubscriptionOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeCustomAvailabilityZonesOutput)
+			r1 = ret.Get(1).(*rds.DeleteEventSubscriptionOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeCustomAvailabilityZonesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeCustomAvailabilityZonesWithContext(_a0 context.Context, _a1 *rds.DescribeCustomAvailabilityZonesInput, _a2 ...request.Option) (*rds.DescribeCustomAvailabilityZonesOutput, error) {
+// DeleteEventSubscriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteEventSubscriptionWithContext(_a0 context.Context, _a1 *rds.DeleteEventSubscriptionInput, _a2 ...request.Option) (*rds.DeleteEventSubscriptionOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3811,17 +3897,17 @@ func (_m *RDSAPI) DescribeCustomAvailabilityZonesWithContext(_a0 context.Context
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeCustomAvailabilityZonesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeCustomAvailabilityZonesInput, ...request.Option) *rds.DescribeCustomAvailabilityZonesOutput); ok {
+	var r0 *rds.DeleteEventSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteEventSubscriptionInput, ...request.Option) *rds.DeleteEventSubscriptionOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeCustomAvailabilityZonesOutput)
+			r0 = ret.Get(0).(*rds.DeleteEventSubscriptionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeCustomAvailabilityZonesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteEventSubscriptionInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -3830,21 +3916,21 @@ func (_m *RDSAPI) DescribeCustomAvailabilityZonesWithContext(_a0 context.Context
 	return r0, r1
 }
 
-// DescribeDBClusterBacktracks provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBClusterBacktracks(_a0 *rds.DescribeDBClusterBacktracksInput) (*rds.DescribeDBClusterBacktracksOutput, error) {
+// DeleteGlobalCluster provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteGlobalCluster(_a0 *rds.DeleteGlobalClusterInput) (*rds.DeleteGlobalClusterOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBClusterBacktracksOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterBacktracksInput) *rds.DescribeDBClusterBacktracksOutput); ok {
+	var r0 *rds.DeleteGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteGlobalClusterInput) *rds.DeleteGlobalClusterOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBClusterBacktracksOutput)
+			r0 = ret.Get(0).(*rds.DeleteGlobalClusterOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterBacktracksInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeleteGlobalClusterInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -3853,68 +3939,33 @@ func (_m *RDSAPI) DescribeDBClusterBacktracks(_a0 *rds.DescribeDBClusterBacktrac
 	return r0, r1
 }
 
-// DescribeDBClusterBacktracksPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeDBClusterBacktracksPages(_a0 *rds.DescribeDBClusterBacktracksInput, _a1 func(*rds.DescribeDBClusterBacktracksOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
+// DeleteGlobalClusterRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteGlobalClusterRequest(_a0 *rds.DeleteGlobalClusterInput) (*request.Request, *rds.DeleteGlobalClusterOutput) {
+	ret := _m.Called(_a0)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterBacktracksInput, func(*rds.DescribeDBClusterBacktracksOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DescribeDBClusterBacktracksPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeDBClusterBacktracksPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterBacktracksInput, _a2 func(*rds.DescribeDBClusterBacktracksOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterBacktracksInput, func(*rds.DescribeDBClusterBacktracksOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DescribeDBClusterBacktracksRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBClusterBacktracksRequest(_a0 *rds.DescribeDBClusterBacktracksInput) (*request.Request, *rds.DescribeDBClusterBacktracksOutput) {
-	ret := _m.Called(_a0)
-
-	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterBacktracksInput) *request.Request); ok {
-		r0 = rf(_a0)
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DeleteGlobalClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*request.Request)
 		}
 	}
 
-	var r1 *rds.DescribeDBClusterBacktracksOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterBacktracksInput) *rds.DescribeDBClusterBacktracksOutput); ok {
+	var r1 *rds.DeleteGlobalClusterOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteGlobalClusterInput) *rds.DeleteGlobalClusterOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBClusterBacktracksOutput)
+			r1 = ret.Get(1).(*rds.DeleteGlobalClusterOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBClusterBacktracksWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBClusterBacktracksWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterBacktracksInput, _a2 ...request.Option) (*rds.DescribeDBClusterBacktracksOutput, error) {
+// DeleteGlobalClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteGlobalClusterWithContext(_a0 context.Context, _a1 *rds.DeleteGlobalClusterInput, _a2 ...request.Option) (*rds.DeleteGlobalClusterOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3924,17 +3975,17 @@ func (_m *RDSAPI) DescribeDBClusterBacktracksWithContext(_a0 context.Context, _a
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBClusterBacktracksOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterBacktracksInput, ...request.Option) *rds.DescribeDBClusterBacktracksOutput); ok {
+	var r0 *rds.DeleteGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteGlobalClusterInput, ...request.Option) *rds.DeleteGlobalClusterOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBClusterBacktracksOutput)
+			r0 = ret.Get(0).(*rds.DeleteGlobalClusterOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBClusterBacktracksInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteGlobalClusterInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -3943,21 +3994,21 @@ func (_m *RDSAPI) DescribeDBClusterBacktracksWithContext(_a0 context.Context, _a
 	return r0, r1
 }
 
-// DescribeDBClusterEndpoints provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBClusterEndpoints(_a0 *rds.DescribeDBClusterEndpointsInput) (*rds.DescribeDBClusterEndpointsOutput, error) {
+// DeleteIntegration provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteIntegration(_a0 *rds.DeleteIntegrationInput) (*rds.DeleteIntegrationOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBClusterEndpointsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterEndpointsInput) *rds.DescribeDBClusterEndpointsOutput); ok {
+	var r0 *rds.DeleteIntegrationOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteIntegrationInput) *rds.DeleteIntegrationOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBClusterEndpointsOutput)
+			r0 = ret.Get(0).(*rds.DeleteIntegrationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterEndpointsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeleteIntegrationInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -3966,47 +4017,12 @@ func (_m *RDSAPI) DescribeDBClusterEndpoints(_a0 *rds.DescribeDBClusterEndpoints
 	return r0, r1
 }
 
-// DescribeDBClusterEndpointsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeDBClusterEndpointsPages(_a0 *rds.DescribeDBClusterEndpointsInput, _a1 func(*rds.DescribeDBClusterEndpointsOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterEndpointsInput, func(*rds.DescribeDBClusterEndpointsOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DescribeDBClusterEndpointsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeDBClusterEndpointsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterEndpointsInput, _a2 func(*rds.DescribeDBClusterEndpointsOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterEndpointsInput, func(*rds.DescribeDBClusterEndpointsOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DescribeDBClusterEndpointsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBClusterEndpointsRequest(_a0 *rds.DescribeDBClusterEndpointsInput) (*request.Request, *rds.DescribeDBClusterEndpointsOutput) {
+// DeleteIntegrationRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteIntegrationRequest(_a0 *rds.DeleteIntegrationInput) (*request.Request, *rds.DeleteIntegrationOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterEndpointsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeleteIntegrationInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -4014,20 +4030,20 @@ func (_m *RDSAPI) DescribeDBClusterEndpointsRequest(_a0 *rds.DescribeDBClusterEn
 		}
 	}
 
-	var r1 *rds.DescribeDBClusterEndpointsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterEndpointsInput) *rds.DescribeDBClusterEndpointsOutput); ok {
+	var r1 *rds.DeleteIntegrationOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteIntegrationInput) *rds.DeleteIntegrationOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBClusterEndpointsOutput)
+			r1 = ret.Get(1).(*rds.DeleteIntegrationOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBClusterEndpointsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBClusterEndpointsWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterEndpointsInput, _a2 ...request.Option) (*rds.DescribeDBClusterEndpointsOutput, error) {
+// DeleteIntegrationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteIntegrationWithContext(_a0 context.Context, _a1 *rds.DeleteIntegrationInput, _a2 ...request.Option) (*rds.DeleteIntegrationOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -4037,17 +4053,17 @@ func (_m *RDSAPI) DescribeDBClusterEndpointsWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBClusterEndpointsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterEndpointsInput, ...request.Option) *rds.DescribeDBClusterEndpointsOutput); ok {
+	var r0 *rds.DeleteIntegrationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteIntegrationInput, ...request.Option) *rds.DeleteIntegrationOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBClusterEndpointsOutput)
+			r0 = ret.Get(0).(*rds.DeleteIntegrationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBClusterEndpointsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteIntegrationInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -4056,21 +4072,21 @@ func (_m *RDSAPI) DescribeDBClusterEndpointsWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// DescribeDBClusterParameterGroups provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBClusterParameterGroups(_a0 *rds.DescribeDBClusterParameterGroupsInput) (*rds.DescribeDBClusterParameterGroupsOutput, error) {
+// DeleteOptionGroup provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteOptionGroup(_a0 *rds.DeleteOptionGroupInput) (*rds.DeleteOptionGroupOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBClusterParameterGroupsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterParameterGroupsInput) *rds.DescribeDBClusterParameterGroupsOutput); ok {
+	var r0 *rds.DeleteOptionGroupOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteOptionGroupInput) *rds.DeleteOptionGroupOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBClusterParameterGroupsOutput)
+			r0 = ret.Get(0).(*rds.DeleteOptionGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterParameterGroupsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeleteOptionGroupInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -4079,47 +4095,12 @@ func (_m *RDSAPI) DescribeDBClusterParameterGroups(_a0 *rds.DescribeDBClusterPar
 	return r0, r1
 }
 
-// DescribeDBClusterParameterGroupsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeDBClusterParameterGroupsPages(_a0 *rds.DescribeDBClusterParameterGroupsInput, _a1 func(*rds.DescribeDBClusterParameterGroupsOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterParameterGroupsInput, func(*rds.DescribeDBClusterParameterGroupsOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DescribeDBClusterParameterGroupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeDBClusterParameterGroupsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterParameterGroupsInput, _a2 func(*rds.DescribeDBClusterParameterGroupsOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterParameterGroupsInput, func(*rds.DescribeDBClusterParameterGroupsOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DescribeDBClusterParameterGroupsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBClusterParameterGroupsRequest(_a0 *rds.DescribeDBClusterParameterGroupsInput) (*request.Request, *rds.DescribeDBClusterParameterGroupsOutput) {
+// DeleteOptionGroupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteOptionGroupRequest(_a0 *rds.DeleteOptionGroupInput) (*request.Request, *rds.DeleteOptionGroupOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterParameterGroupsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeleteOptionGroupInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -4127,20 +4108,20 @@ func (_m *RDSAPI) DescribeDBClusterParameterGroupsRequest(_a0 *rds.DescribeDBClu
 		}
 	}
 
-	var r1 *rds.DescribeDBClusterParameterGroupsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterParameterGroupsInput) *rds.DescribeDBClusterParameterGroupsOutput); ok {
+	var r1 *rds.DeleteOptionGroupOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteOptionGroupInput) *rds.DeleteOptionGroupOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBClusterParameterGroupsOutput)
+			r1 = ret.Get(1).(*rds.DeleteOptionGroupOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBClusterParameterGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBClusterParameterGroupsWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterParameterGroupsInput, _a2 ...request.Option) (*rds.DescribeDBClusterParameterGroupsOutput, error) {
+// DeleteOptionGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteOptionGroupWithContext(_a0 context.Context, _a1 *rds.DeleteOptionGroupInput, _a2 ...request.Option) (*rds.DeleteOptionGroupOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -4150,17 +4131,17 @@ func (_m *RDSAPI) DescribeDBClusterParameterGroupsWithContext(_a0 context.Contex
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBClusterParameterGroupsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterParameterGroupsInput, ...request.Option) *rds.DescribeDBClusterParameterGroupsOutput); ok {
+	var r0 *rds.DeleteOptionGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteOptionGroupInput, ...request.Option) *rds.DeleteOptionGroupOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBClusterParameterGroupsOutput)
+			r0 = ret.Get(0).(*rds.DeleteOptionGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBClusterParameterGroupsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteOptionGroupInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -4169,21 +4150,21 @@ func (_m *RDSAPI) DescribeDBClusterParameterGroupsWithContext(_a0 context.Contex
 	return r0, r1
 }
 
-// DescribeDBClusterParameters provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBClusterParameters(_a0 *rds.DescribeDBClusterParametersInput) (*rds.DescribeDBClusterParametersOutput, error) {
+// DeleteTenantDatabase provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteTenantDatabase(_a0 *rds.DeleteTenantDatabaseInput) (*rds.DeleteTenantDatabaseOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBClusterParametersOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterParametersInput) *rds.DescribeDBClusterParametersOutput); ok {
+	var r0 *rds.DeleteTenantDatabaseOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeleteTenantDatabaseInput) *rds.DeleteTenantDatabaseOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBClusterParametersOutput)
+			r0 = ret.Get(0).(*rds.DeleteTenantDatabaseOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterParametersInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeleteTenantDatabaseInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -4192,47 +4173,12 @@ func (_m *RDSAPI) DescribeDBClusterParameters(_a0 *rds.DescribeDBClusterParamete
 	return r0, r1
 }
 
-// DescribeDBClusterParametersPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeDBClusterParametersPages(_a0 *rds.DescribeDBClusterParametersInput, _a1 func(*rds.DescribeDBClusterParametersOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterParametersInput, func(*rds.DescribeDBClusterParametersOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DescribeDBClusterParametersPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeDBClusterParametersPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterParametersInput, _a2 func(*rds.DescribeDBClusterParametersOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterParametersInput, func(*rds.DescribeDBClusterParametersOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DescribeDBClusterParametersRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBClusterParametersRequest(_a0 *rds.DescribeDBClusterParametersInput) (*request.Request, *rds.DescribeDBClusterParametersOutput) {
+// DeleteTenantDatabaseRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeleteTenantDatabaseRequest(_a0 *rds.DeleteTenantDatabaseInput) (*request.Request, *rds.DeleteTenantDatabaseOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterParametersInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeleteTenantDatabaseInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -4240,20 +4186,20 @@ func (_m *RDSAPI) DescribeDBClusterParametersRequest(_a0 *rds.DescribeDBClusterP
 		}
 	}
 
-	var r1 *rds.DescribeDBClusterParametersOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterParametersInput) *rds.DescribeDBClusterParametersOutput); ok {
+	var r1 *rds.DeleteTenantDatabaseOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeleteTenantDatabaseInput) *rds.DeleteTenantDatabaseOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBClusterParametersOutput)
+			r1 = ret.Get(1).(*rds.DeleteTenantDatabaseOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBClusterParametersWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBClusterParametersWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterParametersInput, _a2 ...request.Option) (*rds.DescribeDBClusterParametersOutput, error) {
+// DeleteTenantDatabaseWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeleteTenantDatabaseWithContext(_a0 context.Context, _a1 *rds.DeleteTenantDatabaseInput, _a2 ...request.Option) (*rds.DeleteTenantDatabaseOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -4263,17 +4209,17 @@ func (_m *RDSAPI) DescribeDBClusterParametersWithContext(_a0 context.Context, _a
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBClusterParametersOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterParametersInput, ...request.Option) *rds.DescribeDBClusterParametersOutput); ok {
+	var r0 *rds.DeleteTenantDatabaseOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeleteTenantDatabaseInput, ...request.Option) *rds.DeleteTenantDatabaseOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBClusterParametersOutput)
+			r0 = ret.Get(0).(*rds.DeleteTenantDatabaseOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBClusterParametersInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeleteTenantDatabaseInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -4282,21 +4228,21 @@ func (_m *RDSAPI) DescribeDBClusterParametersWithContext(_a0 context.Context, _a
 	return r0, r1
 }
 
-// DescribeDBClusterSnapshotAttributes provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBClusterSnapshotAttributes(_a0 *rds.DescribeDBClusterSnapshotAttributesInput) (*rds.DescribeDBClusterSnapshotAttributesOutput, error) {
+// DeregisterDBProxyTargets provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeregisterDBProxyTargets(_a0 *rds.DeregisterDBProxyTargetsInput) (*rds.DeregisterDBProxyTargetsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBClusterSnapshotAttributesOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterSnapshotAttributesInput) *rds.DescribeDBClusterSnapshotAttributesOutput); ok {
+	var r0 *rds.DeregisterDBProxyTargetsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DeregisterDBProxyTargetsInput) *rds.DeregisterDBProxyTargetsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBClusterSnapshotAttributesOutput)
+			r0 = ret.Get(0).(*rds.DeregisterDBProxyTargetsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterSnapshotAttributesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DeregisterDBProxyTargetsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -4305,12 +4251,12 @@ func (_m *RDSAPI) DescribeDBClusterSnapshotAttributes(_a0 *rds.DescribeDBCluster
 	return r0, r1
 }
 
-// DescribeDBClusterSnapshotAttributesRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBClusterSnapshotAttributesRequest(_a0 *rds.DescribeDBClusterSnapshotAttributesInput) (*request.Request, *rds.DescribeDBClusterSnapshotAttributesOutput) {
+// DeregisterDBProxyTargetsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DeregisterDBProxyTargetsRequest(_a0 *rds.DeregisterDBProxyTargetsInput) (*request.Request, *rds.DeregisterDBProxyTargetsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterSnapshotAttributesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DeregisterDBProxyTargetsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -4318,20 +4264,20 @@ func (_m *RDSAPI) DescribeDBClusterSnapshotAttributesRequest(_a0 *rds.DescribeDB
 		}
 	}
 
-	var r1 *rds.DescribeDBClusterSnapshotAttributesOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterSnapshotAttributesInput) *rds.DescribeDBClusterSnapshotAttributesOutput); ok {
+	var r1 *rds.DeregisterDBProxyTargetsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DeregisterDBProxyTargetsInput) *rds.DeregisterDBProxyTargetsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBClusterSnapshotAttributesOutput)
+			r1 = ret.Get(1).(*rds.DeregisterDBProxyTargetsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBClusterSnapshotAttributesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBClusterSnapshotAttributesWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterSnapshotAttributesInput, _a2 ...request.Option) (*rds.DescribeDBClusterSnapshotAttributesOutput, error) {
+// DeregisterDBProxyTargetsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DeregisterDBProxyTargetsWithContext(_a0 context.Context, _a1 *rds.DeregisterDBProxyTargetsInput, _a2 ...request.Option) (*rds.DeregisterDBProxyTargetsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -4341,17 +4287,17 @@ func (_m *RDSAPI) DescribeDBClusterSnapshotAttributesWithContext(_a0 context.Con
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBClusterSnapshotAttributesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterSnapshotAttributesInput, ...request.Option) *rds.DescribeDBClusterSnapshotAttributesOutput); ok {
+	var r0 *rds.DeregisterDBProxyTargetsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DeregisterDBProxyTargetsInput, ...request.Option) *rds.DeregisterDBProxyTargetsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBClusterSnapshotAttributesOutput)
+			r0 = ret.Get(0).(*rds.DeregisterDBProxyTargetsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBClusterSnapshotAttributesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DeregisterDBProxyTargetsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -4360,21 +4306,21 @@ func (_m *RDSAPI) DescribeDBClusterSnapshotAttributesWithContext(_a0 context.Con
 	return r0, r1
 }
 
-// DescribeDBClusterSnapshots provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBClusterSnapshots(_a0 *rds.DescribeDBClusterSnapshotsInput) (*rds.DescribeDBClusterSnapshotsOutput, error) {
+// DescribeAccountAttributes provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeAccountAttributes(_a0 *rds.DescribeAccountAttributesInput) (*rds.DescribeAccountAttributesOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBClusterSnapshotsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterSnapshotsInput) *rds.DescribeDBClusterSnapshotsOutput); ok {
+	var r0 *rds.DescribeAccountAttributesOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeAccountAttributesInput) *rds.DescribeAccountAttributesOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBClusterSnapshotsOutput)
+			r0 = ret.Get(0).(*rds.DescribeAccountAttributesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterSnapshotsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeAccountAttributesInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -4383,47 +4329,12 @@ func (_m *RDSAPI) DescribeDBClusterSnapshots(_a0 *rds.DescribeDBClusterSnapshots
 	return r0, r1
 }
 
-// DescribeDBClusterSnapshotsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeDBClusterSnapshotsPages(_a0 *rds.DescribeDBClusterSnapshotsInput, _a1 func(*rds.DescribeDBClusterSnapshotsOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterSnapshotsInput, func(*rds.DescribeDBClusterSnapshotsOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DescribeDBClusterSnapshotsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeDBClusterSnapshotsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterSnapshotsInput, _a2 func(*rds.DescribeDBClusterSnapshotsOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterSnapshotsInput, func(*rds.DescribeDBClusterSnapshotsOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DescribeDBClusterSnapshotsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBClusterSnapshotsRequest(_a0 *rds.DescribeDBClusterSnapshotsInput) (*request.Request, *rds.DescribeDBClusterSnapshotsOutput) {
+// DescribeAccountAttributesRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeAccountAttributesRequest(_a0 *rds.DescribeAccountAttributesInput) (*request.Request, *rds.DescribeAccountAttributesOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterSnapshotsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeAccountAttributesInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -4431,20 +4342,20 @@ func (_m *RDSAPI) DescribeDBClusterSnapshotsRequest(_a0 *rds.DescribeDBClusterSn
 		}
 	}
 
-	var r1 *rds.DescribeDBClusterSnapshotsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterSnapshotsInput) *rds.DescribeDBClusterSnapshotsOutput); ok {
+	var r1 *rds.DescribeAccountAttributesOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeAccountAttributesInput) *rds.DescribeAccountAttributesOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBClusterSnapshotsOutput)
+			r1 = ret.Get(1).(*rds.DescribeAccountAttributesOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBClusterSnapshotsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBClusterSnapshotsWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterSnapshotsInput, _a2 ...request.Option) (*rds.DescribeDBClusterSnapshotsOutput, error) {
+// DescribeAccountAttributesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeAccountAttributesWithContext(_a0 context.Context, _a1 *rds.DescribeAccountAttributesInput, _a2 ...request.Option) (*rds.DescribeAccountAttributesOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -4454,17 +4365,17 @@ func (_m *RDSAPI) DescribeDBClusterSnapshotsWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBClusterSnapshotsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterSnapshotsInput, ...request.Option) *rds.DescribeDBClusterSnapshotsOutput); ok {
+	var r0 *rds.DescribeAccountAttributesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeAccountAttributesInput, ...request.Option) *rds.DescribeAccountAttributesOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBClusterSnapshotsOutput)
+			r0 = ret.Get(0).(*rds.DescribeAccountAttributesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBClusterSnapshotsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeAccountAttributesInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -4473,21 +4384,21 @@ func (_m *RDSAPI) DescribeDBClusterSnapshotsWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// DescribeDBClusters provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBClusters(_a0 *rds.DescribeDBClustersInput) (*rds.DescribeDBClustersOutput, error) {
+// DescribeBlueGreenDeployments provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeBlueGreenDeployments(_a0 *rds.DescribeBlueGreenDeploymentsInput) (*rds.DescribeBlueGreenDeploymentsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBClustersOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClustersInput) *rds.DescribeDBClustersOutput); ok {
+	var r0 *rds.DescribeBlueGreenDeploymentsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeBlueGreenDeploymentsInput) *rds.DescribeBlueGreenDeploymentsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBClustersOutput)
+			r0 = ret.Get(0).(*rds.DescribeBlueGreenDeploymentsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClustersInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeBlueGreenDeploymentsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -4496,12 +4407,12 @@ func (_m *RDSAPI) DescribeDBClusters(_a0 *rds.DescribeDBClustersInput) (*rds.Des
 	return r0, r1
 }
 
-// DescribeDBClustersPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeDBClustersPages(_a0 *rds.DescribeDBClustersInput, _a1 func(*rds.DescribeDBClustersOutput, bool) bool) error {
+// DescribeBlueGreenDeploymentsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeBlueGreenDeploymentsPages(_a0 *rds.DescribeBlueGreenDeploymentsInput, _a1 func(*rds.DescribeBlueGreenDeploymentsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClustersInput, func(*rds.DescribeDBClustersOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeBlueGreenDeploymentsInput, func(*rds.DescribeBlueGreenDeploymentsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -4510,8 +4421,8 @@ func (_m *RDSAPI) DescribeDBClustersPages(_a0 *rds.DescribeDBClustersInput, _a1
 	return r0
 }
 
-// DescribeDBClustersPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeDBClustersPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBClustersInput, _a2 func(*rds.DescribeDBClustersOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeBlueGreenDeploymentsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeBlueGreenDeploymentsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeBlueGreenDeploymentsInput, _a2 func(*rds.DescribeBlueGreenDeploymentsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -4522,7 +4433,7 @@ func (_m *RDSAPI) DescribeDBClustersPagesWithContext(_a0 context.Context, _a1 *r
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClustersInput, func(*rds.DescribeDBClustersOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeBlueGreenDeploymentsInput, func(*rds.DescribeBlueGreenDeploymentsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -4531,12 +4442,12 @@ func (_m *RDSAPI) DescribeDBClustersPagesWithContext(_a0 context.Context, _a1 *r
 	return r0
 }
 
-// DescribeDBClustersRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBClustersRequest(_a0 *rds.DescribeDBClustersInput) (*request.Request, *rds.DescribeDBClustersOutput) {
+// DescribeBlueGreenDeploymentsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeBlueGreenDeploymentsRequest(_a0 *rds.DescribeBlueGreenDeploymentsInput) (*request.Request, *rds.DescribeBlueGreenDeploymentsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClustersInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeBlueGreenDeploymentsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -4544,20 +4455,20 @@ func (_m *RDSAPI) DescribeDBClustersRequest(_a0 *rds.DescribeDBClustersInput) (*
 		}
 	}
 
-	var r1 *rds.DescribeDBClustersOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClustersInput) *rds.DescribeDBClustersOutput); ok {
+	var r1 *rds.DescribeBlueGreenDeploymentsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeBlueGreenDeploymentsInput) *rds.DescribeBlueGreenDeploymentsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBClustersOutput)
+			r1 = ret.Get(1).(*rds.DescribeBlueGreenDeploymentsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBClustersWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBClustersWithContext(_a0 context.Context, _a1 *rds.DescribeDBClustersInput, _a2 ...request.Option) (*rds.DescribeDBClustersOutput, error) {
+// DescribeBlueGreenDeploymentsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeBlueGreenDeploymentsWithContext(_a0 context.Context, _a1 *rds.DescribeBlueGreenDeploymentsInput, _a2 ...request.Option) (*rds.DescribeBlueGreenDeploymentsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -4567,17 +4478,17 @@ func (_m *RDSAPI) DescribeDBClustersWithContext(_a0 context.Context, _a1 *rds.De
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBClustersOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClustersInput, ...request.Option) *rds.DescribeDBClustersOutput); ok {
+	var r0 *rds.DescribeBlueGreenDeploymentsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeBlueGreenDeploymentsInput, ...request.Option) *rds.DescribeBlueGreenDeploymentsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBClustersOutput)
+			r0 = ret.Get(0).(*rds.DescribeBlueGreenDeploymentsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBClustersInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeBlueGreenDeploymentsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -4586,21 +4497,21 @@ func (_m *RDSAPI) DescribeDBClustersWithContext(_a0 context.Context, _a1 *rds.De
 	return r0, r1
 }
 
-// DescribeDBEngineVersions provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBEngineVersions(_a0 *rds.DescribeDBEngineVersionsInput) (*rds.DescribeDBEngineVersionsOutput, error) {
+// DescribeCertificates provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeCertificates(_a0 *rds.DescribeCertificatesInput) (*rds.DescribeCertificatesOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBEngineVersionsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBEngineVersionsInput) *rds.DescribeDBEngineVersionsOutput); ok {
+	var r0 *rds.DescribeCertificatesOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeCertificatesInput) *rds.DescribeCertificatesOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBEngineVersionsOutput)
+			r0 = ret.Get(0).(*rds.DescribeCertificatesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBEngineVersionsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeCertificatesInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -4609,12 +4520,12 @@ func (_m *RDSAPI) DescribeDBEngineVersions(_a0 *rds.DescribeDBEngineVersionsInpu
 	return r0, r1
 }
 
-// DescribeDBEngineVersionsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeDBEngineVersionsPages(_a0 *rds.DescribeDBEngineVersionsInput, _a1 func(*rds.DescribeDBEngineVersionsOutput, bool) bool) error {
+// DescribeCertificatesPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeCertificatesPages(_a0 *rds.DescribeCertificatesInput, _a1 func(*rds.DescribeCertificatesOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBEngineVersionsInput, func(*rds.DescribeDBEngineVersionsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeCertificatesInput, func(*rds.DescribeCertificatesOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -4623,8 +4534,8 @@ func (_m *RDSAPI) DescribeDBEngineVersionsPages(_a0 *rds.DescribeDBEngineVersion
 	return r0
 }
 
-// DescribeDBEngineVersionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeDBEngineVersionsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBEngineVersionsInput, _a2 func(*rds.DescribeDBEngineVersionsOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeCertificatesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeCertificatesPagesWithContext(_a0 context.Context, _a1 *rds.DescribeCertificatesInput, _a2 func(*rds.DescribeCertificatesOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -4635,7 +4546,7 @@ func (_m *RDSAPI) DescribeDBEngineVersionsPagesWithContext(_a0 context.Context,
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBEngineVersionsInput, func(*rds.DescribeDBEngineVersionsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeCertificatesInput, func(*rds.DescribeCertificatesOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -4644,12 +4555,12 @@ func (_m *RDSAPI) DescribeDBEngineVersionsPagesWithContext(_a0 context.Context,
 	return r0
 }
 
-// DescribeDBEngineVersionsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBEngineVersionsRequest(_a0 *rds.DescribeDBEngineVersionsInput) (*request.Request, *rds.DescribeDBEngineVersionsOutput) {
+// DescribeCertificatesRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeCertificatesRequest(_a0 *rds.DescribeCertificatesInput) (*request.Request, *rds.DescribeCertificatesOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBEngineVersionsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeCertificatesInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -4657,20 +4568,20 @@ func (_m *RDSAPI) DescribeDBEngineVersionsRequest(_a0 *rds.DescribeDBEngineVersi
 		}
 	}
 
-	var r1 *rds.DescribeDBEngineVersionsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBEngineVersionsInput) *rds.DescribeDBEngineVersionsOutput); ok {
+	var r1 *rds.DescribeCertificatesOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeCertificatesInput) *rds.DescribeCertificatesOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBEngineVersionsOutput)
+			r1 = ret.Get(1).(*rds.DescribeCertificatesOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBEngineVersionsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBEngineVersionsWithContext(_a0 context.Context, _a1 *rds.DescribeDBEngineVersionsInput, _a2 ...request.Option) (*rds.DescribeDBEngineVersionsOutput, error) {
+// DescribeCertificatesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeCertificatesWithContext(_a0 context.Context, _a1 *rds.DescribeCertificatesInput, _a2 ...request.Option) (*rds.DescribeCertificatesOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -4680,17 +4591,17 @@ func (_m *RDSAPI) DescribeDBEngineVersionsWithContext(_a0 context.Context, _a1 *
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBEngineVersionsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBEngineVersionsInput, ...request.Option) *rds.DescribeDBEngineVersionsOutput); ok {
+	var r0 *rds.DescribeCertificatesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeCertificatesInput, ...request.Option) *rds.DescribeCertificatesOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBEngineVersionsOutput)
+			r0 = ret.Get(0).(*rds.DescribeCertificatesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBEngineVersionsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeCertificatesInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -4699,21 +4610,21 @@ func (_m *RDSAPI) DescribeDBEngineVersionsWithContext(_a0 context.Context, _a1 *
 	return r0, r1
 }
 
-// DescribeDBInstanceAutomatedBackups provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBInstanceAutomatedBackups(_a0 *rds.DescribeDBInstanceAutomatedBackupsInput) (*rds.DescribeDBInstanceAutomatedBackupsOutput, error) {
+// DescribeDBClusterAutomatedBackups provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBClusterAutomatedBackups(_a0 *rds.DescribeDBClusterAutomatedBackupsInput) (*rds.DescribeDBClusterAutomatedBackupsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBInstanceAutomatedBackupsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBInstanceAutomatedBackupsInput) *rds.DescribeDBInstanceAutomatedBackupsOutput); ok {
+	var r0 *rds.DescribeDBClusterAutomatedBackupsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterAutomatedBackupsInput) *rds.DescribeDBClusterAutomatedBackupsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBInstanceAutomatedBackupsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBClusterAutomatedBackupsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBInstanceAutomatedBackupsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterAutomatedBackupsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -4722,12 +4633,12 @@ func (_m *RDSAPI) DescribeDBInstanceAutomatedBackups(_a0 *rds.DescribeDBInstance
 	return r0, r1
 }
 
-// DescribeDBInstanceAutomatedBackupsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeDBInstanceAutomatedBackupsPages(_a0 *rds.DescribeDBInstanceAutomatedBackupsInput, _a1 func(*rds.DescribeDBInstanceAutomatedBackupsOutput, bool) bool) error {
+// DescribeDBClusterAutomatedBackupsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBClusterAutomatedBackupsPages(_a0 *rds.DescribeDBClusterAutomatedBackupsInput, _a1 func(*rds.DescribeDBClusterAutomatedBackupsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBInstanceAutomatedBackupsInput, func(*rds.DescribeDBInstanceAutomatedBackupsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterAutomatedBackupsInput, func(*rds.DescribeDBClusterAutomatedBackupsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -4736,8 +4647,8 @@ func (_m *RDSAPI) DescribeDBInstanceAutomatedBackupsPages(_a0 *rds.DescribeDBIns
 	return r0
 }
 
-// DescribeDBInstanceAutomatedBackupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeDBInstanceAutomatedBackupsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBInstanceAutomatedBackupsInput, _a2 func(*rds.DescribeDBInstanceAutomatedBackupsOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBClusterAutomatedBackupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBClusterAutomatedBackupsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterAutomatedBackupsInput, _a2 func(*rds.DescribeDBClusterAutomatedBackupsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -4748,7 +4659,7 @@ func (_m *RDSAPI) DescribeDBInstanceAutomatedBackupsPagesWithContext(_a0 context
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBInstanceAutomatedBackupsInput, func(*rds.DescribeDBInstanceAutomatedBackupsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterAutomatedBackupsInput, func(*rds.DescribeDBClusterAutomatedBackupsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -4757,12 +4668,12 @@ func (_m *RDSAPI) DescribeDBInstanceAutomatedBackupsPagesWithContext(_a0 context
 	return r0
 }
 
-// DescribeDBInstanceAutomatedBackupsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBInstanceAutomatedBackupsRequest(_a0 *rds.DescribeDBInstanceAutomatedBackupsInput) (*request.Request, *rds.DescribeDBInstanceAutomatedBackupsOutput) {
+// DescribeDBClusterAutomatedBackupsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBClusterAutomatedBackupsRequest(_a0 *rds.DescribeDBClusterAutomatedBackupsInput) (*request.Request, *rds.DescribeDBClusterAutomatedBackupsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBInstanceAutomatedBackupsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterAutomatedBackupsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -4770,20 +4681,20 @@ func (_m *RDSAPI) DescribeDBInstanceAutomatedBackupsRequest(_a0 *rds.DescribeDBI
 		}
 	}
 
-	var r1 *rds.DescribeDBInstanceAutomatedBackupsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBInstanceAutomatedBackupsInput) *rds.DescribeDBInstanceAutomatedBackupsOutput); ok {
+	var r1 *rds.DescribeDBClusterAutomatedBackupsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterAutomatedBackupsInput) *rds.DescribeDBClusterAutomatedBackupsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBInstanceAutomatedBackupsOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBClusterAutomatedBackupsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBInstanceAutomatedBackupsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBInstanceAutomatedBackupsWithContext(_a0 context.Context, _a1 *rds.DescribeDBInstanceAutomatedBackupsInput, _a2 ...request.Option) (*rds.DescribeDBInstanceAutomatedBackupsOutput, error) {
+// DescribeDBClusterAutomatedBackupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBClusterAutomatedBackupsWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterAutomatedBackupsInput, _a2 ...request.Option) (*rds.DescribeDBClusterAutomatedBackupsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -4793,17 +4704,17 @@ func (_m *RDSAPI) DescribeDBInstanceAutomatedBackupsWithContext(_a0 context.Cont
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBInstanceAutomatedBackupsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBInstanceAutomatedBackupsInput, ...request.Option) *rds.DescribeDBInstanceAutomatedBackupsOutput); ok {
+	var r0 *rds.DescribeDBClusterAutomatedBackupsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterAutomatedBackupsInput, ...request.Option) *rds.DescribeDBClusterAutomatedBackupsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBInstanceAutomatedBackupsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBClusterAutomatedBackupsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBInstanceAutomatedBackupsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBClusterAutomatedBackupsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -4812,21 +4723,21 @@ func (_m *RDSAPI) DescribeDBInstanceAutomatedBackupsWithContext(_a0 context.Cont
 	return r0, r1
 }
 
-// DescribeDBInstances provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBInstances(_a0 *rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error) {
+// DescribeDBClusterBacktracks provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBClusterBacktracks(_a0 *rds.DescribeDBClusterBacktracksInput) (*rds.DescribeDBClusterBacktracksOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBInstancesOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBInstancesInput) *rds.DescribeDBInstancesOutput); ok {
+	var r0 *rds.DescribeDBClusterBacktracksOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterBacktracksInput) *rds.DescribeDBClusterBacktracksOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBInstancesOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBClusterBacktracksOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBInstancesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterBacktracksInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -4835,12 +4746,12 @@ func (_m *RDSAPI) DescribeDBInstances(_a0 *rds.DescribeDBInstancesInput) (*rds.D
 	return r0, r1
 }
 
-// DescribeDBInstancesPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeDBInstancesPages(_a0 *rds.DescribeDBInstancesInput, _a1 func(*rds.DescribeDBInstancesOutput, bool) bool) error {
+// DescribeDBClusterBacktracksPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBClusterBacktracksPages(_a0 *rds.DescribeDBClusterBacktracksInput, _a1 func(*rds.DescribeDBClusterBacktracksOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBInstancesInput, func(*rds.DescribeDBInstancesOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterBacktracksInput, func(*rds.DescribeDBClusterBacktracksOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -4849,8 +4760,8 @@ func (_m *RDSAPI) DescribeDBInstancesPages(_a0 *rds.DescribeDBInstancesInput, _a
 	return r0
 }
 
-// DescribeDBInstancesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeDBInstancesPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBInstancesInput, _a2 func(*rds.DescribeDBInstancesOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBClusterBacktracksPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBClusterBacktracksPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterBacktracksInput, _a2 func(*rds.DescribeDBClusterBacktracksOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -4861,7 +4772,7 @@ func (_m *RDSAPI) DescribeDBInstancesPagesWithContext(_a0 context.Context, _a1 *
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBInstancesInput, func(*rds.DescribeDBInstancesOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterBacktracksInput, func(*rds.DescribeDBClusterBacktracksOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -4870,12 +4781,12 @@ func (_m *RDSAPI) DescribeDBInstancesPagesWithContext(_a0 context.Context, _a1 *
 	return r0
 }
 
-// DescribeDBInstancesRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBInstancesRequest(_a0 *rds.DescribeDBInstancesInput) (*request.Request, *rds.DescribeDBInstancesOutput) {
+// DescribeDBClusterBacktracksRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBClusterBacktracksRequest(_a0 *rds.DescribeDBClusterBacktracksInput) (*request.Request, *rds.DescribeDBClusterBacktracksOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBInstancesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterBacktracksInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -4883,20 +4794,20 @@ func (_m *RDSAPI) DescribeDBInstancesRequest(_a0 *rds.DescribeDBInstancesInput)
 		}
 	}
 
-	var r1 *rds.DescribeDBInstancesOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBInstancesInput) *rds.DescribeDBInstancesOutput); ok {
+	var r1 *rds.DescribeDBClusterBacktracksOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterBacktracksInput) *rds.DescribeDBClusterBacktracksOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBInstancesOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBClusterBacktracksOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBInstancesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBInstancesWithContext(_a0 context.Context, _a1 *rds.DescribeDBInstancesInput, _a2 ...request.Option) (*rds.DescribeDBInstancesOutput, error) {
+// DescribeDBClusterBacktracksWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBClusterBacktracksWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterBacktracksInput, _a2 ...request.Option) (*rds.DescribeDBClusterBacktracksOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -4906,17 +4817,17 @@ func (_m *RDSAPI) DescribeDBInstancesWithContext(_a0 context.Context, _a1 *rds.D
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBInstancesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBInstancesInput, ...request.Option) *rds.DescribeDBInstancesOutput); ok {
+	var r0 *rds.DescribeDBClusterBacktracksOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterBacktracksInput, ...request.Option) *rds.DescribeDBClusterBacktracksOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBInstancesOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBClusterBacktracksOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBInstancesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBClusterBacktracksInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -4925,21 +4836,21 @@ func (_m *RDSAPI) DescribeDBInstancesWithContext(_a0 context.Context, _a1 *rds.D
 	return r0, r1
 }
 
-// DescribeDBLogFiles provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBLogFiles(_a0 *rds.DescribeDBLogFilesInput) (*rds.DescribeDBLogFilesOutput, error) {
+// DescribeDBClusterEndpoints provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBClusterEndpoints(_a0 *rds.DescribeDBClusterEndpointsInput) (*rds.DescribeDBClusterEndpointsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBLogFilesOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBLogFilesInput) *rds.DescribeDBLogFilesOutput); ok {
+	var r0 *rds.DescribeDBClusterEndpointsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterEndpointsInput) *rds.DescribeDBClusterEndpointsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBLogFilesOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBClusterEndpointsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBLogFilesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterEndpointsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -4948,12 +4859,12 @@ func (_m *RDSAPI) DescribeDBLogFiles(_a0 *rds.DescribeDBLogFilesInput) (*rds.Des
 	return r0, r1
 }
 
-// DescribeDBLogFilesPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeDBLogFilesPages(_a0 *rds.DescribeDBLogFilesInput, _a1 func(*rds.DescribeDBLogFilesOutput, bool) bool) error {
+// DescribeDBClusterEndpointsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBClusterEndpointsPages(_a0 *rds.DescribeDBClusterEndpointsInput, _a1 func(*rds.DescribeDBClusterEndpointsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBLogFilesInput, func(*rds.DescribeDBLogFilesOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterEndpointsInput, func(*rds.DescribeDBClusterEndpointsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -4962,8 +4873,8 @@ func (_m *RDSAPI) DescribeDBLogFilesPages(_a0 *rds.DescribeDBLogFilesInput, _a1
 	return r0
 }
 
-// DescribeDBLogFilesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeDBLogFilesPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBLogFilesInput, _a2 func(*rds.DescribeDBLogFilesOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBClusterEndpointsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBClusterEndpointsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterEndpointsInput, _a2 func(*rds.DescribeDBClusterEndpointsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -4974,7 +4885,7 @@ func (_m *RDSAPI) DescribeDBLogFilesPagesWithContext(_a0 context.Context, _a1 *r
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBLogFilesInput, func(*rds.DescribeDBLogFilesOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterEndpointsInput, func(*rds.DescribeDBClusterEndpointsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -4983,12 +4894,12 @@ func (_m *RDSAPI) DescribeDBLogFilesPagesWithContext(_a0 context.Context, _a1 *r
 	return r0
 }
 
-// DescribeDBLogFilesRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBLogFilesRequest(_a0 *rds.DescribeDBLogFilesInput) (*request.Request, *rds.DescribeDBLogFilesOutput) {
+// DescribeDBClusterEndpointsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBClusterEndpointsRequest(_a0 *rds.DescribeDBClusterEndpointsInput) (*request.Request, *rds.DescribeDBClusterEndpointsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBLogFilesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterEndpointsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -4996,20 +4907,20 @@ func (_m *RDSAPI) DescribeDBLogFilesRequest(_a0 *rds.DescribeDBLogFilesInput) (*
 		}
 	}
 
-	var r1 *rds.DescribeDBLogFilesOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBLogFilesInput) *rds.DescribeDBLogFilesOutput); ok {
-		r1 = rf(_a0)
+	var r1 *rds.DescribeDBClusterEndpointsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterEndpointsInput) *rds.DescribeDBClusterEndpointsOutput); ok {
+		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBLogFilesOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBClusterEndpointsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBLogFilesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBLogFilesWithContext(_a0 context.Context, _a1 *rds.DescribeDBLogFilesInput, _a2 ...request.Option) (*rds.DescribeDBLogFilesOutput, error) {
+// DescribeDBClusterEndpointsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBClusterEndpointsWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterEndpointsInput, _a2 ...request.Option) (*rds.DescribeDBClusterEndpointsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -5019,17 +4930,17 @@ func (_m *RDSAPI) DescribeDBLogFilesWithContext(_a0 context.Context, _a1 *rds.De
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBLogFilesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBLogFilesInput, ...request.Option) *rds.DescribeDBLogFilesOutput); ok {
+	var r0 *rds.DescribeDBClusterEndpointsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterEndpointsInput, ...request.Option) *rds.DescribeDBClusterEndpointsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBLogFilesOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBClusterEndpointsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBLogFilesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBClusterEndpointsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -5038,21 +4949,21 @@ func (_m *RDSAPI) DescribeDBLogFilesWithContext(_a0 context.Context, _a1 *rds.De
 	return r0, r1
 }
 
-// DescribeDBParameterGroups provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBParameterGroups(_a0 *rds.DescribeDBParameterGroupsInput) (*rds.DescribeDBParameterGroupsOutput, error) {
+// DescribeDBClusterParameterGroups provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBClusterParameterGroups(_a0 *rds.DescribeDBClusterParameterGroupsInput) (*rds.DescribeDBClusterParameterGroupsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBParameterGroupsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBParameterGroupsInput) *rds.DescribeDBParameterGroupsOutput); ok {
+	var r0 *rds.DescribeDBClusterParameterGroupsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterParameterGroupsInput) *rds.DescribeDBClusterParameterGroupsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBParameterGroupsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBClusterParameterGroupsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBParameterGroupsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterParameterGroupsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -5061,12 +4972,12 @@ func (_m *RDSAPI) DescribeDBParameterGroups(_a0 *rds.DescribeDBParameterGroupsIn
 	return r0, r1
 }
 
-// DescribeDBParameterGroupsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeDBParameterGroupsPages(_a0 *rds.DescribeDBParameterGroupsInput, _a1 func(*rds.DescribeDBParameterGroupsOutput, bool) bool) error {
+// DescribeDBClusterParameterGroupsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBClusterParameterGroupsPages(_a0 *rds.DescribeDBClusterParameterGroupsInput, _a1 func(*rds.DescribeDBClusterParameterGroupsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBParameterGroupsInput, func(*rds.DescribeDBParameterGroupsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterParameterGroupsInput, func(*rds.DescribeDBClusterParameterGroupsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -5075,8 +4986,8 @@ func (_m *RDSAPI) DescribeDBParameterGroupsPages(_a0 *rds.DescribeDBParameterGro
 	return r0
 }
 
-// DescribeDBParameterGroupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeDBParameterGroupsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBParameterGroupsInput, _a2 func(*rds.DescribeDBParameterGroupsOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBClusterParameterGroupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBClusterParameterGroupsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterParameterGroupsInput, _a2 func(*rds.DescribeDBClusterParameterGroupsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -5087,7 +4998,7 @@ func (_m *RDSAPI) DescribeDBParameterGroupsPagesWithContext(_a0 context.Context,
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBParameterGroupsInput, func(*rds.DescribeDBParameterGroupsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterParameterGroupsInput, func(*rds.DescribeDBClusterParameterGroupsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -5096,12 +5007,12 @@ func (_m *RDSAPI) DescribeDBParameterGroupsPagesWithContext(_a0 context.Context,
 	return r0
 }
 
-// DescribeDBParameterGroupsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBParameterGroupsRequest(_a0 *rds.DescribeDBParameterGroupsInput) (*request.Request, *rds.DescribeDBParameterGroupsOutput) {
+// DescribeDBClusterParameterGroupsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBClusterParameterGroupsRequest(_a0 *rds.DescribeDBClusterParameterGroupsInput) (*request.Request, *rds.DescribeDBClusterParameterGroupsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBParameterGroupsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterParameterGroupsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -5109,20 +5020,20 @@ func (_m *RDSAPI) DescribeDBParameterGroupsRequest(_a0 *rds.DescribeDBParameterG
 		}
 	}
 
-	var r1 *rds.DescribeDBParameterGroupsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBParameterGroupsInput) *rds.DescribeDBParameterGroupsOutput); ok {
+	var r1 *rds.DescribeDBClusterParameterGroupsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterParameterGroupsInput) *rds.DescribeDBClusterParameterGroupsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBParameterGroupsOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBClusterParameterGroupsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBParameterGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBParameterGroupsWithContext(_a0 context.Context, _a1 *rds.DescribeDBParameterGroupsInput, _a2 ...request.Option) (*rds.DescribeDBParameterGroupsOutput, error) {
+// DescribeDBClusterParameterGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBClusterParameterGroupsWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterParameterGroupsInput, _a2 ...request.Option) (*rds.DescribeDBClusterParameterGroupsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -5132,17 +5043,17 @@ func (_m *RDSAPI) DescribeDBParameterGroupsWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBParameterGroupsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBParameterGroupsInput, ...request.Option) *rds.DescribeDBParameterGroupsOutput); ok {
+	var r0 *rds.DescribeDBClusterParameterGroupsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterParameterGroupsInput, ...request.Option) *rds.DescribeDBClusterParameterGroupsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBParameterGroupsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBClusterParameterGroupsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBParameterGroupsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBClusterParameterGroupsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -5151,21 +5062,21 @@ func (_m *RDSAPI) DescribeDBParameterGroupsWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// DescribeDBParameters provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBParameters(_a0 *rds.DescribeDBParametersInput) (*rds.DescribeDBParametersOutput, error) {
+// DescribeDBClusterParameters provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBClusterParameters(_a0 *rds.DescribeDBClusterParametersInput) (*rds.DescribeDBClusterParametersOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBParametersOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBParametersInput) *rds.DescribeDBParametersOutput); ok {
+	var r0 *rds.DescribeDBClusterParametersOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterParametersInput) *rds.DescribeDBClusterParametersOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBParametersOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBClusterParametersOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBParametersInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterParametersInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -5174,12 +5085,12 @@ func (_m *RDSAPI) DescribeDBParameters(_a0 *rds.DescribeDBParametersInput) (*rds
 	return r0, r1
 }
 
-// DescribeDBParametersPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeDBParametersPages(_a0 *rds.DescribeDBParametersInput, _a1 func(*rds.DescribeDBParametersOutput, bool) bool) error {
+// DescribeDBClusterParametersPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBClusterParametersPages(_a0 *rds.DescribeDBClusterParametersInput, _a1 func(*rds.DescribeDBClusterParametersOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBParametersInput, func(*rds.DescribeDBParametersOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterParametersInput, func(*rds.DescribeDBClusterParametersOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -5188,8 +5099,8 @@ func (_m *RDSAPI) DescribeDBParametersPages(_a0 *rds.DescribeDBParametersInput,
 	return r0
 }
 
-// DescribeDBParametersPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeDBParametersPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBParametersInput, _a2 func(*rds.DescribeDBParametersOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBClusterParametersPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBClusterParametersPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterParametersInput, _a2 func(*rds.DescribeDBClusterParametersOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -5200,7 +5111,7 @@ func (_m *RDSAPI) DescribeDBParametersPagesWithContext(_a0 context.Context, _a1
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBParametersInput, func(*rds.DescribeDBParametersOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterParametersInput, func(*rds.DescribeDBClusterParametersOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -5209,12 +5120,12 @@ func (_m *RDSAPI) DescribeDBParametersPagesWithContext(_a0 context.Context, _a1
 	return r0
 }
 
-// DescribeDBParametersRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBParametersRequest(_a0 *rds.DescribeDBParametersInput) (*request.Request, *rds.DescribeDBParametersOutput) {
+// DescribeDBClusterParametersRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBClusterParametersRequest(_a0 *rds.DescribeDBClusterParametersInput) (*request.Request, *rds.DescribeDBClusterParametersOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBParametersInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterParametersInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -5222,20 +5133,20 @@ func (_m *RDSAPI) DescribeDBParametersRequest(_a0 *rds.DescribeDBParametersInput
 		}
 	}
 
-	var r1 *rds.DescribeDBParametersOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBParametersInput) *rds.DescribeDBParametersOutput); ok {
+	var r1 *rds.DescribeDBClusterParametersOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterParametersInput) *rds.DescribeDBClusterParametersOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBParametersOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBClusterParametersOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBParametersWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBParametersWithContext(_a0 context.Context, _a1 *rds.DescribeDBParametersInput, _a2 ...request.Option) (*rds.DescribeDBParametersOutput, error) {
+// DescribeDBClusterParametersWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBClusterParametersWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterParametersInput, _a2 ...request.Option) (*rds.DescribeDBClusterParametersOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -5245,17 +5156,17 @@ func (_m *RDSAPI) DescribeDBParametersWithContext(_a0 context.Context, _a1 *rds.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBParametersOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBParametersInput, ...request.Option) *rds.DescribeDBParametersOutput); ok {
+	var r0 *rds.DescribeDBClusterParametersOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterParametersInput, ...request.Option) *rds.DescribeDBClusterParametersOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBParametersOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBClusterParametersOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBParametersInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBClusterParametersInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -5264,21 +5175,21 @@ func (_m *RDSAPI) DescribeDBParametersWithContext(_a0 context.Context, _a1 *rds.
 	return r0, r1
 }
 
-// DescribeDBProxies provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBProxies(_a0 *rds.DescribeDBProxiesInput) (*rds.DescribeDBProxiesOutput, error) {
+// DescribeDBClusterSnapshotAttributes provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBClusterSnapshotAttributes(_a0 *rds.DescribeDBClusterSnapshotAttributesInput) (*rds.DescribeDBClusterSnapshotAttributesOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBProxiesOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxiesInput) *rds.DescribeDBProxiesOutput); ok {
+	var r0 *rds.DescribeDBClusterSnapshotAttributesOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterSnapshotAttributesInput) *rds.DescribeDBClusterSnapshotAttributesOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBProxiesOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBClusterSnapshotAttributesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBProxiesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterSnapshotAttributesInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -5287,47 +5198,12 @@ func (_m *RDSAPI) DescribeDBProxies(_a0 *rds.DescribeDBProxiesInput) (*rds.Descr
 	return r0, r1
 }
 
-// DescribeDBProxiesPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeDBProxiesPages(_a0 *rds.DescribeDBProxiesInput, _a1 func(*rds.DescribeDBProxiesOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxiesInput, func(*rds.DescribeDBProxiesOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DescribeDBProxiesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeDBProxiesPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBProxiesInput, _a2 func(*rds.DescribeDBProxiesOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBProxiesInput, func(*rds.DescribeDBProxiesOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DescribeDBProxiesRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBProxiesRequest(_a0 *rds.DescribeDBProxiesInput) (*request.Request, *rds.DescribeDBProxiesOutput) {
+// DescribeDBClusterSnapshotAttributesRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBClusterSnapshotAttributesRequest(_a0 *rds.DescribeDBClusterSnapshotAttributesInput) (*request.Request, *rds.DescribeDBClusterSnapshotAttributesOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxiesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterSnapshotAttributesInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -5335,20 +5211,20 @@ func (_m *RDSAPI) DescribeDBProxiesRequest(_a0 *rds.DescribeDBProxiesInput) (*re
 		}
 	}
 
-	var r1 *rds.DescribeDBProxiesOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBProxiesInput) *rds.DescribeDBProxiesOutput); ok {
+	var r1 *rds.DescribeDBClusterSnapshotAttributesOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterSnapshotAttributesInput) *rds.DescribeDBClusterSnapshotAttributesOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBProxiesOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBClusterSnapshotAttributesOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBProxiesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBProxiesWithContext(_a0 context.Context, _a1 *rds.DescribeDBProxiesInput, _a2 ...request.Option) (*rds.DescribeDBProxiesOutput, error) {
+// DescribeDBClusterSnapshotAttributesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBClusterSnapshotAttributesWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterSnapshotAttributesInput, _a2 ...request.Option) (*rds.DescribeDBClusterSnapshotAttributesOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -5358,17 +5234,17 @@ func (_m *RDSAPI) DescribeDBProxiesWithContext(_a0 context.Context, _a1 *rds.Des
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBProxiesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBProxiesInput, ...request.Option) *rds.DescribeDBProxiesOutput); ok {
+	var r0 *rds.DescribeDBClusterSnapshotAttributesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterSnapshotAttributesInput, ...request.Option) *rds.DescribeDBClusterSnapshotAttributesOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBProxiesOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBClusterSnapshotAttributesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBProxiesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBClusterSnapshotAttributesInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -5377,21 +5253,21 @@ func (_m *RDSAPI) DescribeDBProxiesWithContext(_a0 context.Context, _a1 *rds.Des
 	return r0, r1
 }
 
-// DescribeDBProxyTargetGroups provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBProxyTargetGroups(_a0 *rds.DescribeDBProxyTargetGroupsInput) (*rds.DescribeDBProxyTargetGroupsOutput, error) {
+// DescribeDBClusterSnapshots provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBClusterSnapshots(_a0 *rds.DescribeDBClusterSnapshotsInput) (*rds.DescribeDBClusterSnapshotsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBProxyTargetGroupsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxyTargetGroupsInput) *rds.DescribeDBProxyTargetGroupsOutput); ok {
+	var r0 *rds.DescribeDBClusterSnapshotsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterSnapshotsInput) *rds.DescribeDBClusterSnapshotsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBProxyTargetGroupsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBClusterSnapshotsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBProxyTargetGroupsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterSnapshotsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -5400,12 +5276,12 @@ func (_m *RDSAPI) DescribeDBProxyTargetGroups(_a0 *rds.DescribeDBProxyTargetGrou
 	return r0, r1
 }
 
-// DescribeDBProxyTargetGroupsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeDBProxyTargetGroupsPages(_a0 *rds.DescribeDBProxyTargetGroupsInput, _a1 func(*rds.DescribeDBProxyTargetGroupsOutput, bool) bool) error {
+// DescribeDBClusterSnapshotsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBClusterSnapshotsPages(_a0 *rds.DescribeDBClusterSnapshotsInput, _a1 func(*rds.DescribeDBClusterSnapshotsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxyTargetGroupsInput, func(*rds.DescribeDBProxyTargetGroupsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterSnapshotsInput, func(*rds.DescribeDBClusterSnapshotsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -5414,8 +5290,8 @@ func (_m *RDSAPI) DescribeDBProxyTargetGroupsPages(_a0 *rds.DescribeDBProxyTarge
 	return r0
 }
 
-// DescribeDBProxyTargetGroupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeDBProxyTargetGroupsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBProxyTargetGroupsInput, _a2 func(*rds.DescribeDBProxyTargetGroupsOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBClusterSnapshotsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBClusterSnapshotsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterSnapshotsInput, _a2 func(*rds.DescribeDBClusterSnapshotsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -5426,7 +5302,7 @@ func (_m *RDSAPI) DescribeDBProxyTargetGroupsPagesWithContext(_a0 context.Contex
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBProxyTargetGroupsInput, func(*rds.DescribeDBProxyTargetGroupsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterSnapshotsInput, func(*rds.DescribeDBClusterSnapshotsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -5435,12 +5311,12 @@ func (_m *RDSAPI) DescribeDBProxyTargetGroupsPagesWithContext(_a0 context.Contex
 	return r0
 }
 
-// DescribeDBProxyTargetGroupsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBProxyTargetGroupsRequest(_a0 *rds.DescribeDBProxyTargetGroupsInput) (*request.Request, *rds.DescribeDBProxyTargetGroupsOutput) {
+// DescribeDBClusterSnapshotsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBClusterSnapshotsRequest(_a0 *rds.DescribeDBClusterSnapshotsInput) (*request.Request, *rds.DescribeDBClusterSnapshotsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxyTargetGroupsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClusterSnapshotsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -5448,20 +5324,20 @@ func (_m *RDSAPI) DescribeDBProxyTargetGroupsRequest(_a0 *rds.DescribeDBProxyTar
 		}
 	}
 
-	var r1 *rds.DescribeDBProxyTargetGroupsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBProxyTargetGroupsInput) *rds.DescribeDBProxyTargetGroupsOutput); ok {
+	var r1 *rds.DescribeDBClusterSnapshotsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClusterSnapshotsInput) *rds.DescribeDBClusterSnapshotsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBProxyTargetGroupsOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBClusterSnapshotsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBProxyTargetGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBProxyTargetGroupsWithContext(_a0 context.Context, _a1 *rds.DescribeDBProxyTargetGroupsInput, _a2 ...request.Option) (*rds.DescribeDBProxyTargetGroupsOutput, error) {
+// DescribeDBClusterSnapshotsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBClusterSnapshotsWithContext(_a0 context.Context, _a1 *rds.DescribeDBClusterSnapshotsInput, _a2 ...request.Option) (*rds.DescribeDBClusterSnapshotsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -5471,17 +5347,17 @@ func (_m *RDSAPI) DescribeDBProxyTargetGroupsWithContext(_a0 context.Context, _a
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBProxyTargetGroupsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBProxyTargetGroupsInput, ...request.Option) *rds.DescribeDBProxyTargetGroupsOutput); ok {
+	var r0 *rds.DescribeDBClusterSnapshotsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClusterSnapshotsInput, ...request.Option) *rds.DescribeDBClusterSnapshotsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBProxyTargetGroupsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBClusterSnapshotsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBProxyTargetGroupsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBClusterSnapshotsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -5490,21 +5366,21 @@ func (_m *RDSAPI) DescribeDBProxyTargetGroupsWithContext(_a0 context.Context, _a
 	return r0, r1
 }
 
-// DescribeDBProxyTargets provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBProxyTargets(_a0 *rds.DescribeDBProxyTargetsInput) (*rds.DescribeDBProxyTargetsOutput, error) {
+// DescribeDBClusters provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBClusters(_a0 *rds.DescribeDBClustersInput) (*rds.DescribeDBClustersOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBProxyTargetsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxyTargetsInput) *rds.DescribeDBProxyTargetsOutput); ok {
+	var r0 *rds.DescribeDBClustersOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClustersInput) *rds.DescribeDBClustersOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBProxyTargetsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBClustersOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBProxyTargetsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClustersInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -5513,12 +5389,12 @@ func (_m *RDSAPI) DescribeDBProxyTargets(_a0 *rds.DescribeDBProxyTargetsInput) (
 	return r0, r1
 }
 
-// DescribeDBProxyTargetsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeDBProxyTargetsPages(_a0 *rds.DescribeDBProxyTargetsInput, _a1 func(*rds.DescribeDBProxyTargetsOutput, bool) bool) error {
+// DescribeDBClustersPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBClustersPages(_a0 *rds.DescribeDBClustersInput, _a1 func(*rds.DescribeDBClustersOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxyTargetsInput, func(*rds.DescribeDBProxyTargetsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClustersInput, func(*rds.DescribeDBClustersOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -5527,8 +5403,8 @@ func (_m *RDSAPI) DescribeDBProxyTargetsPages(_a0 *rds.DescribeDBProxyTargetsInp
 	return r0
 }
 
-// DescribeDBProxyTargetsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeDBProxyTargetsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBProxyTargetsInput, _a2 func(*rds.DescribeDBProxyTargetsOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBClustersPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBClustersPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBClustersInput, _a2 func(*rds.DescribeDBClustersOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -5539,7 +5415,7 @@ func (_m *RDSAPI) DescribeDBProxyTargetsPagesWithContext(_a0 context.Context, _a
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBProxyTargetsInput, func(*rds.DescribeDBProxyTargetsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClustersInput, func(*rds.DescribeDBClustersOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -5548,12 +5424,12 @@ func (_m *RDSAPI) DescribeDBProxyTargetsPagesWithContext(_a0 context.Context, _a
 	return r0
 }
 
-// DescribeDBProxyTargetsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBProxyTargetsRequest(_a0 *rds.DescribeDBProxyTargetsInput) (*request.Request, *rds.DescribeDBProxyTargetsOutput) {
+// DescribeDBClustersRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBClustersRequest(_a0 *rds.DescribeDBClustersInput) (*request.Request, *rds.DescribeDBClustersOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxyTargetsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClustersInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -5561,20 +5437,20 @@ func (_m *RDSAPI) DescribeDBProxyTargetsRequest(_a0 *rds.DescribeDBProxyTargetsI
 		}
 	}
 
-	var r1 *rds.DescribeDBProxyTargetsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBProxyTargetsInput) *rds.DescribeDBProxyTargetsOutput); ok {
+	var r1 *rds.DescribeDBClustersOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBClustersInput) *rds.DescribeDBClustersOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBProxyTargetsOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBClustersOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBProxyTargetsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBProxyTargetsWithContext(_a0 context.Context, _a1 *rds.DescribeDBProxyTargetsInput, _a2 ...request.Option) (*rds.DescribeDBProxyTargetsOutput, error) {
+// DescribeDBClustersWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBClustersWithContext(_a0 context.Context, _a1 *rds.DescribeDBClustersInput, _a2 ...request.Option) (*rds.DescribeDBClustersOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -5584,17 +5460,17 @@ func (_m *RDSAPI) DescribeDBProxyTargetsWithContext(_a0 context.Context, _a1 *rd
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBProxyTargetsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBProxyTargetsInput, ...request.Option) *rds.DescribeDBProxyTargetsOutput); ok {
+	var r0 *rds.DescribeDBClustersOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClustersInput, ...request.Option) *rds.DescribeDBClustersOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBProxyTargetsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBClustersOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBProxyTargetsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBClustersInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -5603,21 +5479,21 @@ func (_m *RDSAPI) DescribeDBProxyTargetsWithContext(_a0 context.Context, _a1 *rd
 	return r0, r1
 }
 
-// DescribeDBSecurityGroups provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBSecurityGroups(_a0 *rds.DescribeDBSecurityGroupsInput) (*rds.DescribeDBSecurityGroupsOutput, error) {
+// DescribeDBEngineVersions provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBEngineVersions(_a0 *rds.DescribeDBEngineVersionsInput) (*rds.DescribeDBEngineVersionsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBSecurityGroupsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSecurityGroupsInput) *rds.DescribeDBSecurityGroupsOutput); ok {
+	var r0 *rds.DescribeDBEngineVersionsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBEngineVersionsInput) *rds.DescribeDBEngineVersionsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBSecurityGroupsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBEngineVersionsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBSecurityGroupsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBEngineVersionsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -5626,12 +5502,12 @@ func (_m *RDSAPI) DescribeDBSecurityGroups(_a0 *rds.DescribeDBSecurityGroupsInpu
 	return r0, r1
 }
 
-// DescribeDBSecurityGroupsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeDBSecurityGroupsPages(_a0 *rds.DescribeDBSecurityGroupsInput, _a1 func(*rds.DescribeDBSecurityGroupsOutput, bool) bool) error {
+// DescribeDBEngineVersionsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBEngineVersionsPages(_a0 *rds.DescribeDBEngineVersionsInput, _a1 func(*rds.DescribeDBEngineVersionsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSecurityGroupsInput, func(*rds.DescribeDBSecurityGroupsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBEngineVersionsInput, func(*rds.DescribeDBEngineVersionsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -5640,8 +5516,8 @@ func (_m *RDSAPI) DescribeDBSecurityGroupsPages(_a0 *rds.DescribeDBSecurityGroup
 	return r0
 }
 
-// DescribeDBSecurityGroupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeDBSecurityGroupsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBSecurityGroupsInput, _a2 func(*rds.DescribeDBSecurityGroupsOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBEngineVersionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBEngineVersionsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBEngineVersionsInput, _a2 func(*rds.DescribeDBEngineVersionsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -5652,7 +5528,7 @@ func (_m *RDSAPI) DescribeDBSecurityGroupsPagesWithContext(_a0 context.Context,
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBSecurityGroupsInput, func(*rds.DescribeDBSecurityGroupsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBEngineVersionsInput, func(*rds.DescribeDBEngineVersionsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -5661,12 +5537,12 @@ func (_m *RDSAPI) DescribeDBSecurityGroupsPagesWithContext(_a0 context.Context,
 	return r0
 }
 
-// DescribeDBSecurityGroupsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBSecurityGroupsRequest(_a0 *rds.DescribeDBSecurityGroupsInput) (*request.Request, *rds.DescribeDBSecurityGroupsOutput) {
+// DescribeDBEngineVersionsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBEngineVersionsRequest(_a0 *rds.DescribeDBEngineVersionsInput) (*request.Request, *rds.DescribeDBEngineVersionsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSecurityGroupsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBEngineVersionsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -5674,20 +5550,20 @@ func (_m *RDSAPI) DescribeDBSecurityGroupsRequest(_a0 *rds.DescribeDBSecurityGro
 		}
 	}
 
-	var r1 *rds.DescribeDBSecurityGroupsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBSecurityGroupsInput) *rds.DescribeDBSecurityGroupsOutput); ok {
+	var r1 *rds.DescribeDBEngineVersionsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBEngineVersionsInput) *rds.DescribeDBEngineVersionsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBSecurityGroupsOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBEngineVersionsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBSecurityGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBSecurityGroupsWithContext(_a0 context.Context, _a1 *rds.DescribeDBSecurityGroupsInput, _a2 ...request.Option) (*rds.DescribeDBSecurityGroupsOutput, error) {
+// DescribeDBEngineVersionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBEngineVersionsWithContext(_a0 context.Context, _a1 *rds.DescribeDBEngineVersionsInput, _a2 ...request.Option) (*rds.DescribeDBEngineVersionsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -5697,17 +5573,17 @@ func (_m *RDSAPI) DescribeDBSecurityGroupsWithContext(_a0 context.Context, _a1 *
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBSecurityGroupsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBSecurityGroupsInput, ...request.Option) *rds.DescribeDBSecurityGroupsOutput); ok {
+	var r0 *rds.DescribeDBEngineVersionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBEngineVersionsInput, ...request.Option) *rds.DescribeDBEngineVersionsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBSecurityGroupsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBEngineVersionsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBSecurityGroupsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBEngineVersionsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -5716,21 +5592,21 @@ func (_m *RDSAPI) DescribeDBSecurityGroupsWithContext(_a0 context.Context, _a1 *
 	return r0, r1
 }
 
-// DescribeDBSnapshotAttributes provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBSnapshotAttributes(_a0 *rds.DescribeDBSnapshotAttributesInput) (*rds.DescribeDBSnapshotAttributesOutput, error) {
+// DescribeDBInstanceAutomatedBackups provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBInstanceAutomatedBackups(_a0 *rds.DescribeDBInstanceAutomatedBackupsInput) (*rds.DescribeDBInstanceAutomatedBackupsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBSnapshotAttributesOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSnapshotAttributesInput) *rds.DescribeDBSnapshotAttributesOutput); ok {
+	var r0 *rds.DescribeDBInstanceAutomatedBackupsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBInstanceAutomatedBackupsInput) *rds.DescribeDBInstanceAutomatedBackupsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBSnapshotAttributesOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBInstanceAutomatedBackupsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBSnapshotAttributesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBInstanceAutomatedBackupsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -5739,12 +5615,47 @@ func (_m *RDSAPI) DescribeDBSnapshotAttributes(_a0 *rds.DescribeDBSnapshotAttrib
 	return r0, r1
 }
 
-// DescribeDBSnapshotAttributesRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBSnapshotAttributesRequest(_a0 *rds.DescribeDBSnapshotAttributesInput) (*request.Request, *rds.DescribeDBSnapshotAttributesOutput) {
+// DescribeDBInstanceAutomatedBackupsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBInstanceAutomatedBackupsPages(_a0 *rds.DescribeDBInstanceAutomatedBackupsInput, _a1 func(*rds.DescribeDBInstanceAutomatedBackupsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBInstanceAutomatedBackupsInput, func(*rds.DescribeDBInstanceAutomatedBackupsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBInstanceAutomatedBackupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBInstanceAutomatedBackupsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBInstanceAutomatedBackupsInput, _a2 func(*rds.DescribeDBInstanceAutomatedBackupsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBInstanceAutomatedBackupsInput, func(*rds.DescribeDBInstanceAutomatedBackupsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBInstanceAutomatedBackupsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBInstanceAutomatedBackupsRequest(_a0 *rds.DescribeDBInstanceAutomatedBackupsInput) (*request.Request, *rds.DescribeDBInstanceAutomatedBackupsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSnapshotAttributesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBInstanceAutomatedBackupsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -5752,20 +5663,20 @@ func (_m *RDSAPI) DescribeDBSnapshotAttributesRequest(_a0 *rds.DescribeDBSnapsho
 		}
 	}
 
-	var r1 *rds.DescribeDBSnapshotAttributesOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBSnapshotAttributesInput) *rds.DescribeDBSnapshotAttributesOutput); ok {
+	var r1 *rds.DescribeDBInstanceAutomatedBackupsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBInstanceAutomatedBackupsInput) *rds.DescribeDBInstanceAutomatedBackupsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBSnapshotAttributesOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBInstanceAutomatedBackupsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBSnapshotAttributesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBSnapshotAttributesWithContext(_a0 context.Context, _a1 *rds.DescribeDBSnapshotAttributesInput, _a2 ...request.Option) (*rds.DescribeDBSnapshotAttributesOutput, error) {
+// DescribeDBInstanceAutomatedBackupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBInstanceAutomatedBackupsWithContext(_a0 context.Context, _a1 *rds.DescribeDBInstanceAutomatedBackupsInput, _a2 ...request.Option) (*rds.DescribeDBInstanceAutomatedBackupsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -5775,17 +5686,17 @@ func (_m *RDSAPI) DescribeDBSnapshotAttributesWithContext(_a0 context.Context, _
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBSnapshotAttributesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBSnapshotAttributesInput, ...request.Option) *rds.DescribeDBSnapshotAttributesOutput); ok {
+	var r0 *rds.DescribeDBInstanceAutomatedBackupsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBInstanceAutomatedBackupsInput, ...request.Option) *rds.DescribeDBInstanceAutomatedBackupsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBSnapshotAttributesOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBInstanceAutomatedBackupsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBSnapshotAttributesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBInstanceAutomatedBackupsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -5794,21 +5705,21 @@ func (_m *RDSAPI) DescribeDBSnapshotAttributesWithContext(_a0 context.Context, _
 	return r0, r1
 }
 
-// DescribeDBSnapshots provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBSnapshots(_a0 *rds.DescribeDBSnapshotsInput) (*rds.DescribeDBSnapshotsOutput, error) {
+// DescribeDBInstances provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBInstances(_a0 *rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBSnapshotsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSnapshotsInput) *rds.DescribeDBSnapshotsOutput); ok {
+	var r0 *rds.DescribeDBInstancesOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBInstancesInput) *rds.DescribeDBInstancesOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBSnapshotsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBInstancesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBSnapshotsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBInstancesInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -5817,12 +5728,12 @@ func (_m *RDSAPI) DescribeDBSnapshots(_a0 *rds.DescribeDBSnapshotsInput) (*rds.D
 	return r0, r1
 }
 
-// DescribeDBSnapshotsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeDBSnapshotsPages(_a0 *rds.DescribeDBSnapshotsInput, _a1 func(*rds.DescribeDBSnapshotsOutput, bool) bool) error {
+// DescribeDBInstancesPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBInstancesPages(_a0 *rds.DescribeDBInstancesInput, _a1 func(*rds.DescribeDBInstancesOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSnapshotsInput, func(*rds.DescribeDBSnapshotsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBInstancesInput, func(*rds.DescribeDBInstancesOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -5831,8 +5742,8 @@ func (_m *RDSAPI) DescribeDBSnapshotsPages(_a0 *rds.DescribeDBSnapshotsInput, _a
 	return r0
 }
 
-// DescribeDBSnapshotsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeDBSnapshotsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBSnapshotsInput, _a2 func(*rds.DescribeDBSnapshotsOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBInstancesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBInstancesPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBInstancesInput, _a2 func(*rds.DescribeDBInstancesOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -5843,7 +5754,7 @@ func (_m *RDSAPI) DescribeDBSnapshotsPagesWithContext(_a0 context.Context, _a1 *
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBSnapshotsInput, func(*rds.DescribeDBSnapshotsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBInstancesInput, func(*rds.DescribeDBInstancesOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -5852,12 +5763,12 @@ func (_m *RDSAPI) DescribeDBSnapshotsPagesWithContext(_a0 context.Context, _a1 *
 	return r0
 }
 
-// DescribeDBSnapshotsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBSnapshotsRequest(_a0 *rds.DescribeDBSnapshotsInput) (*request.Request, *rds.DescribeDBSnapshotsOutput) {
+// DescribeDBInstancesRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBInstancesRequest(_a0 *rds.DescribeDBInstancesInput) (*request.Request, *rds.DescribeDBInstancesOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSnapshotsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBInstancesInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -5865,20 +5776,20 @@ func (_m *RDSAPI) DescribeDBSnapshotsRequest(_a0 *rds.DescribeDBSnapshotsInput)
 		}
 	}
 
-	var r1 *rds.DescribeDBSnapshotsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBSnapshotsInput) *rds.DescribeDBSnapshotsOutput); ok {
+	var r1 *rds.DescribeDBInstancesOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBInstancesInput) *rds.DescribeDBInstancesOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBSnapshotsOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBInstancesOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBSnapshotsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBSnapshotsWithContext(_a0 context.Context, _a1 *rds.DescribeDBSnapshotsInput, _a2 ...request.Option) (*rds.DescribeDBSnapshotsOutput, error) {
+// DescribeDBInstancesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBInstancesWithContext(_a0 context.Context, _a1 *rds.DescribeDBInstancesInput, _a2 ...request.Option) (*rds.DescribeDBInstancesOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -5888,17 +5799,17 @@ func (_m *RDSAPI) DescribeDBSnapshotsWithContext(_a0 context.Context, _a1 *rds.D
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBSnapshotsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBSnapshotsInput, ...request.Option) *rds.DescribeDBSnapshotsOutput); ok {
+	var r0 *rds.DescribeDBInstancesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBInstancesInput, ...request.Option) *rds.DescribeDBInstancesOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBSnapshotsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBInstancesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBSnapshotsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBInstancesInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -5907,21 +5818,21 @@ func (_m *RDSAPI) DescribeDBSnapshotsWithContext(_a0 context.Context, _a1 *rds.D
 	return r0, r1
 }
 
-// DescribeDBSubnetGroups provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBSubnetGroups(_a0 *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error) {
+// DescribeDBLogFiles provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBLogFiles(_a0 *rds.DescribeDBLogFilesInput) (*rds.DescribeDBLogFilesOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeDBSubnetGroupsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSubnetGroupsInput) *rds.DescribeDBSubnetGroupsOutput); ok {
+	var r0 *rds.DescribeDBLogFilesOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBLogFilesInput) *rds.DescribeDBLogFilesOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBSubnetGroupsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBLogFilesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBSubnetGroupsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBLogFilesInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -5930,12 +5841,12 @@ func (_m *RDSAPI) DescribeDBSubnetGroups(_a0 *rds.DescribeDBSubnetGroupsInput) (
 	return r0, r1
 }
 
-// DescribeDBSubnetGroupsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeDBSubnetGroupsPages(_a0 *rds.DescribeDBSubnetGroupsInput, _a1 func(*rds.DescribeDBSubnetGroupsOutput, bool) bool) error {
+// DescribeDBLogFilesPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBLogFilesPages(_a0 *rds.DescribeDBLogFilesInput, _a1 func(*rds.DescribeDBLogFilesOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSubnetGroupsInput, func(*rds.DescribeDBSubnetGroupsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBLogFilesInput, func(*rds.DescribeDBLogFilesOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -5944,8 +5855,8 @@ func (_m *RDSAPI) DescribeDBSubnetGroupsPages(_a0 *rds.DescribeDBSubnetGroupsInp
 	return r0
 }
 
-// DescribeDBSubnetGroupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeDBSubnetGroupsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBSubnetGroupsInput, _a2 func(*rds.DescribeDBSubnetGroupsOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBLogFilesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBLogFilesPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBLogFilesInput, _a2 func(*rds.DescribeDBLogFilesOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -5956,7 +5867,7 @@ func (_m *RDSAPI) DescribeDBSubnetGroupsPagesWithContext(_a0 context.Context, _a
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBSubnetGroupsInput, func(*rds.DescribeDBSubnetGroupsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBLogFilesInput, func(*rds.DescribeDBLogFilesOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -5965,12 +5876,12 @@ func (_m *RDSAPI) DescribeDBSubnetGroupsPagesWithContext(_a0 context.Context, _a
 	return r0
 }
 
-// DescribeDBSubnetGroupsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeDBSubnetGroupsRequest(_a0 *rds.DescribeDBSubnetGroupsInput) (*request.Request, *rds.DescribeDBSubnetGroupsOutput) {
+// DescribeDBLogFilesRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBLogFilesRequest(_a0 *rds.DescribeDBLogFilesInput) (*request.Request, *rds.DescribeDBLogFilesOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSubnetGroupsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBLogFilesInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -5978,20 +5889,20 @@ func (_m *RDSAPI) DescribeDBSubnetGroupsRequest(_a0 *rds.DescribeDBSubnetGroupsI
 		}
 	}
 
-	var r1 *rds.DescribeDBSubnetGroupsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeDBSubnetGroupsInput) *rds.DescribeDBSubnetGroupsOutput); ok {
+	var r1 *rds.DescribeDBLogFilesOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBLogFilesInput) *rds.DescribeDBLogFilesOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeDBSubnetGroupsOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBLogFilesOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeDBSubnetGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeDBSubnetGroupsWithContext(_a0 context.Context, _a1 *rds.DescribeDBSubnetGroupsInput, _a2 ...request.Option) (*rds.DescribeDBSubnetGroupsOutput, error) {
+// DescribeDBLogFilesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBLogFilesWithContext(_a0 context.Context, _a1 *rds.DescribeDBLogFilesInput, _a2 ...request.Option) (*rds.DescribeDBLogFilesOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -6001,17 +5912,17 @@ func (_m *RDSAPI) DescribeDBSubnetGroupsWithContext(_a0 context.Context, _a1 *rd
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeDBSubnetGroupsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBSubnetGroupsInput, ...request.Option) *rds.DescribeDBSubnetGroupsOutput); ok {
+	var r0 *rds.DescribeDBLogFilesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBLogFilesInput, ...request.Option) *rds.DescribeDBLogFilesOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeDBSubnetGroupsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBLogFilesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBSubnetGroupsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBLogFilesInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -6020,21 +5931,21 @@ func (_m *RDSAPI) DescribeDBSubnetGroupsWithContext(_a0 context.Context, _a1 *rd
 	return r0, r1
 }
 
-// DescribeEngineDefaultClusterParameters provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeEngineDefaultClusterParameters(_a0 *rds.DescribeEngineDefaultClusterParametersInput) (*rds.DescribeEngineDefaultClusterParametersOutput, error) {
+// DescribeDBParameterGroups provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBParameterGroups(_a0 *rds.DescribeDBParameterGroupsInput) (*rds.DescribeDBParameterGroupsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeEngineDefaultClusterParametersOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeEngineDefaultClusterParametersInput) *rds.DescribeEngineDefaultClusterParametersOutput); ok {
+	var r0 *rds.DescribeDBParameterGroupsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBParameterGroupsInput) *rds.DescribeDBParameterGroupsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeEngineDefaultClusterParametersOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBParameterGroupsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeEngineDefaultClusterParametersInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBParameterGroupsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -6043,12 +5954,47 @@ func (_m *RDSAPI) DescribeEngineDefaultClusterParameters(_a0 *rds.DescribeEngine
 	return r0, r1
 }
 
-// DescribeEngineDefaultClusterParametersRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeEngineDefaultClusterParametersRequest(_a0 *rds.DescribeEngineDefaultClusterParametersInput) (*request.Request, *rds.DescribeEngineDefaultClusterParametersOutput) {
-	ret := _m.Called(_a0)
-
-	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeEngineDefaultClusterParametersInput) *request.Request); ok {
+// DescribeDBParameterGroupsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBParameterGroupsPages(_a0 *rds.DescribeDBParameterGroupsInput, _a1 func(*rds.DescribeDBParameterGroupsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBParameterGroupsInput, func(*rds.DescribeDBParameterGroupsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBParameterGroupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBParameterGroupsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBParameterGroupsInput, _a2 func(*rds.DescribeDBParameterGroupsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBParameterGroupsInput, func(*rds.DescribeDBParameterGroupsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBParameterGroupsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBParameterGroupsRequest(_a0 *rds.DescribeDBParameterGroupsInput) (*request.Request, *rds.DescribeDBParameterGroupsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBParameterGroupsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -6056,20 +6002,20 @@ func (_m *RDSAPI) DescribeEngineDefaultClusterParametersRequest(_a0 *rds.Describ
 		}
 	}
 
-	var r1 *rds.DescribeEngineDefaultClusterParametersOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeEngineDefaultClusterParametersInput) *rds.DescribeEngineDefaultClusterParametersOutput); ok {
+	var r1 *rds.DescribeDBParameterGroupsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBParameterGroupsInput) *rds.DescribeDBParameterGroupsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeEngineDefaultClusterParametersOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBParameterGroupsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeEngineDefaultClusterParametersWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeEngineDefaultClusterParametersWithContext(_a0 context.Context, _a1 *rds.DescribeEngineDefaultClusterParametersInput, _a2 ...request.Option) (*rds.DescribeEngineDefaultClusterParametersOutput, error) {
+// DescribeDBParameterGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBParameterGroupsWithContext(_a0 context.Context, _a1 *rds.DescribeDBParameterGroupsInput, _a2 ...request.Option) (*rds.DescribeDBParameterGroupsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -6079,17 +6025,17 @@ func (_m *RDSAPI) DescribeEngineDefaultClusterParametersWithContext(_a0 context.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeEngineDefaultClusterParametersOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeEngineDefaultClusterParametersInput, ...request.Option) *rds.DescribeEngineDefaultClusterParametersOutput); ok {
+	var r0 *rds.DescribeDBParameterGroupsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBParameterGroupsInput, ...request.Option) *rds.DescribeDBParameterGroupsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeEngineDefaultClusterParametersOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBParameterGroupsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeEngineDefaultClusterParametersInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBParameterGroupsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -6098,21 +6044,21 @@ func (_m *RDSAPI) DescribeEngineDefaultClusterParametersWithContext(_a0 context.
 	return r0, r1
 }
 
-// DescribeEngineDefaultParameters provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeEngineDefaultParameters(_a0 *rds.DescribeEngineDefaultParametersInput) (*rds.DescribeEngineDefaultParametersOutput, error) {
+// DescribeDBParameters provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBParameters(_a0 *rds.DescribeDBParametersInput) (*rds.DescribeDBParametersOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeEngineDefaultParametersOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeEngineDefaultParametersInput) *rds.DescribeEngineDefaultParametersOutput); ok {
+	var r0 *rds.DescribeDBParametersOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBParametersInput) *rds.DescribeDBParametersOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeEngineDefaultParametersOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBParametersOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeEngineDefaultParametersInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBParametersInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -6121,12 +6067,12 @@ func (_m *RDSAPI) DescribeEngineDefaultParameters(_a0 *rds.DescribeEngineDefault
 	return r0, r1
 }
 
-// DescribeEngineDefaultParametersPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeEngineDefaultParametersPages(_a0 *rds.DescribeEngineDefaultParametersInput, _a1 func(*rds.DescribeEngineDefaultParametersOutput, bool) bool) error {
+// DescribeDBParametersPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBParametersPages(_a0 *rds.DescribeDBParametersInput, _a1 func(*rds.DescribeDBParametersOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeEngineDefaultParametersInput, func(*rds.DescribeEngineDefaultParametersOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBParametersInput, func(*rds.DescribeDBParametersOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -6135,8 +6081,8 @@ func (_m *RDSAPI) DescribeEngineDefaultParametersPages(_a0 *rds.DescribeEngineDe
 	return r0
 }
 
-// DescribeEngineDefaultParametersPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeEngineDefaultParametersPagesWithContext(_a0 context.Context, _a1 *rds.DescribeEngineDefaultParametersInput, _a2 func(*rds.DescribeEngineDefaultParametersOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBParametersPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBParametersPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBParametersInput, _a2 func(*rds.DescribeDBParametersOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -6147,7 +6093,7 @@ func (_m *RDSAPI) DescribeEngineDefaultParametersPagesWithContext(_a0 context.Co
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeEngineDefaultParametersInput, func(*rds.DescribeEngineDefaultParametersOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBParametersInput, func(*rds.DescribeDBParametersOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -6156,12 +6102,12 @@ func (_m *RDSAPI) DescribeEngineDefaultParametersPagesWithContext(_a0 context.Co
 	return r0
 }
 
-// DescribeEngineDefaultParametersRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeEngineDefaultParametersRequest(_a0 *rds.DescribeEngineDefaultParametersInput) (*request.Request, *rds.DescribeEngineDefaultParametersOutput) {
+// DescribeDBParametersRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBParametersRequest(_a0 *rds.DescribeDBParametersInput) (*request.Request, *rds.DescribeDBParametersOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeEngineDefaultParametersInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBParametersInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -6169,20 +6115,20 @@ func (_m *RDSAPI) DescribeEngineDefaultParametersRequest(_a0 *rds.DescribeEngine
 		}
 	}
 
-	var r1 *rds.DescribeEngineDefaultParametersOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeEngineDefaultParametersInput) *rds.DescribeEngineDefaultParametersOutput); ok {
+	var r1 *rds.DescribeDBParametersOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBParametersInput) *rds.DescribeDBParametersOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeEngineDefaultParametersOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBParametersOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeEngineDefaultParametersWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeEngineDefaultParametersWithContext(_a0 context.Context, _a1 *rds.DescribeEngineDefaultParametersInput, _a2 ...request.Option) (*rds.DescribeEngineDefaultParametersOutput, error) {
+// DescribeDBParametersWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBParametersWithContext(_a0 context.Context, _a1 *rds.DescribeDBParametersInput, _a2 ...request.Option) (*rds.DescribeDBParametersOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -6192,17 +6138,17 @@ func (_m *RDSAPI) DescribeEngineDefaultParametersWithContext(_a0 context.Context
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeEngineDefaultParametersOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeEngineDefaultParametersInput, ...request.Option) *rds.DescribeEngineDefaultParametersOutput); ok {
+	var r0 *rds.DescribeDBParametersOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBParametersInput, ...request.Option) *rds.DescribeDBParametersOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeEngineDefaultParametersOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBParametersOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeEngineDefaultParametersInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBParametersInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -6211,21 +6157,21 @@ func (_m *RDSAPI) DescribeEngineDefaultParametersWithContext(_a0 context.Context
 	return r0, r1
 }
 
-// DescribeEventCategories provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeEventCategories(_a0 *rds.DescribeEventCategoriesInput) (*rds.DescribeEventCategoriesOutput, error) {
+// DescribeDBProxies provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBProxies(_a0 *rds.DescribeDBProxiesInput) (*rds.DescribeDBProxiesOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeEventCategoriesOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeEventCategoriesInput) *rds.DescribeEventCategoriesOutput); ok {
+	var r0 *rds.DescribeDBProxiesOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxiesInput) *rds.DescribeDBProxiesOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeEventCategoriesOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBProxiesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeEventCategoriesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBProxiesInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -6234,12 +6180,47 @@ func (_m *RDSAPI) DescribeEventCategories(_a0 *rds.DescribeEventCategoriesInput)
 	return r0, r1
 }
 
-// DescribeEventCategoriesRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeEventCategoriesRequest(_a0 *rds.DescribeEventCategoriesInput) (*request.Request, *rds.DescribeEventCategoriesOutput) {
+// DescribeDBProxiesPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBProxiesPages(_a0 *rds.DescribeDBProxiesInput, _a1 func(*rds.DescribeDBProxiesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxiesInput, func(*rds.DescribeDBProxiesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBProxiesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBProxiesPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBProxiesInput, _a2 func(*rds.DescribeDBProxiesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBProxiesInput, func(*rds.DescribeDBProxiesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDBProxiesRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBProxiesRequest(_a0 *rds.DescribeDBProxiesInput) (*request.Request, *rds.DescribeDBProxiesOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeEventCategoriesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxiesInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -6247,20 +6228,20 @@ func (_m *RDSAPI) DescribeEventCategoriesRequest(_a0 *rds.DescribeEventCategorie
 		}
 	}
 
-	var r1 *rds.DescribeEventCategoriesOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeEventCategoriesInput) *rds.DescribeEventCategoriesOutput); ok {
+	var r1 *rds.DescribeDBProxiesOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBProxiesInput) *rds.DescribeDBProxiesOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeEventCategoriesOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBProxiesOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeEventCategoriesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeEventCategoriesWithContext(_a0 context.Context, _a1 *rds.DescribeEventCategoriesInput, _a2 ...request.Option) (*rds.DescribeEventCategoriesOutput, error) {
+// DescribeDBProxiesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBProxiesWithContext(_a0 context.Context, _a1 *rds.DescribeDBProxiesInput, _a2 ...request.Option) (*rds.DescribeDBProxiesOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -6270,17 +6251,17 @@ func (_m *RDSAPI) DescribeEventCategoriesWithContext(_a0 context.Context, _a1 *r
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeEventCategoriesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeEventCategoriesInput, ...request.Option) *rds.DescribeEventCategoriesOutput); ok {
+	var r0 *rds.DescribeDBProxiesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBProxiesInput, ...request.Option) *rds.DescribeDBProxiesOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeEventCategoriesOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBProxiesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeEventCategoriesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBProxiesInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -6289,21 +6270,21 @@ func (_m *RDSAPI) DescribeEventCategoriesWithContext(_a0 context.Context, _a1 *r
 	return r0, r1
 }
 
-// DescribeEventSubscriptions provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeEventSubscriptions(_a0 *rds.DescribeEventSubscriptionsInput) (*rds.DescribeEventSubscriptionsOutput, error) {
+// DescribeDBProxyEndpoints provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBProxyEndpoints(_a0 *rds.DescribeDBProxyEndpointsInput) (*rds.DescribeDBProxyEndpointsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeEventSubscriptionsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeEventSubscriptionsInput) *rds.DescribeEventSubscriptionsOutput); ok {
+	var r0 *rds.DescribeDBProxyEndpointsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxyEndpointsInput) *rds.DescribeDBProxyEndpointsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeEventSubscriptionsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBProxyEndpointsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeEventSubscriptionsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBProxyEndpointsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -6312,12 +6293,12 @@ func (_m *RDSAPI) DescribeEventSubscriptions(_a0 *rds.DescribeEventSubscriptions
 	return r0, r1
 }
 
-// DescribeEventSubscriptionsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeEventSubscriptionsPages(_a0 *rds.DescribeEventSubscriptionsInput, _a1 func(*rds.DescribeEventSubscriptionsOutput, bool) bool) error {
+// DescribeDBProxyEndpointsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBProxyEndpointsPages(_a0 *rds.DescribeDBProxyEndpointsInput, _a1 func(*rds.DescribeDBProxyEndpointsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeEventSubscriptionsInput, func(*rds.DescribeEventSubscriptionsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxyEndpointsInput, func(*rds.DescribeDBProxyEndpointsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -6326,8 +6307,8 @@ func (_m *RDSAPI) DescribeEventSubscriptionsPages(_a0 *rds.DescribeEventSubscrip
 	return r0
 }
 
-// DescribeEventSubscriptionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeEventSubscriptionsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeEventSubscriptionsInput, _a2 func(*rds.DescribeEventSubscriptionsOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBProxyEndpointsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBProxyEndpointsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBProxyEndpointsInput, _a2 func(*rds.DescribeDBProxyEndpointsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -6338,7 +6319,7 @@ func (_m *RDSAPI) DescribeEventSubscriptionsPagesWithContext(_a0 context.Context
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeEventSubscriptionsInput, func(*rds.DescribeEventSubscriptionsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBProxyEndpointsInput, func(*rds.DescribeDBProxyEndpointsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -6347,12 +6328,12 @@ func (_m *RDSAPI) DescribeEventSubscriptionsPagesWithContext(_a0 context.Context
 	return r0
 }
 
-// DescribeEventSubscriptionsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeEventSubscriptionsRequest(_a0 *rds.DescribeEventSubscriptionsInput) (*request.Request, *rds.DescribeEventSubscriptionsOutput) {
+// DescribeDBProxyEndpointsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBProxyEndpointsRequest(_a0 *rds.DescribeDBProxyEndpointsInput) (*request.Request, *rds.DescribeDBProxyEndpointsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeEventSubscriptionsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxyEndpointsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -6360,20 +6341,20 @@ func (_m *RDSAPI) DescribeEventSubscriptionsRequest(_a0 *rds.DescribeEventSubscr
 		}
 	}
 
-	var r1 *rds.DescribeEventSubscriptionsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeEventSubscriptionsInput) *rds.DescribeEventSubscriptionsOutput); ok {
+	var r1 *rds.DescribeDBProxyEndpointsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBProxyEndpointsInput) *rds.DescribeDBProxyEndpointsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeEventSubscriptionsOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBProxyEndpointsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeEventSubscriptionsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeEventSubscriptionsWithContext(_a0 context.Context, _a1 *rds.DescribeEventSubscriptionsInput, _a2 ...request.Option) (*rds.DescribeEventSubscriptionsOutput, error) {
+// DescribeDBProxyEndpointsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBProxyEndpointsWithContext(_a0 context.Context, _a1 *rds.DescribeDBProxyEndpointsInput, _a2 ...request.Option) (*rds.DescribeDBProxyEndpointsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -6383,17 +6364,17 @@ func (_m *RDSAPI) DescribeEventSubscriptionsWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeEventSubscriptionsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeEventSubscriptionsInput, ...request.Option) *rds.DescribeEventSubscriptionsOutput); ok {
+	var r0 *rds.DescribeDBProxyEndpointsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBProxyEndpointsInput, ...request.Option) *rds.DescribeDBProxyEndpointsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeEventSubscriptionsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBProxyEndpointsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeEventSubscriptionsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBProxyEndpointsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -6402,21 +6383,21 @@ func (_m *RDSAPI) DescribeEventSubscriptionsWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// DescribeEvents provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeEvents(_a0 *rds.DescribeEventsInput) (*rds.DescribeEventsOutput, error) {
+// DescribeDBProxyTargetGroups provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBProxyTargetGroups(_a0 *rds.DescribeDBProxyTargetGroupsInput) (*rds.DescribeDBProxyTargetGroupsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeEventsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeEventsInput) *rds.DescribeEventsOutput); ok {
+	var r0 *rds.DescribeDBProxyTargetGroupsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxyTargetGroupsInput) *rds.DescribeDBProxyTargetGroupsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeEventsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBProxyTargetGroupsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeEventsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBProxyTargetGroupsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -6425,12 +6406,12 @@ func (_m *RDSAPI) DescribeEvents(_a0 *rds.DescribeEventsInput) (*rds.DescribeEve
 	return r0, r1
 }
 
-// DescribeEventsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeEventsPages(_a0 *rds.DescribeEventsInput, _a1 func(*rds.DescribeEventsOutput, bool) bool) error {
+// DescribeDBProxyTargetGroupsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBProxyTargetGroupsPages(_a0 *rds.DescribeDBProxyTargetGroupsInput, _a1 func(*rds.DescribeDBProxyTargetGroupsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeEventsInput, func(*rds.DescribeEventsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxyTargetGroupsInput, func(*rds.DescribeDBProxyTargetGroupsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -6439,8 +6420,8 @@ func (_m *RDSAPI) DescribeEventsPages(_a0 *rds.DescribeEventsInput, _a1 func(*rd
 	return r0
 }
 
-// DescribeEventsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeEventsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeEventsInput, _a2 func(*rds.DescribeEventsOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBProxyTargetGroupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBProxyTargetGroupsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBProxyTargetGroupsInput, _a2 func(*rds.DescribeDBProxyTargetGroupsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -6451,7 +6432,7 @@ func (_m *RDSAPI) DescribeEventsPagesWithContext(_a0 context.Context, _a1 *rds.D
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeEventsInput, func(*rds.DescribeEventsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBProxyTargetGroupsInput, func(*rds.DescribeDBProxyTargetGroupsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -6460,12 +6441,12 @@ func (_m *RDSAPI) DescribeEventsPagesWithContext(_a0 context.Context, _a1 *rds.D
 	return r0
 }
 
-// DescribeEventsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeEventsRequest(_a0 *rds.DescribeEventsInput) (*request.Request, *rds.DescribeEventsOutput) {
+// DescribeDBProxyTargetGroupsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBProxyTargetGroupsRequest(_a0 *rds.DescribeDBProxyTargetGroupsInput) (*request.Request, *rds.DescribeDBProxyTargetGroupsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeEventsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxyTargetGroupsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -6473,20 +6454,20 @@ func (_m *RDSAPI) DescribeEventsRequest(_a0 *rds.DescribeEventsInput) (*request.
 		}
 	}
 
-	var r1 *rds.DescribeEventsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeEventsInput) *rds.DescribeEventsOutput); ok {
+	var r1 *rds.DescribeDBProxyTargetGroupsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBProxyTargetGroupsInput) *rds.DescribeDBProxyTargetGroupsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeEventsOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBProxyTargetGroupsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeEventsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeEventsWithContext(_a0 context.Context, _a1 *rds.DescribeEventsInput, _a2 ...request.Option) (*rds.DescribeEventsOutput, error) {
+// DescribeDBProxyTargetGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBProxyTargetGroupsWithContext(_a0 context.Context, _a1 *rds.DescribeDBProxyTargetGroupsInput, _a2 ...request.Option) (*rds.DescribeDBProxyTargetGroupsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -6496,17 +6477,17 @@ func (_m *RDSAPI) DescribeEventsWithContext(_a0 context.Context, _a1 *rds.Descri
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeEventsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeEventsInput, ...request.Option) *rds.DescribeEventsOutput); ok {
+	var r0 *rds.DescribeDBProxyTargetGroupsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBProxyTargetGroupsInput, ...request.Option) *rds.DescribeDBProxyTargetGroupsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeEventsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBProxyTargetGroupsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeEventsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBProxyTargetGroupsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -6515,21 +6496,21 @@ func (_m *RDSAPI) DescribeEventsWithContext(_a0 context.Context, _a1 *rds.Descri
 	return r0, r1
 }
 
-// DescribeExportTasks provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeExportTasks(_a0 *rds.DescribeExportTasksInput) (*rds.DescribeExportTasksOutput, error) {
+// DescribeDBProxyTargets provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBProxyTargets(_a0 *rds.DescribeDBProxyTargetsInput) (*rds.DescribeDBProxyTargetsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeExportTasksOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeExportTasksInput) *rds.DescribeExportTasksOutput); ok {
+	var r0 *rds.DescribeDBProxyTargetsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxyTargetsInput) *rds.DescribeDBProxyTargetsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeExportTasksOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBProxyTargetsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeExportTasksInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBProxyTargetsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -6538,12 +6519,12 @@ func (_m *RDSAPI) DescribeExportTasks(_a0 *rds.DescribeExportTasksInput) (*rds.D
 	return r0, r1
 }
 
-// DescribeExportTasksPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeExportTasksPages(_a0 *rds.DescribeExportTasksInput, _a1 func(*rds.DescribeExportTasksOutput, bool) bool) error {
+// DescribeDBProxyTargetsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBProxyTargetsPages(_a0 *rds.DescribeDBProxyTargetsInput, _a1 func(*rds.DescribeDBProxyTargetsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeExportTasksInput, func(*rds.DescribeExportTasksOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxyTargetsInput, func(*rds.DescribeDBProxyTargetsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -6552,8 +6533,8 @@ func (_m *RDSAPI) DescribeExportTasksPages(_a0 *rds.DescribeExportTasksInput, _a
 	return r0
 }
 
-// DescribeExportTasksPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeExportTasksPagesWithContext(_a0 context.Context, _a1 *rds.DescribeExportTasksInput, _a2 func(*rds.DescribeExportTasksOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBProxyTargetsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBProxyTargetsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBProxyTargetsInput, _a2 func(*rds.DescribeDBProxyTargetsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -6564,7 +6545,7 @@ func (_m *RDSAPI) DescribeExportTasksPagesWithContext(_a0 context.Context, _a1 *
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeExportTasksInput, func(*rds.DescribeExportTasksOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBProxyTargetsInput, func(*rds.DescribeDBProxyTargetsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -6573,12 +6554,12 @@ func (_m *RDSAPI) DescribeExportTasksPagesWithContext(_a0 context.Context, _a1 *
 	return r0
 }
 
-// DescribeExportTasksRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeExportTasksRequest(_a0 *rds.DescribeExportTasksInput) (*request.Request, *rds.DescribeExportTasksOutput) {
+// DescribeDBProxyTargetsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBProxyTargetsRequest(_a0 *rds.DescribeDBProxyTargetsInput) (*request.Request, *rds.DescribeDBProxyTargetsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeExportTasksInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBProxyTargetsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -6586,20 +6567,20 @@ func (_m *RDSAPI) DescribeExportTasksRequest(_a0 *rds.DescribeExportTasksInput)
 		}
 	}
 
-	var r1 *rds.DescribeExportTasksOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeExportTasksInput) *rds.DescribeExportTasksOutput); ok {
+	var r1 *rds.DescribeDBProxyTargetsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBProxyTargetsInput) *rds.DescribeDBProxyTargetsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeExportTasksOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBProxyTargetsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeExportTasksWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeExportTasksWithContext(_a0 context.Context, _a1 *rds.DescribeExportTasksInput, _a2 ...request.Option) (*rds.DescribeExportTasksOutput, error) {
+// DescribeDBProxyTargetsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBProxyTargetsWithContext(_a0 context.Context, _a1 *rds.DescribeDBProxyTargetsInput, _a2 ...request.Option) (*rds.DescribeDBProxyTargetsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -6609,17 +6590,17 @@ func (_m *RDSAPI) DescribeExportTasksWithContext(_a0 context.Context, _a1 *rds.D
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeExportTasksOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeExportTasksInput, ...request.Option) *rds.DescribeExportTasksOutput); ok {
+	var r0 *rds.DescribeDBProxyTargetsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBProxyTargetsInput, ...request.Option) *rds.DescribeDBProxyTargetsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeExportTasksOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBProxyTargetsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeExportTasksInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBProxyTargetsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -6628,21 +6609,21 @@ func (_m *RDSAPI) DescribeExportTasksWithContext(_a0 context.Context, _a1 *rds.D
 	return r0, r1
 }
 
-// DescribeGlobalClusters provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeGlobalClusters(_a0 *rds.DescribeGlobalClustersInput) (*rds.DescribeGlobalClustersOutput, error) {
+// DescribeDBRecommendations provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBRecommendations(_a0 *rds.DescribeDBRecommendationsInput) (*rds.DescribeDBRecommendationsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeGlobalClustersOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeGlobalClustersInput) *rds.DescribeGlobalClustersOutput); ok {
+	var r0 *rds.DescribeDBRecommendationsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBRecommendationsInput) *rds.DescribeDBRecommendationsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeGlobalClustersOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBRecommendationsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeGlobalClustersInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBRecommendationsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -6651,12 +6632,12 @@ func (_m *RDSAPI) DescribeGlobalClusters(_a0 *rds.DescribeGlobalClustersInput) (
 	return r0, r1
 }
 
-// DescribeGlobalClustersPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeGlobalClustersPages(_a0 *rds.DescribeGlobalClustersInput, _a1 func(*rds.DescribeGlobalClustersOutput, bool) bool) error {
+// DescribeDBRecommendationsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBRecommendationsPages(_a0 *rds.DescribeDBRecommendationsInput, _a1 func(*rds.DescribeDBRecommendationsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeGlobalClustersInput, func(*rds.DescribeGlobalClustersOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBRecommendationsInput, func(*rds.DescribeDBRecommendationsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -6665,8 +6646,8 @@ func (_m *RDSAPI) DescribeGlobalClustersPages(_a0 *rds.DescribeGlobalClustersInp
 	return r0
 }
 
-// DescribeGlobalClustersPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeGlobalClustersPagesWithContext(_a0 context.Context, _a1 *rds.DescribeGlobalClustersInput, _a2 func(*rds.DescribeGlobalClustersOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBRecommendationsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBRecommendationsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBRecommendationsInput, _a2 func(*rds.DescribeDBRecommendationsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -6677,7 +6658,7 @@ func (_m *RDSAPI) DescribeGlobalClustersPagesWithContext(_a0 context.Context, _a
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeGlobalClustersInput, func(*rds.DescribeGlobalClustersOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBRecommendationsInput, func(*rds.DescribeDBRecommendationsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -6686,12 +6667,12 @@ func (_m *RDSAPI) DescribeGlobalClustersPagesWithContext(_a0 context.Context, _a
 	return r0
 }
 
-// DescribeGlobalClustersRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeGlobalClustersRequest(_a0 *rds.DescribeGlobalClustersInput) (*request.Request, *rds.DescribeGlobalClustersOutput) {
+// DescribeDBRecommendationsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBRecommendationsRequest(_a0 *rds.DescribeDBRecommendationsInput) (*request.Request, *rds.DescribeDBRecommendationsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeGlobalClustersInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBRecommendationsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -6699,20 +6680,20 @@ func (_m *RDSAPI) DescribeGlobalClustersRequest(_a0 *rds.DescribeGlobalClustersI
 		}
 	}
 
-	var r1 *rds.DescribeGlobalClustersOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeGlobalClustersInput) *rds.DescribeGlobalClustersOutput); ok {
+	var r1 *rds.DescribeDBRecommendationsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBRecommendationsInput) *rds.DescribeDBRecommendationsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeGlobalClustersOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBRecommendationsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeGlobalClustersWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeGlobalClustersWithContext(_a0 context.Context, _a1 *rds.DescribeGlobalClustersInput, _a2 ...request.Option) (*rds.DescribeGlobalClustersOutput, error) {
+// DescribeDBRecommendationsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBRecommendationsWithContext(_a0 context.Context, _a1 *rds.DescribeDBRecommendationsInput, _a2 ...request.Option) (*rds.DescribeDBRecommendationsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -6722,17 +6703,17 @@ func (_m *RDSAPI) DescribeGlobalClustersWithContext(_a0 context.Context, _a1 *rd
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeGlobalClustersOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeGlobalClustersInput, ...request.Option) *rds.DescribeGlobalClustersOutput); ok {
+	var r0 *rds.DescribeDBRecommendationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBRecommendationsInput, ...request.Option) *rds.DescribeDBRecommendationsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeGlobalClustersOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBRecommendationsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeGlobalClustersInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBRecommendationsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -6741,21 +6722,21 @@ func (_m *RDSAPI) DescribeGlobalClustersWithContext(_a0 context.Context, _a1 *rd
 	return r0, r1
 }
 
-// DescribeInstallationMedia provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeInstallationMedia(_a0 *rds.DescribeInstallationMediaInput) (*rds.DescribeInstallationMediaOutput, error) {
+// DescribeDBSecurityGroups provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBSecurityGroups(_a0 *rds.DescribeDBSecurityGroupsInput) (*rds.DescribeDBSecurityGroupsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeInstallationMediaOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeInstallationMediaInput) *rds.DescribeInstallationMediaOutput); ok {
+	var r0 *rds.DescribeDBSecurityGroupsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSecurityGroupsInput) *rds.DescribeDBSecurityGroupsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeInstallationMediaOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBSecurityGroupsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeInstallationMediaInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBSecurityGroupsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -6764,12 +6745,12 @@ func (_m *RDSAPI) DescribeInstallationMedia(_a0 *rds.DescribeInstallationMediaIn
 	return r0, r1
 }
 
-// DescribeInstallationMediaPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeInstallationMediaPages(_a0 *rds.DescribeInstallationMediaInput, _a1 func(*rds.DescribeInstallationMediaOutput, bool) bool) error {
+// DescribeDBSecurityGroupsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBSecurityGroupsPages(_a0 *rds.DescribeDBSecurityGroupsInput, _a1 func(*rds.DescribeDBSecurityGroupsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeInstallationMediaInput, func(*rds.DescribeInstallationMediaOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSecurityGroupsInput, func(*rds.DescribeDBSecurityGroupsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -6778,8 +6759,8 @@ func (_m *RDSAPI) DescribeInstallationMediaPages(_a0 *rds.DescribeInstallationMe
 	return r0
 }
 
-// DescribeInstallationMediaPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeInstallationMediaPagesWithContext(_a0 context.Context, _a1 *rds.DescribeInstallationMediaInput, _a2 func(*rds.DescribeInstallationMediaOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBSecurityGroupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBSecurityGroupsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBSecurityGroupsInput, _a2 func(*rds.DescribeDBSecurityGroupsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -6790,7 +6771,7 @@ func (_m *RDSAPI) DescribeInstallationMediaPagesWithContext(_a0 context.Context,
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeInstallationMediaInput, func(*rds.DescribeInstallationMediaOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBSecurityGroupsInput, func(*rds.DescribeDBSecurityGroupsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -6799,12 +6780,12 @@ func (_m *RDSAPI) DescribeInstallationMediaPagesWithContext(_a0 context.Context,
 	return r0
 }
 
-// DescribeInstallationMediaRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeInstallationMediaRequest(_a0 *rds.DescribeInstallationMediaInput) (*request.Request, *rds.DescribeInstallationMediaOutput) {
+// DescribeDBSecurityGroupsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBSecurityGroupsRequest(_a0 *rds.DescribeDBSecurityGroupsInput) (*request.Request, *rds.DescribeDBSecurityGroupsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeInstallationMediaInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSecurityGroupsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -6812,20 +6793,20 @@ func (_m *RDSAPI) DescribeInstallationMediaRequest(_a0 *rds.DescribeInstallation
 		}
 	}
 
-	var r1 *rds.DescribeInstallationMediaOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeInstallationMediaInput) *rds.DescribeInstallationMediaOutput); ok {
+	var r1 *rds.DescribeDBSecurityGroupsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBSecurityGroupsInput) *rds.DescribeDBSecurityGroupsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeInstallationMediaOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBSecurityGroupsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeInstallationMediaWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeInstallationMediaWithContext(_a0 context.Context, _a1 *rds.DescribeInstallationMediaInput, _a2 ...request.Option) (*rds.DescribeInstallationMediaOutput, error) {
+// DescribeDBSecurityGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBSecurityGroupsWithContext(_a0 context.Context, _a1 *rds.DescribeDBSecurityGroupsInput, _a2 ...request.Option) (*rds.DescribeDBSecurityGroupsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -6835,17 +6816,17 @@ func (_m *RDSAPI) DescribeInstallationMediaWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeInstallationMediaOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeInstallationMediaInput, ...request.Option) *rds.DescribeInstallationMediaOutput); ok {
+	var r0 *rds.DescribeDBSecurityGroupsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBSecurityGroupsInput, ...request.Option) *rds.DescribeDBSecurityGroupsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeInstallationMediaOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBSecurityGroupsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeInstallationMediaInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBSecurityGroupsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -6854,21 +6835,21 @@ func (_m *RDSAPI) DescribeInstallationMediaWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// DescribeOptionGroupOptions provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeOptionGroupOptions(_a0 *rds.DescribeOptionGroupOptionsInput) (*rds.DescribeOptionGroupOptionsOutput, error) {
+// DescribeDBShardGroups provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBShardGroups(_a0 *rds.DescribeDBShardGroupsInput) (*rds.DescribeDBShardGroupsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeOptionGroupOptionsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeOptionGroupOptionsInput) *rds.DescribeOptionGroupOptionsOutput); ok {
+	var r0 *rds.DescribeDBShardGroupsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBShardGroupsInput) *rds.DescribeDBShardGroupsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeOptionGroupOptionsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBShardGroupsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeOptionGroupOptionsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBShardGroupsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -6877,47 +6858,90 @@ func (_m *RDSAPI) DescribeOptionGroupOptions(_a0 *rds.DescribeOptionGroupOptions
 	return r0, r1
 }
 
-// DescribeOptionGroupOptionsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeOptionGroupOptionsPages(_a0 *rds.DescribeOptionGroupOptionsInput, _a1 func(*rds.DescribeOptionGroupOptionsOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
+// DescribeDBShardGroupsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBShardGroupsRequest(_a0 *rds.DescribeDBShardGroupsInput) (*request.Request, *rds.DescribeDBShardGroupsOutput) {
+	ret := _m.Called(_a0)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeOptionGroupOptionsInput, func(*rds.DescribeOptionGroupOptionsOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBShardGroupsInput) *request.Request); ok {
+		r0 = rf(_a0)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
 	}
 
-	return r0
+	var r1 *rds.DescribeDBShardGroupsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBShardGroupsInput) *rds.DescribeDBShardGroupsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DescribeDBShardGroupsOutput)
+		}
+	}
+
+	return r0, r1
 }
 
-// DescribeOptionGroupOptionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeOptionGroupOptionsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeOptionGroupOptionsInput, _a2 func(*rds.DescribeOptionGroupOptionsOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
+// DescribeDBShardGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBShardGroupsWithContext(_a0 context.Context, _a1 *rds.DescribeDBShardGroupsInput, _a2 ...request.Option) (*rds.DescribeDBShardGroupsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _a0, _a1)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeOptionGroupOptionsInput, func(*rds.DescribeOptionGroupOptionsOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
+	var r0 *rds.DescribeDBShardGroupsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBShardGroupsInput, ...request.Option) *rds.DescribeDBShardGroupsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeDBShardGroupsOutput)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBShardGroupsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// DescribeOptionGroupOptionsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeOptionGroupOptionsRequest(_a0 *rds.DescribeOptionGroupOptionsInput) (*request.Request, *rds.DescribeOptionGroupOptionsOutput) {
+// DescribeDBSnapshotAttributes provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBSnapshotAttributes(_a0 *rds.DescribeDBSnapshotAttributesInput) (*rds.DescribeDBSnapshotAttributesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DescribeDBSnapshotAttributesOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSnapshotAttributesInput) *rds.DescribeDBSnapshotAttributesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeDBSnapshotAttributesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBSnapshotAttributesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDBSnapshotAttributesRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBSnapshotAttributesRequest(_a0 *rds.DescribeDBSnapshotAttributesInput) (*request.Request, *rds.DescribeDBSnapshotAttributesOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeOptionGroupOptionsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSnapshotAttributesInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -6925,20 +6949,20 @@ func (_m *RDSAPI) DescribeOptionGroupOptionsRequest(_a0 *rds.DescribeOptionGroup
 		}
 	}
 
-	var r1 *rds.DescribeOptionGroupOptionsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeOptionGroupOptionsInput) *rds.DescribeOptionGroupOptionsOutput); ok {
+	var r1 *rds.DescribeDBSnapshotAttributesOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBSnapshotAttributesInput) *rds.DescribeDBSnapshotAttributesOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeOptionGroupOptionsOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBSnapshotAttributesOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeOptionGroupOptionsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeOptionGroupOptionsWithContext(_a0 context.Context, _a1 *rds.DescribeOptionGroupOptionsInput, _a2 ...request.Option) (*rds.DescribeOptionGroupOptionsOutput, error) {
+// DescribeDBSnapshotAttributesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBSnapshotAttributesWithContext(_a0 context.Context, _a1 *rds.DescribeDBSnapshotAttributesInput, _a2 ...request.Option) (*rds.DescribeDBSnapshotAttributesOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -6948,17 +6972,17 @@ func (_m *RDSAPI) DescribeOptionGroupOptionsWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeOptionGroupOptionsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeOptionGroupOptionsInput, ...request.Option) *rds.DescribeOptionGroupOptionsOutput); ok {
+	var r0 *rds.DescribeDBSnapshotAttributesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBSnapshotAttributesInput, ...request.Option) *rds.DescribeDBSnapshotAttributesOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeOptionGroupOptionsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBSnapshotAttributesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeOptionGroupOptionsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBSnapshotAttributesInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -6967,21 +6991,21 @@ func (_m *RDSAPI) DescribeOptionGroupOptionsWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// DescribeOptionGroups provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeOptionGroups(_a0 *rds.DescribeOptionGroupsInput) (*rds.DescribeOptionGroupsOutput, error) {
+// DescribeDBSnapshotTenantDatabases provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBSnapshotTenantDatabases(_a0 *rds.DescribeDBSnapshotTenantDatabasesInput) (*rds.DescribeDBSnapshotTenantDatabasesOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeOptionGroupsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeOptionGroupsInput) *rds.DescribeOptionGroupsOutput); ok {
+	var r0 *rds.DescribeDBSnapshotTenantDatabasesOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSnapshotTenantDatabasesInput) *rds.DescribeDBSnapshotTenantDatabasesOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeOptionGroupsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBSnapshotTenantDatabasesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeOptionGroupsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBSnapshotTenantDatabasesInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -6990,12 +7014,12 @@ func (_m *RDSAPI) DescribeOptionGroups(_a0 *rds.DescribeOptionGroupsInput) (*rds
 	return r0, r1
 }
 
-// DescribeOptionGroupsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeOptionGroupsPages(_a0 *rds.DescribeOptionGroupsInput, _a1 func(*rds.DescribeOptionGroupsOutput, bool) bool) error {
+// DescribeDBSnapshotTenantDatabasesPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBSnapshotTenantDatabasesPages(_a0 *rds.DescribeDBSnapshotTenantDatabasesInput, _a1 func(*rds.DescribeDBSnapshotTenantDatabasesOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeOptionGroupsInput, func(*rds.DescribeOptionGroupsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSnapshotTenantDatabasesInput, func(*rds.DescribeDBSnapshotTenantDatabasesOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -7004,8 +7028,8 @@ func (_m *RDSAPI) DescribeOptionGroupsPages(_a0 *rds.DescribeOptionGroupsInput,
 	return r0
 }
 
-// DescribeOptionGroupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeOptionGroupsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeOptionGroupsInput, _a2 func(*rds.DescribeOptionGroupsOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBSnapshotTenantDatabasesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBSnapshotTenantDatabasesPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBSnapshotTenantDatabasesInput, _a2 func(*rds.DescribeDBSnapshotTenantDatabasesOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -7016,7 +7040,7 @@ func (_m *RDSAPI) DescribeOptionGroupsPagesWithContext(_a0 context.Context, _a1
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeOptionGroupsInput, func(*rds.DescribeOptionGroupsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBSnapshotTenantDatabasesInput, func(*rds.DescribeDBSnapshotTenantDatabasesOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -7025,12 +7049,12 @@ func (_m *RDSAPI) DescribeOptionGroupsPagesWithContext(_a0 context.Context, _a1
 	return r0
 }
 
-// DescribeOptionGroupsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeOptionGroupsRequest(_a0 *rds.DescribeOptionGroupsInput) (*request.Request, *rds.DescribeOptionGroupsOutput) {
+// DescribeDBSnapshotTenantDatabasesRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBSnapshotTenantDatabasesRequest(_a0 *rds.DescribeDBSnapshotTenantDatabasesInput) (*request.Request, *rds.DescribeDBSnapshotTenantDatabasesOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeOptionGroupsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSnapshotTenantDatabasesInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -7038,20 +7062,20 @@ func (_m *RDSAPI) DescribeOptionGroupsRequest(_a0 *rds.DescribeOptionGroupsInput
 		}
 	}
 
-	var r1 *rds.DescribeOptionGroupsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeOptionGroupsInput) *rds.DescribeOptionGroupsOutput); ok {
+	var r1 *rds.DescribeDBSnapshotTenantDatabasesOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBSnapshotTenantDatabasesInput) *rds.DescribeDBSnapshotTenantDatabasesOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeOptionGroupsOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBSnapshotTenantDatabasesOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeOptionGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeOptionGroupsWithContext(_a0 context.Context, _a1 *rds.DescribeOptionGroupsInput, _a2 ...request.Option) (*rds.DescribeOptionGroupsOutput, error) {
+// DescribeDBSnapshotTenantDatabasesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBSnapshotTenantDatabasesWithContext(_a0 context.Context, _a1 *rds.DescribeDBSnapshotTenantDatabasesInput, _a2 ...request.Option) (*rds.DescribeDBSnapshotTenantDatabasesOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -7061,17 +7085,17 @@ func (_m *RDSAPI) DescribeOptionGroupsWithContext(_a0 context.Context, _a1 *rds.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeOptionGroupsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeOptionGroupsInput, ...request.Option) *rds.DescribeOptionGroupsOutput); ok {
+	var r0 *rds.DescribeDBSnapshotTenantDatabasesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBSnapshotTenantDatabasesInput, ...request.Option) *rds.DescribeDBSnapshotTenantDatabasesOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeOptionGroupsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBSnapshotTenantDatabasesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeOptionGroupsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBSnapshotTenantDatabasesInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -7080,21 +7104,21 @@ func (_m *RDSAPI) DescribeOptionGroupsWithContext(_a0 context.Context, _a1 *rds.
 	return r0, r1
 }
 
-// DescribeOrderableDBInstanceOptions provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeOrderableDBInstanceOptions(_a0 *rds.DescribeOrderableDBInstanceOptionsInput) (*rds.DescribeOrderableDBInstanceOptionsOutput, error) {
+// DescribeDBSnapshots provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBSnapshots(_a0 *rds.DescribeDBSnapshotsInput) (*rds.DescribeDBSnapshotsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeOrderableDBInstanceOptionsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeOrderableDBInstanceOptionsInput) *rds.DescribeOrderableDBInstanceOptionsOutput); ok {
+	var r0 *rds.DescribeDBSnapshotsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSnapshotsInput) *rds.DescribeDBSnapshotsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeOrderableDBInstanceOptionsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBSnapshotsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeOrderableDBInstanceOptionsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBSnapshotsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -7103,12 +7127,12 @@ func (_m *RDSAPI) DescribeOrderableDBInstanceOptions(_a0 *rds.DescribeOrderableD
 	return r0, r1
 }
 
-// DescribeOrderableDBInstanceOptionsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeOrderableDBInstanceOptionsPages(_a0 *rds.DescribeOrderableDBInstanceOptionsInput, _a1 func(*rds.DescribeOrderableDBInstanceOptionsOutput, bool) bool) error {
+// DescribeDBSnapshotsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBSnapshotsPages(_a0 *rds.DescribeDBSnapshotsInput, _a1 func(*rds.DescribeDBSnapshotsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeOrderableDBInstanceOptionsInput, func(*rds.DescribeOrderableDBInstanceOptionsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSnapshotsInput, func(*rds.DescribeDBSnapshotsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -7117,8 +7141,8 @@ func (_m *RDSAPI) DescribeOrderableDBInstanceOptionsPages(_a0 *rds.DescribeOrder
 	return r0
 }
 
-// DescribeOrderableDBInstanceOptionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeOrderableDBInstanceOptionsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeOrderableDBInstanceOptionsInput, _a2 func(*rds.DescribeOrderableDBInstanceOptionsOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBSnapshotsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBSnapshotsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBSnapshotsInput, _a2 func(*rds.DescribeDBSnapshotsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -7129,7 +7153,7 @@ func (_m *RDSAPI) DescribeOrderableDBInstanceOptionsPagesWithContext(_a0 context
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeOrderableDBInstanceOptionsInput, func(*rds.DescribeOrderableDBInstanceOptionsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBSnapshotsInput, func(*rds.DescribeDBSnapshotsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -7138,12 +7162,12 @@ func (_m *RDSAPI) DescribeOrderableDBInstanceOptionsPagesWithContext(_a0 context
 	return r0
 }
 
-// DescribeOrderableDBInstanceOptionsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeOrderableDBInstanceOptionsRequest(_a0 *rds.DescribeOrderableDBInstanceOptionsInput) (*request.Request, *rds.DescribeOrderableDBInstanceOptionsOutput) {
+// DescribeDBSnapshotsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBSnapshotsRequest(_a0 *rds.DescribeDBSnapshotsInput) (*request.Request, *rds.DescribeDBSnapshotsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeOrderableDBInstanceOptionsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSnapshotsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -7151,20 +7175,20 @@ func (_m *RDSAPI) DescribeOrderableDBInstanceOptionsRequest(_a0 *rds.DescribeOrd
 		}
 	}
 
-	var r1 *rds.DescribeOrderableDBInstanceOptionsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeOrderableDBInstanceOptionsInput) *rds.DescribeOrderableDBInstanceOptionsOutput); ok {
+	var r1 *rds.DescribeDBSnapshotsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBSnapshotsInput) *rds.DescribeDBSnapshotsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeOrderableDBInstanceOptionsOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBSnapshotsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeOrderableDBInstanceOptionsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeOrderableDBInstanceOptionsWithContext(_a0 context.Context, _a1 *rds.DescribeOrderableDBInstanceOptionsInput, _a2 ...request.Option) (*rds.DescribeOrderableDBInstanceOptionsOutput, error) {
+// DescribeDBSnapshotsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBSnapshotsWithContext(_a0 context.Context, _a1 *rds.DescribeDBSnapshotsInput, _a2 ...request.Option) (*rds.DescribeDBSnapshotsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -7174,17 +7198,17 @@ func (_m *RDSAPI) DescribeOrderableDBInstanceOptionsWithContext(_a0 context.Cont
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeOrderableDBInstanceOptionsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeOrderableDBInstanceOptionsInput, ...request.Option) *rds.DescribeOrderableDBInstanceOptionsOutput); ok {
+	var r0 *rds.DescribeDBSnapshotsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBSnapshotsInput, ...request.Option) *rds.DescribeDBSnapshotsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeOrderableDBInstanceOptionsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBSnapshotsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeOrderableDBInstanceOptionsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBSnapshotsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -7193,21 +7217,21 @@ func (_m *RDSAPI) DescribeOrderableDBInstanceOptionsWithContext(_a0 context.Cont
 	return r0, r1
 }
 
-// DescribePendingMaintenanceActions provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribePendingMaintenanceActions(_a0 *rds.DescribePendingMaintenanceActionsInput) (*rds.DescribePendingMaintenanceActionsOutput, error) {
+// DescribeDBSubnetGroups provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBSubnetGroups(_a0 *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribePendingMaintenanceActionsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribePendingMaintenanceActionsInput) *rds.DescribePendingMaintenanceActionsOutput); ok {
+	var r0 *rds.DescribeDBSubnetGroupsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSubnetGroupsInput) *rds.DescribeDBSubnetGroupsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribePendingMaintenanceActionsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBSubnetGroupsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribePendingMaintenanceActionsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBSubnetGroupsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -7216,12 +7240,12 @@ func (_m *RDSAPI) DescribePendingMaintenanceActions(_a0 *rds.DescribePendingMain
 	return r0, r1
 }
 
-// DescribePendingMaintenanceActionsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribePendingMaintenanceActionsPages(_a0 *rds.DescribePendingMaintenanceActionsInput, _a1 func(*rds.DescribePendingMaintenanceActionsOutput, bool) bool) error {
+// DescribeDBSubnetGroupsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeDBSubnetGroupsPages(_a0 *rds.DescribeDBSubnetGroupsInput, _a1 func(*rds.DescribeDBSubnetGroupsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribePendingMaintenanceActionsInput, func(*rds.DescribePendingMaintenanceActionsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSubnetGroupsInput, func(*rds.DescribeDBSubnetGroupsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -7230,8 +7254,8 @@ func (_m *RDSAPI) DescribePendingMaintenanceActionsPages(_a0 *rds.DescribePendin
 	return r0
 }
 
-// DescribePendingMaintenanceActionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribePendingMaintenanceActionsPagesWithContext(_a0 context.Context, _a1 *rds.DescribePendingMaintenanceActionsInput, _a2 func(*rds.DescribePendingMaintenanceActionsOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeDBSubnetGroupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeDBSubnetGroupsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeDBSubnetGroupsInput, _a2 func(*rds.DescribeDBSubnetGroupsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -7242,7 +7266,7 @@ func (_m *RDSAPI) DescribePendingMaintenanceActionsPagesWithContext(_a0 context.
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribePendingMaintenanceActionsInput, func(*rds.DescribePendingMaintenanceActionsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBSubnetGroupsInput, func(*rds.DescribeDBSubnetGroupsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -7251,12 +7275,12 @@ func (_m *RDSAPI) DescribePendingMaintenanceActionsPagesWithContext(_a0 context.
 	return r0
 }
 
-// DescribePendingMaintenanceActionsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribePendingMaintenanceActionsRequest(_a0 *rds.DescribePendingMaintenanceActionsInput) (*request.Request, *rds.DescribePendingMaintenanceActionsOutput) {
+// DescribeDBSubnetGroupsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeDBSubnetGroupsRequest(_a0 *rds.DescribeDBSubnetGroupsInput) (*request.Request, *rds.DescribeDBSubnetGroupsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribePendingMaintenanceActionsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBSubnetGroupsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -7264,20 +7288,20 @@ func (_m *RDSAPI) DescribePendingMaintenanceActionsRequest(_a0 *rds.DescribePend
 		}
 	}
 
-	var r1 *rds.DescribePendingMaintenanceActionsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribePendingMaintenanceActionsInput) *rds.DescribePendingMaintenanceActionsOutput); ok {
+	var r1 *rds.DescribeDBSubnetGroupsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeDBSubnetGroupsInput) *rds.DescribeDBSubnetGroupsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribePendingMaintenanceActionsOutput)
+			r1 = ret.Get(1).(*rds.DescribeDBSubnetGroupsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribePendingMaintenanceActionsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribePendingMaintenanceActionsWithContext(_a0 context.Context, _a1 *rds.DescribePendingMaintenanceActionsInput, _a2 ...request.Option) (*rds.DescribePendingMaintenanceActionsOutput, error) {
+// DescribeDBSubnetGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeDBSubnetGroupsWithContext(_a0 context.Context, _a1 *rds.DescribeDBSubnetGroupsInput, _a2 ...request.Option) (*rds.DescribeDBSubnetGroupsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -7287,17 +7311,17 @@ func (_m *RDSAPI) DescribePendingMaintenanceActionsWithContext(_a0 context.Conte
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribePendingMaintenanceActionsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribePendingMaintenanceActionsInput, ...request.Option) *rds.DescribePendingMaintenanceActionsOutput); ok {
+	var r0 *rds.DescribeDBSubnetGroupsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBSubnetGroupsInput, ...request.Option) *rds.DescribeDBSubnetGroupsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribePendingMaintenanceActionsOutput)
+			r0 = ret.Get(0).(*rds.DescribeDBSubnetGroupsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribePendingMaintenanceActionsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeDBSubnetGroupsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -7306,21 +7330,21 @@ func (_m *RDSAPI) DescribePendingMaintenanceActionsWithContext(_a0 context.Conte
 	return r0, r1
 }
 
-// DescribeReservedDBInstances provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeReservedDBInstances(_a0 *rds.DescribeReservedDBInstancesInput) (*rds.DescribeReservedDBInstancesOutput, error) {
+// DescribeEngineDefaultClusterParameters provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeEngineDefaultClusterParameters(_a0 *rds.DescribeEngineDefaultClusterParametersInput) (*rds.DescribeEngineDefaultClusterParametersOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeReservedDBInstancesOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeReservedDBInstancesInput) *rds.DescribeReservedDBInstancesOutput); ok {
+	var r0 *rds.DescribeEngineDefaultClusterParametersOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeEngineDefaultClusterParametersInput) *rds.DescribeEngineDefaultClusterParametersOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeReservedDBInstancesOutput)
+			r0 = ret.Get(0).(*rds.DescribeEngineDefaultClusterParametersOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeReservedDBInstancesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeEngineDefaultClusterParametersInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -7329,21 +7353,76 @@ func (_m *RDSAPI) DescribeReservedDBInstances(_a0 *rds.DescribeReservedDBInstanc
 	return r0, r1
 }
 
-// DescribeReservedDBInstancesOfferings provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeReservedDBInstancesOfferings(_a0 *rds.DescribeReservedDBInstancesOfferingsInput) (*rds.DescribeReservedDBInstancesOfferingsOutput, error) {
+// DescribeEngineDefaultClusterParametersRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeEngineDefaultClusterParametersRequest(_a0 *rds.DescribeEngineDefaultClusterParametersInput) (*request.Request, *rds.DescribeEngineDefaultClusterParametersOutput) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeReservedDBInstancesOfferingsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeReservedDBInstancesOfferingsInput) *rds.DescribeReservedDBInstancesOfferingsOutput); ok {
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DescribeEngineDefaultClusterParametersInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeReservedDBInstancesOfferingsOutput)
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.DescribeEngineDefaultClusterParametersOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeEngineDefaultClusterParametersInput) *rds.DescribeEngineDefaultClusterParametersOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DescribeEngineDefaultClusterParametersOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeEngineDefaultClusterParametersWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeEngineDefaultClusterParametersWithContext(_a0 context.Context, _a1 *rds.DescribeEngineDefaultClusterParametersInput, _a2 ...request.Option) (*rds.DescribeEngineDefaultClusterParametersOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.DescribeEngineDefaultClusterParametersOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeEngineDefaultClusterParametersInput, ...request.Option) *rds.DescribeEngineDefaultClusterParametersOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeEngineDefaultClusterParametersOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeReservedDBInstancesOfferingsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeEngineDefaultClusterParametersInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEngineDefaultParameters provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeEngineDefaultParameters(_a0 *rds.DescribeEngineDefaultParametersInput) (*rds.DescribeEngineDefaultParametersOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DescribeEngineDefaultParametersOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeEngineDefaultParametersInput) *rds.DescribeEngineDefaultParametersOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeEngineDefaultParametersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.DescribeEngineDefaultParametersInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -7352,12 +7431,12 @@ func (_m *RDSAPI) DescribeReservedDBInstancesOfferings(_a0 *rds.DescribeReserved
 	return r0, r1
 }
 
-// DescribeReservedDBInstancesOfferingsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeReservedDBInstancesOfferingsPages(_a0 *rds.DescribeReservedDBInstancesOfferingsInput, _a1 func(*rds.DescribeReservedDBInstancesOfferingsOutput, bool) bool) error {
+// DescribeEngineDefaultParametersPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeEngineDefaultParametersPages(_a0 *rds.DescribeEngineDefaultParametersInput, _a1 func(*rds.DescribeEngineDefaultParametersOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeReservedDBInstancesOfferingsInput, func(*rds.DescribeReservedDBInstancesOfferingsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeEngineDefaultParametersInput, func(*rds.DescribeEngineDefaultParametersOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -7366,8 +7445,8 @@ func (_m *RDSAPI) DescribeReservedDBInstancesOfferingsPages(_a0 *rds.DescribeRes
 	return r0
 }
 
-// DescribeReservedDBInstancesOfferingsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeReservedDBInstancesOfferingsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeReservedDBInstancesOfferingsInput, _a2 func(*rds.DescribeReservedDBInstancesOfferingsOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeEngineDefaultParametersPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeEngineDefaultParametersPagesWithContext(_a0 context.Context, _a1 *rds.DescribeEngineDefaultParametersInput, _a2 func(*rds.DescribeEngineDefaultParametersOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -7378,7 +7457,7 @@ func (_m *RDSAPI) DescribeReservedDBInstancesOfferingsPagesWithContext(_a0 conte
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeReservedDBInstancesOfferingsInput, func(*rds.DescribeReservedDBInstancesOfferingsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeEngineDefaultParametersInput, func(*rds.DescribeEngineDefaultParametersOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -7387,12 +7466,12 @@ func (_m *RDSAPI) DescribeReservedDBInstancesOfferingsPagesWithContext(_a0 conte
 	return r0
 }
 
-// DescribeReservedDBInstancesOfferingsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeReservedDBInstancesOfferingsRequest(_a0 *rds.DescribeReservedDBInstancesOfferingsInput) (*request.Request, *rds.DescribeReservedDBInstancesOfferingsOutput) {
+// DescribeEngineDefaultParametersRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeEngineDefaultParametersRequest(_a0 *rds.DescribeEngineDefaultParametersInput) (*request.Request, *rds.DescribeEngineDefaultParametersOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeReservedDBInstancesOfferingsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeEngineDefaultParametersInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -7400,20 +7479,20 @@ func (_m *RDSAPI) DescribeReservedDBInstancesOfferingsRequest(_a0 *rds.DescribeR
 		}
 	}
 
-	var r1 *rds.DescribeReservedDBInstancesOfferingsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeReservedDBInstancesOfferingsInput) *rds.DescribeReservedDBInstancesOfferingsOutput); ok {
+	var r1 *rds.DescribeEngineDefaultParametersOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeEngineDefaultParametersInput) *rds.DescribeEngineDefaultParametersOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeReservedDBInstancesOfferingsOutput)
+			r1 = ret.Get(1).(*rds.DescribeEngineDefaultParametersOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeReservedDBInstancesOfferingsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeReservedDBInstancesOfferingsWithContext(_a0 context.Context, _a1 *rds.DescribeReservedDBInstancesOfferingsInput, _a2 ...request.Option) (*rds.DescribeReservedDBInstancesOfferingsOutput, error) {
+// DescribeEngineDefaultParametersWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeEngineDefaultParametersWithContext(_a0 context.Context, _a1 *rds.DescribeEngineDefaultParametersInput, _a2 ...request.Option) (*rds.DescribeEngineDefaultParametersOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -7423,17 +7502,17 @@ func (_m *RDSAPI) DescribeReservedDBInstancesOfferingsWithContext(_a0 context.Co
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeReservedDBInstancesOfferingsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeReservedDBInstancesOfferingsInput, ...request.Option) *rds.DescribeReservedDBInstancesOfferingsOutput); ok {
+	var r0 *rds.DescribeEngineDefaultParametersOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeEngineDefaultParametersInput, ...request.Option) *rds.DescribeEngineDefaultParametersOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeReservedDBInstancesOfferingsOutput)
+			r0 = ret.Get(0).(*rds.DescribeEngineDefaultParametersOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeReservedDBInstancesOfferingsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeEngineDefaultParametersInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -7442,47 +7521,35 @@ func (_m *RDSAPI) DescribeReservedDBInstancesOfferingsWithContext(_a0 context.Co
 	return r0, r1
 }
 
-// DescribeReservedDBInstancesPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeReservedDBInstancesPages(_a0 *rds.DescribeReservedDBInstancesInput, _a1 func(*rds.DescribeReservedDBInstancesOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
+// DescribeEventCategories provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeEventCategories(_a0 *rds.DescribeEventCategoriesInput) (*rds.DescribeEventCategoriesOutput, error) {
+	ret := _m.Called(_a0)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeReservedDBInstancesInput, func(*rds.DescribeReservedDBInstancesOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
+	var r0 *rds.DescribeEventCategoriesOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeEventCategoriesInput) *rds.DescribeEventCategoriesOutput); ok {
+		r0 = rf(_a0)
 	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DescribeReservedDBInstancesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeReservedDBInstancesPagesWithContext(_a0 context.Context, _a1 *rds.DescribeReservedDBInstancesInput, _a2 func(*rds.DescribeReservedDBInstancesOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeEventCategoriesOutput)
+		}
 	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeReservedDBInstancesInput, func(*rds.DescribeReservedDBInstancesOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.DescribeEventCategoriesInput) error); ok {
+		r1 = rf(_a0)
 	} else {
-		r0 = ret.Error(0)
+		r1 = ret.Error(1)
 	}
 
-	return r0
+	return r0, r1
 }
 
-// DescribeReservedDBInstancesRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeReservedDBInstancesRequest(_a0 *rds.DescribeReservedDBInstancesInput) (*request.Request, *rds.DescribeReservedDBInstancesOutput) {
+// DescribeEventCategoriesRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeEventCategoriesRequest(_a0 *rds.DescribeEventCategoriesInput) (*request.Request, *rds.DescribeEventCategoriesOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeReservedDBInstancesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeEventCategoriesInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -7490,20 +7557,20 @@ func (_m *RDSAPI) DescribeReservedDBInstancesRequest(_a0 *rds.DescribeReservedDB
 		}
 	}
 
-	var r1 *rds.DescribeReservedDBInstancesOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeReservedDBInstancesInput) *rds.DescribeReservedDBInstancesOutput); ok {
+	var r1 *rds.DescribeEventCategoriesOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeEventCategoriesInput) *rds.DescribeEventCategoriesOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeReservedDBInstancesOutput)
+			r1 = ret.Get(1).(*rds.DescribeEventCategoriesOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeReservedDBInstancesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeReservedDBInstancesWithContext(_a0 context.Context, _a1 *rds.DescribeReservedDBInstancesInput, _a2 ...request.Option) (*rds.DescribeReservedDBInstancesOutput, error) {
+// DescribeEventCategoriesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeEventCategoriesWithContext(_a0 context.Context, _a1 *rds.DescribeEventCategoriesInput, _a2 ...request.Option) (*rds.DescribeEventCategoriesOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -7513,17 +7580,17 @@ func (_m *RDSAPI) DescribeReservedDBInstancesWithContext(_a0 context.Context, _a
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeReservedDBInstancesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeReservedDBInstancesInput, ...request.Option) *rds.DescribeReservedDBInstancesOutput); ok {
+	var r0 *rds.DescribeEventCategoriesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeEventCategoriesInput, ...request.Option) *rds.DescribeEventCategoriesOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeReservedDBInstancesOutput)
+			r0 = ret.Get(0).(*rds.DescribeEventCategoriesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeReservedDBInstancesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeEventCategoriesInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -7532,21 +7599,21 @@ func (_m *RDSAPI) DescribeReservedDBInstancesWithContext(_a0 context.Context, _a
 	return r0, r1
 }
 
-// DescribeSourceRegions provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeSourceRegions(_a0 *rds.DescribeSourceRegionsInput) (*rds.DescribeSourceRegionsOutput, error) {
+// DescribeEventSubscriptions provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeEventSubscriptions(_a0 *rds.DescribeEventSubscriptionsInput) (*rds.DescribeEventSubscriptionsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeSourceRegionsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeSourceRegionsInput) *rds.DescribeSourceRegionsOutput); ok {
+	var r0 *rds.DescribeEventSubscriptionsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeEventSubscriptionsInput) *rds.DescribeEventSubscriptionsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeSourceRegionsOutput)
+			r0 = ret.Get(0).(*rds.DescribeEventSubscriptionsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeSourceRegionsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeEventSubscriptionsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -7555,12 +7622,12 @@ func (_m *RDSAPI) DescribeSourceRegions(_a0 *rds.DescribeSourceRegionsInput) (*r
 	return r0, r1
 }
 
-// DescribeSourceRegionsPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DescribeSourceRegionsPages(_a0 *rds.DescribeSourceRegionsInput, _a1 func(*rds.DescribeSourceRegionsOutput, bool) bool) error {
+// DescribeEventSubscriptionsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeEventSubscriptionsPages(_a0 *rds.DescribeEventSubscriptionsInput, _a1 func(*rds.DescribeEventSubscriptionsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DescribeSourceRegionsInput, func(*rds.DescribeSourceRegionsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeEventSubscriptionsInput, func(*rds.DescribeEventSubscriptionsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -7569,8 +7636,8 @@ func (_m *RDSAPI) DescribeSourceRegionsPages(_a0 *rds.DescribeSourceRegionsInput
 	return r0
 }
 
-// DescribeSourceRegionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DescribeSourceRegionsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeSourceRegionsInput, _a2 func(*rds.DescribeSourceRegionsOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeEventSubscriptionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeEventSubscriptionsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeEventSubscriptionsInput, _a2 func(*rds.DescribeEventSubscriptionsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -7581,7 +7648,7 @@ func (_m *RDSAPI) DescribeSourceRegionsPagesWithContext(_a0 context.Context, _a1
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeSourceRegionsInput, func(*rds.DescribeSourceRegionsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeEventSubscriptionsInput, func(*rds.DescribeEventSubscriptionsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -7590,12 +7657,12 @@ func (_m *RDSAPI) DescribeSourceRegionsPagesWithContext(_a0 context.Context, _a1
 	return r0
 }
 
-// DescribeSourceRegionsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeSourceRegionsRequest(_a0 *rds.DescribeSourceRegionsInput) (*request.Request, *rds.DescribeSourceRegionsOutput) {
+// DescribeEventSubscriptionsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeEventSubscriptionsRequest(_a0 *rds.DescribeEventSubscriptionsInput) (*request.Request, *rds.DescribeEventSubscriptionsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeSourceRegionsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeEventSubscriptionsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -7603,20 +7670,20 @@ func (_m *RDSAPI) DescribeSourceRegionsRequest(_a0 *rds.DescribeSourceRegionsInp
 		}
 	}
 
-	var r1 *rds.DescribeSourceRegionsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeSourceRegionsInput) *rds.DescribeSourceRegionsOutput); ok {
+	var r1 *rds.DescribeEventSubscriptionsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeEventSubscriptionsInput) *rds.DescribeEventSubscriptionsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeSourceRegionsOutput)
+			r1 = ret.Get(1).(*rds.DescribeEventSubscriptionsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeSourceRegionsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeSourceRegionsWithContext(_a0 context.Context, _a1 *rds.DescribeSourceRegionsInput, _a2 ...request.Option) (*rds.DescribeSourceRegionsOutput, error) {
+// DescribeEventSubscriptionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeEventSubscriptionsWithContext(_a0 context.Context, _a1 *rds.DescribeEventSubscriptionsInput, _a2 ...request.Option) (*rds.DescribeEventSubscriptionsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -7626,17 +7693,17 @@ func (_m *RDSAPI) DescribeSourceRegionsWithContext(_a0 context.Context, _a1 *rds
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeSourceRegionsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeSourceRegionsInput, ...request.Option) *rds.DescribeSourceRegionsOutput); ok {
+	var r0 *rds.DescribeEventSubscriptionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeEventSubscriptionsInput, ...request.Option) *rds.DescribeEventSubscriptionsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeSourceRegionsOutput)
+			r0 = ret.Get(0).(*rds.DescribeEventSubscriptionsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeSourceRegionsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeEventSubscriptionsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -7645,21 +7712,21 @@ func (_m *RDSAPI) DescribeSourceRegionsWithContext(_a0 context.Context, _a1 *rds
 	return r0, r1
 }
 
-// DescribeValidDBInstanceModifications provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeValidDBInstanceModifications(_a0 *rds.DescribeValidDBInstanceModificationsInput) (*rds.DescribeValidDBInstanceModificationsOutput, error) {
+// DescribeEvents provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeEvents(_a0 *rds.DescribeEventsInput) (*rds.DescribeEventsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DescribeValidDBInstanceModificationsOutput
-	if rf, ok := ret.Get(0).(func(*rds.DescribeValidDBInstanceModificationsInput) *rds.DescribeValidDBInstanceModificationsOutput); ok {
+	var r0 *rds.DescribeEventsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeEventsInput) *rds.DescribeEventsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeValidDBInstanceModificationsOutput)
+			r0 = ret.Get(0).(*rds.DescribeEventsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DescribeValidDBInstanceModificationsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeEventsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -7668,12 +7735,47 @@ func (_m *RDSAPI) DescribeValidDBInstanceModifications(_a0 *rds.DescribeValidDBI
 	return r0, r1
 }
 
-// DescribeValidDBInstanceModificationsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DescribeValidDBInstanceModificationsRequest(_a0 *rds.DescribeValidDBInstanceModificationsInput) (*request.Request, *rds.DescribeValidDBInstanceModificationsOutput) {
+// DescribeEventsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeEventsPages(_a0 *rds.DescribeEventsInput, _a1 func(*rds.DescribeEventsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DescribeEventsInput, func(*rds.DescribeEventsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeEventsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeEventsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeEventsInput, _a2 func(*rds.DescribeEventsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeEventsInput, func(*rds.DescribeEventsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeEventsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeEventsRequest(_a0 *rds.DescribeEventsInput) (*request.Request, *rds.DescribeEventsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DescribeValidDBInstanceModificationsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeEventsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -7681,20 +7783,20 @@ func (_m *RDSAPI) DescribeValidDBInstanceModificationsRequest(_a0 *rds.DescribeV
 		}
 	}
 
-	var r1 *rds.DescribeValidDBInstanceModificationsOutput
-	if rf, ok := ret.Get(1).(func(*rds.DescribeValidDBInstanceModificationsInput) *rds.DescribeValidDBInstanceModificationsOutput); ok {
+	var r1 *rds.DescribeEventsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeEventsInput) *rds.DescribeEventsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DescribeValidDBInstanceModificationsOutput)
+			r1 = ret.Get(1).(*rds.DescribeEventsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeValidDBInstanceModificationsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DescribeValidDBInstanceModificationsWithContext(_a0 context.Context, _a1 *rds.DescribeValidDBInstanceModificationsInput, _a2 ...request.Option) (*rds.DescribeValidDBInstanceModificationsOutput, error) {
+// DescribeEventsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeEventsWithContext(_a0 context.Context, _a1 *rds.DescribeEventsInput, _a2 ...request.Option) (*rds.DescribeEventsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -7704,17 +7806,17 @@ func (_m *RDSAPI) DescribeValidDBInstanceModificationsWithContext(_a0 context.Co
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DescribeValidDBInstanceModificationsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeValidDBInstanceModificationsInput, ...request.Option) *rds.DescribeValidDBInstanceModificationsOutput); ok {
+	var r0 *rds.DescribeEventsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeEventsInput, ...request.Option) *rds.DescribeEventsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DescribeValidDBInstanceModificationsOutput)
+			r0 = ret.Get(0).(*rds.DescribeEventsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeValidDBInstanceModificationsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeEventsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -7723,21 +7825,21 @@ func (_m *RDSAPI) DescribeValidDBInstanceModificationsWithContext(_a0 context.Co
 	return r0, r1
 }
 
-// DownloadDBLogFilePortion provides a mock function with given fields: _a0
-func (_m *RDSAPI) DownloadDBLogFilePortion(_a0 *rds.DownloadDBLogFilePortionInput) (*rds.DownloadDBLogFilePortionOutput, error) {
+// DescribeExportTasks provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeExportTasks(_a0 *rds.DescribeExportTasksInput) (*rds.DescribeExportTasksOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DownloadDBLogFilePortionOutput
-	if rf, ok := ret.Get(0).(func(*rds.DownloadDBLogFilePortionInput) *rds.DownloadDBLogFilePortionOutput); ok {
+	var r0 *rds.DescribeExportTasksOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeExportTasksInput) *rds.DescribeExportTasksOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DownloadDBLogFilePortionOutput)
+			r0 = ret.Get(0).(*rds.DescribeExportTasksOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.DownloadDBLogFilePortionInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.DescribeExportTasksInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -7746,12 +7848,12 @@ func (_m *RDSAPI) DownloadDBLogFilePortion(_a0 *rds.DownloadDBLogFilePortionInpu
 	return r0, r1
 }
 
-// DownloadDBLogFilePortionPages provides a mock function with given fields: _a0, _a1
-func (_m *RDSAPI) DownloadDBLogFilePortionPages(_a0 *rds.DownloadDBLogFilePortionInput, _a1 func(*rds.DownloadDBLogFilePortionOutput, bool) bool) error {
+// DescribeExportTasksPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeExportTasksPages(_a0 *rds.DescribeExportTasksInput, _a1 func(*rds.DescribeExportTasksOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*rds.DownloadDBLogFilePortionInput, func(*rds.DownloadDBLogFilePortionOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*rds.DescribeExportTasksInput, func(*rds.DescribeExportTasksOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -7760,8 +7862,8 @@ func (_m *RDSAPI) DownloadDBLogFilePortionPages(_a0 *rds.DownloadDBLogFilePortio
 	return r0
 }
 
-// DownloadDBLogFilePortionPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *RDSAPI) DownloadDBLogFilePortionPagesWithContext(_a0 context.Context, _a1 *rds.DownloadDBLogFilePortionInput, _a2 func(*rds.DownloadDBLogFilePortionOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeExportTasksPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeExportTasksPagesWithContext(_a0 context.Context, _a1 *rds.DescribeExportTasksInput, _a2 func(*rds.DescribeExportTasksOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -7772,7 +7874,7 @@ func (_m *RDSAPI) DownloadDBLogFilePortionPagesWithContext(_a0 context.Context,
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DownloadDBLogFilePortionInput, func(*rds.DownloadDBLogFilePortionOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeExportTasksInput, func(*rds.DescribeExportTasksOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -7781,33 +7883,2758 @@ func (_m *RDSAPI) DownloadDBLogFilePortionPagesWithContext(_a0 context.Context,
 	return r0
 }
 
-// DownloadDBLogFilePortionRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) DownloadDBLogFilePortionRequest(_a0 *rds.DownloadDBLogFilePortionInput) (*request.Request, *rds.DownloadDBLogFilePortionOutput) {
+// DescribeExportTasksRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeExportTasksRequest(_a0 *rds.DescribeExportTasksInput) (*request.Request, *rds.DescribeExportTasksOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DescribeExportTasksInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.DescribeExportTasksOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeExportTasksInput) *rds.DescribeExportTasksOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DescribeExportTasksOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeExportTasksWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeExportTasksWithContext(_a0 context.Context, _a1 *rds.DescribeExportTasksInput, _a2 ...request.Option) (*rds.DescribeExportTasksOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.DescribeExportTasksOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeExportTasksInput, ...request.Option) *rds.DescribeExportTasksOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeExportTasksOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeExportTasksInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeGlobalClusters provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeGlobalClusters(_a0 *rds.DescribeGlobalClustersInput) (*rds.DescribeGlobalClustersOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DescribeGlobalClustersOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeGlobalClustersInput) *rds.DescribeGlobalClustersOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeGlobalClustersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.DescribeGlobalClustersInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeGlobalClustersPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeGlobalClustersPages(_a0 *rds.DescribeGlobalClustersInput, _a1 func(*rds.DescribeGlobalClustersOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DescribeGlobalClustersInput, func(*rds.DescribeGlobalClustersOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeGlobalClustersPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeGlobalClustersPagesWithContext(_a0 context.Context, _a1 *rds.DescribeGlobalClustersInput, _a2 func(*rds.DescribeGlobalClustersOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeGlobalClustersInput, func(*rds.DescribeGlobalClustersOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeGlobalClustersRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeGlobalClustersRequest(_a0 *rds.DescribeGlobalClustersInput) (*request.Request, *rds.DescribeGlobalClustersOutput) {
 	ret := _m.Called(_a0)
 
-	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.DownloadDBLogFilePortionInput) *request.Request); ok {
-		r0 = rf(_a0)
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DescribeGlobalClustersInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.DescribeGlobalClustersOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeGlobalClustersInput) *rds.DescribeGlobalClustersOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DescribeGlobalClustersOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeGlobalClustersWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeGlobalClustersWithContext(_a0 context.Context, _a1 *rds.DescribeGlobalClustersInput, _a2 ...request.Option) (*rds.DescribeGlobalClustersOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.DescribeGlobalClustersOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeGlobalClustersInput, ...request.Option) *rds.DescribeGlobalClustersOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeGlobalClustersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeGlobalClustersInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeIntegrations provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeIntegrations(_a0 *rds.DescribeIntegrationsInput) (*rds.DescribeIntegrationsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DescribeIntegrationsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeIntegrationsInput) *rds.DescribeIntegrationsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeIntegrationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.DescribeIntegrationsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeIntegrationsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeIntegrationsPages(_a0 *rds.DescribeIntegrationsInput, _a1 func(*rds.DescribeIntegrationsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DescribeIntegrationsInput, func(*rds.DescribeIntegrationsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeIntegrationsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeIntegrationsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeIntegrationsInput, _a2 func(*rds.DescribeIntegrationsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeIntegrationsInput, func(*rds.DescribeIntegrationsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeIntegrationsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeIntegrationsRequest(_a0 *rds.DescribeIntegrationsInput) (*request.Request, *rds.DescribeIntegrationsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DescribeIntegrationsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.DescribeIntegrationsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeIntegrationsInput) *rds.DescribeIntegrationsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DescribeIntegrationsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeIntegrationsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeIntegrationsWithContext(_a0 context.Context, _a1 *rds.DescribeIntegrationsInput, _a2 ...request.Option) (*rds.DescribeIntegrationsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.DescribeIntegrationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeIntegrationsInput, ...request.Option) *rds.DescribeIntegrationsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeIntegrationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeIntegrationsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeOptionGroupOptions provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeOptionGroupOptions(_a0 *rds.DescribeOptionGroupOptionsInput) (*rds.DescribeOptionGroupOptionsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DescribeOptionGroupOptionsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeOptionGroupOptionsInput) *rds.DescribeOptionGroupOptionsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeOptionGroupOptionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.DescribeOptionGroupOptionsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeOptionGroupOptionsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeOptionGroupOptionsPages(_a0 *rds.DescribeOptionGroupOptionsInput, _a1 func(*rds.DescribeOptionGroupOptionsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DescribeOptionGroupOptionsInput, func(*rds.DescribeOptionGroupOptionsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeOptionGroupOptionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeOptionGroupOptionsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeOptionGroupOptionsInput, _a2 func(*rds.DescribeOptionGroupOptionsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeOptionGroupOptionsInput, func(*rds.DescribeOptionGroupOptionsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeOptionGroupOptionsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeOptionGroupOptionsRequest(_a0 *rds.DescribeOptionGroupOptionsInput) (*request.Request, *rds.DescribeOptionGroupOptionsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DescribeOptionGroupOptionsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.DescribeOptionGroupOptionsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeOptionGroupOptionsInput) *rds.DescribeOptionGroupOptionsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DescribeOptionGroupOptionsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeOptionGroupOptionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeOptionGroupOptionsWithContext(_a0 context.Context, _a1 *rds.DescribeOptionGroupOptionsInput, _a2 ...request.Option) (*rds.DescribeOptionGroupOptionsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.DescribeOptionGroupOptionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeOptionGroupOptionsInput, ...request.Option) *rds.DescribeOptionGroupOptionsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeOptionGroupOptionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeOptionGroupOptionsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeOptionGroups provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeOptionGroups(_a0 *rds.DescribeOptionGroupsInput) (*rds.DescribeOptionGroupsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DescribeOptionGroupsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeOptionGroupsInput) *rds.DescribeOptionGroupsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeOptionGroupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.DescribeOptionGroupsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeOptionGroupsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeOptionGroupsPages(_a0 *rds.DescribeOptionGroupsInput, _a1 func(*rds.DescribeOptionGroupsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DescribeOptionGroupsInput, func(*rds.DescribeOptionGroupsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeOptionGroupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeOptionGroupsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeOptionGroupsInput, _a2 func(*rds.DescribeOptionGroupsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeOptionGroupsInput, func(*rds.DescribeOptionGroupsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeOptionGroupsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeOptionGroupsRequest(_a0 *rds.DescribeOptionGroupsInput) (*request.Request, *rds.DescribeOptionGroupsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DescribeOptionGroupsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.DescribeOptionGroupsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeOptionGroupsInput) *rds.DescribeOptionGroupsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DescribeOptionGroupsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeOptionGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeOptionGroupsWithContext(_a0 context.Context, _a1 *rds.DescribeOptionGroupsInput, _a2 ...request.Option) (*rds.DescribeOptionGroupsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.DescribeOptionGroupsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeOptionGroupsInput, ...request.Option) *rds.DescribeOptionGroupsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeOptionGroupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeOptionGroupsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeOrderableDBInstanceOptions provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeOrderableDBInstanceOptions(_a0 *rds.DescribeOrderableDBInstanceOptionsInput) (*rds.DescribeOrderableDBInstanceOptionsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DescribeOrderableDBInstanceOptionsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeOrderableDBInstanceOptionsInput) *rds.DescribeOrderableDBInstanceOptionsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeOrderableDBInstanceOptionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.DescribeOrderableDBInstanceOptionsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeOrderableDBInstanceOptionsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeOrderableDBInstanceOptionsPages(_a0 *rds.DescribeOrderableDBInstanceOptionsInput, _a1 func(*rds.DescribeOrderableDBInstanceOptionsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DescribeOrderableDBInstanceOptionsInput, func(*rds.DescribeOrderableDBInstanceOptionsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeOrderableDBInstanceOptionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeOrderableDBInstanceOptionsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeOrderableDBInstanceOptionsInput, _a2 func(*rds.DescribeOrderableDBInstanceOptionsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeOrderableDBInstanceOptionsInput, func(*rds.DescribeOrderableDBInstanceOptionsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeOrderableDBInstanceOptionsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeOrderableDBInstanceOptionsRequest(_a0 *rds.DescribeOrderableDBInstanceOptionsInput) (*request.Request, *rds.DescribeOrderableDBInstanceOptionsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DescribeOrderableDBInstanceOptionsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.DescribeOrderableDBInstanceOptionsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeOrderableDBInstanceOptionsInput) *rds.DescribeOrderableDBInstanceOptionsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DescribeOrderableDBInstanceOptionsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeOrderableDBInstanceOptionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeOrderableDBInstanceOptionsWithContext(_a0 context.Context, _a1 *rds.DescribeOrderableDBInstanceOptionsInput, _a2 ...request.Option) (*rds.DescribeOrderableDBInstanceOptionsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.DescribeOrderableDBInstanceOptionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeOrderableDBInstanceOptionsInput, ...request.Option) *rds.DescribeOrderableDBInstanceOptionsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeOrderableDBInstanceOptionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeOrderableDBInstanceOptionsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribePendingMaintenanceActions provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribePendingMaintenanceActions(_a0 *rds.DescribePendingMaintenanceActionsInput) (*rds.DescribePendingMaintenanceActionsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DescribePendingMaintenanceActionsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribePendingMaintenanceActionsInput) *rds.DescribePendingMaintenanceActionsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribePendingMaintenanceActionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.DescribePendingMaintenanceActionsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribePendingMaintenanceActionsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribePendingMaintenanceActionsPages(_a0 *rds.DescribePendingMaintenanceActionsInput, _a1 func(*rds.DescribePendingMaintenanceActionsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DescribePendingMaintenanceActionsInput, func(*rds.DescribePendingMaintenanceActionsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribePendingMaintenanceActionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribePendingMaintenanceActionsPagesWithContext(_a0 context.Context, _a1 *rds.DescribePendingMaintenanceActionsInput, _a2 func(*rds.DescribePendingMaintenanceActionsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribePendingMaintenanceActionsInput, func(*rds.DescribePendingMaintenanceActionsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribePendingMaintenanceActionsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribePendingMaintenanceActionsRequest(_a0 *rds.DescribePendingMaintenanceActionsInput) (*request.Request, *rds.DescribePendingMaintenanceActionsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DescribePendingMaintenanceActionsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.DescribePendingMaintenanceActionsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribePendingMaintenanceActionsInput) *rds.DescribePendingMaintenanceActionsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DescribePendingMaintenanceActionsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribePendingMaintenanceActionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribePendingMaintenanceActionsWithContext(_a0 context.Context, _a1 *rds.DescribePendingMaintenanceActionsInput, _a2 ...request.Option) (*rds.DescribePendingMaintenanceActionsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.DescribePendingMaintenanceActionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribePendingMaintenanceActionsInput, ...request.Option) *rds.DescribePendingMaintenanceActionsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribePendingMaintenanceActionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribePendingMaintenanceActionsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeReservedDBInstances provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeReservedDBInstances(_a0 *rds.DescribeReservedDBInstancesInput) (*rds.DescribeReservedDBInstancesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DescribeReservedDBInstancesOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeReservedDBInstancesInput) *rds.DescribeReservedDBInstancesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeReservedDBInstancesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.DescribeReservedDBInstancesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeReservedDBInstancesOfferings provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeReservedDBInstancesOfferings(_a0 *rds.DescribeReservedDBInstancesOfferingsInput) (*rds.DescribeReservedDBInstancesOfferingsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DescribeReservedDBInstancesOfferingsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeReservedDBInstancesOfferingsInput) *rds.DescribeReservedDBInstancesOfferingsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeReservedDBInstancesOfferingsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.DescribeReservedDBInstancesOfferingsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeReservedDBInstancesOfferingsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeReservedDBInstancesOfferingsPages(_a0 *rds.DescribeReservedDBInstancesOfferingsInput, _a1 func(*rds.DescribeReservedDBInstancesOfferingsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DescribeReservedDBInstancesOfferingsInput, func(*rds.DescribeReservedDBInstancesOfferingsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeReservedDBInstancesOfferingsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeReservedDBInstancesOfferingsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeReservedDBInstancesOfferingsInput, _a2 func(*rds.DescribeReservedDBInstancesOfferingsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeReservedDBInstancesOfferingsInput, func(*rds.DescribeReservedDBInstancesOfferingsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeReservedDBInstancesOfferingsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeReservedDBInstancesOfferingsRequest(_a0 *rds.DescribeReservedDBInstancesOfferingsInput) (*request.Request, *rds.DescribeReservedDBInstancesOfferingsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DescribeReservedDBInstancesOfferingsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.DescribeReservedDBInstancesOfferingsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeReservedDBInstancesOfferingsInput) *rds.DescribeReservedDBInstancesOfferingsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DescribeReservedDBInstancesOfferingsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeReservedDBInstancesOfferingsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeReservedDBInstancesOfferingsWithContext(_a0 context.Context, _a1 *rds.DescribeReservedDBInstancesOfferingsInput, _a2 ...request.Option) (*rds.DescribeReservedDBInstancesOfferingsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.DescribeReservedDBInstancesOfferingsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeReservedDBInstancesOfferingsInput, ...request.Option) *rds.DescribeReservedDBInstancesOfferingsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeReservedDBInstancesOfferingsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeReservedDBInstancesOfferingsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeReservedDBInstancesPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeReservedDBInstancesPages(_a0 *rds.DescribeReservedDBInstancesInput, _a1 func(*rds.DescribeReservedDBInstancesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DescribeReservedDBInstancesInput, func(*rds.DescribeReservedDBInstancesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeReservedDBInstancesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeReservedDBInstancesPagesWithContext(_a0 context.Context, _a1 *rds.DescribeReservedDBInstancesInput, _a2 func(*rds.DescribeReservedDBInstancesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeReservedDBInstancesInput, func(*rds.DescribeReservedDBInstancesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeReservedDBInstancesRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeReservedDBInstancesRequest(_a0 *rds.DescribeReservedDBInstancesInput) (*request.Request, *rds.DescribeReservedDBInstancesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DescribeReservedDBInstancesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.DescribeReservedDBInstancesOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeReservedDBInstancesInput) *rds.DescribeReservedDBInstancesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DescribeReservedDBInstancesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeReservedDBInstancesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeReservedDBInstancesWithContext(_a0 context.Context, _a1 *rds.DescribeReservedDBInstancesInput, _a2 ...request.Option) (*rds.DescribeReservedDBInstancesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.DescribeReservedDBInstancesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeReservedDBInstancesInput, ...request.Option) *rds.DescribeReservedDBInstancesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeReservedDBInstancesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeReservedDBInstancesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeSourceRegions provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeSourceRegions(_a0 *rds.DescribeSourceRegionsInput) (*rds.DescribeSourceRegionsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DescribeSourceRegionsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeSourceRegionsInput) *rds.DescribeSourceRegionsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeSourceRegionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.DescribeSourceRegionsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeSourceRegionsPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeSourceRegionsPages(_a0 *rds.DescribeSourceRegionsInput, _a1 func(*rds.DescribeSourceRegionsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DescribeSourceRegionsInput, func(*rds.DescribeSourceRegionsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeSourceRegionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeSourceRegionsPagesWithContext(_a0 context.Context, _a1 *rds.DescribeSourceRegionsInput, _a2 func(*rds.DescribeSourceRegionsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeSourceRegionsInput, func(*rds.DescribeSourceRegionsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeSourceRegionsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeSourceRegionsRequest(_a0 *rds.DescribeSourceRegionsInput) (*request.Request, *rds.DescribeSourceRegionsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DescribeSourceRegionsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.DescribeSourceRegionsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeSourceRegionsInput) *rds.DescribeSourceRegionsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DescribeSourceRegionsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeSourceRegionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeSourceRegionsWithContext(_a0 context.Context, _a1 *rds.DescribeSourceRegionsInput, _a2 ...request.Option) (*rds.DescribeSourceRegionsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.DescribeSourceRegionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeSourceRegionsInput, ...request.Option) *rds.DescribeSourceRegionsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeSourceRegionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeSourceRegionsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTenantDatabases provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeTenantDatabases(_a0 *rds.DescribeTenantDatabasesInput) (*rds.DescribeTenantDatabasesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DescribeTenantDatabasesOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeTenantDatabasesInput) *rds.DescribeTenantDatabasesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeTenantDatabasesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.DescribeTenantDatabasesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTenantDatabasesPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DescribeTenantDatabasesPages(_a0 *rds.DescribeTenantDatabasesInput, _a1 func(*rds.DescribeTenantDatabasesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DescribeTenantDatabasesInput, func(*rds.DescribeTenantDatabasesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeTenantDatabasesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DescribeTenantDatabasesPagesWithContext(_a0 context.Context, _a1 *rds.DescribeTenantDatabasesInput, _a2 func(*rds.DescribeTenantDatabasesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeTenantDatabasesInput, func(*rds.DescribeTenantDatabasesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeTenantDatabasesRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeTenantDatabasesRequest(_a0 *rds.DescribeTenantDatabasesInput) (*request.Request, *rds.DescribeTenantDatabasesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DescribeTenantDatabasesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.DescribeTenantDatabasesOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeTenantDatabasesInput) *rds.DescribeTenantDatabasesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DescribeTenantDatabasesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeTenantDatabasesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeTenantDatabasesWithContext(_a0 context.Context, _a1 *rds.DescribeTenantDatabasesInput, _a2 ...request.Option) (*rds.DescribeTenantDatabasesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.DescribeTenantDatabasesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeTenantDatabasesInput, ...request.Option) *rds.DescribeTenantDatabasesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeTenantDatabasesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeTenantDatabasesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeValidDBInstanceModifications provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeValidDBInstanceModifications(_a0 *rds.DescribeValidDBInstanceModificationsInput) (*rds.DescribeValidDBInstanceModificationsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DescribeValidDBInstanceModificationsOutput
+	if rf, ok := ret.Get(0).(func(*rds.DescribeValidDBInstanceModificationsInput) *rds.DescribeValidDBInstanceModificationsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeValidDBInstanceModificationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.DescribeValidDBInstanceModificationsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeValidDBInstanceModificationsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DescribeValidDBInstanceModificationsRequest(_a0 *rds.DescribeValidDBInstanceModificationsInput) (*request.Request, *rds.DescribeValidDBInstanceModificationsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DescribeValidDBInstanceModificationsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.DescribeValidDBInstanceModificationsOutput
+	if rf, ok := ret.Get(1).(func(*rds.DescribeValidDBInstanceModificationsInput) *rds.DescribeValidDBInstanceModificationsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DescribeValidDBInstanceModificationsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeValidDBInstanceModificationsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DescribeValidDBInstanceModificationsWithContext(_a0 context.Context, _a1 *rds.DescribeValidDBInstanceModificationsInput, _a2 ...request.Option) (*rds.DescribeValidDBInstanceModificationsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.DescribeValidDBInstanceModificationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeValidDBInstanceModificationsInput, ...request.Option) *rds.DescribeValidDBInstanceModificationsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DescribeValidDBInstanceModificationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DescribeValidDBInstanceModificationsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableHttpEndpoint provides a mock function with given fields: _a0
+func (_m *RDSAPI) DisableHttpEndpoint(_a0 *rds.DisableHttpEndpointInput) (*rds.DisableHttpEndpointOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DisableHttpEndpointOutput
+	if rf, ok := ret.Get(0).(func(*rds.DisableHttpEndpointInput) *rds.DisableHttpEndpointOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DisableHttpEndpointOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.DisableHttpEndpointInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableHttpEndpointRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DisableHttpEndpointRequest(_a0 *rds.DisableHttpEndpointInput) (*request.Request, *rds.DisableHttpEndpointOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DisableHttpEndpointInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.DisableHttpEndpointOutput
+	if rf, ok := ret.Get(1).(func(*rds.DisableHttpEndpointInput) *rds.DisableHttpEndpointOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DisableHttpEndpointOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DisableHttpEndpointWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DisableHttpEndpointWithContext(_a0 context.Context, _a1 *rds.DisableHttpEndpointInput, _a2 ...request.Option) (*rds.DisableHttpEndpointOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.DisableHttpEndpointOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DisableHttpEndpointInput, ...request.Option) *rds.DisableHttpEndpointOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DisableHttpEndpointOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DisableHttpEndpointInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DownloadDBLogFilePortion provides a mock function with given fields: _a0
+func (_m *RDSAPI) DownloadDBLogFilePortion(_a0 *rds.DownloadDBLogFilePortionInput) (*rds.DownloadDBLogFilePortionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DownloadDBLogFilePortionOutput
+	if rf, ok := ret.Get(0).(func(*rds.DownloadDBLogFilePortionInput) *rds.DownloadDBLogFilePortionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DownloadDBLogFilePortionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.DownloadDBLogFilePortionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DownloadDBLogFilePortionPages provides a mock function with given fields: _a0, _a1
+func (_m *RDSAPI) DownloadDBLogFilePortionPages(_a0 *rds.DownloadDBLogFilePortionInput, _a1 func(*rds.DownloadDBLogFilePortionOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DownloadDBLogFilePortionInput, func(*rds.DownloadDBLogFilePortionOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DownloadDBLogFilePortionPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RDSAPI) DownloadDBLogFilePortionPagesWithContext(_a0 context.Context, _a1 *rds.DownloadDBLogFilePortionInput, _a2 func(*rds.DownloadDBLogFilePortionOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DownloadDBLogFilePortionInput, func(*rds.DownloadDBLogFilePortionOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DownloadDBLogFilePortionRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) DownloadDBLogFilePortionRequest(_a0 *rds.DownloadDBLogFilePortionInput) (*request.Request, *rds.DownloadDBLogFilePortionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.DownloadDBLogFilePortionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.DownloadDBLogFilePortionOutput
+	if rf, ok := ret.Get(1).(func(*rds.DownloadDBLogFilePortionInput) *rds.DownloadDBLogFilePortionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DownloadDBLogFilePortionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DownloadDBLogFilePortionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) DownloadDBLogFilePortionWithContext(_a0 context.Context, _a1 *rds.DownloadDBLogFilePortionInput, _a2 ...request.Option) (*rds.DownloadDBLogFilePortionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.DownloadDBLogFilePortionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DownloadDBLogFilePortionInput, ...request.Option) *rds.DownloadDBLogFilePortionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DownloadDBLogFilePortionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.DownloadDBLogFilePortionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnableHttpEndpoint provides a mock function with given fields: _a0
+func (_m *RDSAPI) EnableHttpEndpoint(_a0 *rds.EnableHttpEndpointInput) (*rds.EnableHttpEndpointOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.EnableHttpEndpointOutput
+	if rf, ok := ret.Get(0).(func(*rds.EnableHttpEndpointInput) *rds.EnableHttpEndpointOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.EnableHttpEndpointOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.EnableHttpEndpointInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnableHttpEndpointRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) EnableHttpEndpointRequest(_a0 *rds.EnableHttpEndpointInput) (*request.Request, *rds.EnableHttpEndpointOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.EnableHttpEndpointInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.EnableHttpEndpointOutput
+	if rf, ok := ret.Get(1).(func(*rds.EnableHttpEndpointInput) *rds.EnableHttpEndpointOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.EnableHttpEndpointOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// EnableHttpEndpointWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) EnableHttpEndpointWithContext(_a0 context.Context, _a1 *rds.EnableHttpEndpointInput, _a2 ...request.Option) (*rds.EnableHttpEndpointOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.EnableHttpEndpointOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.EnableHttpEndpointInput, ...request.Option) *rds.EnableHttpEndpointOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.EnableHttpEndpointOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.EnableHttpEndpointInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FailoverDBCluster provides a mock function with given fields: _a0
+func (_m *RDSAPI) FailoverDBCluster(_a0 *rds.FailoverDBClusterInput) (*rds.FailoverDBClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.FailoverDBClusterOutput
+	if rf, ok := ret.Get(0).(func(*rds.FailoverDBClusterInput) *rds.FailoverDBClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.FailoverDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.FailoverDBClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FailoverDBClusterRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) FailoverDBClusterRequest(_a0 *rds.FailoverDBClusterInput) (*request.Request, *rds.FailoverDBClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.FailoverDBClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.FailoverDBClusterOutput
+	if rf, ok := ret.Get(1).(func(*rds.FailoverDBClusterInput) *rds.FailoverDBClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.FailoverDBClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// FailoverDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) FailoverDBClusterWithContext(_a0 context.Context, _a1 *rds.FailoverDBClusterInput, _a2 ...request.Option) (*rds.FailoverDBClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.FailoverDBClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.FailoverDBClusterInput, ...request.Option) *rds.FailoverDBClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.FailoverDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.FailoverDBClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FailoverGlobalCluster provides a mock function with given fields: _a0
+func (_m *RDSAPI) FailoverGlobalCluster(_a0 *rds.FailoverGlobalClusterInput) (*rds.FailoverGlobalClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.FailoverGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(*rds.FailoverGlobalClusterInput) *rds.FailoverGlobalClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.FailoverGlobalClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.FailoverGlobalClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FailoverGlobalClusterRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) FailoverGlobalClusterRequest(_a0 *rds.FailoverGlobalClusterInput) (*request.Request, *rds.FailoverGlobalClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.FailoverGlobalClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.FailoverGlobalClusterOutput
+	if rf, ok := ret.Get(1).(func(*rds.FailoverGlobalClusterInput) *rds.FailoverGlobalClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.FailoverGlobalClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// FailoverGlobalClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) FailoverGlobalClusterWithContext(_a0 context.Context, _a1 *rds.FailoverGlobalClusterInput, _a2 ...request.Option) (*rds.FailoverGlobalClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.FailoverGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.FailoverGlobalClusterInput, ...request.Option) *rds.FailoverGlobalClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.FailoverGlobalClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.FailoverGlobalClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTagsForResource provides a mock function with given fields: _a0
+func (_m *RDSAPI) ListTagsForResource(_a0 *rds.ListTagsForResourceInput) (*rds.ListTagsForResourceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.ListTagsForResourceOutput
+	if rf, ok := ret.Get(0).(func(*rds.ListTagsForResourceInput) *rds.ListTagsForResourceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ListTagsForResourceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.ListTagsForResourceInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTagsForResourceRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ListTagsForResourceRequest(_a0 *rds.ListTagsForResourceInput) (*request.Request, *rds.ListTagsForResourceOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.ListTagsForResourceInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.ListTagsForResourceOutput
+	if rf, ok := ret.Get(1).(func(*rds.ListTagsForResourceInput) *rds.ListTagsForResourceOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.ListTagsForResourceOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListTagsForResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ListTagsForResourceWithContext(_a0 context.Context, _a1 *rds.ListTagsForResourceInput, _a2 ...request.Option) (*rds.ListTagsForResourceOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.ListTagsForResourceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ListTagsForResourceInput, ...request.Option) *rds.ListTagsForResourceOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ListTagsForResourceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ListTagsForResourceInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyActivityStream provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyActivityStream(_a0 *rds.ModifyActivityStreamInput) (*rds.ModifyActivityStreamOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.ModifyActivityStreamOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyActivityStreamInput) *rds.ModifyActivityStreamOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyActivityStreamOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.ModifyActivityStreamInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyActivityStreamRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyActivityStreamRequest(_a0 *rds.ModifyActivityStreamInput) (*request.Request, *rds.ModifyActivityStreamOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.ModifyActivityStreamInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.ModifyActivityStreamOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyActivityStreamInput) *rds.ModifyActivityStreamOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.ModifyActivityStreamOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyActivityStreamWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyActivityStreamWithContext(_a0 context.Context, _a1 *rds.ModifyActivityStreamInput, _a2 ...request.Option) (*rds.ModifyActivityStreamOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.ModifyActivityStreamOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyActivityStreamInput, ...request.Option) *rds.ModifyActivityStreamOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyActivityStreamOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyActivityStreamInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyCertificates provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyCertificates(_a0 *rds.ModifyCertificatesInput) (*rds.ModifyCertificatesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.ModifyCertificatesOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyCertificatesInput) *rds.ModifyCertificatesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyCertificatesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.ModifyCertificatesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyCertificatesRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyCertificatesRequest(_a0 *rds.ModifyCertificatesInput) (*request.Request, *rds.ModifyCertificatesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.ModifyCertificatesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.ModifyCertificatesOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyCertificatesInput) *rds.ModifyCertificatesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.ModifyCertificatesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyCertificatesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyCertificatesWithContext(_a0 context.Context, _a1 *rds.ModifyCertificatesInput, _a2 ...request.Option) (*rds.ModifyCertificatesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.ModifyCertificatesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyCertificatesInput, ...request.Option) *rds.ModifyCertificatesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyCertificatesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyCertificatesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyCurrentDBClusterCapacity provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyCurrentDBClusterCapacity(_a0 *rds.ModifyCurrentDBClusterCapacityInput) (*rds.ModifyCurrentDBClusterCapacityOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.ModifyCurrentDBClusterCapacityOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyCurrentDBClusterCapacityInput) *rds.ModifyCurrentDBClusterCapacityOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyCurrentDBClusterCapacityOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.ModifyCurrentDBClusterCapacityInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyCurrentDBClusterCapacityRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyCurrentDBClusterCapacityRequest(_a0 *rds.ModifyCurrentDBClusterCapacityInput) (*request.Request, *rds.ModifyCurrentDBClusterCapacityOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.ModifyCurrentDBClusterCapacityInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.ModifyCurrentDBClusterCapacityOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyCurrentDBClusterCapacityInput) *rds.ModifyCurrentDBClusterCapacityOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.ModifyCurrentDBClusterCapacityOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyCurrentDBClusterCapacityWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyCurrentDBClusterCapacityWithContext(_a0 context.Context, _a1 *rds.ModifyCurrentDBClusterCapacityInput, _a2 ...request.Option) (*rds.ModifyCurrentDBClusterCapacityOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.ModifyCurrentDBClusterCapacityOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyCurrentDBClusterCapacityInput, ...request.Option) *rds.ModifyCurrentDBClusterCapacityOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyCurrentDBClusterCapacityOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyCurrentDBClusterCapacityInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyCustomDBEngineVersion provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyCustomDBEngineVersion(_a0 *rds.ModifyCustomDBEngineVersionInput) (*rds.ModifyCustomDBEngineVersionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.ModifyCustomDBEngineVersionOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyCustomDBEngineVersionInput) *rds.ModifyCustomDBEngineVersionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyCustomDBEngineVersionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.ModifyCustomDBEngineVersionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyCustomDBEngineVersionRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyCustomDBEngineVersionRequest(_a0 *rds.ModifyCustomDBEngineVersionInput) (*request.Request, *rds.ModifyCustomDBEngineVersionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.ModifyCustomDBEngineVersionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.ModifyCustomDBEngineVersionOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyCustomDBEngineVersionInput) *rds.ModifyCustomDBEngineVersionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.ModifyCustomDBEngineVersionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyCustomDBEngineVersionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyCustomDBEngineVersionWithContext(_a0 context.Context, _a1 *rds.ModifyCustomDBEngineVersionInput, _a2 ...request.Option) (*rds.ModifyCustomDBEngineVersionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.ModifyCustomDBEngineVersionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyCustomDBEngineVersionInput, ...request.Option) *rds.ModifyCustomDBEngineVersionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyCustomDBEngineVersionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyCustomDBEngineVersionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBCluster provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBCluster(_a0 *rds.ModifyDBClusterInput) (*rds.ModifyDBClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.ModifyDBClusterOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBClusterInput) *rds.ModifyDBClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterEndpoint provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBClusterEndpoint(_a0 *rds.ModifyDBClusterEndpointInput) (*rds.ModifyDBClusterEndpointOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.ModifyDBClusterEndpointOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBClusterEndpointInput) *rds.ModifyDBClusterEndpointOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyDBClusterEndpointOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBClusterEndpointInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterEndpointRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBClusterEndpointRequest(_a0 *rds.ModifyDBClusterEndpointInput) (*request.Request, *rds.ModifyDBClusterEndpointOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBClusterEndpointInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.ModifyDBClusterEndpointOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBClusterEndpointInput) *rds.ModifyDBClusterEndpointOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.ModifyDBClusterEndpointOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterEndpointWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyDBClusterEndpointWithContext(_a0 context.Context, _a1 *rds.ModifyDBClusterEndpointInput, _a2 ...request.Option) (*rds.ModifyDBClusterEndpointOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.ModifyDBClusterEndpointOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBClusterEndpointInput, ...request.Option) *rds.ModifyDBClusterEndpointOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyDBClusterEndpointOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBClusterEndpointInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterParameterGroup provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBClusterParameterGroup(_a0 *rds.ModifyDBClusterParameterGroupInput) (*rds.DBClusterParameterGroupNameMessage, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DBClusterParameterGroupNameMessage
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBClusterParameterGroupInput) *rds.DBClusterParameterGroupNameMessage); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DBClusterParameterGroupNameMessage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBClusterParameterGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterParameterGroupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBClusterParameterGroupRequest(_a0 *rds.ModifyDBClusterParameterGroupInput) (*request.Request, *rds.DBClusterParameterGroupNameMessage) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBClusterParameterGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.DBClusterParameterGroupNameMessage
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBClusterParameterGroupInput) *rds.DBClusterParameterGroupNameMessage); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DBClusterParameterGroupNameMessage)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyDBClusterParameterGroupWithContext(_a0 context.Context, _a1 *rds.ModifyDBClusterParameterGroupInput, _a2 ...request.Option) (*rds.DBClusterParameterGroupNameMessage, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.DBClusterParameterGroupNameMessage
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBClusterParameterGroupInput, ...request.Option) *rds.DBClusterParameterGroupNameMessage); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DBClusterParameterGroupNameMessage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBClusterParameterGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBClusterRequest(_a0 *rds.ModifyDBClusterInput) (*request.Request, *rds.ModifyDBClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.ModifyDBClusterOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBClusterInput) *rds.ModifyDBClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.ModifyDBClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterSnapshotAttribute provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBClusterSnapshotAttribute(_a0 *rds.ModifyDBClusterSnapshotAttributeInput) (*rds.ModifyDBClusterSnapshotAttributeOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.ModifyDBClusterSnapshotAttributeOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBClusterSnapshotAttributeInput) *rds.ModifyDBClusterSnapshotAttributeOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyDBClusterSnapshotAttributeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBClusterSnapshotAttributeInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterSnapshotAttributeRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBClusterSnapshotAttributeRequest(_a0 *rds.ModifyDBClusterSnapshotAttributeInput) (*request.Request, *rds.ModifyDBClusterSnapshotAttributeOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBClusterSnapshotAttributeInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.ModifyDBClusterSnapshotAttributeOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBClusterSnapshotAttributeInput) *rds.ModifyDBClusterSnapshotAttributeOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.ModifyDBClusterSnapshotAttributeOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterSnapshotAttributeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyDBClusterSnapshotAttributeWithContext(_a0 context.Context, _a1 *rds.ModifyDBClusterSnapshotAttributeInput, _a2 ...request.Option) (*rds.ModifyDBClusterSnapshotAttributeOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.ModifyDBClusterSnapshotAttributeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBClusterSnapshotAttributeInput, ...request.Option) *rds.ModifyDBClusterSnapshotAttributeOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyDBClusterSnapshotAttributeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBClusterSnapshotAttributeInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyDBClusterWithContext(_a0 context.Context, _a1 *rds.ModifyDBClusterInput, _a2 ...request.Option) (*rds.ModifyDBClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.ModifyDBClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBClusterInput, ...request.Option) *rds.ModifyDBClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBInstance provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBInstance(_a0 *rds.ModifyDBInstanceInput) (*rds.ModifyDBInstanceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.ModifyDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBInstanceInput) *rds.ModifyDBInstanceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyDBInstanceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBInstanceInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBInstanceRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBInstanceRequest(_a0 *rds.ModifyDBInstanceInput) (*request.Request, *rds.ModifyDBInstanceOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBInstanceInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.ModifyDBInstanceOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBInstanceInput) *rds.ModifyDBInstanceOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.ModifyDBInstanceOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyDBInstanceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyDBInstanceWithContext(_a0 context.Context, _a1 *rds.ModifyDBInstanceInput, _a2 ...request.Option) (*rds.ModifyDBInstanceOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.ModifyDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBInstanceInput, ...request.Option) *rds.ModifyDBInstanceOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyDBInstanceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBInstanceInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBParameterGroup provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBParameterGroup(_a0 *rds.ModifyDBParameterGroupInput) (*rds.DBParameterGroupNameMessage, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.DBParameterGroupNameMessage
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBParameterGroupInput) *rds.DBParameterGroupNameMessage); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DBParameterGroupNameMessage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBParameterGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBParameterGroupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBParameterGroupRequest(_a0 *rds.ModifyDBParameterGroupInput) (*request.Request, *rds.DBParameterGroupNameMessage) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBParameterGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.DBParameterGroupNameMessage
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBParameterGroupInput) *rds.DBParameterGroupNameMessage); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.DBParameterGroupNameMessage)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyDBParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyDBParameterGroupWithContext(_a0 context.Context, _a1 *rds.ModifyDBParameterGroupInput, _a2 ...request.Option) (*rds.DBParameterGroupNameMessage, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.DBParameterGroupNameMessage
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBParameterGroupInput, ...request.Option) *rds.DBParameterGroupNameMessage); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.DBParameterGroupNameMessage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBParameterGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBProxy provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBProxy(_a0 *rds.ModifyDBProxyInput) (*rds.ModifyDBProxyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.ModifyDBProxyOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBProxyInput) *rds.ModifyDBProxyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyDBProxyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBProxyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBProxyEndpoint provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBProxyEndpoint(_a0 *rds.ModifyDBProxyEndpointInput) (*rds.ModifyDBProxyEndpointOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.ModifyDBProxyEndpointOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBProxyEndpointInput) *rds.ModifyDBProxyEndpointOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyDBProxyEndpointOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBProxyEndpointInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBProxyEndpointRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBProxyEndpointRequest(_a0 *rds.ModifyDBProxyEndpointInput) (*request.Request, *rds.ModifyDBProxyEndpointOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBProxyEndpointInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.ModifyDBProxyEndpointOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBProxyEndpointInput) *rds.ModifyDBProxyEndpointOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.ModifyDBProxyEndpointOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyDBProxyEndpointWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyDBProxyEndpointWithContext(_a0 context.Context, _a1 *rds.ModifyDBProxyEndpointInput, _a2 ...request.Option) (*rds.ModifyDBProxyEndpointOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.ModifyDBProxyEndpointOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBProxyEndpointInput, ...request.Option) *rds.ModifyDBProxyEndpointOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyDBProxyEndpointOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBProxyEndpointInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBProxyRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBProxyRequest(_a0 *rds.ModifyDBProxyInput) (*request.Request, *rds.ModifyDBProxyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBProxyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.ModifyDBProxyOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBProxyInput) *rds.ModifyDBProxyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.ModifyDBProxyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyDBProxyTargetGroup provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBProxyTargetGroup(_a0 *rds.ModifyDBProxyTargetGroupInput) (*rds.ModifyDBProxyTargetGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.ModifyDBProxyTargetGroupOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBProxyTargetGroupInput) *rds.ModifyDBProxyTargetGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyDBProxyTargetGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBProxyTargetGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBProxyTargetGroupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBProxyTargetGroupRequest(_a0 *rds.ModifyDBProxyTargetGroupInput) (*request.Request, *rds.ModifyDBProxyTargetGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBProxyTargetGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *rds.ModifyDBProxyTargetGroupOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBProxyTargetGroupInput) *rds.ModifyDBProxyTargetGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.ModifyDBProxyTargetGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyDBProxyTargetGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyDBProxyTargetGroupWithContext(_a0 context.Context, _a1 *rds.ModifyDBProxyTargetGroupInput, _a2 ...request.Option) (*rds.ModifyDBProxyTargetGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rds.ModifyDBProxyTargetGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBProxyTargetGroupInput, ...request.Option) *rds.ModifyDBProxyTargetGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*request.Request)
+			r0 = ret.Get(0).(*rds.ModifyDBProxyTargetGroupOutput)
 		}
 	}
 
-	var r1 *rds.DownloadDBLogFilePortionOutput
-	if rf, ok := ret.Get(1).(func(*rds.DownloadDBLogFilePortionInput) *rds.DownloadDBLogFilePortionOutput); ok {
-		r1 = rf(_a0)
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBProxyTargetGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DownloadDBLogFilePortionOutput)
-		}
+		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
 
-// DownloadDBLogFilePortionWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) DownloadDBLogFilePortionWithContext(_a0 context.Context, _a1 *rds.DownloadDBLogFilePortionInput, _a2 ...request.Option) (*rds.DownloadDBLogFilePortionOutput, error) {
+// ModifyDBProxyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyDBProxyWithContext(_a0 context.Context, _a1 *rds.ModifyDBProxyInput, _a2 ...request.Option) (*rds.ModifyDBProxyOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -7817,17 +10644,17 @@ func (_m *RDSAPI) DownloadDBLogFilePortionWithContext(_a0 context.Context, _a1 *
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DownloadDBLogFilePortionOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.DownloadDBLogFilePortionInput, ...request.Option) *rds.DownloadDBLogFilePortionOutput); ok {
+	var r0 *rds.ModifyDBProxyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBProxyInput, ...request.Option) *rds.ModifyDBProxyOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DownloadDBLogFilePortionOutput)
+			r0 = ret.Get(0).(*rds.ModifyDBProxyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.DownloadDBLogFilePortionInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBProxyInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -7836,21 +10663,21 @@ func (_m *RDSAPI) DownloadDBLogFilePortionWithContext(_a0 context.Context, _a1 *
 	return r0, r1
 }
 
-// FailoverDBCluster provides a mock function with given fields: _a0
-func (_m *RDSAPI) FailoverDBCluster(_a0 *rds.FailoverDBClusterInput) (*rds.FailoverDBClusterOutput, error) {
+// ModifyDBRecommendation provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBRecommendation(_a0 *rds.ModifyDBRecommendationInput) (*rds.ModifyDBRecommendationOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.FailoverDBClusterOutput
-	if rf, ok := ret.Get(0).(func(*rds.FailoverDBClusterInput) *rds.FailoverDBClusterOutput); ok {
+	var r0 *rds.ModifyDBRecommendationOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBRecommendationInput) *rds.ModifyDBRecommendationOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.FailoverDBClusterOutput)
+			r0 = ret.Get(0).(*rds.ModifyDBRecommendationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.FailoverDBClusterInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBRecommendationInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -7859,12 +10686,12 @@ func (_m *RDSAPI) FailoverDBCluster(_a0 *rds.FailoverDBClusterInput) (*rds.Failo
 	return r0, r1
 }
 
-// FailoverDBClusterRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) FailoverDBClusterRequest(_a0 *rds.FailoverDBClusterInput) (*request.Request, *rds.FailoverDBClusterOutput) {
+// ModifyDBRecommendationRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBRecommendationRequest(_a0 *rds.ModifyDBRecommendationInput) (*request.Request, *rds.ModifyDBRecommendationOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.FailoverDBClusterInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBRecommendationInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -7872,20 +10699,20 @@ func (_m *RDSAPI) FailoverDBClusterRequest(_a0 *rds.FailoverDBClusterInput) (*re
 		}
 	}
 
-	var r1 *rds.FailoverDBClusterOutput
-	if rf, ok := ret.Get(1).(func(*rds.FailoverDBClusterInput) *rds.FailoverDBClusterOutput); ok {
+	var r1 *rds.ModifyDBRecommendationOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBRecommendationInput) *rds.ModifyDBRecommendationOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.FailoverDBClusterOutput)
+			r1 = ret.Get(1).(*rds.ModifyDBRecommendationOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// FailoverDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) FailoverDBClusterWithContext(_a0 context.Context, _a1 *rds.FailoverDBClusterInput, _a2 ...request.Option) (*rds.FailoverDBClusterOutput, error) {
+// ModifyDBRecommendationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyDBRecommendationWithContext(_a0 context.Context, _a1 *rds.ModifyDBRecommendationInput, _a2 ...request.Option) (*rds.ModifyDBRecommendationOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -7895,17 +10722,17 @@ func (_m *RDSAPI) FailoverDBClusterWithContext(_a0 context.Context, _a1 *rds.Fai
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.FailoverDBClusterOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.FailoverDBClusterInput, ...request.Option) *rds.FailoverDBClusterOutput); ok {
+	var r0 *rds.ModifyDBRecommendationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBRecommendationInput, ...request.Option) *rds.ModifyDBRecommendationOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.FailoverDBClusterOutput)
+			r0 = ret.Get(0).(*rds.ModifyDBRecommendationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.FailoverDBClusterInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBRecommendationInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -7914,21 +10741,21 @@ func (_m *RDSAPI) FailoverDBClusterWithContext(_a0 context.Context, _a1 *rds.Fai
 	return r0, r1
 }
 
-// ImportInstallationMedia provides a mock function with given fields: _a0
-func (_m *RDSAPI) ImportInstallationMedia(_a0 *rds.ImportInstallationMediaInput) (*rds.ImportInstallationMediaOutput, error) {
+// ModifyDBShardGroup provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBShardGroup(_a0 *rds.ModifyDBShardGroupInput) (*rds.ModifyDBShardGroupOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.ImportInstallationMediaOutput
-	if rf, ok := ret.Get(0).(func(*rds.ImportInstallationMediaInput) *rds.ImportInstallationMediaOutput); ok {
+	var r0 *rds.ModifyDBShardGroupOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBShardGroupInput) *rds.ModifyDBShardGroupOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ImportInstallationMediaOutput)
+			r0 = ret.Get(0).(*rds.ModifyDBShardGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ImportInstallationMediaInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBShardGroupInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -7937,12 +10764,12 @@ func (_m *RDSAPI) ImportInstallationMedia(_a0 *rds.ImportInstallationMediaInput)
 	return r0, r1
 }
 
-// ImportInstallationMediaRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ImportInstallationMediaRequest(_a0 *rds.ImportInstallationMediaInput) (*request.Request, *rds.ImportInstallationMediaOutput) {
+// ModifyDBShardGroupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBShardGroupRequest(_a0 *rds.ModifyDBShardGroupInput) (*request.Request, *rds.ModifyDBShardGroupOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ImportInstallationMediaInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBShardGroupInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -7950,20 +10777,20 @@ func (_m *RDSAPI) ImportInstallationMediaRequest(_a0 *rds.ImportInstallationMedi
 		}
 	}
 
-	var r1 *rds.ImportInstallationMediaOutput
-	if rf, ok := ret.Get(1).(func(*rds.ImportInstallationMediaInput) *rds.ImportInstallationMediaOutput); ok {
+	var r1 *rds.ModifyDBShardGroupOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBShardGroupInput) *rds.ModifyDBShardGroupOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.ImportInstallationMediaOutput)
+			r1 = ret.Get(1).(*rds.ModifyDBShardGroupOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ImportInstallationMediaWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ImportInstallationMediaWithContext(_a0 context.Context, _a1 *rds.ImportInstallationMediaInput, _a2 ...request.Option) (*rds.ImportInstallationMediaOutput, error) {
+// ModifyDBShardGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyDBShardGroupWithContext(_a0 context.Context, _a1 *rds.ModifyDBShardGroupInput, _a2 ...request.Option) (*rds.ModifyDBShardGroupOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -7973,17 +10800,17 @@ func (_m *RDSAPI) ImportInstallationMediaWithContext(_a0 context.Context, _a1 *r
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.ImportInstallationMediaOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ImportInstallationMediaInput, ...request.Option) *rds.ImportInstallationMediaOutput); ok {
+	var r0 *rds.ModifyDBShardGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBShardGroupInput, ...request.Option) *rds.ModifyDBShardGroupOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ImportInstallationMediaOutput)
+			r0 = ret.Get(0).(*rds.ModifyDBShardGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ImportInstallationMediaInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBShardGroupInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -7992,21 +10819,21 @@ func (_m *RDSAPI) ImportInstallationMediaWithContext(_a0 context.Context, _a1 *r
 	return r0, r1
 }
 
-// ListTagsForResource provides a mock function with given fields: _a0
-func (_m *RDSAPI) ListTagsForResource(_a0 *rds.ListTagsForResourceInput) (*rds.ListTagsForResourceOutput, error) {
+// ModifyDBSnapshot provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBSnapshot(_a0 *rds.ModifyDBSnapshotInput) (*rds.ModifyDBSnapshotOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.ListTagsForResourceOutput
-	if rf, ok := ret.Get(0).(func(*rds.ListTagsForResourceInput) *rds.ListTagsForResourceOutput); ok {
+	var r0 *rds.ModifyDBSnapshotOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBSnapshotInput) *rds.ModifyDBSnapshotOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ListTagsForResourceOutput)
+			r0 = ret.Get(0).(*rds.ModifyDBSnapshotOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ListTagsForResourceInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBSnapshotInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -8015,12 +10842,35 @@ func (_m *RDSAPI) ListTagsForResource(_a0 *rds.ListTagsForResourceInput) (*rds.L
 	return r0, r1
 }
 
-// ListTagsForResourceRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ListTagsForResourceRequest(_a0 *rds.ListTagsForResourceInput) (*request.Request, *rds.ListTagsForResourceOutput) {
+// ModifyDBSnapshotAttribute provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBSnapshotAttribute(_a0 *rds.ModifyDBSnapshotAttributeInput) (*rds.ModifyDBSnapshotAttributeOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.ModifyDBSnapshotAttributeOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBSnapshotAttributeInput) *rds.ModifyDBSnapshotAttributeOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.ModifyDBSnapshotAttributeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBSnapshotAttributeInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyDBSnapshotAttributeRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBSnapshotAttributeRequest(_a0 *rds.ModifyDBSnapshotAttributeInput) (*request.Request, *rds.ModifyDBSnapshotAttributeOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ListTagsForResourceInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBSnapshotAttributeInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -8028,20 +10878,20 @@ func (_m *RDSAPI) ListTagsForResourceRequest(_a0 *rds.ListTagsForResourceInput)
 		}
 	}
 
-	var r1 *rds.ListTagsForResourceOutput
-	if rf, ok := ret.Get(1).(func(*rds.ListTagsForResourceInput) *rds.ListTagsForResourceOutput); ok {
+	var r1 *rds.ModifyDBSnapshotAttributeOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBSnapshotAttributeInput) *rds.ModifyDBSnapshotAttributeOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.ListTagsForResourceOutput)
+			r1 = ret.Get(1).(*rds.ModifyDBSnapshotAttributeOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListTagsForResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ListTagsForResourceWithContext(_a0 context.Context, _a1 *rds.ListTagsForResourceInput, _a2 ...request.Option) (*rds.ListTagsForResourceOutput, error) {
+// ModifyDBSnapshotAttributeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyDBSnapshotAttributeWithContext(_a0 context.Context, _a1 *rds.ModifyDBSnapshotAttributeInput, _a2 ...request.Option) (*rds.ModifyDBSnapshotAttributeOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -8051,17 +10901,17 @@ func (_m *RDSAPI) ListTagsForResourceWithContext(_a0 context.Context, _a1 *rds.L
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.ListTagsForResourceOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ListTagsForResourceInput, ...request.Option) *rds.ListTagsForResourceOutput); ok {
+	var r0 *rds.ModifyDBSnapshotAttributeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBSnapshotAttributeInput, ...request.Option) *rds.ModifyDBSnapshotAttributeOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ListTagsForResourceOutput)
+			r0 = ret.Get(0).(*rds.ModifyDBSnapshotAttributeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ListTagsForResourceInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBSnapshotAttributeInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -8070,35 +10920,12 @@ func (_m *RDSAPI) ListTagsForResourceWithContext(_a0 context.Context, _a1 *rds.L
 	return r0, r1
 }
 
-// ModifyCertificates provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyCertificates(_a0 *rds.ModifyCertificatesInput) (*rds.ModifyCertificatesOutput, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *rds.ModifyCertificatesOutput
-	if rf, ok := ret.Get(0).(func(*rds.ModifyCertificatesInput) *rds.ModifyCertificatesOutput); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyCertificatesOutput)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ModifyCertificatesInput) error); ok {
-		r1 = rf(_a0)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// ModifyCertificatesRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyCertificatesRequest(_a0 *rds.ModifyCertificatesInput) (*request.Request, *rds.ModifyCertificatesOutput) {
+// ModifyDBSnapshotRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBSnapshotRequest(_a0 *rds.ModifyDBSnapshotInput) (*request.Request, *rds.ModifyDBSnapshotOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ModifyCertificatesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBSnapshotInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -8106,20 +10933,20 @@ func (_m *RDSAPI) ModifyCertificatesRequest(_a0 *rds.ModifyCertificatesInput) (*
 		}
 	}
 
-	var r1 *rds.ModifyCertificatesOutput
-	if rf, ok := ret.Get(1).(func(*rds.ModifyCertificatesInput) *rds.ModifyCertificatesOutput); ok {
+	var r1 *rds.ModifyDBSnapshotOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBSnapshotInput) *rds.ModifyDBSnapshotOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.ModifyCertificatesOutput)
+			r1 = ret.Get(1).(*rds.ModifyDBSnapshotOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ModifyCertificatesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ModifyCertificatesWithContext(_a0 context.Context, _a1 *rds.ModifyCertificatesInput, _a2 ...request.Option) (*rds.ModifyCertificatesOutput, error) {
+// ModifyDBSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyDBSnapshotWithContext(_a0 context.Context, _a1 *rds.ModifyDBSnapshotInput, _a2 ...request.Option) (*rds.ModifyDBSnapshotOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -8129,17 +10956,17 @@ func (_m *RDSAPI) ModifyCertificatesWithContext(_a0 context.Context, _a1 *rds.Mo
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.ModifyCertificatesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyCertificatesInput, ...request.Option) *rds.ModifyCertificatesOutput); ok {
+	var r0 *rds.ModifyDBSnapshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBSnapshotInput, ...request.Option) *rds.ModifyDBSnapshotOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyCertificatesOutput)
+			r0 = ret.Get(0).(*rds.ModifyDBSnapshotOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyCertificatesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBSnapshotInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -8148,21 +10975,21 @@ func (_m *RDSAPI) ModifyCertificatesWithContext(_a0 context.Context, _a1 *rds.Mo
 	return r0, r1
 }
 
-// ModifyCurrentDBClusterCapacity provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyCurrentDBClusterCapacity(_a0 *rds.ModifyCurrentDBClusterCapacityInput) (*rds.ModifyCurrentDBClusterCapacityOutput, error) {
+// ModifyDBSubnetGroup provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBSubnetGroup(_a0 *rds.ModifyDBSubnetGroupInput) (*rds.ModifyDBSubnetGroupOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.ModifyCurrentDBClusterCapacityOutput
-	if rf, ok := ret.Get(0).(func(*rds.ModifyCurrentDBClusterCapacityInput) *rds.ModifyCurrentDBClusterCapacityOutput); ok {
+	var r0 *rds.ModifyDBSubnetGroupOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBSubnetGroupInput) *rds.ModifyDBSubnetGroupOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyCurrentDBClusterCapacityOutput)
+			r0 = ret.Get(0).(*rds.ModifyDBSubnetGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ModifyCurrentDBClusterCapacityInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBSubnetGroupInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -8171,12 +10998,12 @@ func (_m *RDSAPI) ModifyCurrentDBClusterCapacity(_a0 *rds.ModifyCurrentDBCluster
 	return r0, r1
 }
 
-// ModifyCurrentDBClusterCapacityRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyCurrentDBClusterCapacityRequest(_a0 *rds.ModifyCurrentDBClusterCapacityInput) (*request.Request, *rds.ModifyCurrentDBClusterCapacityOutput) {
+// ModifyDBSubnetGroupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyDBSubnetGroupRequest(_a0 *rds.ModifyDBSubnetGroupInput) (*request.Request, *rds.ModifyDBSubnetGroupOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ModifyCurrentDBClusterCapacityInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.ModifyDBSubnetGroupInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -8184,20 +11011,20 @@ func (_m *RDSAPI) ModifyCurrentDBClusterCapacityRequest(_a0 *rds.ModifyCurrentDB
 		}
 	}
 
-	var r1 *rds.ModifyCurrentDBClusterCapacityOutput
-	if rf, ok := ret.Get(1).(func(*rds.ModifyCurrentDBClusterCapacityInput) *rds.ModifyCurrentDBClusterCapacityOutput); ok {
+	var r1 *rds.ModifyDBSubnetGroupOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyDBSubnetGroupInput) *rds.ModifyDBSubnetGroupOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.ModifyCurrentDBClusterCapacityOutput)
+			r1 = ret.Get(1).(*rds.ModifyDBSubnetGroupOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ModifyCurrentDBClusterCapacityWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ModifyCurrentDBClusterCapacityWithContext(_a0 context.Context, _a1 *rds.ModifyCurrentDBClusterCapacityInput, _a2 ...request.Option) (*rds.ModifyCurrentDBClusterCapacityOutput, error) {
+// ModifyDBSubnetGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyDBSubnetGroupWithContext(_a0 context.Context, _a1 *rds.ModifyDBSubnetGroupInput, _a2 ...request.Option) (*rds.ModifyDBSubnetGroupOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -8207,17 +11034,17 @@ func (_m *RDSAPI) ModifyCurrentDBClusterCapacityWithContext(_a0 context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.ModifyCurrentDBClusterCapacityOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyCurrentDBClusterCapacityInput, ...request.Option) *rds.ModifyCurrentDBClusterCapacityOutput); ok {
+	var r0 *rds.ModifyDBSubnetGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBSubnetGroupInput, ...request.Option) *rds.ModifyDBSubnetGroupOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyCurrentDBClusterCapacityOutput)
+			r0 = ret.Get(0).(*rds.ModifyDBSubnetGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyCurrentDBClusterCapacityInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBSubnetGroupInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -8226,44 +11053,21 @@ func (_m *RDSAPI) ModifyCurrentDBClusterCapacityWithContext(_a0 context.Context,
 	return r0, r1
 }
 
-// ModifyDBCluster provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBCluster(_a0 *rds.ModifyDBClusterInput) (*rds.ModifyDBClusterOutput, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *rds.ModifyDBClusterOutput
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBClusterInput) *rds.ModifyDBClusterOutput); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyDBClusterOutput)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBClusterInput) error); ok {
-		r1 = rf(_a0)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// ModifyDBClusterEndpoint provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBClusterEndpoint(_a0 *rds.ModifyDBClusterEndpointInput) (*rds.ModifyDBClusterEndpointOutput, error) {
+// ModifyEventSubscription provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyEventSubscription(_a0 *rds.ModifyEventSubscriptionInput) (*rds.ModifyEventSubscriptionOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.ModifyDBClusterEndpointOutput
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBClusterEndpointInput) *rds.ModifyDBClusterEndpointOutput); ok {
+	var r0 *rds.ModifyEventSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyEventSubscriptionInput) *rds.ModifyEventSubscriptionOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyDBClusterEndpointOutput)
+			r0 = ret.Get(0).(*rds.ModifyEventSubscriptionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBClusterEndpointInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.ModifyEventSubscriptionInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -8272,12 +11076,12 @@ func (_m *RDSAPI) ModifyDBClusterEndpoint(_a0 *rds.ModifyDBClusterEndpointInput)
 	return r0, r1
 }
 
-// ModifyDBClusterEndpointRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBClusterEndpointRequest(_a0 *rds.ModifyDBClusterEndpointInput) (*request.Request, *rds.ModifyDBClusterEndpointOutput) {
+// ModifyEventSubscriptionRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyEventSubscriptionRequest(_a0 *rds.ModifyEventSubscriptionInput) (*request.Request, *rds.ModifyEventSubscriptionOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBClusterEndpointInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.ModifyEventSubscriptionInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -8285,20 +11089,20 @@ func (_m *RDSAPI) ModifyDBClusterEndpointRequest(_a0 *rds.ModifyDBClusterEndpoin
 		}
 	}
 
-	var r1 *rds.ModifyDBClusterEndpointOutput
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBClusterEndpointInput) *rds.ModifyDBClusterEndpointOutput); ok {
+	var r1 *rds.ModifyEventSubscriptionOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyEventSubscriptionInput) *rds.ModifyEventSubscriptionOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.ModifyDBClusterEndpointOutput)
+			r1 = ret.Get(1).(*rds.ModifyEventSubscriptionOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ModifyDBClusterEndpointWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ModifyDBClusterEndpointWithContext(_a0 context.Context, _a1 *rds.ModifyDBClusterEndpointInput, _a2 ...request.Option) (*rds.ModifyDBClusterEndpointOutput, error) {
+// ModifyEventSubscriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyEventSubscriptionWithContext(_a0 context.Context, _a1 *rds.ModifyEventSubscriptionInput, _a2 ...request.Option) (*rds.ModifyEventSubscriptionOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -8308,17 +11112,17 @@ func (_m *RDSAPI) ModifyDBClusterEndpointWithContext(_a0 context.Context, _a1 *r
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.ModifyDBClusterEndpointOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBClusterEndpointInput, ...request.Option) *rds.ModifyDBClusterEndpointOutput); ok {
+	var r0 *rds.ModifyEventSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyEventSubscriptionInput, ...request.Option) *rds.ModifyEventSubscriptionOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyDBClusterEndpointOutput)
+			r0 = ret.Get(0).(*rds.ModifyEventSubscriptionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBClusterEndpointInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyEventSubscriptionInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -8326,22 +11130,22 @@ func (_m *RDSAPI) ModifyDBClusterEndpointWithContext(_a0 context.Context, _a1 *r
 
 	return r0, r1
 }
-
-// ModifyDBClusterParameterGroup provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBClusterParameterGroup(_a0 *rds.ModifyDBClusterParameterGroupInput) (*rds.DBClusterParameterGroupNameMessage, error) {
+
+// ModifyGlobalCluster provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyGlobalCluster(_a0 *rds.ModifyGlobalClusterInput) (*rds.ModifyGlobalClusterOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DBClusterParameterGroupNameMessage
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBClusterParameterGroupInput) *rds.DBClusterParameterGroupNameMessage); ok {
+	var r0 *rds.ModifyGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyGlobalClusterInput) *rds.ModifyGlobalClusterOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DBClusterParameterGroupNameMessage)
+			r0 = ret.Get(0).(*rds.ModifyGlobalClusterOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBClusterParameterGroupInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.ModifyGlobalClusterInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -8350,12 +11154,12 @@ func (_m *RDSAPI) ModifyDBClusterParameterGroup(_a0 *rds.ModifyDBClusterParamete
 	return r0, r1
 }
 
-// ModifyDBClusterParameterGroupRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBClusterParameterGroupRequest(_a0 *rds.ModifyDBClusterParameterGroupInput) (*request.Request, *rds.DBClusterParameterGroupNameMessage) {
+// ModifyGlobalClusterRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyGlobalClusterRequest(_a0 *rds.ModifyGlobalClusterInput) (*request.Request, *rds.ModifyGlobalClusterOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBClusterParameterGroupInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.ModifyGlobalClusterInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -8363,20 +11167,20 @@ func (_m *RDSAPI) ModifyDBClusterParameterGroupRequest(_a0 *rds.ModifyDBClusterP
 		}
 	}
 
-	var r1 *rds.DBClusterParameterGroupNameMessage
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBClusterParameterGroupInput) *rds.DBClusterParameterGroupNameMessage); ok {
+	var r1 *rds.ModifyGlobalClusterOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyGlobalClusterInput) *rds.ModifyGlobalClusterOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DBClusterParameterGroupNameMessage)
+			r1 = ret.Get(1).(*rds.ModifyGlobalClusterOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ModifyDBClusterParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ModifyDBClusterParameterGroupWithContext(_a0 context.Context, _a1 *rds.ModifyDBClusterParameterGroupInput, _a2 ...request.Option) (*rds.DBClusterParameterGroupNameMessage, error) {
+// ModifyGlobalClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyGlobalClusterWithContext(_a0 context.Context, _a1 *rds.ModifyGlobalClusterInput, _a2 ...request.Option) (*rds.ModifyGlobalClusterOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -8386,17 +11190,17 @@ func (_m *RDSAPI) ModifyDBClusterParameterGroupWithContext(_a0 context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DBClusterParameterGroupNameMessage
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBClusterParameterGroupInput, ...request.Option) *rds.DBClusterParameterGroupNameMessage); ok {
+	var r0 *rds.ModifyGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyGlobalClusterInput, ...request.Option) *rds.ModifyGlobalClusterOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DBClusterParameterGroupNameMessage)
+			r0 = ret.Get(0).(*rds.ModifyGlobalClusterOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBClusterParameterGroupInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyGlobalClusterInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -8405,46 +11209,21 @@ func (_m *RDSAPI) ModifyDBClusterParameterGroupWithContext(_a0 context.Context,
 	return r0, r1
 }
 
-// ModifyDBClusterRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBClusterRequest(_a0 *rds.ModifyDBClusterInput) (*request.Request, *rds.ModifyDBClusterOutput) {
-	ret := _m.Called(_a0)
-
-	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBClusterInput) *request.Request); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*request.Request)
-		}
-	}
-
-	var r1 *rds.ModifyDBClusterOutput
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBClusterInput) *rds.ModifyDBClusterOutput); ok {
-		r1 = rf(_a0)
-	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.ModifyDBClusterOutput)
-		}
-	}
-
-	return r0, r1
-}
-
-// ModifyDBClusterSnapshotAttribute provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBClusterSnapshotAttribute(_a0 *rds.ModifyDBClusterSnapshotAttributeInput) (*rds.ModifyDBClusterSnapshotAttributeOutput, error) {
+// ModifyIntegration provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyIntegration(_a0 *rds.ModifyIntegrationInput) (*rds.ModifyIntegrationOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.ModifyDBClusterSnapshotAttributeOutput
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBClusterSnapshotAttributeInput) *rds.ModifyDBClusterSnapshotAttributeOutput); ok {
+	var r0 *rds.ModifyIntegrationOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyIntegrationInput) *rds.ModifyIntegrationOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyDBClusterSnapshotAttributeOutput)
+			r0 = ret.Get(0).(*rds.ModifyIntegrationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBClusterSnapshotAttributeInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.ModifyIntegrationInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -8453,12 +11232,12 @@ func (_m *RDSAPI) ModifyDBClusterSnapshotAttribute(_a0 *rds.ModifyDBClusterSnaps
 	return r0, r1
 }
 
-// ModifyDBClusterSnapshotAttributeRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBClusterSnapshotAttributeRequest(_a0 *rds.ModifyDBClusterSnapshotAttributeInput) (*request.Request, *rds.ModifyDBClusterSnapshotAttributeOutput) {
+// ModifyIntegrationRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyIntegrationRequest(_a0 *rds.ModifyIntegrationInput) (*request.Request, *rds.ModifyIntegrationOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBClusterSnapshotAttributeInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.ModifyIntegrationInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -8466,50 +11245,20 @@ func (_m *RDSAPI) ModifyDBClusterSnapshotAttributeRequest(_a0 *rds.ModifyDBClust
 		}
 	}
 
-	var r1 *rds.ModifyDBClusterSnapshotAttributeOutput
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBClusterSnapshotAttributeInput) *rds.ModifyDBClusterSnapshotAttributeOutput); ok {
+	var r1 *rds.ModifyIntegrationOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyIntegrationInput) *rds.ModifyIntegrationOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.ModifyDBClusterSnapshotAttributeOutput)
-		}
-	}
-
-	return r0, r1
-}
-
-// ModifyDBClusterSnapshotAttributeWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ModifyDBClusterSnapshotAttributeWithContext(_a0 context.Context, _a1 *rds.ModifyDBClusterSnapshotAttributeInput, _a2 ...request.Option) (*rds.ModifyDBClusterSnapshotAttributeOutput, error) {
-	_va := make([]interface{}, len(_a2))
-	for _i := range _a2 {
-		_va[_i] = _a2[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 *rds.ModifyDBClusterSnapshotAttributeOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBClusterSnapshotAttributeInput, ...request.Option) *rds.ModifyDBClusterSnapshotAttributeOutput); ok {
-		r0 = rf(_a0, _a1, _a2...)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyDBClusterSnapshotAttributeOutput)
+			r1 = ret.Get(1).(*rds.ModifyIntegrationOutput)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBClusterSnapshotAttributeInput, ...request.Option) error); ok {
-		r1 = rf(_a0, _a1, _a2...)
-	} else {
-		r1 = ret.Error(1)
-	}
-
 	return r0, r1
 }
 
-// ModifyDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ModifyDBClusterWithContext(_a0 context.Context, _a1 *rds.ModifyDBClusterInput, _a2 ...request.Option) (*rds.ModifyDBClusterOutput, error) {
+// ModifyIntegrationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyIntegrationWithContext(_a0 context.Context, _a1 *rds.ModifyIntegrationInput, _a2 ...request.Option) (*rds.ModifyIntegrationOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -8519,17 +11268,17 @@ func (_m *RDSAPI) ModifyDBClusterWithContext(_a0 context.Context, _a1 *rds.Modif
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.ModifyDBClusterOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBClusterInput, ...request.Option) *rds.ModifyDBClusterOutput); ok {
+	var r0 *rds.ModifyIntegrationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyIntegrationInput, ...request.Option) *rds.ModifyIntegrationOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyDBClusterOutput)
+			r0 = ret.Get(0).(*rds.ModifyIntegrationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBClusterInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyIntegrationInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -8538,21 +11287,21 @@ func (_m *RDSAPI) ModifyDBClusterWithContext(_a0 context.Context, _a1 *rds.Modif
 	return r0, r1
 }
 
-// ModifyDBInstance provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBInstance(_a0 *rds.ModifyDBInstanceInput) (*rds.ModifyDBInstanceOutput, error) {
+// ModifyOptionGroup provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyOptionGroup(_a0 *rds.ModifyOptionGroupInput) (*rds.ModifyOptionGroupOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.ModifyDBInstanceOutput
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBInstanceInput) *rds.ModifyDBInstanceOutput); ok {
+	var r0 *rds.ModifyOptionGroupOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyOptionGroupInput) *rds.ModifyOptionGroupOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyDBInstanceOutput)
+			r0 = ret.Get(0).(*rds.ModifyOptionGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBInstanceInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.ModifyOptionGroupInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -8561,12 +11310,12 @@ func (_m *RDSAPI) ModifyDBInstance(_a0 *rds.ModifyDBInstanceInput) (*rds.ModifyD
 	return r0, r1
 }
 
-// ModifyDBInstanceRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBInstanceRequest(_a0 *rds.ModifyDBInstanceInput) (*request.Request, *rds.ModifyDBInstanceOutput) {
+// ModifyOptionGroupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyOptionGroupRequest(_a0 *rds.ModifyOptionGroupInput) (*request.Request, *rds.ModifyOptionGroupOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBInstanceInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.ModifyOptionGroupInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -8574,20 +11323,20 @@ func (_m *RDSAPI) ModifyDBInstanceRequest(_a0 *rds.ModifyDBInstanceInput) (*requ
 		}
 	}
 
-	var r1 *rds.ModifyDBInstanceOutput
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBInstanceInput) *rds.ModifyDBInstanceOutput); ok {
+	var r1 *rds.ModifyOptionGroupOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyOptionGroupInput) *rds.ModifyOptionGroupOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.ModifyDBInstanceOutput)
+			r1 = ret.Get(1).(*rds.ModifyOptionGroupOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ModifyDBInstanceWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ModifyDBInstanceWithContext(_a0 context.Context, _a1 *rds.ModifyDBInstanceInput, _a2 ...request.Option) (*rds.ModifyDBInstanceOutput, error) {
+// ModifyOptionGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyOptionGroupWithContext(_a0 context.Context, _a1 *rds.ModifyOptionGroupInput, _a2 ...request.Option) (*rds.ModifyOptionGroupOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -8597,17 +11346,17 @@ func (_m *RDSAPI) ModifyDBInstanceWithContext(_a0 context.Context, _a1 *rds.Modi
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.ModifyDBInstanceOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBInstanceInput, ...request.Option) *rds.ModifyDBInstanceOutput); ok {
+	var r0 *rds.ModifyOptionGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyOptionGroupInput, ...request.Option) *rds.ModifyOptionGroupOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyDBInstanceOutput)
+			r0 = ret.Get(0).(*rds.ModifyOptionGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBInstanceInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyOptionGroupInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -8616,21 +11365,21 @@ func (_m *RDSAPI) ModifyDBInstanceWithContext(_a0 context.Context, _a1 *rds.Modi
 	return r0, r1
 }
 
-// ModifyDBParameterGroup provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBParameterGroup(_a0 *rds.ModifyDBParameterGroupInput) (*rds.DBParameterGroupNameMessage, error) {
+// ModifyTenantDatabase provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyTenantDatabase(_a0 *rds.ModifyTenantDatabaseInput) (*rds.ModifyTenantDatabaseOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DBParameterGroupNameMessage
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBParameterGroupInput) *rds.DBParameterGroupNameMessage); ok {
+	var r0 *rds.ModifyTenantDatabaseOutput
+	if rf, ok := ret.Get(0).(func(*rds.ModifyTenantDatabaseInput) *rds.ModifyTenantDatabaseOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DBParameterGroupNameMessage)
+			r0 = ret.Get(0).(*rds.ModifyTenantDatabaseOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBParameterGroupInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.ModifyTenantDatabaseInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -8639,12 +11388,12 @@ func (_m *RDSAPI) ModifyDBParameterGroup(_a0 *rds.ModifyDBParameterGroupInput) (
 	return r0, r1
 }
 
-// ModifyDBParameterGroupRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBParameterGroupRequest(_a0 *rds.ModifyDBParameterGroupInput) (*request.Request, *rds.DBParameterGroupNameMessage) {
+// ModifyTenantDatabaseRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ModifyTenantDatabaseRequest(_a0 *rds.ModifyTenantDatabaseInput) (*request.Request, *rds.ModifyTenantDatabaseOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBParameterGroupInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.ModifyTenantDatabaseInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -8652,20 +11401,20 @@ func (_m *RDSAPI) ModifyDBParameterGroupRequest(_a0 *rds.ModifyDBParameterGroupI
 		}
 	}
 
-	var r1 *rds.DBParameterGroupNameMessage
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBParameterGroupInput) *rds.DBParameterGroupNameMessage); ok {
+	var r1 *rds.ModifyTenantDatabaseOutput
+	if rf, ok := ret.Get(1).(func(*rds.ModifyTenantDatabaseInput) *rds.ModifyTenantDatabaseOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DBParameterGroupNameMessage)
+			r1 = ret.Get(1).(*rds.ModifyTenantDatabaseOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ModifyDBParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ModifyDBParameterGroupWithContext(_a0 context.Context, _a1 *rds.ModifyDBParameterGroupInput, _a2 ...request.Option) (*rds.DBParameterGroupNameMessage, error) {
+// ModifyTenantDatabaseWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ModifyTenantDatabaseWithContext(_a0 context.Context, _a1 *rds.ModifyTenantDatabaseInput, _a2 ...request.Option) (*rds.ModifyTenantDatabaseOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -8675,17 +11424,17 @@ func (_m *RDSAPI) ModifyDBParameterGroupWithContext(_a0 context.Context, _a1 *rd
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DBParameterGroupNameMessage
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBParameterGroupInput, ...request.Option) *rds.DBParameterGroupNameMessage); ok {
+	var r0 *rds.ModifyTenantDatabaseOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyTenantDatabaseInput, ...request.Option) *rds.ModifyTenantDatabaseOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DBParameterGroupNameMessage)
+			r0 = ret.Get(0).(*rds.ModifyTenantDatabaseOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBParameterGroupInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyTenantDatabaseInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -8694,21 +11443,21 @@ func (_m *RDSAPI) ModifyDBParameterGroupWithContext(_a0 context.Context, _a1 *rd
 	return r0, r1
 }
 
-// ModifyDBProxy provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBProxy(_a0 *rds.ModifyDBProxyInput) (*rds.ModifyDBProxyOutput, error) {
+// PromoteReadReplica provides a mock function with given fields: _a0
+func (_m *RDSAPI) PromoteReadReplica(_a0 *rds.PromoteReadReplicaInput) (*rds.PromoteReadReplicaOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.ModifyDBProxyOutput
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBProxyInput) *rds.ModifyDBProxyOutput); ok {
+	var r0 *rds.PromoteReadReplicaOutput
+	if rf, ok := ret.Get(0).(func(*rds.PromoteReadReplicaInput) *rds.PromoteReadReplicaOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyDBProxyOutput)
+			r0 = ret.Get(0).(*rds.PromoteReadReplicaOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBProxyInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.PromoteReadReplicaInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -8717,46 +11466,21 @@ func (_m *RDSAPI) ModifyDBProxy(_a0 *rds.ModifyDBProxyInput) (*rds.ModifyDBProxy
 	return r0, r1
 }
 
-// ModifyDBProxyRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBProxyRequest(_a0 *rds.ModifyDBProxyInput) (*request.Request, *rds.ModifyDBProxyOutput) {
-	ret := _m.Called(_a0)
-
-	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBProxyInput) *request.Request); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*request.Request)
-		}
-	}
-
-	var r1 *rds.ModifyDBProxyOutput
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBProxyInput) *rds.ModifyDBProxyOutput); ok {
-		r1 = rf(_a0)
-	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.ModifyDBProxyOutput)
-		}
-	}
-
-	return r0, r1
-}
-
-// ModifyDBProxyTargetGroup provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBProxyTargetGroup(_a0 *rds.ModifyDBProxyTargetGroupInput) (*rds.ModifyDBProxyTargetGroupOutput, error) {
+// PromoteReadReplicaDBCluster provides a mock function with given fields: _a0
+func (_m *RDSAPI) PromoteReadReplicaDBCluster(_a0 *rds.PromoteReadReplicaDBClusterInput) (*rds.PromoteReadReplicaDBClusterOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.ModifyDBProxyTargetGroupOutput
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBProxyTargetGroupInput) *rds.ModifyDBProxyTargetGroupOutput); ok {
+	var r0 *rds.PromoteReadReplicaDBClusterOutput
+	if rf, ok := ret.Get(0).(func(*rds.PromoteReadReplicaDBClusterInput) *rds.PromoteReadReplicaDBClusterOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyDBProxyTargetGroupOutput)
+			r0 = ret.Get(0).(*rds.PromoteReadReplicaDBClusterOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBProxyTargetGroupInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.PromoteReadReplicaDBClusterInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -8765,12 +11489,12 @@ func (_m *RDSAPI) ModifyDBProxyTargetGroup(_a0 *rds.ModifyDBProxyTargetGroupInpu
 	return r0, r1
 }
 
-// ModifyDBProxyTargetGroupRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBProxyTargetGroupRequest(_a0 *rds.ModifyDBProxyTargetGroupInput) (*request.Request, *rds.ModifyDBProxyTargetGroupOutput) {
+// PromoteReadReplicaDBClusterRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) PromoteReadReplicaDBClusterRequest(_a0 *rds.PromoteReadReplicaDBClusterInput) (*request.Request, *rds.PromoteReadReplicaDBClusterOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBProxyTargetGroupInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.PromoteReadReplicaDBClusterInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -8778,20 +11502,20 @@ func (_m *RDSAPI) ModifyDBProxyTargetGroupRequest(_a0 *rds.ModifyDBProxyTargetGr
 		}
 	}
 
-	var r1 *rds.ModifyDBProxyTargetGroupOutput
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBProxyTargetGroupInput) *rds.ModifyDBProxyTargetGroupOutput); ok {
+	var r1 *rds.PromoteReadReplicaDBClusterOutput
+	if rf, ok := ret.Get(1).(func(*rds.PromoteReadReplicaDBClusterInput) *rds.PromoteReadReplicaDBClusterOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.ModifyDBProxyTargetGroupOutput)
+			r1 = ret.Get(1).(*rds.PromoteReadReplicaDBClusterOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ModifyDBProxyTargetGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ModifyDBProxyTargetGroupWithContext(_a0 context.Context, _a1 *rds.ModifyDBProxyTargetGroupInput, _a2 ...request.Option) (*rds.ModifyDBProxyTargetGroupOutput, error) {
+// PromoteReadReplicaDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) PromoteReadReplicaDBClusterWithContext(_a0 context.Context, _a1 *rds.PromoteReadReplicaDBClusterInput, _a2 ...request.Option) (*rds.PromoteReadReplicaDBClusterOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -8801,27 +11525,52 @@ func (_m *RDSAPI) ModifyDBProxyTargetGroupWithContext(_a0 context.Context, _a1 *
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.ModifyDBProxyTargetGroupOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBProxyTargetGroupInput, ...request.Option) *rds.ModifyDBProxyTargetGroupOutput); ok {
+	var r0 *rds.PromoteReadReplicaDBClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.PromoteReadReplicaDBClusterInput, ...request.Option) *rds.PromoteReadReplicaDBClusterOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyDBProxyTargetGroupOutput)
+			r0 = ret.Get(0).(*rds.PromoteReadReplicaDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.PromoteReadReplicaDBClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PromoteReadReplicaRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) PromoteReadReplicaRequest(_a0 *rds.PromoteReadReplicaInput) (*request.Request, *rds.PromoteReadReplicaOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*rds.PromoteReadReplicaInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBProxyTargetGroupInput, ...request.Option) error); ok {
-		r1 = rf(_a0, _a1, _a2...)
+	var r1 *rds.PromoteReadReplicaOutput
+	if rf, ok := ret.Get(1).(func(*rds.PromoteReadReplicaInput) *rds.PromoteReadReplicaOutput); ok {
+		r1 = rf(_a0)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*rds.PromoteReadReplicaOutput)
+		}
 	}
 
 	return r0, r1
 }
 
-// ModifyDBProxyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ModifyDBProxyWithContext(_a0 context.Context, _a1 *rds.ModifyDBProxyInput, _a2 ...request.Option) (*rds.ModifyDBProxyOutput, error) {
+// PromoteReadReplicaWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) PromoteReadReplicaWithContext(_a0 context.Context, _a1 *rds.PromoteReadReplicaInput, _a2 ...request.Option) (*rds.PromoteReadReplicaOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -8831,17 +11580,17 @@ func (_m *RDSAPI) ModifyDBProxyWithContext(_a0 context.Context, _a1 *rds.ModifyD
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.ModifyDBProxyOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBProxyInput, ...request.Option) *rds.ModifyDBProxyOutput); ok {
+	var r0 *rds.PromoteReadReplicaOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.PromoteReadReplicaInput, ...request.Option) *rds.PromoteReadReplicaOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyDBProxyOutput)
+			r0 = ret.Get(0).(*rds.PromoteReadReplicaOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBProxyInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.PromoteReadReplicaInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -8850,44 +11599,21 @@ func (_m *RDSAPI) ModifyDBProxyWithContext(_a0 context.Context, _a1 *rds.ModifyD
 	return r0, r1
 }
 
-// ModifyDBSnapshot provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBSnapshot(_a0 *rds.ModifyDBSnapshotInput) (*rds.ModifyDBSnapshotOutput, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *rds.ModifyDBSnapshotOutput
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBSnapshotInput) *rds.ModifyDBSnapshotOutput); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyDBSnapshotOutput)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBSnapshotInput) error); ok {
-		r1 = rf(_a0)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// ModifyDBSnapshotAttribute provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBSnapshotAttribute(_a0 *rds.ModifyDBSnapshotAttributeInput) (*rds.ModifyDBSnapshotAttributeOutput, error) {
+// PurchaseReservedDBInstancesOffering provides a mock function with given fields: _a0
+func (_m *RDSAPI) PurchaseReservedDBInstancesOffering(_a0 *rds.PurchaseReservedDBInstancesOfferingInput) (*rds.PurchaseReservedDBInstancesOfferingOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.ModifyDBSnapshotAttributeOutput
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBSnapshotAttributeInput) *rds.ModifyDBSnapshotAttributeOutput); ok {
+	var r0 *rds.PurchaseReservedDBInstancesOfferingOutput
+	if rf, ok := ret.Get(0).(func(*rds.PurchaseReservedDBInstancesOfferingInput) *rds.PurchaseReservedDBInstancesOfferingOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyDBSnapshotAttributeOutput)
+			r0 = ret.Get(0).(*rds.PurchaseReservedDBInstancesOfferingOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBSnapshotAttributeInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.PurchaseReservedDBInstancesOfferingInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -8896,12 +11622,12 @@ func (_m *RDSAPI) ModifyDBSnapshotAttribute(_a0 *rds.ModifyDBSnapshotAttributeIn
 	return r0, r1
 }
 
-// ModifyDBSnapshotAttributeRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBSnapshotAttributeRequest(_a0 *rds.ModifyDBSnapshotAttributeInput) (*request.Request, *rds.ModifyDBSnapshotAttributeOutput) {
+// PurchaseReservedDBInstancesOfferingRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) PurchaseReservedDBInstancesOfferingRequest(_a0 *rds.PurchaseReservedDBInstancesOfferingInput) (*request.Request, *rds.PurchaseReservedDBInstancesOfferingOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBSnapshotAttributeInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.PurchaseReservedDBInstancesOfferingInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -8909,20 +11635,20 @@ func (_m *RDSAPI) ModifyDBSnapshotAttributeRequest(_a0 *rds.ModifyDBSnapshotAttr
 		}
 	}
 
-	var r1 *rds.ModifyDBSnapshotAttributeOutput
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBSnapshotAttributeInput) *rds.ModifyDBSnapshotAttributeOutput); ok {
+	var r1 *rds.PurchaseReservedDBInstancesOfferingOutput
+	if rf, ok := ret.Get(1).(func(*rds.PurchaseReservedDBInstancesOfferingInput) *rds.PurchaseReservedDBInstancesOfferingOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.ModifyDBSnapshotAttributeOutput)
+			r1 = ret.Get(1).(*rds.PurchaseReservedDBInstancesOfferingOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ModifyDBSnapshotAttributeWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ModifyDBSnapshotAttributeWithContext(_a0 context.Context, _a1 *rds.ModifyDBSnapshotAttributeInput, _a2 ...request.Option) (*rds.ModifyDBSnapshotAttributeOutput, error) {
+// PurchaseReservedDBInstancesOfferingWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) PurchaseReservedDBInstancesOfferingWithContext(_a0 context.Context, _a1 *rds.PurchaseReservedDBInstancesOfferingInput, _a2 ...request.Option) (*rds.PurchaseReservedDBInstancesOfferingOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -8932,17 +11658,17 @@ func (_m *RDSAPI) ModifyDBSnapshotAttributeWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.ModifyDBSnapshotAttributeOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBSnapshotAttributeInput, ...request.Option) *rds.ModifyDBSnapshotAttributeOutput); ok {
+	var r0 *rds.PurchaseReservedDBInstancesOfferingOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.PurchaseReservedDBInstancesOfferingInput, ...request.Option) *rds.PurchaseReservedDBInstancesOfferingOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyDBSnapshotAttributeOutput)
+			r0 = ret.Get(0).(*rds.PurchaseReservedDBInstancesOfferingOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBSnapshotAttributeInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.PurchaseReservedDBInstancesOfferingInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -8951,12 +11677,35 @@ func (_m *RDSAPI) ModifyDBSnapshotAttributeWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// ModifyDBSnapshotRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBSnapshotRequest(_a0 *rds.ModifyDBSnapshotInput) (*request.Request, *rds.ModifyDBSnapshotOutput) {
+// RebootDBCluster provides a mock function with given fields: _a0
+func (_m *RDSAPI) RebootDBCluster(_a0 *rds.RebootDBClusterInput) (*rds.RebootDBClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.RebootDBClusterOutput
+	if rf, ok := ret.Get(0).(func(*rds.RebootDBClusterInput) *rds.RebootDBClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.RebootDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.RebootDBClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RebootDBClusterRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) RebootDBClusterRequest(_a0 *rds.RebootDBClusterInput) (*request.Request, *rds.RebootDBClusterOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBSnapshotInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.RebootDBClusterInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -8964,20 +11713,20 @@ func (_m *RDSAPI) ModifyDBSnapshotRequest(_a0 *rds.ModifyDBSnapshotInput) (*requ
 		}
 	}
 
-	var r1 *rds.ModifyDBSnapshotOutput
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBSnapshotInput) *rds.ModifyDBSnapshotOutput); ok {
+	var r1 *rds.RebootDBClusterOutput
+	if rf, ok := ret.Get(1).(func(*rds.RebootDBClusterInput) *rds.RebootDBClusterOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.ModifyDBSnapshotOutput)
+			r1 = ret.Get(1).(*rds.RebootDBClusterOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ModifyDBSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ModifyDBSnapshotWithContext(_a0 context.Context, _a1 *rds.ModifyDBSnapshotInput, _a2 ...request.Option) (*rds.ModifyDBSnapshotOutput, error) {
+// RebootDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) RebootDBClusterWithContext(_a0 context.Context, _a1 *rds.RebootDBClusterInput, _a2 ...request.Option) (*rds.RebootDBClusterOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -8987,17 +11736,17 @@ func (_m *RDSAPI) ModifyDBSnapshotWithContext(_a0 context.Context, _a1 *rds.Modi
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.ModifyDBSnapshotOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBSnapshotInput, ...request.Option) *rds.ModifyDBSnapshotOutput); ok {
+	var r0 *rds.RebootDBClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.RebootDBClusterInput, ...request.Option) *rds.RebootDBClusterOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyDBSnapshotOutput)
+			r0 = ret.Get(0).(*rds.RebootDBClusterOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBSnapshotInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.RebootDBClusterInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9006,21 +11755,21 @@ func (_m *RDSAPI) ModifyDBSnapshotWithContext(_a0 context.Context, _a1 *rds.Modi
 	return r0, r1
 }
 
-// ModifyDBSubnetGroup provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBSubnetGroup(_a0 *rds.ModifyDBSubnetGroupInput) (*rds.ModifyDBSubnetGroupOutput, error) {
+// RebootDBInstance provides a mock function with given fields: _a0
+func (_m *RDSAPI) RebootDBInstance(_a0 *rds.RebootDBInstanceInput) (*rds.RebootDBInstanceOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.ModifyDBSubnetGroupOutput
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBSubnetGroupInput) *rds.ModifyDBSubnetGroupOutput); ok {
+	var r0 *rds.RebootDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(*rds.RebootDBInstanceInput) *rds.RebootDBInstanceOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyDBSubnetGroupOutput)
+			r0 = ret.Get(0).(*rds.RebootDBInstanceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBSubnetGroupInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.RebootDBInstanceInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9029,12 +11778,12 @@ func (_m *RDSAPI) ModifyDBSubnetGroup(_a0 *rds.ModifyDBSubnetGroupInput) (*rds.M
 	return r0, r1
 }
 
-// ModifyDBSubnetGroupRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyDBSubnetGroupRequest(_a0 *rds.ModifyDBSubnetGroupInput) (*request.Request, *rds.ModifyDBSubnetGroupOutput) {
+// RebootDBInstanceRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) RebootDBInstanceRequest(_a0 *rds.RebootDBInstanceInput) (*request.Request, *rds.RebootDBInstanceOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ModifyDBSubnetGroupInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.RebootDBInstanceInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9042,20 +11791,20 @@ func (_m *RDSAPI) ModifyDBSubnetGroupRequest(_a0 *rds.ModifyDBSubnetGroupInput)
 		}
 	}
 
-	var r1 *rds.ModifyDBSubnetGroupOutput
-	if rf, ok := ret.Get(1).(func(*rds.ModifyDBSubnetGroupInput) *rds.ModifyDBSubnetGroupOutput); ok {
+	var r1 *rds.RebootDBInstanceOutput
+	if rf, ok := ret.Get(1).(func(*rds.RebootDBInstanceInput) *rds.RebootDBInstanceOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.ModifyDBSubnetGroupOutput)
+			r1 = ret.Get(1).(*rds.RebootDBInstanceOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ModifyDBSubnetGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ModifyDBSubnetGroupWithContext(_a0 context.Context, _a1 *rds.ModifyDBSubnetGroupInput, _a2 ...request.Option) (*rds.ModifyDBSubnetGroupOutput, error) {
+// RebootDBInstanceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) RebootDBInstanceWithContext(_a0 context.Context, _a1 *rds.RebootDBInstanceInput, _a2 ...request.Option) (*rds.RebootDBInstanceOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9065,17 +11814,17 @@ func (_m *RDSAPI) ModifyDBSubnetGroupWithContext(_a0 context.Context, _a1 *rds.M
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.ModifyDBSubnetGroupOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyDBSubnetGroupInput, ...request.Option) *rds.ModifyDBSubnetGroupOutput); ok {
+	var r0 *rds.RebootDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.RebootDBInstanceInput, ...request.Option) *rds.RebootDBInstanceOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyDBSubnetGroupOutput)
+			r0 = ret.Get(0).(*rds.RebootDBInstanceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyDBSubnetGroupInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.RebootDBInstanceInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9084,21 +11833,21 @@ func (_m *RDSAPI) ModifyDBSubnetGroupWithContext(_a0 context.Context, _a1 *rds.M
 	return r0, r1
 }
 
-// ModifyEventSubscription provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyEventSubscription(_a0 *rds.ModifyEventSubscriptionInput) (*rds.ModifyEventSubscriptionOutput, error) {
+// RebootDBShardGroup provides a mock function with given fields: _a0
+func (_m *RDSAPI) RebootDBShardGroup(_a0 *rds.RebootDBShardGroupInput) (*rds.RebootDBShardGroupOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.ModifyEventSubscriptionOutput
-	if rf, ok := ret.Get(0).(func(*rds.ModifyEventSubscriptionInput) *rds.ModifyEventSubscriptionOutput); ok {
+	var r0 *rds.RebootDBShardGroupOutput
+	if rf, ok := ret.Get(0).(func(*rds.RebootDBShardGroupInput) *rds.RebootDBShardGroupOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyEventSubscriptionOutput)
+			r0 = ret.Get(0).(*rds.RebootDBShardGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ModifyEventSubscriptionInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.RebootDBShardGroupInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9107,12 +11856,12 @@ func (_m *RDSAPI) ModifyEventSubscription(_a0 *rds.ModifyEventSubscriptionInput)
 	return r0, r1
 }
 
-// ModifyEventSubscriptionRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyEventSubscriptionRequest(_a0 *rds.ModifyEventSubscriptionInput) (*request.Request, *rds.ModifyEventSubscriptionOutput) {
+// RebootDBShardGroupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) RebootDBShardGroupRequest(_a0 *rds.RebootDBShardGroupInput) (*request.Request, *rds.RebootDBShardGroupOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ModifyEventSubscriptionInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.RebootDBShardGroupInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9120,20 +11869,20 @@ func (_m *RDSAPI) ModifyEventSubscriptionRequest(_a0 *rds.ModifyEventSubscriptio
 		}
 	}
 
-	var r1 *rds.ModifyEventSubscriptionOutput
-	if rf, ok := ret.Get(1).(func(*rds.ModifyEventSubscriptionInput) *rds.ModifyEventSubscriptionOutput); ok {
+	var r1 *rds.RebootDBShardGroupOutput
+	if rf, ok := ret.Get(1).(func(*rds.RebootDBShardGroupInput) *rds.RebootDBShardGroupOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.ModifyEventSubscriptionOutput)
+			r1 = ret.Get(1).(*rds.RebootDBShardGroupOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ModifyEventSubscriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ModifyEventSubscriptionWithContext(_a0 context.Context, _a1 *rds.ModifyEventSubscriptionInput, _a2 ...request.Option) (*rds.ModifyEventSubscriptionOutput, error) {
+// RebootDBShardGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) RebootDBShardGroupWithContext(_a0 context.Context, _a1 *rds.RebootDBShardGroupInput, _a2 ...request.Option) (*rds.RebootDBShardGroupOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9143,17 +11892,17 @@ func (_m *RDSAPI) ModifyEventSubscriptionWithContext(_a0 context.Context, _a1 *r
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.ModifyEventSubscriptionOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyEventSubscriptionInput, ...request.Option) *rds.ModifyEventSubscriptionOutput); ok {
+	var r0 *rds.RebootDBShardGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.RebootDBShardGroupInput, ...request.Option) *rds.RebootDBShardGroupOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyEventSubscriptionOutput)
+			r0 = ret.Get(0).(*rds.RebootDBShardGroupOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyEventSubscriptionInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.RebootDBShardGroupInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9162,21 +11911,21 @@ func (_m *RDSAPI) ModifyEventSubscriptionWithContext(_a0 context.Context, _a1 *r
 	return r0, r1
 }
 
-// ModifyGlobalCluster provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyGlobalCluster(_a0 *rds.ModifyGlobalClusterInput) (*rds.ModifyGlobalClusterOutput, error) {
+// RegisterDBProxyTargets provides a mock function with given fields: _a0
+func (_m *RDSAPI) RegisterDBProxyTargets(_a0 *rds.RegisterDBProxyTargetsInput) (*rds.RegisterDBProxyTargetsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.ModifyGlobalClusterOutput
-	if rf, ok := ret.Get(0).(func(*rds.ModifyGlobalClusterInput) *rds.ModifyGlobalClusterOutput); ok {
+	var r0 *rds.RegisterDBProxyTargetsOutput
+	if rf, ok := ret.Get(0).(func(*rds.RegisterDBProxyTargetsInput) *rds.RegisterDBProxyTargetsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyGlobalClusterOutput)
+			r0 = ret.Get(0).(*rds.RegisterDBProxyTargetsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ModifyGlobalClusterInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.RegisterDBProxyTargetsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9185,12 +11934,12 @@ func (_m *RDSAPI) ModifyGlobalCluster(_a0 *rds.ModifyGlobalClusterInput) (*rds.M
 	return r0, r1
 }
 
-// ModifyGlobalClusterRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyGlobalClusterRequest(_a0 *rds.ModifyGlobalClusterInput) (*request.Request, *rds.ModifyGlobalClusterOutput) {
+// RegisterDBProxyTargetsRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) RegisterDBProxyTargetsRequest(_a0 *rds.RegisterDBProxyTargetsInput) (*request.Request, *rds.RegisterDBProxyTargetsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ModifyGlobalClusterInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.RegisterDBProxyTargetsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9198,20 +11947,20 @@ func (_m *RDSAPI) ModifyGlobalClusterRequest(_a0 *rds.ModifyGlobalClusterInput)
 		}
 	}
 
-	var r1 *rds.ModifyGlobalClusterOutput
-	if rf, ok := ret.Get(1).(func(*rds.ModifyGlobalClusterInput) *rds.ModifyGlobalClusterOutput); ok {
+	var r1 *rds.RegisterDBProxyTargetsOutput
+	if rf, ok := ret.Get(1).(func(*rds.RegisterDBProxyTargetsInput) *rds.RegisterDBProxyTargetsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.ModifyGlobalClusterOutput)
+			r1 = ret.Get(1).(*rds.RegisterDBProxyTargetsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ModifyGlobalClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ModifyGlobalClusterWithContext(_a0 context.Context, _a1 *rds.ModifyGlobalClusterInput, _a2 ...request.Option) (*rds.ModifyGlobalClusterOutput, error) {
+// RegisterDBProxyTargetsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) RegisterDBProxyTargetsWithContext(_a0 context.Context, _a1 *rds.RegisterDBProxyTargetsInput, _a2 ...request.Option) (*rds.RegisterDBProxyTargetsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9221,17 +11970,17 @@ func (_m *RDSAPI) ModifyGlobalClusterWithContext(_a0 context.Context, _a1 *rds.M
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.ModifyGlobalClusterOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyGlobalClusterInput, ...request.Option) *rds.ModifyGlobalClusterOutput); ok {
+	var r0 *rds.RegisterDBProxyTargetsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.RegisterDBProxyTargetsInput, ...request.Option) *rds.RegisterDBProxyTargetsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyGlobalClusterOutput)
+			r0 = ret.Get(0).(*rds.RegisterDBProxyTargetsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyGlobalClusterInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.RegisterDBProxyTargetsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9240,21 +11989,21 @@ func (_m *RDSAPI) ModifyGlobalClusterWithContext(_a0 context.Context, _a1 *rds.M
 	return r0, r1
 }
 
-// ModifyOptionGroup provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyOptionGroup(_a0 *rds.ModifyOptionGroupInput) (*rds.ModifyOptionGroupOutput, error) {
+// RemoveFromGlobalCluster provides a mock function with given fields: _a0
+func (_m *RDSAPI) RemoveFromGlobalCluster(_a0 *rds.RemoveFromGlobalClusterInput) (*rds.RemoveFromGlobalClusterOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.ModifyOptionGroupOutput
-	if rf, ok := ret.Get(0).(func(*rds.ModifyOptionGroupInput) *rds.ModifyOptionGroupOutput); ok {
+	var r0 *rds.RemoveFromGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(*rds.RemoveFromGlobalClusterInput) *rds.RemoveFromGlobalClusterOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyOptionGroupOutput)
+			r0 = ret.Get(0).(*rds.RemoveFromGlobalClusterOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ModifyOptionGroupInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.RemoveFromGlobalClusterInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9263,12 +12012,12 @@ func (_m *RDSAPI) ModifyOptionGroup(_a0 *rds.ModifyOptionGroupInput) (*rds.Modif
 	return r0, r1
 }
 
-// ModifyOptionGroupRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ModifyOptionGroupRequest(_a0 *rds.ModifyOptionGroupInput) (*request.Request, *rds.ModifyOptionGroupOutput) {
+// RemoveFromGlobalClusterRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) RemoveFromGlobalClusterRequest(_a0 *rds.RemoveFromGlobalClusterInput) (*request.Request, *rds.RemoveFromGlobalClusterOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ModifyOptionGroupInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.RemoveFromGlobalClusterInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9276,20 +12025,20 @@ func (_m *RDSAPI) ModifyOptionGroupRequest(_a0 *rds.ModifyOptionGroupInput) (*re
 		}
 	}
 
-	var r1 *rds.ModifyOptionGroupOutput
-	if rf, ok := ret.Get(1).(func(*rds.ModifyOptionGroupInput) *rds.ModifyOptionGroupOutput); ok {
+	var r1 *rds.RemoveFromGlobalClusterOutput
+	if rf, ok := ret.Get(1).(func(*rds.RemoveFromGlobalClusterInput) *rds.RemoveFromGlobalClusterOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.ModifyOptionGroupOutput)
+			r1 = ret.Get(1).(*rds.RemoveFromGlobalClusterOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ModifyOptionGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ModifyOptionGroupWithContext(_a0 context.Context, _a1 *rds.ModifyOptionGroupInput, _a2 ...request.Option) (*rds.ModifyOptionGroupOutput, error) {
+// RemoveFromGlobalClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) RemoveFromGlobalClusterWithContext(_a0 context.Context, _a1 *rds.RemoveFromGlobalClusterInput, _a2 ...request.Option) (*rds.RemoveFromGlobalClusterOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9299,41 +12048,18 @@ func (_m *RDSAPI) ModifyOptionGroupWithContext(_a0 context.Context, _a1 *rds.Mod
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.ModifyOptionGroupOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ModifyOptionGroupInput, ...request.Option) *rds.ModifyOptionGroupOutput); ok {
-		r0 = rf(_a0, _a1, _a2...)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.ModifyOptionGroupOutput)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ModifyOptionGroupInput, ...request.Option) error); ok {
-		r1 = rf(_a0, _a1, _a2...)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// PromoteReadReplica provides a mock function with given fields: _a0
-func (_m *RDSAPI) PromoteReadReplica(_a0 *rds.PromoteReadReplicaInput) (*rds.PromoteReadReplicaOutput, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *rds.PromoteReadReplicaOutput
-	if rf, ok := ret.Get(0).(func(*rds.PromoteReadReplicaInput) *rds.PromoteReadReplicaOutput); ok {
-		r0 = rf(_a0)
+	var r0 *rds.RemoveFromGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.RemoveFromGlobalClusterInput, ...request.Option) *rds.RemoveFromGlobalClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.PromoteReadReplicaOutput)
+			r0 = ret.Get(0).(*rds.RemoveFromGlobalClusterOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.PromoteReadReplicaInput) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.RemoveFromGlobalClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -9341,21 +12067,21 @@ func (_m *RDSAPI) PromoteReadReplica(_a0 *rds.PromoteReadReplicaInput) (*rds.Pro
 	return r0, r1
 }
 
-// PromoteReadReplicaDBCluster provides a mock function with given fields: _a0
-func (_m *RDSAPI) PromoteReadReplicaDBCluster(_a0 *rds.PromoteReadReplicaDBClusterInput) (*rds.PromoteReadReplicaDBClusterOutput, error) {
+// RemoveRoleFromDBCluster provides a mock function with given fields: _a0
+func (_m *RDSAPI) RemoveRoleFromDBCluster(_a0 *rds.RemoveRoleFromDBClusterInput) (*rds.RemoveRoleFromDBClusterOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.PromoteReadReplicaDBClusterOutput
-	if rf, ok := ret.Get(0).(func(*rds.PromoteReadReplicaDBClusterInput) *rds.PromoteReadReplicaDBClusterOutput); ok {
+	var r0 *rds.RemoveRoleFromDBClusterOutput
+	if rf, ok := ret.Get(0).(func(*rds.RemoveRoleFromDBClusterInput) *rds.RemoveRoleFromDBClusterOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.PromoteReadReplicaDBClusterOutput)
+			r0 = ret.Get(0).(*rds.RemoveRoleFromDBClusterOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.PromoteReadReplicaDBClusterInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.RemoveRoleFromDBClusterInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9364,12 +12090,12 @@ func (_m *RDSAPI) PromoteReadReplicaDBCluster(_a0 *rds.PromoteReadReplicaDBClust
 	return r0, r1
 }
 
-// PromoteReadReplicaDBClusterRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) PromoteReadReplicaDBClusterRequest(_a0 *rds.PromoteReadReplicaDBClusterInput) (*request.Request, *rds.PromoteReadReplicaDBClusterOutput) {
+// RemoveRoleFromDBClusterRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) RemoveRoleFromDBClusterRequest(_a0 *rds.RemoveRoleFromDBClusterInput) (*request.Request, *rds.RemoveRoleFromDBClusterOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.PromoteReadReplicaDBClusterInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.RemoveRoleFromDBClusterInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9377,20 +12103,20 @@ func (_m *RDSAPI) PromoteReadReplicaDBClusterRequest(_a0 *rds.PromoteReadReplica
 		}
 	}
 
-	var r1 *rds.PromoteReadReplicaDBClusterOutput
-	if rf, ok := ret.Get(1).(func(*rds.PromoteReadReplicaDBClusterInput) *rds.PromoteReadReplicaDBClusterOutput); ok {
+	var r1 *rds.RemoveRoleFromDBClusterOutput
+	if rf, ok := ret.Get(1).(func(*rds.RemoveRoleFromDBClusterInput) *rds.RemoveRoleFromDBClusterOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.PromoteReadReplicaDBClusterOutput)
+			r1 = ret.Get(1).(*rds.RemoveRoleFromDBClusterOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// PromoteReadReplicaDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) PromoteReadReplicaDBClusterWithContext(_a0 context.Context, _a1 *rds.PromoteReadReplicaDBClusterInput, _a2 ...request.Option) (*rds.PromoteReadReplicaDBClusterOutput, error) {
+// RemoveRoleFromDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) RemoveRoleFromDBClusterWithContext(_a0 context.Context, _a1 *rds.RemoveRoleFromDBClusterInput, _a2 ...request.Option) (*rds.RemoveRoleFromDBClusterOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9400,17 +12126,17 @@ func (_m *RDSAPI) PromoteReadReplicaDBClusterWithContext(_a0 context.Context, _a
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.PromoteReadReplicaDBClusterOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.PromoteReadReplicaDBClusterInput, ...request.Option) *rds.PromoteReadReplicaDBClusterOutput); ok {
+	var r0 *rds.RemoveRoleFromDBClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.RemoveRoleFromDBClusterInput, ...request.Option) *rds.RemoveRoleFromDBClusterOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.PromoteReadReplicaDBClusterOutput)
+			r0 = ret.Get(0).(*rds.RemoveRoleFromDBClusterOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.PromoteReadReplicaDBClusterInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.RemoveRoleFromDBClusterInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9419,12 +12145,35 @@ func (_m *RDSAPI) PromoteReadReplicaDBClusterWithContext(_a0 context.Context, _a
 	return r0, r1
 }
 
-// PromoteReadReplicaRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) PromoteReadReplicaRequest(_a0 *rds.PromoteReadReplicaInput) (*request.Request, *rds.PromoteReadReplicaOutput) {
+// RemoveRoleFromDBInstance provides a mock function with given fields: _a0
+func (_m *RDSAPI) RemoveRoleFromDBInstance(_a0 *rds.RemoveRoleFromDBInstanceInput) (*rds.RemoveRoleFromDBInstanceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.RemoveRoleFromDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(*rds.RemoveRoleFromDBInstanceInput) *rds.RemoveRoleFromDBInstanceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.RemoveRoleFromDBInstanceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.RemoveRoleFromDBInstanceInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveRoleFromDBInstanceRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) RemoveRoleFromDBInstanceRequest(_a0 *rds.RemoveRoleFromDBInstanceInput) (*request.Request, *rds.RemoveRoleFromDBInstanceOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.PromoteReadReplicaInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.RemoveRoleFromDBInstanceInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9432,20 +12181,20 @@ func (_m *RDSAPI) PromoteReadReplicaRequest(_a0 *rds.PromoteReadReplicaInput) (*
 		}
 	}
 
-	var r1 *rds.PromoteReadReplicaOutput
-	if rf, ok := ret.Get(1).(func(*rds.PromoteReadReplicaInput) *rds.PromoteReadReplicaOutput); ok {
+	var r1 *rds.RemoveRoleFromDBInstanceOutput
+	if rf, ok := ret.Get(1).(func(*rds.RemoveRoleFromDBInstanceInput) *rds.RemoveRoleFromDBInstanceOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.PromoteReadReplicaOutput)
+			r1 = ret.Get(1).(*rds.RemoveRoleFromDBInstanceOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// PromoteReadReplicaWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) PromoteReadReplicaWithContext(_a0 context.Context, _a1 *rds.PromoteReadReplicaInput, _a2 ...request.Option) (*rds.PromoteReadReplicaOutput, error) {
+// RemoveRoleFromDBInstanceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) RemoveRoleFromDBInstanceWithContext(_a0 context.Context, _a1 *rds.RemoveRoleFromDBInstanceInput, _a2 ...request.Option) (*rds.RemoveRoleFromDBInstanceOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9455,17 +12204,17 @@ func (_m *RDSAPI) PromoteReadReplicaWithContext(_a0 context.Context, _a1 *rds.Pr
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.PromoteReadReplicaOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.PromoteReadReplicaInput, ...request.Option) *rds.PromoteReadReplicaOutput); ok {
+	var r0 *rds.RemoveRoleFromDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.RemoveRoleFromDBInstanceInput, ...request.Option) *rds.RemoveRoleFromDBInstanceOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.PromoteReadReplicaOutput)
+			r0 = ret.Get(0).(*rds.RemoveRoleFromDBInstanceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.PromoteReadReplicaInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.RemoveRoleFromDBInstanceInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9474,21 +12223,21 @@ func (_m *RDSAPI) PromoteReadReplicaWithContext(_a0 context.Context, _a1 *rds.Pr
 	return r0, r1
 }
 
-// PurchaseReservedDBInstancesOffering provides a mock function with given fields: _a0
-func (_m *RDSAPI) PurchaseReservedDBInstancesOffering(_a0 *rds.PurchaseReservedDBInstancesOfferingInput) (*rds.PurchaseReservedDBInstancesOfferingOutput, error) {
+// RemoveSourceIdentifierFromSubscription provides a mock function with given fields: _a0
+func (_m *RDSAPI) RemoveSourceIdentifierFromSubscription(_a0 *rds.RemoveSourceIdentifierFromSubscriptionInput) (*rds.RemoveSourceIdentifierFromSubscriptionOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.PurchaseReservedDBInstancesOfferingOutput
-	if rf, ok := ret.Get(0).(func(*rds.PurchaseReservedDBInstancesOfferingInput) *rds.PurchaseReservedDBInstancesOfferingOutput); ok {
+	var r0 *rds.RemoveSourceIdentifierFromSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(*rds.RemoveSourceIdentifierFromSubscriptionInput) *rds.RemoveSourceIdentifierFromSubscriptionOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.PurchaseReservedDBInstancesOfferingOutput)
+			r0 = ret.Get(0).(*rds.RemoveSourceIdentifierFromSubscriptionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.PurchaseReservedDBInstancesOfferingInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.RemoveSourceIdentifierFromSubscriptionInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9497,12 +12246,12 @@ func (_m *RDSAPI) PurchaseReservedDBInstancesOffering(_a0 *rds.PurchaseReservedD
 	return r0, r1
 }
 
-// PurchaseReservedDBInstancesOfferingRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) PurchaseReservedDBInstancesOfferingRequest(_a0 *rds.PurchaseReservedDBInstancesOfferingInput) (*request.Request, *rds.PurchaseReservedDBInstancesOfferingOutput) {
+// RemoveSourceIdentifierFromSubscriptionRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) RemoveSourceIdentifierFromSubscriptionRequest(_a0 *rds.RemoveSourceIdentifierFromSubscriptionInput) (*request.Request, *rds.RemoveSourceIdentifierFromSubscriptionOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.PurchaseReservedDBInstancesOfferingInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.RemoveSourceIdentifierFromSubscriptionInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9510,20 +12259,20 @@ func (_m *RDSAPI) PurchaseReservedDBInstancesOfferingRequest(_a0 *rds.PurchaseRe
 		}
 	}
 
-	var r1 *rds.PurchaseReservedDBInstancesOfferingOutput
-	if rf, ok := ret.Get(1).(func(*rds.PurchaseReservedDBInstancesOfferingInput) *rds.PurchaseReservedDBInstancesOfferingOutput); ok {
+	var r1 *rds.RemoveSourceIdentifierFromSubscriptionOutput
+	if rf, ok := ret.Get(1).(func(*rds.RemoveSourceIdentifierFromSubscriptionInput) *rds.RemoveSourceIdentifierFromSubscriptionOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.PurchaseReservedDBInstancesOfferingOutput)
+			r1 = ret.Get(1).(*rds.RemoveSourceIdentifierFromSubscriptionOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// PurchaseReservedDBInstancesOfferingWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) PurchaseReservedDBInstancesOfferingWithContext(_a0 context.Context, _a1 *rds.PurchaseReservedDBInstancesOfferingInput, _a2 ...request.Option) (*rds.PurchaseReservedDBInstancesOfferingOutput, error) {
+// RemoveSourceIdentifierFromSubscriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) RemoveSourceIdentifierFromSubscriptionWithContext(_a0 context.Context, _a1 *rds.RemoveSourceIdentifierFromSubscriptionInput, _a2 ...request.Option) (*rds.RemoveSourceIdentifierFromSubscriptionOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9533,17 +12282,17 @@ func (_m *RDSAPI) PurchaseReservedDBInstancesOfferingWithContext(_a0 context.Con
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.PurchaseReservedDBInstancesOfferingOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.PurchaseReservedDBInstancesOfferingInput, ...request.Option) *rds.PurchaseReservedDBInstancesOfferingOutput); ok {
+	var r0 *rds.RemoveSourceIdentifierFromSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.RemoveSourceIdentifierFromSubscriptionInput, ...request.Option) *rds.RemoveSourceIdentifierFromSubscriptionOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.PurchaseReservedDBInstancesOfferingOutput)
+			r0 = ret.Get(0).(*rds.RemoveSourceIdentifierFromSubscriptionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.PurchaseReservedDBInstancesOfferingInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.RemoveSourceIdentifierFromSubscriptionInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9552,21 +12301,21 @@ func (_m *RDSAPI) PurchaseReservedDBInstancesOfferingWithContext(_a0 context.Con
 	return r0, r1
 }
 
-// RebootDBInstance provides a mock function with given fields: _a0
-func (_m *RDSAPI) RebootDBInstance(_a0 *rds.RebootDBInstanceInput) (*rds.RebootDBInstanceOutput, error) {
+// RemoveTagsFromResource provides a mock function with given fields: _a0
+func (_m *RDSAPI) RemoveTagsFromResource(_a0 *rds.RemoveTagsFromResourceInput) (*rds.RemoveTagsFromResourceOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.RebootDBInstanceOutput
-	if rf, ok := ret.Get(0).(func(*rds.RebootDBInstanceInput) *rds.RebootDBInstanceOutput); ok {
+	var r0 *rds.RemoveTagsFromResourceOutput
+	if rf, ok := ret.Get(0).(func(*rds.RemoveTagsFromResourceInput) *rds.RemoveTagsFromResourceOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RebootDBInstanceOutput)
+			r0 = ret.Get(0).(*rds.RemoveTagsFromResourceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.RebootDBInstanceInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.RemoveTagsFromResourceInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9575,12 +12324,12 @@ func (_m *RDSAPI) RebootDBInstance(_a0 *rds.RebootDBInstanceInput) (*rds.RebootD
 	return r0, r1
 }
 
-// RebootDBInstanceRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) RebootDBInstanceRequest(_a0 *rds.RebootDBInstanceInput) (*request.Request, *rds.RebootDBInstanceOutput) {
+// RemoveTagsFromResourceRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) RemoveTagsFromResourceRequest(_a0 *rds.RemoveTagsFromResourceInput) (*request.Request, *rds.RemoveTagsFromResourceOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.RebootDBInstanceInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.RemoveTagsFromResourceInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9588,20 +12337,20 @@ func (_m *RDSAPI) RebootDBInstanceRequest(_a0 *rds.RebootDBInstanceInput) (*requ
 		}
 	}
 
-	var r1 *rds.RebootDBInstanceOutput
-	if rf, ok := ret.Get(1).(func(*rds.RebootDBInstanceInput) *rds.RebootDBInstanceOutput); ok {
+	var r1 *rds.RemoveTagsFromResourceOutput
+	if rf, ok := ret.Get(1).(func(*rds.RemoveTagsFromResourceInput) *rds.RemoveTagsFromResourceOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.RebootDBInstanceOutput)
+			r1 = ret.Get(1).(*rds.RemoveTagsFromResourceOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// RebootDBInstanceWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) RebootDBInstanceWithContext(_a0 context.Context, _a1 *rds.RebootDBInstanceInput, _a2 ...request.Option) (*rds.RebootDBInstanceOutput, error) {
+// RemoveTagsFromResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) RemoveTagsFromResourceWithContext(_a0 context.Context, _a1 *rds.RemoveTagsFromResourceInput, _a2 ...request.Option) (*rds.RemoveTagsFromResourceOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9611,17 +12360,17 @@ func (_m *RDSAPI) RebootDBInstanceWithContext(_a0 context.Context, _a1 *rds.Rebo
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.RebootDBInstanceOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.RebootDBInstanceInput, ...request.Option) *rds.RebootDBInstanceOutput); ok {
+	var r0 *rds.RemoveTagsFromResourceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.RemoveTagsFromResourceInput, ...request.Option) *rds.RemoveTagsFromResourceOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RebootDBInstanceOutput)
+			r0 = ret.Get(0).(*rds.RemoveTagsFromResourceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.RebootDBInstanceInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.RemoveTagsFromResourceInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9630,21 +12379,21 @@ func (_m *RDSAPI) RebootDBInstanceWithContext(_a0 context.Context, _a1 *rds.Rebo
 	return r0, r1
 }
 
-// RegisterDBProxyTargets provides a mock function with given fields: _a0
-func (_m *RDSAPI) RegisterDBProxyTargets(_a0 *rds.RegisterDBProxyTargetsInput) (*rds.RegisterDBProxyTargetsOutput, error) {
+// ResetDBClusterParameterGroup provides a mock function with given fields: _a0
+func (_m *RDSAPI) ResetDBClusterParameterGroup(_a0 *rds.ResetDBClusterParameterGroupInput) (*rds.DBClusterParameterGroupNameMessage, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.RegisterDBProxyTargetsOutput
-	if rf, ok := ret.Get(0).(func(*rds.RegisterDBProxyTargetsInput) *rds.RegisterDBProxyTargetsOutput); ok {
+	var r0 *rds.DBClusterParameterGroupNameMessage
+	if rf, ok := ret.Get(0).(func(*rds.ResetDBClusterParameterGroupInput) *rds.DBClusterParameterGroupNameMessage); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RegisterDBProxyTargetsOutput)
+			r0 = ret.Get(0).(*rds.DBClusterParameterGroupNameMessage)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.RegisterDBProxyTargetsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.ResetDBClusterParameterGroupInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9653,12 +12402,12 @@ func (_m *RDSAPI) RegisterDBProxyTargets(_a0 *rds.RegisterDBProxyTargetsInput) (
 	return r0, r1
 }
 
-// RegisterDBProxyTargetsRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) RegisterDBProxyTargetsRequest(_a0 *rds.RegisterDBProxyTargetsInput) (*request.Request, *rds.RegisterDBProxyTargetsOutput) {
+// ResetDBClusterParameterGroupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ResetDBClusterParameterGroupRequest(_a0 *rds.ResetDBClusterParameterGroupInput) (*request.Request, *rds.DBClusterParameterGroupNameMessage) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.RegisterDBProxyTargetsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.ResetDBClusterParameterGroupInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9666,20 +12415,20 @@ func (_m *RDSAPI) RegisterDBProxyTargetsRequest(_a0 *rds.RegisterDBProxyTargetsI
 		}
 	}
 
-	var r1 *rds.RegisterDBProxyTargetsOutput
-	if rf, ok := ret.Get(1).(func(*rds.RegisterDBProxyTargetsInput) *rds.RegisterDBProxyTargetsOutput); ok {
+	var r1 *rds.DBClusterParameterGroupNameMessage
+	if rf, ok := ret.Get(1).(func(*rds.ResetDBClusterParameterGroupInput) *rds.DBClusterParameterGroupNameMessage); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.RegisterDBProxyTargetsOutput)
+			r1 = ret.Get(1).(*rds.DBClusterParameterGroupNameMessage)
 		}
 	}
 
 	return r0, r1
 }
 
-// RegisterDBProxyTargetsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) RegisterDBProxyTargetsWithContext(_a0 context.Context, _a1 *rds.RegisterDBProxyTargetsInput, _a2 ...request.Option) (*rds.RegisterDBProxyTargetsOutput, error) {
+// ResetDBClusterParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ResetDBClusterParameterGroupWithContext(_a0 context.Context, _a1 *rds.ResetDBClusterParameterGroupInput, _a2 ...request.Option) (*rds.DBClusterParameterGroupNameMessage, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9689,17 +12438,17 @@ func (_m *RDSAPI) RegisterDBProxyTargetsWithContext(_a0 context.Context, _a1 *rd
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.RegisterDBProxyTargetsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.RegisterDBProxyTargetsInput, ...request.Option) *rds.RegisterDBProxyTargetsOutput); ok {
+	var r0 *rds.DBClusterParameterGroupNameMessage
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ResetDBClusterParameterGroupInput, ...request.Option) *rds.DBClusterParameterGroupNameMessage); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RegisterDBProxyTargetsOutput)
+			r0 = ret.Get(0).(*rds.DBClusterParameterGroupNameMessage)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.RegisterDBProxyTargetsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ResetDBClusterParameterGroupInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9708,21 +12457,21 @@ func (_m *RDSAPI) RegisterDBProxyTargetsWithContext(_a0 context.Context, _a1 *rd
 	return r0, r1
 }
 
-// RemoveFromGlobalCluster provides a mock function with given fields: _a0
-func (_m *RDSAPI) RemoveFromGlobalCluster(_a0 *rds.RemoveFromGlobalClusterInput) (*rds.RemoveFromGlobalClusterOutput, error) {
+// ResetDBParameterGroup provides a mock function with given fields: _a0
+func (_m *RDSAPI) ResetDBParameterGroup(_a0 *rds.ResetDBParameterGroupInput) (*rds.DBParameterGroupNameMessage, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.RemoveFromGlobalClusterOutput
-	if rf, ok := ret.Get(0).(func(*rds.RemoveFromGlobalClusterInput) *rds.RemoveFromGlobalClusterOutput); ok {
+	var r0 *rds.DBParameterGroupNameMessage
+	if rf, ok := ret.Get(0).(func(*rds.ResetDBParameterGroupInput) *rds.DBParameterGroupNameMessage); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RemoveFromGlobalClusterOutput)
+			r0 = ret.Get(0).(*rds.DBParameterGroupNameMessage)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.RemoveFromGlobalClusterInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.ResetDBParameterGroupInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9731,12 +12480,12 @@ func (_m *RDSAPI) RemoveFromGlobalCluster(_a0 *rds.RemoveFromGlobalClusterInput)
 	return r0, r1
 }
 
-// RemoveFromGlobalClusterRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) RemoveFromGlobalClusterRequest(_a0 *rds.RemoveFromGlobalClusterInput) (*request.Request, *rds.RemoveFromGlobalClusterOutput) {
+// ResetDBParameterGroupRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) ResetDBParameterGroupRequest(_a0 *rds.ResetDBParameterGroupInput) (*request.Request, *rds.DBParameterGroupNameMessage) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.RemoveFromGlobalClusterInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.ResetDBParameterGroupInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9744,20 +12493,20 @@ func (_m *RDSAPI) RemoveFromGlobalClusterRequest(_a0 *rds.RemoveFromGlobalCluste
 		}
 	}
 
-	var r1 *rds.RemoveFromGlobalClusterOutput
-	if rf, ok := ret.Get(1).(func(*rds.RemoveFromGlobalClusterInput) *rds.RemoveFromGlobalClusterOutput); ok {
+	var r1 *rds.DBParameterGroupNameMessage
+	if rf, ok := ret.Get(1).(func(*rds.ResetDBParameterGroupInput) *rds.DBParameterGroupNameMessage); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.RemoveFromGlobalClusterOutput)
+			r1 = ret.Get(1).(*rds.DBParameterGroupNameMessage)
 		}
 	}
 
 	return r0, r1
 }
 
-// RemoveFromGlobalClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) RemoveFromGlobalClusterWithContext(_a0 context.Context, _a1 *rds.RemoveFromGlobalClusterInput, _a2 ...request.Option) (*rds.RemoveFromGlobalClusterOutput, error) {
+// ResetDBParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) ResetDBParameterGroupWithContext(_a0 context.Context, _a1 *rds.ResetDBParameterGroupInput, _a2 ...request.Option) (*rds.DBParameterGroupNameMessage, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9767,17 +12516,17 @@ func (_m *RDSAPI) RemoveFromGlobalClusterWithContext(_a0 context.Context, _a1 *r
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.RemoveFromGlobalClusterOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.RemoveFromGlobalClusterInput, ...request.Option) *rds.RemoveFromGlobalClusterOutput); ok {
+	var r0 *rds.DBParameterGroupNameMessage
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.ResetDBParameterGroupInput, ...request.Option) *rds.DBParameterGroupNameMessage); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RemoveFromGlobalClusterOutput)
+			r0 = ret.Get(0).(*rds.DBParameterGroupNameMessage)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.RemoveFromGlobalClusterInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.ResetDBParameterGroupInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9786,21 +12535,21 @@ func (_m *RDSAPI) RemoveFromGlobalClusterWithContext(_a0 context.Context, _a1 *r
 	return r0, r1
 }
 
-// RemoveRoleFromDBCluster provides a mock function with given fields: _a0
-func (_m *RDSAPI) RemoveRoleFromDBCluster(_a0 *rds.RemoveRoleFromDBClusterInput) (*rds.RemoveRoleFromDBClusterOutput, error) {
+// RestoreDBClusterFromS3 provides a mock function with given fields: _a0
+func (_m *RDSAPI) RestoreDBClusterFromS3(_a0 *rds.RestoreDBClusterFromS3Input) (*rds.RestoreDBClusterFromS3Output, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.RemoveRoleFromDBClusterOutput
-	if rf, ok := ret.Get(0).(func(*rds.RemoveRoleFromDBClusterInput) *rds.RemoveRoleFromDBClusterOutput); ok {
+	var r0 *rds.RestoreDBClusterFromS3Output
+	if rf, ok := ret.Get(0).(func(*rds.RestoreDBClusterFromS3Input) *rds.RestoreDBClusterFromS3Output); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RemoveRoleFromDBClusterOutput)
+			r0 = ret.Get(0).(*rds.RestoreDBClusterFromS3Output)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.RemoveRoleFromDBClusterInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.RestoreDBClusterFromS3Input) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9809,12 +12558,12 @@ func (_m *RDSAPI) RemoveRoleFromDBCluster(_a0 *rds.RemoveRoleFromDBClusterInput)
 	return r0, r1
 }
 
-// RemoveRoleFromDBClusterRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) RemoveRoleFromDBClusterRequest(_a0 *rds.RemoveRoleFromDBClusterInput) (*request.Request, *rds.RemoveRoleFromDBClusterOutput) {
+// RestoreDBClusterFromS3Request provides a mock function with given fields: _a0
+func (_m *RDSAPI) RestoreDBClusterFromS3Request(_a0 *rds.RestoreDBClusterFromS3Input) (*request.Request, *rds.RestoreDBClusterFromS3Output) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.RemoveRoleFromDBClusterInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.RestoreDBClusterFromS3Input) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9822,20 +12571,20 @@ func (_m *RDSAPI) RemoveRoleFromDBClusterRequest(_a0 *rds.RemoveRoleFromDBCluste
 		}
 	}
 
-	var r1 *rds.RemoveRoleFromDBClusterOutput
-	if rf, ok := ret.Get(1).(func(*rds.RemoveRoleFromDBClusterInput) *rds.RemoveRoleFromDBClusterOutput); ok {
+	var r1 *rds.RestoreDBClusterFromS3Output
+	if rf, ok := ret.Get(1).(func(*rds.RestoreDBClusterFromS3Input) *rds.RestoreDBClusterFromS3Output); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.RemoveRoleFromDBClusterOutput)
+			r1 = ret.Get(1).(*rds.RestoreDBClusterFromS3Output)
 		}
 	}
 
 	return r0, r1
 }
 
-// RemoveRoleFromDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) RemoveRoleFromDBClusterWithContext(_a0 context.Context, _a1 *rds.RemoveRoleFromDBClusterInput, _a2 ...request.Option) (*rds.RemoveRoleFromDBClusterOutput, error) {
+// RestoreDBClusterFromS3WithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) RestoreDBClusterFromS3WithContext(_a0 context.Context, _a1 *rds.RestoreDBClusterFromS3Input, _a2 ...request.Option) (*rds.RestoreDBClusterFromS3Output, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9845,17 +12594,17 @@ func (_m *RDSAPI) RemoveRoleFromDBClusterWithContext(_a0 context.Context, _a1 *r
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.RemoveRoleFromDBClusterOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.RemoveRoleFromDBClusterInput, ...request.Option) *rds.RemoveRoleFromDBClusterOutput); ok {
+	var r0 *rds.RestoreDBClusterFromS3Output
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.RestoreDBClusterFromS3Input, ...request.Option) *rds.RestoreDBClusterFromS3Output); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RemoveRoleFromDBClusterOutput)
+			r0 = ret.Get(0).(*rds.RestoreDBClusterFromS3Output)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.RemoveRoleFromDBClusterInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.RestoreDBClusterFromS3Input, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9864,21 +12613,21 @@ func (_m *RDSAPI) RemoveRoleFromDBClusterWithContext(_a0 context.Context, _a1 *r
 	return r0, r1
 }
 
-// RemoveRoleFromDBInstance provides a mock function with given fields: _a0
-func (_m *RDSAPI) RemoveRoleFromDBInstance(_a0 *rds.RemoveRoleFromDBInstanceInput) (*rds.RemoveRoleFromDBInstanceOutput, error) {
+// RestoreDBClusterFromSnapshot provides a mock function with given fields: _a0
+func (_m *RDSAPI) RestoreDBClusterFromSnapshot(_a0 *rds.RestoreDBClusterFromSnapshotInput) (*rds.RestoreDBClusterFromSnapshotOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.RemoveRoleFromDBInstanceOutput
-	if rf, ok := ret.Get(0).(func(*rds.RemoveRoleFromDBInstanceInput) *rds.RemoveRoleFromDBInstanceOutput); ok {
+	var r0 *rds.RestoreDBClusterFromSnapshotOutput
+	if rf, ok := ret.Get(0).(func(*rds.RestoreDBClusterFromSnapshotInput) *rds.RestoreDBClusterFromSnapshotOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RemoveRoleFromDBInstanceOutput)
+			r0 = ret.Get(0).(*rds.RestoreDBClusterFromSnapshotOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.RemoveRoleFromDBInstanceInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.RestoreDBClusterFromSnapshotInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9887,12 +12636,12 @@ func (_m *RDSAPI) RemoveRoleFromDBInstance(_a0 *rds.RemoveRoleFromDBInstanceInpu
 	return r0, r1
 }
 
-// RemoveRoleFromDBInstanceRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) RemoveRoleFromDBInstanceRequest(_a0 *rds.RemoveRoleFromDBInstanceInput) (*request.Request, *rds.RemoveRoleFromDBInstanceOutput) {
+// RestoreDBClusterFromSnapshotRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) RestoreDBClusterFromSnapshotRequest(_a0 *rds.RestoreDBClusterFromSnapshotInput) (*request.Request, *rds.RestoreDBClusterFromSnapshotOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.RemoveRoleFromDBInstanceInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.RestoreDBClusterFromSnapshotInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9900,20 +12649,20 @@ func (_m *RDSAPI) RemoveRoleFromDBInstanceRequest(_a0 *rds.RemoveRoleFromDBInsta
 		}
 	}
 
-	var r1 *rds.RemoveRoleFromDBInstanceOutput
-	if rf, ok := ret.Get(1).(func(*rds.RemoveRoleFromDBInstanceInput) *rds.RemoveRoleFromDBInstanceOutput); ok {
+	var r1 *rds.RestoreDBClusterFromSnapshotOutput
+	if rf, ok := ret.Get(1).(func(*rds.RestoreDBClusterFromSnapshotInput) *rds.RestoreDBClusterFromSnapshotOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.RemoveRoleFromDBInstanceOutput)
+			r1 = ret.Get(1).(*rds.RestoreDBClusterFromSnapshotOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// RemoveRoleFromDBInstanceWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) RemoveRoleFromDBInstanceWithContext(_a0 context.Context, _a1 *rds.RemoveRoleFromDBInstanceInput, _a2 ...request.Option) (*rds.RemoveRoleFromDBInstanceOutput, error) {
+// RestoreDBClusterFromSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) RestoreDBClusterFromSnapshotWithContext(_a0 context.Context, _a1 *rds.RestoreDBClusterFromSnapshotInput, _a2 ...request.Option) (*rds.RestoreDBClusterFromSnapshotOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9923,17 +12672,17 @@ func (_m *RDSAPI) RemoveRoleFromDBInstanceWithContext(_a0 context.Context, _a1 *
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.RemoveRoleFromDBInstanceOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.RemoveRoleFromDBInstanceInput, ...request.Option) *rds.RemoveRoleFromDBInstanceOutput); ok {
+	var r0 *rds.RestoreDBClusterFromSnapshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.RestoreDBClusterFromSnapshotInput, ...request.Option) *rds.RestoreDBClusterFromSnapshotOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RemoveRoleFromDBInstanceOutput)
+			r0 = ret.Get(0).(*rds.RestoreDBClusterFromSnapshotOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.RemoveRoleFromDBInstanceInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.RestoreDBClusterFromSnapshotInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9942,21 +12691,21 @@ func (_m *RDSAPI) RemoveRoleFromDBInstanceWithContext(_a0 context.Context, _a1 *
 	return r0, r1
 }
 
-// RemoveSourceIdentifierFromSubscription provides a mock function with given fields: _a0
-func (_m *RDSAPI) RemoveSourceIdentifierFromSubscription(_a0 *rds.RemoveSourceIdentifierFromSubscriptionInput) (*rds.RemoveSourceIdentifierFromSubscriptionOutput, error) {
+// RestoreDBClusterToPointInTime provides a mock function with given fields: _a0
+func (_m *RDSAPI) RestoreDBClusterToPointInTime(_a0 *rds.RestoreDBClusterToPointInTimeInput) (*rds.RestoreDBClusterToPointInTimeOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.RemoveSourceIdentifierFromSubscriptionOutput
-	if rf, ok := ret.Get(0).(func(*rds.RemoveSourceIdentifierFromSubscriptionInput) *rds.RemoveSourceIdentifierFromSubscriptionOutput); ok {
+	var r0 *rds.RestoreDBClusterToPointInTimeOutput
+	if rf, ok := ret.Get(0).(func(*rds.RestoreDBClusterToPointInTimeInput) *rds.RestoreDBClusterToPointInTimeOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RemoveSourceIdentifierFromSubscriptionOutput)
+			r0 = ret.Get(0).(*rds.RestoreDBClusterToPointInTimeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.RemoveSourceIdentifierFromSubscriptionInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.RestoreDBClusterToPointInTimeInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9965,12 +12714,12 @@ func (_m *RDSAPI) RemoveSourceIdentifierFromSubscription(_a0 *rds.RemoveSourceId
 	return r0, r1
 }
 
-// RemoveSourceIdentifierFromSubscriptionRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) RemoveSourceIdentifierFromSubscriptionRequest(_a0 *rds.RemoveSourceIdentifierFromSubscriptionInput) (*request.Request, *rds.RemoveSourceIdentifierFromSubscriptionOutput) {
+// RestoreDBClusterToPointInTimeRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) RestoreDBClusterToPointInTimeRequest(_a0 *rds.RestoreDBClusterToPointInTimeInput) (*request.Request, *rds.RestoreDBClusterToPointInTimeOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.RemoveSourceIdentifierFromSubscriptionInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.RestoreDBClusterToPointInTimeInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9978,20 +12727,20 @@ func (_m *RDSAPI) RemoveSourceIdentifierFromSubscriptionRequest(_a0 *rds.RemoveS
 		}
 	}
 
-	var r1 *rds.RemoveSourceIdentifierFromSubscriptionOutput
-	if rf, ok := ret.Get(1).(func(*rds.RemoveSourceIdentifierFromSubscriptionInput) *rds.RemoveSourceIdentifierFromSubscriptionOutput); ok {
+	var r1 *rds.RestoreDBClusterToPointInTimeOutput
+	if rf, ok := ret.Get(1).(func(*rds.RestoreDBClusterToPointInTimeInput) *rds.RestoreDBClusterToPointInTimeOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.RemoveSourceIdentifierFromSubscriptionOutput)
+			r1 = ret.Get(1).(*rds.RestoreDBClusterToPointInTimeOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// RemoveSourceIdentifierFromSubscriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) RemoveSourceIdentifierFromSubscriptionWithContext(_a0 context.Context, _a1 *rds.RemoveSourceIdentifierFromSubscriptionInput, _a2 ...request.Option) (*rds.RemoveSourceIdentifierFromSubscriptionOutput, error) {
+// RestoreDBClusterToPointInTimeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) RestoreDBClusterToPointInTimeWithContext(_a0 context.Context, _a1 *rds.RestoreDBClusterToPointInTimeInput, _a2 ...request.Option) (*rds.RestoreDBClusterToPointInTimeOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -10001,17 +12750,17 @@ func (_m *RDSAPI) RemoveSourceIdentifierFromSubscriptionWithContext(_a0 context.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.RemoveSourceIdentifierFromSubscriptionOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.RemoveSourceIdentifierFromSubscriptionInput, ...request.Option) *rds.RemoveSourceIdentifierFromSubscriptionOutput); ok {
+	var r0 *rds.RestoreDBClusterToPointInTimeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.RestoreDBClusterToPointInTimeInput, ...request.Option) *rds.RestoreDBClusterToPointInTimeOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RemoveSourceIdentifierFromSubscriptionOutput)
+			r0 = ret.Get(0).(*rds.RestoreDBClusterToPointInTimeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.RemoveSourceIdentifierFromSubscriptionInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.RestoreDBClusterToPointInTimeInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -10020,21 +12769,21 @@ func (_m *RDSAPI) RemoveSourceIdentifierFromSubscriptionWithContext(_a0 context.
 	return r0, r1
 }
 
-// RemoveTagsFromResource provides a mock function with given fields: _a0
-func (_m *RDSAPI) RemoveTagsFromResource(_a0 *rds.RemoveTagsFromResourceInput) (*rds.RemoveTagsFromResourceOutput, error) {
+// RestoreDBInstanceFromDBSnapshot provides a mock function with given fields: _a0
+func (_m *RDSAPI) RestoreDBInstanceFromDBSnapshot(_a0 *rds.RestoreDBInstanceFromDBSnapshotInput) (*rds.RestoreDBInstanceFromDBSnapshotOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.RemoveTagsFromResourceOutput
-	if rf, ok := ret.Get(0).(func(*rds.RemoveTagsFromResourceInput) *rds.RemoveTagsFromResourceOutput); ok {
+	var r0 *rds.RestoreDBInstanceFromDBSnapshotOutput
+	if rf, ok := ret.Get(0).(func(*rds.RestoreDBInstanceFromDBSnapshotInput) *rds.RestoreDBInstanceFromDBSnapshotOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RemoveTagsFromResourceOutput)
+			r0 = ret.Get(0).(*rds.RestoreDBInstanceFromDBSnapshotOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.RemoveTagsFromResourceInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.RestoreDBInstanceFromDBSnapshotInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -10043,12 +12792,12 @@ func (_m *RDSAPI) RemoveTagsFromResource(_a0 *rds.RemoveTagsFromResourceInput) (
 	return r0, r1
 }
 
-// RemoveTagsFromResourceRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) RemoveTagsFromResourceRequest(_a0 *rds.RemoveTagsFromResourceInput) (*request.Request, *rds.RemoveTagsFromResourceOutput) {
+// RestoreDBInstanceFromDBSnapshotRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) RestoreDBInstanceFromDBSnapshotRequest(_a0 *rds.RestoreDBInstanceFromDBSnapshotInput) (*request.Request, *rds.RestoreDBInstanceFromDBSnapshotOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.RemoveTagsFromResourceInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.RestoreDBInstanceFromDBSnapshotInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -10056,20 +12805,20 @@ func (_m *RDSAPI) RemoveTagsFromResourceRequest(_a0 *rds.RemoveTagsFromResourceI
 		}
 	}
 
-	var r1 *rds.RemoveTagsFromResourceOutput
-	if rf, ok := ret.Get(1).(func(*rds.RemoveTagsFromResourceInput) *rds.RemoveTagsFromResourceOutput); ok {
+	var r1 *rds.RestoreDBInstanceFromDBSnapshotOutput
+	if rf, ok := ret.Get(1).(func(*rds.RestoreDBInstanceFromDBSnapshotInput) *rds.RestoreDBInstanceFromDBSnapshotOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.RemoveTagsFromResourceOutput)
+			r1 = ret.Get(1).(*rds.RestoreDBInstanceFromDBSnapshotOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// RemoveTagsFromResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) RemoveTagsFromResourceWithContext(_a0 context.Context, _a1 *rds.RemoveTagsFromResourceInput, _a2 ...request.Option) (*rds.RemoveTagsFromResourceOutput, error) {
+// RestoreDBInstanceFromDBSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) RestoreDBInstanceFromDBSnapshotWithContext(_a0 context.Context, _a1 *rds.RestoreDBInstanceFromDBSnapshotInput, _a2 ...request.Option) (*rds.RestoreDBInstanceFromDBSnapshotOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -10079,17 +12828,17 @@ func (_m *RDSAPI) RemoveTagsFromResourceWithContext(_a0 context.Context, _a1 *rd
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.RemoveTagsFromResourceOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.RemoveTagsFromResourceInput, ...request.Option) *rds.RemoveTagsFromResourceOutput); ok {
+	var r0 *rds.RestoreDBInstanceFromDBSnapshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.RestoreDBInstanceFromDBSnapshotInput, ...request.Option) *rds.RestoreDBInstanceFromDBSnapshotOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RemoveTagsFromResourceOutput)
+			r0 = ret.Get(0).(*rds.RestoreDBInstanceFromDBSnapshotOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.RemoveTagsFromResourceInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.RestoreDBInstanceFromDBSnapshotInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -10098,21 +12847,21 @@ func (_m *RDSAPI) RemoveTagsFromResourceWithContext(_a0 context.Context, _a1 *rd
 	return r0, r1
 }
 
-// ResetDBClusterParameterGroup provides a mock function with given fields: _a0
-func (_m *RDSAPI) ResetDBClusterParameterGroup(_a0 *rds.ResetDBClusterParameterGroupInput) (*rds.DBClusterParameterGroupNameMessage, error) {
+// RestoreDBInstanceFromS3 provides a mock function with given fields: _a0
+func (_m *RDSAPI) RestoreDBInstanceFromS3(_a0 *rds.RestoreDBInstanceFromS3Input) (*rds.RestoreDBInstanceFromS3Output, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DBClusterParameterGroupNameMessage
-	if rf, ok := ret.Get(0).(func(*rds.ResetDBClusterParameterGroupInput) *rds.DBClusterParameterGroupNameMessage); ok {
+	var r0 *rds.RestoreDBInstanceFromS3Output
+	if rf, ok := ret.Get(0).(func(*rds.RestoreDBInstanceFromS3Input) *rds.RestoreDBInstanceFromS3Output); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DBClusterParameterGroupNameMessage)
+			r0 = ret.Get(0).(*rds.RestoreDBInstanceFromS3Output)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ResetDBClusterParameterGroupInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.RestoreDBInstanceFromS3Input) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -10121,12 +12870,12 @@ func (_m *RDSAPI) ResetDBClusterParameterGroup(_a0 *rds.ResetDBClusterParameterG
 	return r0, r1
 }
 
-// ResetDBClusterParameterGroupRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ResetDBClusterParameterGroupRequest(_a0 *rds.ResetDBClusterParameterGroupInput) (*request.Request, *rds.DBClusterParameterGroupNameMessage) {
+// RestoreDBInstanceFromS3Request provides a mock function with given fields: _a0
+func (_m *RDSAPI) RestoreDBInstanceFromS3Request(_a0 *rds.RestoreDBInstanceFromS3Input) (*request.Request, *rds.RestoreDBInstanceFromS3Output) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ResetDBClusterParameterGroupInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.RestoreDBInstanceFromS3Input) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -10134,20 +12883,20 @@ func (_m *RDSAPI) ResetDBClusterParameterGroupRequest(_a0 *rds.ResetDBClusterPar
 		}
 	}
 
-	var r1 *rds.DBClusterParameterGroupNameMessage
-	if rf, ok := ret.Get(1).(func(*rds.ResetDBClusterParameterGroupInput) *rds.DBClusterParameterGroupNameMessage); ok {
+	var r1 *rds.RestoreDBInstanceFromS3Output
+	if rf, ok := ret.Get(1).(func(*rds.RestoreDBInstanceFromS3Input) *rds.RestoreDBInstanceFromS3Output); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DBClusterParameterGroupNameMessage)
+			r1 = ret.Get(1).(*rds.RestoreDBInstanceFromS3Output)
 		}
 	}
 
 	return r0, r1
 }
 
-// ResetDBClusterParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ResetDBClusterParameterGroupWithContext(_a0 context.Context, _a1 *rds.ResetDBClusterParameterGroupInput, _a2 ...request.Option) (*rds.DBClusterParameterGroupNameMessage, error) {
+// RestoreDBInstanceFromS3WithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) RestoreDBInstanceFromS3WithContext(_a0 context.Context, _a1 *rds.RestoreDBInstanceFromS3Input, _a2 ...request.Option) (*rds.RestoreDBInstanceFromS3Output, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -10157,17 +12906,17 @@ func (_m *RDSAPI) ResetDBClusterParameterGroupWithContext(_a0 context.Context, _
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DBClusterParameterGroupNameMessage
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ResetDBClusterParameterGroupInput, ...request.Option) *rds.DBClusterParameterGroupNameMessage); ok {
+	var r0 *rds.RestoreDBInstanceFromS3Output
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.RestoreDBInstanceFromS3Input, ...request.Option) *rds.RestoreDBInstanceFromS3Output); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DBClusterParameterGroupNameMessage)
+			r0 = ret.Get(0).(*rds.RestoreDBInstanceFromS3Output)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ResetDBClusterParameterGroupInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.RestoreDBInstanceFromS3Input, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -10176,21 +12925,21 @@ func (_m *RDSAPI) ResetDBClusterParameterGroupWithContext(_a0 context.Context, _
 	return r0, r1
 }
 
-// ResetDBParameterGroup provides a mock function with given fields: _a0
-func (_m *RDSAPI) ResetDBParameterGroup(_a0 *rds.ResetDBParameterGroupInput) (*rds.DBParameterGroupNameMessage, error) {
+// RestoreDBInstanceToPointInTime provides a mock function with given fields: _a0
+func (_m *RDSAPI) RestoreDBInstanceToPointInTime(_a0 *rds.RestoreDBInstanceToPointInTimeInput) (*rds.RestoreDBInstanceToPointInTimeOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.DBParameterGroupNameMessage
-	if rf, ok := ret.Get(0).(func(*rds.ResetDBParameterGroupInput) *rds.DBParameterGroupNameMessage); ok {
+	var r0 *rds.RestoreDBInstanceToPointInTimeOutput
+	if rf, ok := ret.Get(0).(func(*rds.RestoreDBInstanceToPointInTimeInput) *rds.RestoreDBInstanceToPointInTimeOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DBParameterGroupNameMessage)
+			r0 = ret.Get(0).(*rds.RestoreDBInstanceToPointInTimeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.ResetDBParameterGroupInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.RestoreDBInstanceToPointInTimeInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -10199,12 +12948,12 @@ func (_m *RDSAPI) ResetDBParameterGroup(_a0 *rds.ResetDBParameterGroupInput) (*r
 	return r0, r1
 }
 
-// ResetDBParameterGroupRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) ResetDBParameterGroupRequest(_a0 *rds.ResetDBParameterGroupInput) (*request.Request, *rds.DBParameterGroupNameMessage) {
+// RestoreDBInstanceToPointInTimeRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) RestoreDBInstanceToPointInTimeRequest(_a0 *rds.RestoreDBInstanceToPointInTimeInput) (*request.Request, *rds.RestoreDBInstanceToPointInTimeOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.ResetDBParameterGroupInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.RestoreDBInstanceToPointInTimeInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -10212,20 +12961,20 @@ func (_m *RDSAPI) ResetDBParameterGroupRequest(_a0 *rds.ResetDBParameterGroupInp
 		}
 	}
 
-	var r1 *rds.DBParameterGroupNameMessage
-	if rf, ok := ret.Get(1).(func(*rds.ResetDBParameterGroupInput) *rds.DBParameterGroupNameMessage); ok {
+	var r1 *rds.RestoreDBInstanceToPointInTimeOutput
+	if rf, ok := ret.Get(1).(func(*rds.RestoreDBInstanceToPointInTimeInput) *rds.RestoreDBInstanceToPointInTimeOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.DBParameterGroupNameMessage)
+			r1 = ret.Get(1).(*rds.RestoreDBInstanceToPointInTimeOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ResetDBParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) ResetDBParameterGroupWithContext(_a0 context.Context, _a1 *rds.ResetDBParameterGroupInput, _a2 ...request.Option) (*rds.DBParameterGroupNameMessage, error) {
+// RestoreDBInstanceToPointInTimeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) RestoreDBInstanceToPointInTimeWithContext(_a0 context.Context, _a1 *rds.RestoreDBInstanceToPointInTimeInput, _a2 ...request.Option) (*rds.RestoreDBInstanceToPointInTimeOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -10235,17 +12984,17 @@ func (_m *RDSAPI) ResetDBParameterGroupWithContext(_a0 context.Context, _a1 *rds
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.DBParameterGroupNameMessage
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.ResetDBParameterGroupInput, ...request.Option) *rds.DBParameterGroupNameMessage); ok {
+	var r0 *rds.RestoreDBInstanceToPointInTimeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.RestoreDBInstanceToPointInTimeInput, ...request.Option) *rds.RestoreDBInstanceToPointInTimeOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.DBParameterGroupNameMessage)
+			r0 = ret.Get(0).(*rds.RestoreDBInstanceToPointInTimeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.ResetDBParameterGroupInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.RestoreDBInstanceToPointInTimeInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -10254,21 +13003,21 @@ func (_m *RDSAPI) ResetDBParameterGroupWithContext(_a0 context.Context, _a1 *rds
 	return r0, r1
 }
 
-// RestoreDBClusterFromS3 provides a mock function with given fields: _a0
-func (_m *RDSAPI) RestoreDBClusterFromS3(_a0 *rds.RestoreDBClusterFromS3Input) (*rds.RestoreDBClusterFromS3Output, error) {
+// RevokeDBSecurityGroupIngress provides a mock function with given fields: _a0
+func (_m *RDSAPI) RevokeDBSecurityGroupIngress(_a0 *rds.RevokeDBSecurityGroupIngressInput) (*rds.RevokeDBSecurityGroupIngressOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.RestoreDBClusterFromS3Output
-	if rf, ok := ret.Get(0).(func(*rds.RestoreDBClusterFromS3Input) *rds.RestoreDBClusterFromS3Output); ok {
+	var r0 *rds.RevokeDBSecurityGroupIngressOutput
+	if rf, ok := ret.Get(0).(func(*rds.RevokeDBSecurityGroupIngressInput) *rds.RevokeDBSecurityGroupIngressOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RestoreDBClusterFromS3Output)
+			r0 = ret.Get(0).(*rds.RevokeDBSecurityGroupIngressOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.RestoreDBClusterFromS3Input) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.RevokeDBSecurityGroupIngressInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -10277,12 +13026,12 @@ func (_m *RDSAPI) RestoreDBClusterFromS3(_a0 *rds.RestoreDBClusterFromS3Input) (
 	return r0, r1
 }
 
-// RestoreDBClusterFromS3Request provides a mock function with given fields: _a0
-func (_m *RDSAPI) RestoreDBClusterFromS3Request(_a0 *rds.RestoreDBClusterFromS3Input) (*request.Request, *rds.RestoreDBClusterFromS3Output) {
+// RevokeDBSecurityGroupIngressRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) RevokeDBSecurityGroupIngressRequest(_a0 *rds.RevokeDBSecurityGroupIngressInput) (*request.Request, *rds.RevokeDBSecurityGroupIngressOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.RestoreDBClusterFromS3Input) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.RevokeDBSecurityGroupIngressInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -10290,20 +13039,20 @@ func (_m *RDSAPI) RestoreDBClusterFromS3Request(_a0 *rds.RestoreDBClusterFromS3I
 		}
 	}
 
-	var r1 *rds.RestoreDBClusterFromS3Output
-	if rf, ok := ret.Get(1).(func(*rds.RestoreDBClusterFromS3Input) *rds.RestoreDBClusterFromS3Output); ok {
+	var r1 *rds.RevokeDBSecurityGroupIngressOutput
+	if rf, ok := ret.Get(1).(func(*rds.RevokeDBSecurityGroupIngressInput) *rds.RevokeDBSecurityGroupIngressOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.RestoreDBClusterFromS3Output)
+			r1 = ret.Get(1).(*rds.RevokeDBSecurityGroupIngressOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// RestoreDBClusterFromS3WithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) RestoreDBClusterFromS3WithContext(_a0 context.Context, _a1 *rds.RestoreDBClusterFromS3Input, _a2 ...request.Option) (*rds.RestoreDBClusterFromS3Output, error) {
+// RevokeDBSecurityGroupIngressWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) RevokeDBSecurityGroupIngressWithContext(_a0 context.Context, _a1 *rds.RevokeDBSecurityGroupIngressInput, _a2 ...request.Option) (*rds.RevokeDBSecurityGroupIngressOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -10313,17 +13062,17 @@ func (_m *RDSAPI) RestoreDBClusterFromS3WithContext(_a0 context.Context, _a1 *rd
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.RestoreDBClusterFromS3Output
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.RestoreDBClusterFromS3Input, ...request.Option) *rds.RestoreDBClusterFromS3Output); ok {
+	var r0 *rds.RevokeDBSecurityGroupIngressOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.RevokeDBSecurityGroupIngressInput, ...request.Option) *rds.RevokeDBSecurityGroupIngressOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RestoreDBClusterFromS3Output)
+			r0 = ret.Get(0).(*rds.RevokeDBSecurityGroupIngressOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.RestoreDBClusterFromS3Input, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.RevokeDBSecurityGroupIngressInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -10332,21 +13081,21 @@ func (_m *RDSAPI) RestoreDBClusterFromS3WithContext(_a0 context.Context, _a1 *rd
 	return r0, r1
 }
 
-// RestoreDBClusterFromSnapshot provides a mock function with given fields: _a0
-func (_m *RDSAPI) RestoreDBClusterFromSnapshot(_a0 *rds.RestoreDBClusterFromSnapshotInput) (*rds.RestoreDBClusterFromSnapshotOutput, error) {
+// StartActivityStream provides a mock function with given fields: _a0
+func (_m *RDSAPI) StartActivityStream(_a0 *rds.StartActivityStreamInput) (*rds.StartActivityStreamOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.RestoreDBClusterFromSnapshotOutput
-	if rf, ok := ret.Get(0).(func(*rds.RestoreDBClusterFromSnapshotInput) *rds.RestoreDBClusterFromSnapshotOutput); ok {
+	var r0 *rds.StartActivityStreamOutput
+	if rf, ok := ret.Get(0).(func(*rds.StartActivityStreamInput) *rds.StartActivityStreamOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RestoreDBClusterFromSnapshotOutput)
+			r0 = ret.Get(0).(*rds.StartActivityStreamOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.RestoreDBClusterFromSnapshotInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.StartActivityStreamInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -10355,12 +13104,12 @@ func (_m *RDSAPI) RestoreDBClusterFromSnapshot(_a0 *rds.RestoreDBClusterFromSnap
 	return r0, r1
 }
 
-// RestoreDBClusterFromSnapshotRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) RestoreDBClusterFromSnapshotRequest(_a0 *rds.RestoreDBClusterFromSnapshotInput) (*request.Request, *rds.RestoreDBClusterFromSnapshotOutput) {
+// StartActivityStreamRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) StartActivityStreamRequest(_a0 *rds.StartActivityStreamInput) (*request.Request, *rds.StartActivityStreamOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.RestoreDBClusterFromSnapshotInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.StartActivityStreamInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -10368,20 +13117,20 @@ func (_m *RDSAPI) RestoreDBClusterFromSnapshotRequest(_a0 *rds.RestoreDBClusterF
 		}
 	}
 
-	var r1 *rds.RestoreDBClusterFromSnapshotOutput
-	if rf, ok := ret.Get(1).(func(*rds.RestoreDBClusterFromSnapshotInput) *rds.RestoreDBClusterFromSnapshotOutput); ok {
+	var r1 *rds.StartActivityStreamOutput
+	if rf, ok := ret.Get(1).(func(*rds.StartActivityStreamInput) *rds.StartActivityStreamOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.RestoreDBClusterFromSnapshotOutput)
+			r1 = ret.Get(1).(*rds.StartActivityStreamOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// RestoreDBClusterFromSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) RestoreDBClusterFromSnapshotWithContext(_a0 context.Context, _a1 *rds.RestoreDBClusterFromSnapshotInput, _a2 ...request.Option) (*rds.RestoreDBClusterFromSnapshotOutput, error) {
+// StartActivityStreamWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) StartActivityStreamWithContext(_a0 context.Context, _a1 *rds.StartActivityStreamInput, _a2 ...request.Option) (*rds.StartActivityStreamOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -10391,17 +13140,17 @@ func (_m *RDSAPI) RestoreDBClusterFromSnapshotWithContext(_a0 context.Context, _
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.RestoreDBClusterFromSnapshotOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.RestoreDBClusterFromSnapshotInput, ...request.Option) *rds.RestoreDBClusterFromSnapshotOutput); ok {
+	var r0 *rds.StartActivityStreamOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.StartActivityStreamInput, ...request.Option) *rds.StartActivityStreamOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RestoreDBClusterFromSnapshotOutput)
+			r0 = ret.Get(0).(*rds.StartActivityStreamOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.RestoreDBClusterFromSnapshotInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.StartActivityStreamInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -10410,21 +13159,21 @@ func (_m *RDSAPI) RestoreDBClusterFromSnapshotWithContext(_a0 context.Context, _
 	return r0, r1
 }
 
-// RestoreDBClusterToPointInTime provides a mock function with given fields: _a0
-func (_m *RDSAPI) RestoreDBClusterToPointInTime(_a0 *rds.RestoreDBClusterToPointInTimeInput) (*rds.RestoreDBClusterToPointInTimeOutput, error) {
+// StartDBCluster provides a mock function with given fields: _a0
+func (_m *RDSAPI) StartDBCluster(_a0 *rds.StartDBClusterInput) (*rds.StartDBClusterOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.RestoreDBClusterToPointInTimeOutput
-	if rf, ok := ret.Get(0).(func(*rds.RestoreDBClusterToPointInTimeInput) *rds.RestoreDBClusterToPointInTimeOutput); ok {
+	var r0 *rds.StartDBClusterOutput
+	if rf, ok := ret.Get(0).(func(*rds.StartDBClusterInput) *rds.StartDBClusterOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RestoreDBClusterToPointInTimeOutput)
+			r0 = ret.Get(0).(*rds.StartDBClusterOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.RestoreDBClusterToPointInTimeInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.StartDBClusterInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -10433,12 +13182,12 @@ func (_m *RDSAPI) RestoreDBClusterToPointInTime(_a0 *rds.RestoreDBClusterToPoint
 	return r0, r1
 }
 
-// RestoreDBClusterToPointInTimeRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) RestoreDBClusterToPointInTimeRequest(_a0 *rds.RestoreDBClusterToPointInTimeInput) (*request.Request, *rds.RestoreDBClusterToPointInTimeOutput) {
+// StartDBClusterRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) StartDBClusterRequest(_a0 *rds.StartDBClusterInput) (*request.Request, *rds.StartDBClusterOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.RestoreDBClusterToPointInTimeInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.StartDBClusterInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -10446,20 +13195,20 @@ func (_m *RDSAPI) RestoreDBClusterToPointInTimeRequest(_a0 *rds.RestoreDBCluster
 		}
 	}
 
-	var r1 *rds.RestoreDBClusterToPointInTimeOutput
-	if rf, ok := ret.Get(1).(func(*rds.RestoreDBClusterToPointInTimeInput) *rds.RestoreDBClusterToPointInTimeOutput); ok {
+	var r1 *rds.StartDBClusterOutput
+	if rf, ok := ret.Get(1).(func(*rds.StartDBClusterInput) *rds.StartDBClusterOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.RestoreDBClusterToPointInTimeOutput)
+			r1 = ret.Get(1).(*rds.StartDBClusterOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// RestoreDBClusterToPointInTimeWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) RestoreDBClusterToPointInTimeWithContext(_a0 context.Context, _a1 *rds.RestoreDBClusterToPointInTimeInput, _a2 ...request.Option) (*rds.RestoreDBClusterToPointInTimeOutput, error) {
+// StartDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) StartDBClusterWithContext(_a0 context.Context, _a1 *rds.StartDBClusterInput, _a2 ...request.Option) (*rds.StartDBClusterOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -10469,17 +13218,17 @@ func (_m *RDSAPI) RestoreDBClusterToPointInTimeWithContext(_a0 context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.RestoreDBClusterToPointInTimeOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.RestoreDBClusterToPointInTimeInput, ...request.Option) *rds.RestoreDBClusterToPointInTimeOutput); ok {
+	var r0 *rds.StartDBClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.StartDBClusterInput, ...request.Option) *rds.StartDBClusterOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RestoreDBClusterToPointInTimeOutput)
+			r0 = ret.Get(0).(*rds.StartDBClusterOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.RestoreDBClusterToPointInTimeInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.StartDBClusterInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -10488,21 +13237,21 @@ func (_m *RDSAPI) RestoreDBClusterToPointInTimeWithContext(_a0 context.Context,
 	return r0, r1
 }
 
-// RestoreDBInstanceFromDBSnapshot provides a mock function with given fields: _a0
-func (_m *RDSAPI) RestoreDBInstanceFromDBSnapshot(_a0 *rds.RestoreDBInstanceFromDBSnapshotInput) (*rds.RestoreDBInstanceFromDBSnapshotOutput, error) {
+// StartDBInstance provides a mock function with given fields: _a0
+func (_m *RDSAPI) StartDBInstance(_a0 *rds.StartDBInstanceInput) (*rds.StartDBInstanceOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.RestoreDBInstanceFromDBSnapshotOutput
-	if rf, ok := ret.Get(0).(func(*rds.RestoreDBInstanceFromDBSnapshotInput) *rds.RestoreDBInstanceFromDBSnapshotOutput); ok {
+	var r0 *rds.StartDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(*rds.StartDBInstanceInput) *rds.StartDBInstanceOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RestoreDBInstanceFromDBSnapshotOutput)
+			r0 = ret.Get(0).(*rds.StartDBInstanceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.RestoreDBInstanceFromDBSnapshotInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.StartDBInstanceInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -10511,12 +13260,35 @@ func (_m *RDSAPI) RestoreDBInstanceFromDBSnapshot(_a0 *rds.RestoreDBInstanceFrom
 	return r0, r1
 }
 
-// RestoreDBInstanceFromDBSnapshotRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) RestoreDBInstanceFromDBSnapshotRequest(_a0 *rds.RestoreDBInstanceFromDBSnapshotInput) (*request.Request, *rds.RestoreDBInstanceFromDBSnapshotOutput) {
+// StartDBInstanceAutomatedBackupsReplication provides a mock function with given fields: _a0
+func (_m *RDSAPI) StartDBInstanceAutomatedBackupsReplication(_a0 *rds.StartDBInstanceAutomatedBackupsReplicationInput) (*rds.StartDBInstanceAutomatedBackupsReplicationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.StartDBInstanceAutomatedBackupsReplicationOutput
+	if rf, ok := ret.Get(0).(func(*rds.StartDBInstanceAutomatedBackupsReplicationInput) *rds.StartDBInstanceAutomatedBackupsReplicationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.StartDBInstanceAutomatedBackupsReplicationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*rds.StartDBInstanceAutomatedBackupsReplicationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartDBInstanceAutomatedBackupsReplicationRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) StartDBInstanceAutomatedBackupsReplicationRequest(_a0 *rds.StartDBInstanceAutomatedBackupsReplicationInput) (*request.Request, *rds.StartDBInstanceAutomatedBackupsReplicationOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.RestoreDBInstanceFromDBSnapshotInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.StartDBInstanceAutomatedBackupsReplicationInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -10524,20 +13296,20 @@ func (_m *RDSAPI) RestoreDBInstanceFromDBSnapshotRequest(_a0 *rds.RestoreDBInsta
 		}
 	}
 
-	var r1 *rds.RestoreDBInstanceFromDBSnapshotOutput
-	if rf, ok := ret.Get(1).(func(*rds.RestoreDBInstanceFromDBSnapshotInput) *rds.RestoreDBInstanceFromDBSnapshotOutput); ok {
+	var r1 *rds.StartDBInstanceAutomatedBackupsReplicationOutput
+	if rf, ok := ret.Get(1).(func(*rds.StartDBInstanceAutomatedBackupsReplicationInput) *rds.StartDBInstanceAutomatedBackupsReplicationOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.RestoreDBInstanceFromDBSnapshotOutput)
+			r1 = ret.Get(1).(*rds.StartDBInstanceAutomatedBackupsReplicationOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// RestoreDBInstanceFromDBSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) RestoreDBInstanceFromDBSnapshotWithContext(_a0 context.Context, _a1 *rds.RestoreDBInstanceFromDBSnapshotInput, _a2 ...request.Option) (*rds.RestoreDBInstanceFromDBSnapshotOutput, error) {
+// StartDBInstanceAutomatedBackupsReplicationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) StartDBInstanceAutomatedBackupsReplicationWithContext(_a0 context.Context, _a1 *rds.StartDBInstanceAutomatedBackupsReplicationInput, _a2 ...request.Option) (*rds.StartDBInstanceAutomatedBackupsReplicationOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -10547,17 +13319,17 @@ func (_m *RDSAPI) RestoreDBInstanceFromDBSnapshotWithContext(_a0 context.Context
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.RestoreDBInstanceFromDBSnapshotOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.RestoreDBInstanceFromDBSnapshotInput, ...request.Option) *rds.RestoreDBInstanceFromDBSnapshotOutput); ok {
+	var r0 *rds.StartDBInstanceAutomatedBackupsReplicationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.StartDBInstanceAutomatedBackupsReplicationInput, ...request.Option) *rds.StartDBInstanceAutomatedBackupsReplicationOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RestoreDBInstanceFromDBSnapshotOutput)
+			r0 = ret.Get(0).(*rds.StartDBInstanceAutomatedBackupsReplicationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.RestoreDBInstanceFromDBSnapshotInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.StartDBInstanceAutomatedBackupsReplicationInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -10566,35 +13338,12 @@ func (_m *RDSAPI) RestoreDBInstanceFromDBSnapshotWithContext(_a0 context.Context
 	return r0, r1
 }
 
-// RestoreDBInstanceFromS3 provides a mock function with given fields: _a0
-func (_m *RDSAPI) RestoreDBInstanceFromS3(_a0 *rds.RestoreDBInstanceFromS3Input) (*rds.RestoreDBInstanceFromS3Output, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *rds.RestoreDBInstanceFromS3Output
-	if rf, ok := ret.Get(0).(func(*rds.RestoreDBInstanceFromS3Input) *rds.RestoreDBInstanceFromS3Output); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RestoreDBInstanceFromS3Output)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.RestoreDBInstanceFromS3Input) error); ok {
-		r1 = rf(_a0)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// RestoreDBInstanceFromS3Request provides a mock function with given fields: _a0
-func (_m *RDSAPI) RestoreDBInstanceFromS3Request(_a0 *rds.RestoreDBInstanceFromS3Input) (*request.Request, *rds.RestoreDBInstanceFromS3Output) {
+// StartDBInstanceRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) StartDBInstanceRequest(_a0 *rds.StartDBInstanceInput) (*request.Request, *rds.StartDBInstanceOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.RestoreDBInstanceFromS3Input) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.StartDBInstanceInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -10602,20 +13351,20 @@ func (_m *RDSAPI) RestoreDBInstanceFromS3Request(_a0 *rds.RestoreDBInstanceFromS
 		}
 	}
 
-	var r1 *rds.RestoreDBInstanceFromS3Output
-	if rf, ok := ret.Get(1).(func(*rds.RestoreDBInstanceFromS3Input) *rds.RestoreDBInstanceFromS3Output); ok {
+	var r1 *rds.StartDBInstanceOutput
+	if rf, ok := ret.Get(1).(func(*rds.StartDBInstanceInput) *rds.StartDBInstanceOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.RestoreDBInstanceFromS3Output)
+			r1 = ret.Get(1).(*rds.StartDBInstanceOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// RestoreDBInstanceFromS3WithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) RestoreDBInstanceFromS3WithContext(_a0 context.Context, _a1 *rds.RestoreDBInstanceFromS3Input, _a2 ...request.Option) (*rds.RestoreDBInstanceFromS3Output, error) {
+// StartDBInstanceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) StartDBInstanceWithContext(_a0 context.Context, _a1 *rds.StartDBInstanceInput, _a2 ...request.Option) (*rds.StartDBInstanceOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -10625,17 +13374,17 @@ func (_m *RDSAPI) RestoreDBInstanceFromS3WithContext(_a0 context.Context, _a1 *r
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.RestoreDBInstanceFromS3Output
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.RestoreDBInstanceFromS3Input, ...request.Option) *rds.RestoreDBInstanceFromS3Output); ok {
+	var r0 *rds.StartDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.StartDBInstanceInput, ...request.Option) *rds.StartDBInstanceOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RestoreDBInstanceFromS3Output)
+			r0 = ret.Get(0).(*rds.StartDBInstanceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.RestoreDBInstanceFromS3Input, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.StartDBInstanceInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -10644,21 +13393,21 @@ func (_m *RDSAPI) RestoreDBInstanceFromS3WithContext(_a0 context.Context, _a1 *r
 	return r0, r1
 }
 
-// RestoreDBInstanceToPointInTime provides a mock function with given fields: _a0
-func (_m *RDSAPI) RestoreDBInstanceToPointInTime(_a0 *rds.RestoreDBInstanceToPointInTimeInput) (*rds.RestoreDBInstanceToPointInTimeOutput, error) {
+// StartExportTask provides a mock function with given fields: _a0
+func (_m *RDSAPI) StartExportTask(_a0 *rds.StartExportTaskInput) (*rds.StartExportTaskOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.RestoreDBInstanceToPointInTimeOutput
-	if rf, ok := ret.Get(0).(func(*rds.RestoreDBInstanceToPointInTimeInput) *rds.RestoreDBInstanceToPointInTimeOutput); ok {
+	var r0 *rds.StartExportTaskOutput
+	if rf, ok := ret.Get(0).(func(*rds.StartExportTaskInput) *rds.StartExportTaskOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RestoreDBInstanceToPointInTimeOutput)
+			r0 = ret.Get(0).(*rds.StartExportTaskOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.RestoreDBInstanceToPointInTimeInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.StartExportTaskInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -10667,12 +13416,12 @@ func (_m *RDSAPI) RestoreDBInstanceToPointInTime(_a0 *rds.RestoreDBInstanceToPoi
 	return r0, r1
 }
 
-// RestoreDBInstanceToPointInTimeRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) RestoreDBInstanceToPointInTimeRequest(_a0 *rds.RestoreDBInstanceToPointInTimeInput) (*request.Request, *rds.RestoreDBInstanceToPointInTimeOutput) {
+// StartExportTaskRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) StartExportTaskRequest(_a0 *rds.StartExportTaskInput) (*request.Request, *rds.StartExportTaskOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.RestoreDBInstanceToPointInTimeInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.StartExportTaskInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -10680,20 +13429,20 @@ func (_m *RDSAPI) RestoreDBInstanceToPointInTimeRequest(_a0 *rds.RestoreDBInstan
 		}
 	}
 
-	var r1 *rds.RestoreDBInstanceToPointInTimeOutput
-	if rf, ok := ret.Get(1).(func(*rds.RestoreDBInstanceToPointInTimeInput) *rds.RestoreDBInstanceToPointInTimeOutput); ok {
+	var r1 *rds.StartExportTaskOutput
+	if rf, ok := ret.Get(1).(func(*rds.StartExportTaskInput) *rds.StartExportTaskOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.RestoreDBInstanceToPointInTimeOutput)
+			r1 = ret.Get(1).(*rds.StartExportTaskOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// RestoreDBInstanceToPointInTimeWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) RestoreDBInstanceToPointInTimeWithContext(_a0 context.Context, _a1 *rds.RestoreDBInstanceToPointInTimeInput, _a2 ...request.Option) (*rds.RestoreDBInstanceToPointInTimeOutput, error) {
+// StartExportTaskWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) StartExportTaskWithContext(_a0 context.Context, _a1 *rds.StartExportTaskInput, _a2 ...request.Option) (*rds.StartExportTaskOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -10703,17 +13452,17 @@ func (_m *RDSAPI) RestoreDBInstanceToPointInTimeWithContext(_a0 context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.RestoreDBInstanceToPointInTimeOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.RestoreDBInstanceToPointInTimeInput, ...request.Option) *rds.RestoreDBInstanceToPointInTimeOutput); ok {
+	var r0 *rds.StartExportTaskOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.StartExportTaskInput, ...request.Option) *rds.StartExportTaskOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RestoreDBInstanceToPointInTimeOutput)
+			r0 = ret.Get(0).(*rds.StartExportTaskOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.RestoreDBInstanceToPointInTimeInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.StartExportTaskInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -10722,21 +13471,21 @@ func (_m *RDSAPI) RestoreDBInstanceToPointInTimeWithContext(_a0 context.Context,
 	return r0, r1
 }
 
-// RevokeDBSecurityGroupIngress provides a mock function with given fields: _a0
-func (_m *RDSAPI) RevokeDBSecurityGroupIngress(_a0 *rds.RevokeDBSecurityGroupIngressInput) (*rds.RevokeDBSecurityGroupIngressOutput, error) {
+// StopActivityStream provides a mock function with given fields: _a0
+func (_m *RDSAPI) StopActivityStream(_a0 *rds.StopActivityStreamInput) (*rds.StopActivityStreamOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.RevokeDBSecurityGroupIngressOutput
-	if rf, ok := ret.Get(0).(func(*rds.RevokeDBSecurityGroupIngressInput) *rds.RevokeDBSecurityGroupIngressOutput); ok {
+	var r0 *rds.StopActivityStreamOutput
+	if rf, ok := ret.Get(0).(func(*rds.StopActivityStreamInput) *rds.StopActivityStreamOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RevokeDBSecurityGroupIngressOutput)
+			r0 = ret.Get(0).(*rds.StopActivityStreamOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.RevokeDBSecurityGroupIngressInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.StopActivityStreamInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -10745,12 +13494,12 @@ func (_m *RDSAPI) RevokeDBSecurityGroupIngress(_a0 *rds.RevokeDBSecurityGroupIng
 	return r0, r1
 }
 
-// RevokeDBSecurityGroupIngressRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) RevokeDBSecurityGroupIngressRequest(_a0 *rds.RevokeDBSecurityGroupIngressInput) (*request.Request, *rds.RevokeDBSecurityGroupIngressOutput) {
+// StopActivityStreamRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) StopActivityStreamRequest(_a0 *rds.StopActivityStreamInput) (*request.Request, *rds.StopActivityStreamOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.RevokeDBSecurityGroupIngressInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.StopActivityStreamInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -10758,20 +13507,20 @@ func (_m *RDSAPI) RevokeDBSecurityGroupIngressRequest(_a0 *rds.RevokeDBSecurityG
 		}
 	}
 
-	var r1 *rds.RevokeDBSecurityGroupIngressOutput
-	if rf, ok := ret.Get(1).(func(*rds.RevokeDBSecurityGroupIngressInput) *rds.RevokeDBSecurityGroupIngressOutput); ok {
+	var r1 *rds.StopActivityStreamOutput
+	if rf, ok := ret.Get(1).(func(*rds.StopActivityStreamInput) *rds.StopActivityStreamOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.RevokeDBSecurityGroupIngressOutput)
+			r1 = ret.Get(1).(*rds.StopActivityStreamOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// RevokeDBSecurityGroupIngressWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) RevokeDBSecurityGroupIngressWithContext(_a0 context.Context, _a1 *rds.RevokeDBSecurityGroupIngressInput, _a2 ...request.Option) (*rds.RevokeDBSecurityGroupIngressOutput, error) {
+// StopActivityStreamWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) StopActivityStreamWithContext(_a0 context.Context, _a1 *rds.StopActivityStreamInput, _a2 ...request.Option) (*rds.StopActivityStreamOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -10781,17 +13530,17 @@ func (_m *RDSAPI) RevokeDBSecurityGroupIngressWithContext(_a0 context.Context, _
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.RevokeDBSecurityGroupIngressOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.RevokeDBSecurityGroupIngressInput, ...request.Option) *rds.RevokeDBSecurityGroupIngressOutput); ok {
+	var r0 *rds.StopActivityStreamOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.StopActivityStreamInput, ...request.Option) *rds.StopActivityStreamOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.RevokeDBSecurityGroupIngressOutput)
+			r0 = ret.Get(0).(*rds.StopActivityStreamOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.RevokeDBSecurityGroupIngressInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.StopActivityStreamInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -10800,21 +13549,21 @@ func (_m *RDSAPI) RevokeDBSecurityGroupIngressWithContext(_a0 context.Context, _
 	return r0, r1
 }
 
-// StartActivityStream provides a mock function with given fields: _a0
-func (_m *RDSAPI) StartActivityStream(_a0 *rds.StartActivityStreamInput) (*rds.StartActivityStreamOutput, error) {
+// StopDBCluster provides a mock function with given fields: _a0
+func (_m *RDSAPI) StopDBCluster(_a0 *rds.StopDBClusterInput) (*rds.StopDBClusterOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.StartActivityStreamOutput
-	if rf, ok := ret.Get(0).(func(*rds.StartActivityStreamInput) *rds.StartActivityStreamOutput); ok {
+	var r0 *rds.StopDBClusterOutput
+	if rf, ok := ret.Get(0).(func(*rds.StopDBClusterInput) *rds.StopDBClusterOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.StartActivityStreamOutput)
+			r0 = ret.Get(0).(*rds.StopDBClusterOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.StartActivityStreamInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.StopDBClusterInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -10823,12 +13572,12 @@ func (_m *RDSAPI) StartActivityStream(_a0 *rds.StartActivityStreamInput) (*rds.S
 	return r0, r1
 }
 
-// StartActivityStreamRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) StartActivityStreamRequest(_a0 *rds.StartActivityStreamInput) (*request.Request, *rds.StartActivityStreamOutput) {
+// StopDBClusterRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) StopDBClusterRequest(_a0 *rds.StopDBClusterInput) (*request.Request, *rds.StopDBClusterOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.StartActivityStreamInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.StopDBClusterInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -10836,20 +13585,20 @@ func (_m *RDSAPI) StartActivityStreamRequest(_a0 *rds.StartActivityStreamInput)
 		}
 	}
 
-	var r1 *rds.StartActivityStreamOutput
-	if rf, ok := ret.Get(1).(func(*rds.StartActivityStreamInput) *rds.StartActivityStreamOutput); ok {
+	var r1 *rds.StopDBClusterOutput
+	if rf, ok := ret.Get(1).(func(*rds.StopDBClusterInput) *rds.StopDBClusterOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.StartActivityStreamOutput)
+			r1 = ret.Get(1).(*rds.StopDBClusterOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// StartActivityStreamWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) StartActivityStreamWithContext(_a0 context.Context, _a1 *rds.StartActivityStreamInput, _a2 ...request.Option) (*rds.StartActivityStreamOutput, error) {
+// StopDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) StopDBClusterWithContext(_a0 context.Context, _a1 *rds.StopDBClusterInput, _a2 ...request.Option) (*rds.StopDBClusterOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -10859,18 +13608,41 @@ func (_m *RDSAPI) StartActivityStreamWithContext(_a0 context.Context, _a1 *rds.S
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.StartActivityStreamOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.StartActivityStreamInput, ...request.Option) *rds.StartActivityStreamOutput); ok {
+	var r0 *rds.StopDBClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.StopDBClusterInput, ...request.Option) *rds.StopDBClusterOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.StartActivityStreamOutput)
+			r0 = ret.Get(0).(*rds.StopDBClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.StopDBClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StopDBInstance provides a mock function with given fields: _a0
+func (_m *RDSAPI) StopDBInstance(_a0 *rds.StopDBInstanceInput) (*rds.StopDBInstanceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *rds.StopDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(*rds.StopDBInstanceInput) *rds.StopDBInstanceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rds.StopDBInstanceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.StartActivityStreamInput, ...request.Option) error); ok {
-		r1 = rf(_a0, _a1, _a2...)
+	if rf, ok := ret.Get(1).(func(*rds.StopDBInstanceInput) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -10878,21 +13650,21 @@ func (_m *RDSAPI) StartActivityStreamWithContext(_a0 context.Context, _a1 *rds.S
 	return r0, r1
 }
 
-// StartDBCluster provides a mock function with given fields: _a0
-func (_m *RDSAPI) StartDBCluster(_a0 *rds.StartDBClusterInput) (*rds.StartDBClusterOutput, error) {
+// StopDBInstanceAutomatedBackupsReplication provides a mock function with given fields: _a0
+func (_m *RDSAPI) StopDBInstanceAutomatedBackupsReplication(_a0 *rds.StopDBInstanceAutomatedBackupsReplicationInput) (*rds.StopDBInstanceAutomatedBackupsReplicationOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.StartDBClusterOutput
-	if rf, ok := ret.Get(0).(func(*rds.StartDBClusterInput) *rds.StartDBClusterOutput); ok {
+	var r0 *rds.StopDBInstanceAutomatedBackupsReplicationOutput
+	if rf, ok := ret.Get(0).(func(*rds.StopDBInstanceAutomatedBackupsReplicationInput) *rds.StopDBInstanceAutomatedBackupsReplicationOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.StartDBClusterOutput)
+			r0 = ret.Get(0).(*rds.StopDBInstanceAutomatedBackupsReplicationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.StartDBClusterInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.StopDBInstanceAutomatedBackupsReplicationInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -10901,12 +13673,12 @@ func (_m *RDSAPI) StartDBCluster(_a0 *rds.StartDBClusterInput) (*rds.StartDBClus
 	return r0, r1
 }
 
-// StartDBClusterRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) StartDBClusterRequest(_a0 *rds.StartDBClusterInput) (*request.Request, *rds.StartDBClusterOutput) {
+// StopDBInstanceAutomatedBackupsReplicationRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) StopDBInstanceAutomatedBackupsReplicationRequest(_a0 *rds.StopDBInstanceAutomatedBackupsReplicationInput) (*request.Request, *rds.StopDBInstanceAutomatedBackupsReplicationOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.StartDBClusterInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.StopDBInstanceAutomatedBackupsReplicationInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -10914,20 +13686,20 @@ func (_m *RDSAPI) StartDBClusterRequest(_a0 *rds.StartDBClusterInput) (*request.
 		}
 	}
 
-	var r1 *rds.StartDBClusterOutput
-	if rf, ok := ret.Get(1).(func(*rds.StartDBClusterInput) *rds.StartDBClusterOutput); ok {
+	var r1 *rds.StopDBInstanceAutomatedBackupsReplicationOutput
+	if rf, ok := ret.Get(1).(func(*rds.StopDBInstanceAutomatedBackupsReplicationInput) *rds.StopDBInstanceAutomatedBackupsReplicationOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.StartDBClusterOutput)
+			r1 = ret.Get(1).(*rds.StopDBInstanceAutomatedBackupsReplicationOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// StartDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) StartDBClusterWithContext(_a0 context.Context, _a1 *rds.StartDBClusterInput, _a2 ...request.Option) (*rds.StartDBClusterOutput, error) {
+// StopDBInstanceAutomatedBackupsReplicationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) StopDBInstanceAutomatedBackupsReplicationWithContext(_a0 context.Context, _a1 *rds.StopDBInstanceAutomatedBackupsReplicationInput, _a2 ...request.Option) (*rds.StopDBInstanceAutomatedBackupsReplicationOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -10937,17 +13709,17 @@ func (_m *RDSAPI) StartDBClusterWithContext(_a0 context.Context, _a1 *rds.StartD
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.StartDBClusterOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.StartDBClusterInput, ...request.Option) *rds.StartDBClusterOutput); ok {
+	var r0 *rds.StopDBInstanceAutomatedBackupsReplicationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.StopDBInstanceAutomatedBackupsReplicationInput, ...request.Option) *rds.StopDBInstanceAutomatedBackupsReplicationOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.StartDBClusterOutput)
+			r0 = ret.Get(0).(*rds.StopDBInstanceAutomatedBackupsReplicationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.StartDBClusterInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.StopDBInstanceAutomatedBackupsReplicationInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -10956,35 +13728,12 @@ func (_m *RDSAPI) StartDBClusterWithContext(_a0 context.Context, _a1 *rds.StartD
 	return r0, r1
 }
 
-// StartDBInstance provides a mock function with given fields: _a0
-func (_m *RDSAPI) StartDBInstance(_a0 *rds.StartDBInstanceInput) (*rds.StartDBInstanceOutput, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *rds.StartDBInstanceOutput
-	if rf, ok := ret.Get(0).(func(*rds.StartDBInstanceInput) *rds.StartDBInstanceOutput); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.StartDBInstanceOutput)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.StartDBInstanceInput) error); ok {
-		r1 = rf(_a0)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// StartDBInstanceRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) StartDBInstanceRequest(_a0 *rds.StartDBInstanceInput) (*request.Request, *rds.StartDBInstanceOutput) {
+// StopDBInstanceRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) StopDBInstanceRequest(_a0 *rds.StopDBInstanceInput) (*request.Request, *rds.StopDBInstanceOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.StartDBInstanceInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.StopDBInstanceInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -10992,20 +13741,20 @@ func (_m *RDSAPI) StartDBInstanceRequest(_a0 *rds.StartDBInstanceInput) (*reques
 		}
 	}
 
-	var r1 *rds.StartDBInstanceOutput
-	if rf, ok := ret.Get(1).(func(*rds.StartDBInstanceInput) *rds.StartDBInstanceOutput); ok {
+	var r1 *rds.StopDBInstanceOutput
+	if rf, ok := ret.Get(1).(func(*rds.StopDBInstanceInput) *rds.StopDBInstanceOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.StartDBInstanceOutput)
+			r1 = ret.Get(1).(*rds.StopDBInstanceOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// StartDBInstanceWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) StartDBInstanceWithContext(_a0 context.Context, _a1 *rds.StartDBInstanceInput, _a2 ...request.Option) (*rds.StartDBInstanceOutput, error) {
+// StopDBInstanceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) StopDBInstanceWithContext(_a0 context.Context, _a1 *rds.StopDBInstanceInput, _a2 ...request.Option) (*rds.StopDBInstanceOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -11015,17 +13764,17 @@ func (_m *RDSAPI) StartDBInstanceWithContext(_a0 context.Context, _a1 *rds.Start
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.StartDBInstanceOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.StartDBInstanceInput, ...request.Option) *rds.StartDBInstanceOutput); ok {
+	var r0 *rds.StopDBInstanceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.StopDBInstanceInput, ...request.Option) *rds.StopDBInstanceOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.StartDBInstanceOutput)
+			r0 = ret.Get(0).(*rds.StopDBInstanceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.StartDBInstanceInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.StopDBInstanceInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -11034,21 +13783,21 @@ func (_m *RDSAPI) StartDBInstanceWithContext(_a0 context.Context, _a1 *rds.Start
 	return r0, r1
 }
 
-// StartExportTask provides a mock function with given fields: _a0
-func (_m *RDSAPI) StartExportTask(_a0 *rds.StartExportTaskInput) (*rds.StartExportTaskOutput, error) {
+// SwitchoverBlueGreenDeployment provides a mock function with given fields: _a0
+func (_m *RDSAPI) SwitchoverBlueGreenDeployment(_a0 *rds.SwitchoverBlueGreenDeploymentInput) (*rds.SwitchoverBlueGreenDeploymentOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.StartExportTaskOutput
-	if rf, ok := ret.Get(0).(func(*rds.StartExportTaskInput) *rds.StartExportTaskOutput); ok {
+	var r0 *rds.SwitchoverBlueGreenDeploymentOutput
+	if rf, ok := ret.Get(0).(func(*rds.SwitchoverBlueGreenDeploymentInput) *rds.SwitchoverBlueGreenDeploymentOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.StartExportTaskOutput)
+			r0 = ret.Get(0).(*rds.SwitchoverBlueGreenDeploymentOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.StartExportTaskInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.SwitchoverBlueGreenDeploymentInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -11057,12 +13806,12 @@ func (_m *RDSAPI) StartExportTask(_a0 *rds.StartExportTaskInput) (*rds.StartExpo
 	return r0, r1
 }
 
-// StartExportTaskRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) StartExportTaskRequest(_a0 *rds.StartExportTaskInput) (*request.Request, *rds.StartExportTaskOutput) {
+// SwitchoverBlueGreenDeploymentRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) SwitchoverBlueGreenDeploymentRequest(_a0 *rds.SwitchoverBlueGreenDeploymentInput) (*request.Request, *rds.SwitchoverBlueGreenDeploymentOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.StartExportTaskInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.SwitchoverBlueGreenDeploymentInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -11070,20 +13819,20 @@ func (_m *RDSAPI) StartExportTaskRequest(_a0 *rds.StartExportTaskInput) (*reques
 		}
 	}
 
-	var r1 *rds.StartExportTaskOutput
-	if rf, ok := ret.Get(1).(func(*rds.StartExportTaskInput) *rds.StartExportTaskOutput); ok {
+	var r1 *rds.SwitchoverBlueGreenDeploymentOutput
+	if rf, ok := ret.Get(1).(func(*rds.SwitchoverBlueGreenDeploymentInput) *rds.SwitchoverBlueGreenDeploymentOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.StartExportTaskOutput)
+			r1 = ret.Get(1).(*rds.SwitchoverBlueGreenDeploymentOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// StartExportTaskWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) StartExportTaskWithContext(_a0 context.Context, _a1 *rds.StartExportTaskInput, _a2 ...request.Option) (*rds.StartExportTaskOutput, error) {
+// SwitchoverBlueGreenDeploymentWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) SwitchoverBlueGreenDeploymentWithContext(_a0 context.Context, _a1 *rds.SwitchoverBlueGreenDeploymentInput, _a2 ...request.Option) (*rds.SwitchoverBlueGreenDeploymentOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -11093,17 +13842,17 @@ func (_m *RDSAPI) StartExportTaskWithContext(_a0 context.Context, _a1 *rds.Start
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.StartExportTaskOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.StartExportTaskInput, ...request.Option) *rds.StartExportTaskOutput); ok {
+	var r0 *rds.SwitchoverBlueGreenDeploymentOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.SwitchoverBlueGreenDeploymentInput, ...request.Option) *rds.SwitchoverBlueGreenDeploymentOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.StartExportTaskOutput)
+			r0 = ret.Get(0).(*rds.SwitchoverBlueGreenDeploymentOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.StartExportTaskInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.SwitchoverBlueGreenDeploymentInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -11112,21 +13861,21 @@ func (_m *RDSAPI) StartExportTaskWithContext(_a0 context.Context, _a1 *rds.Start
 	return r0, r1
 }
 
-// StopActivityStream provides a mock function with given fields: _a0
-func (_m *RDSAPI) StopActivityStream(_a0 *rds.StopActivityStreamInput) (*rds.StopActivityStreamOutput, error) {
+// SwitchoverGlobalCluster provides a mock function with given fields: _a0
+func (_m *RDSAPI) SwitchoverGlobalCluster(_a0 *rds.SwitchoverGlobalClusterInput) (*rds.SwitchoverGlobalClusterOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.StopActivityStreamOutput
-	if rf, ok := ret.Get(0).(func(*rds.StopActivityStreamInput) *rds.StopActivityStreamOutput); ok {
+	var r0 *rds.SwitchoverGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(*rds.SwitchoverGlobalClusterInput) *rds.SwitchoverGlobalClusterOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.StopActivityStreamOutput)
+			r0 = ret.Get(0).(*rds.SwitchoverGlobalClusterOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.StopActivityStreamInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.SwitchoverGlobalClusterInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -11135,12 +13884,12 @@ func (_m *RDSAPI) StopActivityStream(_a0 *rds.StopActivityStreamInput) (*rds.Sto
 	return r0, r1
 }
 
-// StopActivityStreamRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) StopActivityStreamRequest(_a0 *rds.StopActivityStreamInput) (*request.Request, *rds.StopActivityStreamOutput) {
+// SwitchoverGlobalClusterRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) SwitchoverGlobalClusterRequest(_a0 *rds.SwitchoverGlobalClusterInput) (*request.Request, *rds.SwitchoverGlobalClusterOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.StopActivityStreamInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.SwitchoverGlobalClusterInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -11148,20 +13897,20 @@ func (_m *RDSAPI) StopActivityStreamRequest(_a0 *rds.StopActivityStreamInput) (*
 		}
 	}
 
-	var r1 *rds.StopActivityStreamOutput
-	if rf, ok := ret.Get(1).(func(*rds.StopActivityStreamInput) *rds.StopActivityStreamOutput); ok {
+	var r1 *rds.SwitchoverGlobalClusterOutput
+	if rf, ok := ret.Get(1).(func(*rds.SwitchoverGlobalClusterInput) *rds.SwitchoverGlobalClusterOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.StopActivityStreamOutput)
+			r1 = ret.Get(1).(*rds.SwitchoverGlobalClusterOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// StopActivityStreamWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) StopActivityStreamWithContext(_a0 context.Context, _a1 *rds.StopActivityStreamInput, _a2 ...request.Option) (*rds.StopActivityStreamOutput, error) {
+// SwitchoverGlobalClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) SwitchoverGlobalClusterWithContext(_a0 context.Context, _a1 *rds.SwitchoverGlobalClusterInput, _a2 ...request.Option) (*rds.SwitchoverGlobalClusterOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -11171,17 +13920,17 @@ func (_m *RDSAPI) StopActivityStreamWithContext(_a0 context.Context, _a1 *rds.St
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.StopActivityStreamOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.StopActivityStreamInput, ...request.Option) *rds.StopActivityStreamOutput); ok {
+	var r0 *rds.SwitchoverGlobalClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.SwitchoverGlobalClusterInput, ...request.Option) *rds.SwitchoverGlobalClusterOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.StopActivityStreamOutput)
+			r0 = ret.Get(0).(*rds.SwitchoverGlobalClusterOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.StopActivityStreamInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.SwitchoverGlobalClusterInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -11190,21 +13939,21 @@ func (_m *RDSAPI) StopActivityStreamWithContext(_a0 context.Context, _a1 *rds.St
 	return r0, r1
 }
 
-// StopDBCluster provides a mock function with given fields: _a0
-func (_m *RDSAPI) StopDBCluster(_a0 *rds.StopDBClusterInput) (*rds.StopDBClusterOutput, error) {
+// SwitchoverReadReplica provides a mock function with given fields: _a0
+func (_m *RDSAPI) SwitchoverReadReplica(_a0 *rds.SwitchoverReadReplicaInput) (*rds.SwitchoverReadReplicaOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.StopDBClusterOutput
-	if rf, ok := ret.Get(0).(func(*rds.StopDBClusterInput) *rds.StopDBClusterOutput); ok {
+	var r0 *rds.SwitchoverReadReplicaOutput
+	if rf, ok := ret.Get(0).(func(*rds.SwitchoverReadReplicaInput) *rds.SwitchoverReadReplicaOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.StopDBClusterOutput)
+			r0 = ret.Get(0).(*rds.SwitchoverReadReplicaOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.StopDBClusterInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*rds.SwitchoverReadReplicaInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -11213,12 +13962,12 @@ func (_m *RDSAPI) StopDBCluster(_a0 *rds.StopDBClusterInput) (*rds.StopDBCluster
 	return r0, r1
 }
 
-// StopDBClusterRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) StopDBClusterRequest(_a0 *rds.StopDBClusterInput) (*request.Request, *rds.StopDBClusterOutput) {
+// SwitchoverReadReplicaRequest provides a mock function with given fields: _a0
+func (_m *RDSAPI) SwitchoverReadReplicaRequest(_a0 *rds.SwitchoverReadReplicaInput) (*request.Request, *rds.SwitchoverReadReplicaOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.StopDBClusterInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*rds.SwitchoverReadReplicaInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -11226,20 +13975,20 @@ func (_m *RDSAPI) StopDBClusterRequest(_a0 *rds.StopDBClusterInput) (*request.Re
 		}
 	}
 
-	var r1 *rds.StopDBClusterOutput
-	if rf, ok := ret.Get(1).(func(*rds.StopDBClusterInput) *rds.StopDBClusterOutput); ok {
+	var r1 *rds.SwitchoverReadReplicaOutput
+	if rf, ok := ret.Get(1).(func(*rds.SwitchoverReadReplicaInput) *rds.SwitchoverReadReplicaOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.StopDBClusterOutput)
+			r1 = ret.Get(1).(*rds.SwitchoverReadReplicaOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// StopDBClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) StopDBClusterWithContext(_a0 context.Context, _a1 *rds.StopDBClusterInput, _a2 ...request.Option) (*rds.StopDBClusterOutput, error) {
+// SwitchoverReadReplicaWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) SwitchoverReadReplicaWithContext(_a0 context.Context, _a1 *rds.SwitchoverReadReplicaInput, _a2 ...request.Option) (*rds.SwitchoverReadReplicaOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -11249,17 +13998,17 @@ func (_m *RDSAPI) StopDBClusterWithContext(_a0 context.Context, _a1 *rds.StopDBC
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.StopDBClusterOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.StopDBClusterInput, ...request.Option) *rds.StopDBClusterOutput); ok {
+	var r0 *rds.SwitchoverReadReplicaOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.SwitchoverReadReplicaInput, ...request.Option) *rds.SwitchoverReadReplicaOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.StopDBClusterOutput)
+			r0 = ret.Get(0).(*rds.SwitchoverReadReplicaOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.StopDBClusterInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rds.SwitchoverReadReplicaInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -11268,56 +14017,57 @@ func (_m *RDSAPI) StopDBClusterWithContext(_a0 context.Context, _a1 *rds.StopDBC
 	return r0, r1
 }
 
-// StopDBInstance provides a mock function with given fields: _a0
-func (_m *RDSAPI) StopDBInstance(_a0 *rds.StopDBInstanceInput) (*rds.StopDBInstanceOutput, error) {
+// WaitUntilDBClusterAvailable provides a mock function with given fields: _a0
+func (_m *RDSAPI) WaitUntilDBClusterAvailable(_a0 *rds.DescribeDBClustersInput) error {
 	ret := _m.Called(_a0)
 
-	var r0 *rds.StopDBInstanceOutput
-	if rf, ok := ret.Get(0).(func(*rds.StopDBInstanceInput) *rds.StopDBInstanceOutput); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClustersInput) error); ok {
 		r0 = rf(_a0)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.StopDBInstanceOutput)
-		}
+		r0 = ret.Error(0)
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*rds.StopDBInstanceInput) error); ok {
-		r1 = rf(_a0)
+	return r0
+}
+
+// WaitUntilDBClusterAvailableWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) WaitUntilDBClusterAvailableWithContext(_a0 context.Context, _a1 *rds.DescribeDBClustersInput, _a2 ...request.WaiterOption) error {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClustersInput, ...request.WaiterOption) error); ok {
+		r0 = rf(_a0, _a1, _a2...)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// StopDBInstanceRequest provides a mock function with given fields: _a0
-func (_m *RDSAPI) StopDBInstanceRequest(_a0 *rds.StopDBInstanceInput) (*request.Request, *rds.StopDBInstanceOutput) {
+// WaitUntilDBClusterDeleted provides a mock function with given fields: _a0
+func (_m *RDSAPI) WaitUntilDBClusterDeleted(_a0 *rds.DescribeDBClustersInput) error {
 	ret := _m.Called(_a0)
 
-	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*rds.StopDBInstanceInput) *request.Request); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DescribeDBClustersInput) error); ok {
 		r0 = rf(_a0)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*request.Request)
-		}
-	}
-
-	var r1 *rds.StopDBInstanceOutput
-	if rf, ok := ret.Get(1).(func(*rds.StopDBInstanceInput) *rds.StopDBInstanceOutput); ok {
-		r1 = rf(_a0)
-	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*rds.StopDBInstanceOutput)
-		}
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// StopDBInstanceWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *RDSAPI) StopDBInstanceWithContext(_a0 context.Context, _a1 *rds.StopDBInstanceInput, _a2 ...request.Option) (*rds.StopDBInstanceOutput, error) {
+// WaitUntilDBClusterDeletedWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) WaitUntilDBClusterDeletedWithContext(_a0 context.Context, _a1 *rds.DescribeDBClustersInput, _a2 ...request.WaiterOption) error {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -11327,23 +14077,14 @@ func (_m *RDSAPI) StopDBInstanceWithContext(_a0 context.Context, _a1 *rds.StopDB
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rds.StopDBInstanceOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *rds.StopDBInstanceInput, ...request.Option) *rds.StopDBInstanceOutput); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeDBClustersInput, ...request.WaiterOption) error); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rds.StopDBInstanceOutput)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *rds.StopDBInstanceInput, ...request.Option) error); ok {
-		r1 = rf(_a0, _a1, _a2...)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
 // WaitUntilDBClusterSnapshotAvailable provides a mock function with given fields: _a0
@@ -11555,3 +14296,73 @@ func (_m *RDSAPI) WaitUntilDBSnapshotDeletedWithContext(_a0 context.Context, _a1
 
 	return r0
 }
+
+// WaitUntilTenantDatabaseAvailable provides a mock function with given fields: _a0
+func (_m *RDSAPI) WaitUntilTenantDatabaseAvailable(_a0 *rds.DescribeTenantDatabasesInput) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DescribeTenantDatabasesInput) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilTenantDatabaseAvailableWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) WaitUntilTenantDatabaseAvailableWithContext(_a0 context.Context, _a1 *rds.DescribeTenantDatabasesInput, _a2 ...request.WaiterOption) error {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeTenantDatabasesInput, ...request.WaiterOption) error); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilTenantDatabaseDeleted provides a mock function with given fields: _a0
+func (_m *RDSAPI) WaitUntilTenantDatabaseDeleted(_a0 *rds.DescribeTenantDatabasesInput) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*rds.DescribeTenantDatabasesInput) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilTenantDatabaseDeletedWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RDSAPI) WaitUntilTenantDatabaseDeletedWithContext(_a0 context.Context, _a1 *rds.DescribeTenantDatabasesInput, _a2 ...request.WaiterOption) error {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rds.DescribeTenantDatabasesInput, ...request.WaiterOption) error); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
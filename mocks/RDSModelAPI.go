@@ -2,38 +2,44 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	rds "github.com/aws/aws-sdk-go/service/rds"
+)
 
 // RDSModelAPI is an autogenerated mock type for the RDSModelAPI type
 type RDSModelAPI struct {
 	mock.Mock
 }
 
-// GetRDSClusterForTags provides a mock function with given fields: repository, branch
-func (_m *RDSModelAPI) GetRDSClusterForTags(repository string, branch string) (*string, *string, error) {
-	ret := _m.Called(repository, branch)
+// GetRDSClustersForTags provides a mock function with given fields: ctx, repository, branch
+func (_m *RDSModelAPI) GetRDSClustersForTags(ctx context.Context, repository string, branch string) ([]*rds.DBCluster, []*rds.DBCluster, error) {
+	ret := _m.Called(ctx, repository, branch)
 
-	var r0 *string
-	if rf, ok := ret.Get(0).(func(string, string) *string); ok {
-		r0 = rf(repository, branch)
+	var r0 []*rds.DBCluster
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*rds.DBCluster); ok {
+		r0 = rf(ctx, repository, branch)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*string)
+			r0 = ret.Get(0).([]*rds.DBCluster)
 		}
 	}
 
-	var r1 *string
-	if rf, ok := ret.Get(1).(func(string, string) *string); ok {
-		r1 = rf(repository, branch)
+	var r1 []*rds.DBCluster
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) []*rds.DBCluster); ok {
+		r1 = rf(ctx, repository, branch)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*string)
+			r1 = ret.Get(1).([]*rds.DBCluster)
 		}
 	}
 
 	var r2 error
-	if rf, ok := ret.Get(2).(func(string, string) error); ok {
-		r2 = rf(repository, branch)
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, repository, branch)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -41,20 +47,73 @@ func (_m *RDSModelAPI) GetRDSClusterForTags(repository string, branch string) (*
 	return r0, r1, r2
 }
 
-// StartRDSCluster provides a mock function with given fields: clusterARN, clusterStatus
-func (_m *RDSModelAPI) StartRDSCluster(clusterARN *string, clusterStatus *string) (bool, error) {
-	ret := _m.Called(clusterARN, clusterStatus)
+// GetRDSInstancesForTags provides a mock function with given fields: ctx, repository, branch
+func (_m *RDSModelAPI) GetRDSInstancesForTags(ctx context.Context, repository string, branch string) ([]*rds.DBInstance, []*rds.DBInstance, error) {
+	ret := _m.Called(ctx, repository, branch)
+
+	var r0 []*rds.DBInstance
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*rds.DBInstance); ok {
+		r0 = rf(ctx, repository, branch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*rds.DBInstance)
+		}
+	}
+
+	var r1 []*rds.DBInstance
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) []*rds.DBInstance); ok {
+		r1 = rf(ctx, repository, branch)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*rds.DBInstance)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, repository, branch)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// StartRDSCluster provides a mock function with given fields: ctx, clusterARN, clusterStatus
+func (_m *RDSModelAPI) StartRDSCluster(ctx context.Context, clusterARN *string, clusterStatus *string) (bool, error) {
+	ret := _m.Called(ctx, clusterARN, clusterStatus)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *string) bool); ok {
+		r0 = rf(ctx, clusterARN, clusterStatus)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *string) error); ok {
+		r1 = rf(ctx, clusterARN, clusterStatus)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartRDSInstance provides a mock function with given fields: ctx, instanceIdentifier, instanceStatus
+func (_m *RDSModelAPI) StartRDSInstance(ctx context.Context, instanceIdentifier *string, instanceStatus *string) (bool, error) {
+	ret := _m.Called(ctx, instanceIdentifier, instanceStatus)
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(*string, *string) bool); ok {
-		r0 = rf(clusterARN, clusterStatus)
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *string) bool); ok {
+		r0 = rf(ctx, instanceIdentifier, instanceStatus)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*string, *string) error); ok {
-		r1 = rf(clusterARN, clusterStatus)
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *string) error); ok {
+		r1 = rf(ctx, instanceIdentifier, instanceStatus)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,23 +121,100 @@ func (_m *RDSModelAPI) StartRDSCluster(clusterARN *string, clusterStatus *string
 	return r0, r1
 }
 
-// StopRDSCluster provides a mock function with given fields: clusterARN, clusterStatus
-func (_m *RDSModelAPI) StopRDSCluster(clusterARN *string, clusterStatus *string) (bool, error) {
-	ret := _m.Called(clusterARN, clusterStatus)
+// StopRDSCluster provides a mock function with given fields: ctx, clusterARN, clusterStatus
+func (_m *RDSModelAPI) StopRDSCluster(ctx context.Context, clusterARN *string, clusterStatus *string) (bool, error) {
+	ret := _m.Called(ctx, clusterARN, clusterStatus)
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(*string, *string) bool); ok {
-		r0 = rf(clusterARN, clusterStatus)
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *string) bool); ok {
+		r0 = rf(ctx, clusterARN, clusterStatus)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*string, *string) error); ok {
-		r1 = rf(clusterARN, clusterStatus)
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *string) error); ok {
+		r1 = rf(ctx, clusterARN, clusterStatus)
 	} else {
 		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
+
+// StopRDSInstance provides a mock function with given fields: ctx, instanceIdentifier, instanceStatus
+func (_m *RDSModelAPI) StopRDSInstance(ctx context.Context, instanceIdentifier *string, instanceStatus *string) (bool, error) {
+	ret := _m.Called(ctx, instanceIdentifier, instanceStatus)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *string) bool); ok {
+		r0 = rf(ctx, instanceIdentifier, instanceStatus)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *string) error); ok {
+		r1 = rf(ctx, instanceIdentifier, instanceStatus)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WaitUntilRDSClusterAvailable provides a mock function with given fields: ctx, clusterARN
+func (_m *RDSModelAPI) WaitUntilRDSClusterAvailable(ctx context.Context, clusterARN *string) error {
+	ret := _m.Called(ctx, clusterARN)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) error); ok {
+		r0 = rf(ctx, clusterARN)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilRDSClusterStopped provides a mock function with given fields: ctx, clusterARN
+func (_m *RDSModelAPI) WaitUntilRDSClusterStopped(ctx context.Context, clusterARN *string) error {
+	ret := _m.Called(ctx, clusterARN)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) error); ok {
+		r0 = rf(ctx, clusterARN)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilRDSInstanceAvailable provides a mock function with given fields: ctx, instanceIdentifier
+func (_m *RDSModelAPI) WaitUntilRDSInstanceAvailable(ctx context.Context, instanceIdentifier *string) error {
+	ret := _m.Called(ctx, instanceIdentifier)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) error); ok {
+		r0 = rf(ctx, instanceIdentifier)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilRDSInstanceStopped provides a mock function with given fields: ctx, instanceIdentifier
+func (_m *RDSModelAPI) WaitUntilRDSInstanceStopped(ctx context.Context, instanceIdentifier *string) error {
+	ret := _m.Called(ctx, instanceIdentifier)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) error); ok {
+		r0 = rf(ctx, instanceIdentifier)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
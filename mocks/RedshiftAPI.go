@@ -0,0 +1,11791 @@
+// Code generated by mockery v2.0.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	redshift "github.com/aws/aws-sdk-go/service/redshift"
+	mock "github.com/stretchr/testify/mock"
+
+	request "github.com/aws/aws-sdk-go/aws/request"
+)
+
+// RedshiftAPI is an autogenerated mock type for the RedshiftAPI type
+type RedshiftAPI struct {
+	mock.Mock
+}
+
+// AcceptReservedNodeExchange provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) AcceptReservedNodeExchange(_a0 *redshift.AcceptReservedNodeExchangeInput) (*redshift.AcceptReservedNodeExchangeOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.AcceptReservedNodeExchangeOutput
+	if rf, ok := ret.Get(0).(func(*redshift.AcceptReservedNodeExchangeInput) *redshift.AcceptReservedNodeExchangeOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.AcceptReservedNodeExchangeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.AcceptReservedNodeExchangeInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AcceptReservedNodeExchangeRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) AcceptReservedNodeExchangeRequest(_a0 *redshift.AcceptReservedNodeExchangeInput) (*request.Request, *redshift.AcceptReservedNodeExchangeOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.AcceptReservedNodeExchangeInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.AcceptReservedNodeExchangeOutput
+	if rf, ok := ret.Get(1).(func(*redshift.AcceptReservedNodeExchangeInput) *redshift.AcceptReservedNodeExchangeOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.AcceptReservedNodeExchangeOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// AcceptReservedNodeExchangeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) AcceptReservedNodeExchangeWithContext(_a0 context.Context, _a1 *redshift.AcceptReservedNodeExchangeInput, _a2 ...request.Option) (*redshift.AcceptReservedNodeExchangeOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.AcceptReservedNodeExchangeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.AcceptReservedNodeExchangeInput, ...request.Option) *redshift.AcceptReservedNodeExchangeOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.AcceptReservedNodeExchangeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.AcceptReservedNodeExchangeInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddPartner provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) AddPartner(_a0 *redshift.AddPartnerInput) (*redshift.AddPartnerOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.AddPartnerOutput
+	if rf, ok := ret.Get(0).(func(*redshift.AddPartnerInput) *redshift.AddPartnerOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.AddPartnerOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.AddPartnerInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddPartnerRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) AddPartnerRequest(_a0 *redshift.AddPartnerInput) (*request.Request, *redshift.AddPartnerOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.AddPartnerInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.AddPartnerOutput
+	if rf, ok := ret.Get(1).(func(*redshift.AddPartnerInput) *redshift.AddPartnerOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.AddPartnerOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddPartnerWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) AddPartnerWithContext(_a0 context.Context, _a1 *redshift.AddPartnerInput, _a2 ...request.Option) (*redshift.AddPartnerOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.AddPartnerOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.AddPartnerInput, ...request.Option) *redshift.AddPartnerOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.AddPartnerOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.AddPartnerInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssociateDataShareConsumer provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) AssociateDataShareConsumer(_a0 *redshift.AssociateDataShareConsumerInput) (*redshift.AssociateDataShareConsumerOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.AssociateDataShareConsumerOutput
+	if rf, ok := ret.Get(0).(func(*redshift.AssociateDataShareConsumerInput) *redshift.AssociateDataShareConsumerOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.AssociateDataShareConsumerOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.AssociateDataShareConsumerInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssociateDataShareConsumerRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) AssociateDataShareConsumerRequest(_a0 *redshift.AssociateDataShareConsumerInput) (*request.Request, *redshift.AssociateDataShareConsumerOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.AssociateDataShareConsumerInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.AssociateDataShareConsumerOutput
+	if rf, ok := ret.Get(1).(func(*redshift.AssociateDataShareConsumerInput) *redshift.AssociateDataShareConsumerOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.AssociateDataShareConsumerOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// AssociateDataShareConsumerWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) AssociateDataShareConsumerWithContext(_a0 context.Context, _a1 *redshift.AssociateDataShareConsumerInput, _a2 ...request.Option) (*redshift.AssociateDataShareConsumerOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.AssociateDataShareConsumerOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.AssociateDataShareConsumerInput, ...request.Option) *redshift.AssociateDataShareConsumerOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.AssociateDataShareConsumerOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.AssociateDataShareConsumerInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthorizeClusterSecurityGroupIngress provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) AuthorizeClusterSecurityGroupIngress(_a0 *redshift.AuthorizeClusterSecurityGroupIngressInput) (*redshift.AuthorizeClusterSecurityGroupIngressOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.AuthorizeClusterSecurityGroupIngressOutput
+	if rf, ok := ret.Get(0).(func(*redshift.AuthorizeClusterSecurityGroupIngressInput) *redshift.AuthorizeClusterSecurityGroupIngressOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.AuthorizeClusterSecurityGroupIngressOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.AuthorizeClusterSecurityGroupIngressInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthorizeClusterSecurityGroupIngressRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) AuthorizeClusterSecurityGroupIngressRequest(_a0 *redshift.AuthorizeClusterSecurityGroupIngressInput) (*request.Request, *redshift.AuthorizeClusterSecurityGroupIngressOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.AuthorizeClusterSecurityGroupIngressInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.AuthorizeClusterSecurityGroupIngressOutput
+	if rf, ok := ret.Get(1).(func(*redshift.AuthorizeClusterSecurityGroupIngressInput) *redshift.AuthorizeClusterSecurityGroupIngressOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.AuthorizeClusterSecurityGroupIngressOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// AuthorizeClusterSecurityGroupIngressWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) AuthorizeClusterSecurityGroupIngressWithContext(_a0 context.Context, _a1 *redshift.AuthorizeClusterSecurityGroupIngressInput, _a2 ...request.Option) (*redshift.AuthorizeClusterSecurityGroupIngressOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.AuthorizeClusterSecurityGroupIngressOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.AuthorizeClusterSecurityGroupIngressInput, ...request.Option) *redshift.AuthorizeClusterSecurityGroupIngressOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.AuthorizeClusterSecurityGroupIngressOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.AuthorizeClusterSecurityGroupIngressInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthorizeDataShare provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) AuthorizeDataShare(_a0 *redshift.AuthorizeDataShareInput) (*redshift.AuthorizeDataShareOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.AuthorizeDataShareOutput
+	if rf, ok := ret.Get(0).(func(*redshift.AuthorizeDataShareInput) *redshift.AuthorizeDataShareOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.AuthorizeDataShareOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.AuthorizeDataShareInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthorizeDataShareRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) AuthorizeDataShareRequest(_a0 *redshift.AuthorizeDataShareInput) (*request.Request, *redshift.AuthorizeDataShareOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.AuthorizeDataShareInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.AuthorizeDataShareOutput
+	if rf, ok := ret.Get(1).(func(*redshift.AuthorizeDataShareInput) *redshift.AuthorizeDataShareOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.AuthorizeDataShareOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// AuthorizeDataShareWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) AuthorizeDataShareWithContext(_a0 context.Context, _a1 *redshift.AuthorizeDataShareInput, _a2 ...request.Option) (*redshift.AuthorizeDataShareOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.AuthorizeDataShareOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.AuthorizeDataShareInput, ...request.Option) *redshift.AuthorizeDataShareOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.AuthorizeDataShareOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.AuthorizeDataShareInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthorizeEndpointAccess provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) AuthorizeEndpointAccess(_a0 *redshift.AuthorizeEndpointAccessInput) (*redshift.AuthorizeEndpointAccessOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.AuthorizeEndpointAccessOutput
+	if rf, ok := ret.Get(0).(func(*redshift.AuthorizeEndpointAccessInput) *redshift.AuthorizeEndpointAccessOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.AuthorizeEndpointAccessOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.AuthorizeEndpointAccessInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthorizeEndpointAccessRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) AuthorizeEndpointAccessRequest(_a0 *redshift.AuthorizeEndpointAccessInput) (*request.Request, *redshift.AuthorizeEndpointAccessOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.AuthorizeEndpointAccessInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.AuthorizeEndpointAccessOutput
+	if rf, ok := ret.Get(1).(func(*redshift.AuthorizeEndpointAccessInput) *redshift.AuthorizeEndpointAccessOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.AuthorizeEndpointAccessOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// AuthorizeEndpointAccessWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) AuthorizeEndpointAccessWithContext(_a0 context.Context, _a1 *redshift.AuthorizeEndpointAccessInput, _a2 ...request.Option) (*redshift.AuthorizeEndpointAccessOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.AuthorizeEndpointAccessOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.AuthorizeEndpointAccessInput, ...request.Option) *redshift.AuthorizeEndpointAccessOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.AuthorizeEndpointAccessOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.AuthorizeEndpointAccessInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthorizeSnapshotAccess provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) AuthorizeSnapshotAccess(_a0 *redshift.AuthorizeSnapshotAccessInput) (*redshift.AuthorizeSnapshotAccessOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.AuthorizeSnapshotAccessOutput
+	if rf, ok := ret.Get(0).(func(*redshift.AuthorizeSnapshotAccessInput) *redshift.AuthorizeSnapshotAccessOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.AuthorizeSnapshotAccessOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.AuthorizeSnapshotAccessInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthorizeSnapshotAccessRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) AuthorizeSnapshotAccessRequest(_a0 *redshift.AuthorizeSnapshotAccessInput) (*request.Request, *redshift.AuthorizeSnapshotAccessOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.AuthorizeSnapshotAccessInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.AuthorizeSnapshotAccessOutput
+	if rf, ok := ret.Get(1).(func(*redshift.AuthorizeSnapshotAccessInput) *redshift.AuthorizeSnapshotAccessOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.AuthorizeSnapshotAccessOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// AuthorizeSnapshotAccessWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) AuthorizeSnapshotAccessWithContext(_a0 context.Context, _a1 *redshift.AuthorizeSnapshotAccessInput, _a2 ...request.Option) (*redshift.AuthorizeSnapshotAccessOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.AuthorizeSnapshotAccessOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.AuthorizeSnapshotAccessInput, ...request.Option) *redshift.AuthorizeSnapshotAccessOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.AuthorizeSnapshotAccessOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.AuthorizeSnapshotAccessInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchDeleteClusterSnapshots provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) BatchDeleteClusterSnapshots(_a0 *redshift.BatchDeleteClusterSnapshotsInput) (*redshift.BatchDeleteClusterSnapshotsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.BatchDeleteClusterSnapshotsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.BatchDeleteClusterSnapshotsInput) *redshift.BatchDeleteClusterSnapshotsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.BatchDeleteClusterSnapshotsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.BatchDeleteClusterSnapshotsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchDeleteClusterSnapshotsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) BatchDeleteClusterSnapshotsRequest(_a0 *redshift.BatchDeleteClusterSnapshotsInput) (*request.Request, *redshift.BatchDeleteClusterSnapshotsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.BatchDeleteClusterSnapshotsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.BatchDeleteClusterSnapshotsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.BatchDeleteClusterSnapshotsInput) *redshift.BatchDeleteClusterSnapshotsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.BatchDeleteClusterSnapshotsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// BatchDeleteClusterSnapshotsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) BatchDeleteClusterSnapshotsWithContext(_a0 context.Context, _a1 *redshift.BatchDeleteClusterSnapshotsInput, _a2 ...request.Option) (*redshift.BatchDeleteClusterSnapshotsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.BatchDeleteClusterSnapshotsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.BatchDeleteClusterSnapshotsInput, ...request.Option) *redshift.BatchDeleteClusterSnapshotsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.BatchDeleteClusterSnapshotsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.BatchDeleteClusterSnapshotsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchModifyClusterSnapshots provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) BatchModifyClusterSnapshots(_a0 *redshift.BatchModifyClusterSnapshotsInput) (*redshift.BatchModifyClusterSnapshotsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.BatchModifyClusterSnapshotsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.BatchModifyClusterSnapshotsInput) *redshift.BatchModifyClusterSnapshotsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.BatchModifyClusterSnapshotsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.BatchModifyClusterSnapshotsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchModifyClusterSnapshotsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) BatchModifyClusterSnapshotsRequest(_a0 *redshift.BatchModifyClusterSnapshotsInput) (*request.Request, *redshift.BatchModifyClusterSnapshotsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.BatchModifyClusterSnapshotsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.BatchModifyClusterSnapshotsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.BatchModifyClusterSnapshotsInput) *redshift.BatchModifyClusterSnapshotsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.BatchModifyClusterSnapshotsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// BatchModifyClusterSnapshotsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) BatchModifyClusterSnapshotsWithContext(_a0 context.Context, _a1 *redshift.BatchModifyClusterSnapshotsInput, _a2 ...request.Option) (*redshift.BatchModifyClusterSnapshotsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.BatchModifyClusterSnapshotsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.BatchModifyClusterSnapshotsInput, ...request.Option) *redshift.BatchModifyClusterSnapshotsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.BatchModifyClusterSnapshotsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.BatchModifyClusterSnapshotsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelResize provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CancelResize(_a0 *redshift.CancelResizeInput) (*redshift.CancelResizeOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CancelResizeOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CancelResizeInput) *redshift.CancelResizeOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CancelResizeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CancelResizeInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelResizeRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CancelResizeRequest(_a0 *redshift.CancelResizeInput) (*request.Request, *redshift.CancelResizeOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CancelResizeInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CancelResizeOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CancelResizeInput) *redshift.CancelResizeOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CancelResizeOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CancelResizeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CancelResizeWithContext(_a0 context.Context, _a1 *redshift.CancelResizeInput, _a2 ...request.Option) (*redshift.CancelResizeOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CancelResizeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CancelResizeInput, ...request.Option) *redshift.CancelResizeOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CancelResizeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CancelResizeInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CopyClusterSnapshot provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CopyClusterSnapshot(_a0 *redshift.CopyClusterSnapshotInput) (*redshift.CopyClusterSnapshotOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CopyClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CopyClusterSnapshotInput) *redshift.CopyClusterSnapshotOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CopyClusterSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CopyClusterSnapshotInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CopyClusterSnapshotRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CopyClusterSnapshotRequest(_a0 *redshift.CopyClusterSnapshotInput) (*request.Request, *redshift.CopyClusterSnapshotOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CopyClusterSnapshotInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CopyClusterSnapshotOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CopyClusterSnapshotInput) *redshift.CopyClusterSnapshotOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CopyClusterSnapshotOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CopyClusterSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CopyClusterSnapshotWithContext(_a0 context.Context, _a1 *redshift.CopyClusterSnapshotInput, _a2 ...request.Option) (*redshift.CopyClusterSnapshotOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CopyClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CopyClusterSnapshotInput, ...request.Option) *redshift.CopyClusterSnapshotOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CopyClusterSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CopyClusterSnapshotInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateAuthenticationProfile provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateAuthenticationProfile(_a0 *redshift.CreateAuthenticationProfileInput) (*redshift.CreateAuthenticationProfileOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CreateAuthenticationProfileOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CreateAuthenticationProfileInput) *redshift.CreateAuthenticationProfileOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateAuthenticationProfileOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CreateAuthenticationProfileInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateAuthenticationProfileRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateAuthenticationProfileRequest(_a0 *redshift.CreateAuthenticationProfileInput) (*request.Request, *redshift.CreateAuthenticationProfileOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CreateAuthenticationProfileInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CreateAuthenticationProfileOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CreateAuthenticationProfileInput) *redshift.CreateAuthenticationProfileOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CreateAuthenticationProfileOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateAuthenticationProfileWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CreateAuthenticationProfileWithContext(_a0 context.Context, _a1 *redshift.CreateAuthenticationProfileInput, _a2 ...request.Option) (*redshift.CreateAuthenticationProfileOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CreateAuthenticationProfileOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CreateAuthenticationProfileInput, ...request.Option) *redshift.CreateAuthenticationProfileOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateAuthenticationProfileOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CreateAuthenticationProfileInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCluster provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateCluster(_a0 *redshift.CreateClusterInput) (*redshift.CreateClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CreateClusterOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CreateClusterInput) *redshift.CreateClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CreateClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateClusterParameterGroup provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateClusterParameterGroup(_a0 *redshift.CreateClusterParameterGroupInput) (*redshift.CreateClusterParameterGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CreateClusterParameterGroupOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CreateClusterParameterGroupInput) *redshift.CreateClusterParameterGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateClusterParameterGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CreateClusterParameterGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateClusterParameterGroupRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateClusterParameterGroupRequest(_a0 *redshift.CreateClusterParameterGroupInput) (*request.Request, *redshift.CreateClusterParameterGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CreateClusterParameterGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CreateClusterParameterGroupOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CreateClusterParameterGroupInput) *redshift.CreateClusterParameterGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CreateClusterParameterGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateClusterParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CreateClusterParameterGroupWithContext(_a0 context.Context, _a1 *redshift.CreateClusterParameterGroupInput, _a2 ...request.Option) (*redshift.CreateClusterParameterGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CreateClusterParameterGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CreateClusterParameterGroupInput, ...request.Option) *redshift.CreateClusterParameterGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateClusterParameterGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CreateClusterParameterGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateClusterRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateClusterRequest(_a0 *redshift.CreateClusterInput) (*request.Request, *redshift.CreateClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CreateClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CreateClusterOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CreateClusterInput) *redshift.CreateClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CreateClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateClusterSecurityGroup provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateClusterSecurityGroup(_a0 *redshift.CreateClusterSecurityGroupInput) (*redshift.CreateClusterSecurityGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CreateClusterSecurityGroupOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CreateClusterSecurityGroupInput) *redshift.CreateClusterSecurityGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateClusterSecurityGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CreateClusterSecurityGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateClusterSecurityGroupRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateClusterSecurityGroupRequest(_a0 *redshift.CreateClusterSecurityGroupInput) (*request.Request, *redshift.CreateClusterSecurityGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CreateClusterSecurityGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CreateClusterSecurityGroupOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CreateClusterSecurityGroupInput) *redshift.CreateClusterSecurityGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CreateClusterSecurityGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateClusterSecurityGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CreateClusterSecurityGroupWithContext(_a0 context.Context, _a1 *redshift.CreateClusterSecurityGroupInput, _a2 ...request.Option) (*redshift.CreateClusterSecurityGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CreateClusterSecurityGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CreateClusterSecurityGroupInput, ...request.Option) *redshift.CreateClusterSecurityGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateClusterSecurityGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CreateClusterSecurityGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateClusterSnapshot provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateClusterSnapshot(_a0 *redshift.CreateClusterSnapshotInput) (*redshift.CreateClusterSnapshotOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CreateClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CreateClusterSnapshotInput) *redshift.CreateClusterSnapshotOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateClusterSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CreateClusterSnapshotInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateClusterSnapshotRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateClusterSnapshotRequest(_a0 *redshift.CreateClusterSnapshotInput) (*request.Request, *redshift.CreateClusterSnapshotOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CreateClusterSnapshotInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CreateClusterSnapshotOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CreateClusterSnapshotInput) *redshift.CreateClusterSnapshotOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CreateClusterSnapshotOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateClusterSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CreateClusterSnapshotWithContext(_a0 context.Context, _a1 *redshift.CreateClusterSnapshotInput, _a2 ...request.Option) (*redshift.CreateClusterSnapshotOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CreateClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CreateClusterSnapshotInput, ...request.Option) *redshift.CreateClusterSnapshotOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateClusterSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CreateClusterSnapshotInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateClusterSubnetGroup provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateClusterSubnetGroup(_a0 *redshift.CreateClusterSubnetGroupInput) (*redshift.CreateClusterSubnetGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CreateClusterSubnetGroupOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CreateClusterSubnetGroupInput) *redshift.CreateClusterSubnetGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateClusterSubnetGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CreateClusterSubnetGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateClusterSubnetGroupRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateClusterSubnetGroupRequest(_a0 *redshift.CreateClusterSubnetGroupInput) (*request.Request, *redshift.CreateClusterSubnetGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CreateClusterSubnetGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CreateClusterSubnetGroupOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CreateClusterSubnetGroupInput) *redshift.CreateClusterSubnetGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CreateClusterSubnetGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateClusterSubnetGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CreateClusterSubnetGroupWithContext(_a0 context.Context, _a1 *redshift.CreateClusterSubnetGroupInput, _a2 ...request.Option) (*redshift.CreateClusterSubnetGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CreateClusterSubnetGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CreateClusterSubnetGroupInput, ...request.Option) *redshift.CreateClusterSubnetGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateClusterSubnetGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CreateClusterSubnetGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CreateClusterWithContext(_a0 context.Context, _a1 *redshift.CreateClusterInput, _a2 ...request.Option) (*redshift.CreateClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CreateClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CreateClusterInput, ...request.Option) *redshift.CreateClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CreateClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCustomDomainAssociation provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateCustomDomainAssociation(_a0 *redshift.CreateCustomDomainAssociationInput) (*redshift.CreateCustomDomainAssociationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CreateCustomDomainAssociationOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CreateCustomDomainAssociationInput) *redshift.CreateCustomDomainAssociationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateCustomDomainAssociationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CreateCustomDomainAssociationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCustomDomainAssociationRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateCustomDomainAssociationRequest(_a0 *redshift.CreateCustomDomainAssociationInput) (*request.Request, *redshift.CreateCustomDomainAssociationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CreateCustomDomainAssociationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CreateCustomDomainAssociationOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CreateCustomDomainAssociationInput) *redshift.CreateCustomDomainAssociationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CreateCustomDomainAssociationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateCustomDomainAssociationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CreateCustomDomainAssociationWithContext(_a0 context.Context, _a1 *redshift.CreateCustomDomainAssociationInput, _a2 ...request.Option) (*redshift.CreateCustomDomainAssociationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CreateCustomDomainAssociationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CreateCustomDomainAssociationInput, ...request.Option) *redshift.CreateCustomDomainAssociationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateCustomDomainAssociationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CreateCustomDomainAssociationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateEndpointAccess provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateEndpointAccess(_a0 *redshift.CreateEndpointAccessInput) (*redshift.CreateEndpointAccessOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CreateEndpointAccessOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CreateEndpointAccessInput) *redshift.CreateEndpointAccessOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateEndpointAccessOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CreateEndpointAccessInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateEndpointAccessRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateEndpointAccessRequest(_a0 *redshift.CreateEndpointAccessInput) (*request.Request, *redshift.CreateEndpointAccessOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CreateEndpointAccessInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CreateEndpointAccessOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CreateEndpointAccessInput) *redshift.CreateEndpointAccessOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CreateEndpointAccessOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateEndpointAccessWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CreateEndpointAccessWithContext(_a0 context.Context, _a1 *redshift.CreateEndpointAccessInput, _a2 ...request.Option) (*redshift.CreateEndpointAccessOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CreateEndpointAccessOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CreateEndpointAccessInput, ...request.Option) *redshift.CreateEndpointAccessOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateEndpointAccessOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CreateEndpointAccessInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateEventSubscription provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateEventSubscription(_a0 *redshift.CreateEventSubscriptionInput) (*redshift.CreateEventSubscriptionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CreateEventSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CreateEventSubscriptionInput) *redshift.CreateEventSubscriptionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateEventSubscriptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CreateEventSubscriptionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateEventSubscriptionRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateEventSubscriptionRequest(_a0 *redshift.CreateEventSubscriptionInput) (*request.Request, *redshift.CreateEventSubscriptionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CreateEventSubscriptionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CreateEventSubscriptionOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CreateEventSubscriptionInput) *redshift.CreateEventSubscriptionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CreateEventSubscriptionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateEventSubscriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CreateEventSubscriptionWithContext(_a0 context.Context, _a1 *redshift.CreateEventSubscriptionInput, _a2 ...request.Option) (*redshift.CreateEventSubscriptionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CreateEventSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CreateEventSubscriptionInput, ...request.Option) *redshift.CreateEventSubscriptionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateEventSubscriptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CreateEventSubscriptionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateHsmClientCertificate provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateHsmClientCertificate(_a0 *redshift.CreateHsmClientCertificateInput) (*redshift.CreateHsmClientCertificateOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CreateHsmClientCertificateOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CreateHsmClientCertificateInput) *redshift.CreateHsmClientCertificateOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateHsmClientCertificateOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CreateHsmClientCertificateInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateHsmClientCertificateRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateHsmClientCertificateRequest(_a0 *redshift.CreateHsmClientCertificateInput) (*request.Request, *redshift.CreateHsmClientCertificateOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CreateHsmClientCertificateInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CreateHsmClientCertificateOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CreateHsmClientCertificateInput) *redshift.CreateHsmClientCertificateOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CreateHsmClientCertificateOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateHsmClientCertificateWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CreateHsmClientCertificateWithContext(_a0 context.Context, _a1 *redshift.CreateHsmClientCertificateInput, _a2 ...request.Option) (*redshift.CreateHsmClientCertificateOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CreateHsmClientCertificateOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CreateHsmClientCertificateInput, ...request.Option) *redshift.CreateHsmClientCertificateOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateHsmClientCertificateOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CreateHsmClientCertificateInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateHsmConfiguration provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateHsmConfiguration(_a0 *redshift.CreateHsmConfigurationInput) (*redshift.CreateHsmConfigurationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CreateHsmConfigurationOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CreateHsmConfigurationInput) *redshift.CreateHsmConfigurationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateHsmConfigurationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CreateHsmConfigurationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateHsmConfigurationRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateHsmConfigurationRequest(_a0 *redshift.CreateHsmConfigurationInput) (*request.Request, *redshift.CreateHsmConfigurationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CreateHsmConfigurationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CreateHsmConfigurationOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CreateHsmConfigurationInput) *redshift.CreateHsmConfigurationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CreateHsmConfigurationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateHsmConfigurationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CreateHsmConfigurationWithContext(_a0 context.Context, _a1 *redshift.CreateHsmConfigurationInput, _a2 ...request.Option) (*redshift.CreateHsmConfigurationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CreateHsmConfigurationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CreateHsmConfigurationInput, ...request.Option) *redshift.CreateHsmConfigurationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateHsmConfigurationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CreateHsmConfigurationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRedshiftIdcApplication provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateRedshiftIdcApplication(_a0 *redshift.CreateRedshiftIdcApplicationInput) (*redshift.CreateRedshiftIdcApplicationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CreateRedshiftIdcApplicationOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CreateRedshiftIdcApplicationInput) *redshift.CreateRedshiftIdcApplicationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateRedshiftIdcApplicationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CreateRedshiftIdcApplicationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRedshiftIdcApplicationRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateRedshiftIdcApplicationRequest(_a0 *redshift.CreateRedshiftIdcApplicationInput) (*request.Request, *redshift.CreateRedshiftIdcApplicationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CreateRedshiftIdcApplicationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CreateRedshiftIdcApplicationOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CreateRedshiftIdcApplicationInput) *redshift.CreateRedshiftIdcApplicationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CreateRedshiftIdcApplicationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateRedshiftIdcApplicationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CreateRedshiftIdcApplicationWithContext(_a0 context.Context, _a1 *redshift.CreateRedshiftIdcApplicationInput, _a2 ...request.Option) (*redshift.CreateRedshiftIdcApplicationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CreateRedshiftIdcApplicationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CreateRedshiftIdcApplicationInput, ...request.Option) *redshift.CreateRedshiftIdcApplicationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateRedshiftIdcApplicationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CreateRedshiftIdcApplicationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateScheduledAction provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateScheduledAction(_a0 *redshift.CreateScheduledActionInput) (*redshift.CreateScheduledActionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CreateScheduledActionOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CreateScheduledActionInput) *redshift.CreateScheduledActionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateScheduledActionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CreateScheduledActionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateScheduledActionRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateScheduledActionRequest(_a0 *redshift.CreateScheduledActionInput) (*request.Request, *redshift.CreateScheduledActionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CreateScheduledActionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CreateScheduledActionOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CreateScheduledActionInput) *redshift.CreateScheduledActionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CreateScheduledActionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateScheduledActionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CreateScheduledActionWithContext(_a0 context.Context, _a1 *redshift.CreateScheduledActionInput, _a2 ...request.Option) (*redshift.CreateScheduledActionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CreateScheduledActionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CreateScheduledActionInput, ...request.Option) *redshift.CreateScheduledActionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateScheduledActionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CreateScheduledActionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateSnapshotCopyGrant provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateSnapshotCopyGrant(_a0 *redshift.CreateSnapshotCopyGrantInput) (*redshift.CreateSnapshotCopyGrantOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CreateSnapshotCopyGrantOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CreateSnapshotCopyGrantInput) *redshift.CreateSnapshotCopyGrantOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateSnapshotCopyGrantOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CreateSnapshotCopyGrantInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateSnapshotCopyGrantRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateSnapshotCopyGrantRequest(_a0 *redshift.CreateSnapshotCopyGrantInput) (*request.Request, *redshift.CreateSnapshotCopyGrantOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CreateSnapshotCopyGrantInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CreateSnapshotCopyGrantOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CreateSnapshotCopyGrantInput) *redshift.CreateSnapshotCopyGrantOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CreateSnapshotCopyGrantOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateSnapshotCopyGrantWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CreateSnapshotCopyGrantWithContext(_a0 context.Context, _a1 *redshift.CreateSnapshotCopyGrantInput, _a2 ...request.Option) (*redshift.CreateSnapshotCopyGrantOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CreateSnapshotCopyGrantOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CreateSnapshotCopyGrantInput, ...request.Option) *redshift.CreateSnapshotCopyGrantOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateSnapshotCopyGrantOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CreateSnapshotCopyGrantInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateSnapshotSchedule provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateSnapshotSchedule(_a0 *redshift.CreateSnapshotScheduleInput) (*redshift.CreateSnapshotScheduleOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CreateSnapshotScheduleOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CreateSnapshotScheduleInput) *redshift.CreateSnapshotScheduleOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateSnapshotScheduleOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CreateSnapshotScheduleInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateSnapshotScheduleRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateSnapshotScheduleRequest(_a0 *redshift.CreateSnapshotScheduleInput) (*request.Request, *redshift.CreateSnapshotScheduleOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CreateSnapshotScheduleInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CreateSnapshotScheduleOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CreateSnapshotScheduleInput) *redshift.CreateSnapshotScheduleOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CreateSnapshotScheduleOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateSnapshotScheduleWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CreateSnapshotScheduleWithContext(_a0 context.Context, _a1 *redshift.CreateSnapshotScheduleInput, _a2 ...request.Option) (*redshift.CreateSnapshotScheduleOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CreateSnapshotScheduleOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CreateSnapshotScheduleInput, ...request.Option) *redshift.CreateSnapshotScheduleOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateSnapshotScheduleOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CreateSnapshotScheduleInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateTags provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateTags(_a0 *redshift.CreateTagsInput) (*redshift.CreateTagsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CreateTagsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CreateTagsInput) *redshift.CreateTagsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateTagsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CreateTagsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateTagsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateTagsRequest(_a0 *redshift.CreateTagsInput) (*request.Request, *redshift.CreateTagsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CreateTagsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CreateTagsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CreateTagsInput) *redshift.CreateTagsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CreateTagsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateTagsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CreateTagsWithContext(_a0 context.Context, _a1 *redshift.CreateTagsInput, _a2 ...request.Option) (*redshift.CreateTagsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CreateTagsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CreateTagsInput, ...request.Option) *redshift.CreateTagsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateTagsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CreateTagsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateUsageLimit provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateUsageLimit(_a0 *redshift.CreateUsageLimitInput) (*redshift.CreateUsageLimitOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.CreateUsageLimitOutput
+	if rf, ok := ret.Get(0).(func(*redshift.CreateUsageLimitInput) *redshift.CreateUsageLimitOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateUsageLimitOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.CreateUsageLimitInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateUsageLimitRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) CreateUsageLimitRequest(_a0 *redshift.CreateUsageLimitInput) (*request.Request, *redshift.CreateUsageLimitOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.CreateUsageLimitInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.CreateUsageLimitOutput
+	if rf, ok := ret.Get(1).(func(*redshift.CreateUsageLimitInput) *redshift.CreateUsageLimitOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.CreateUsageLimitOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateUsageLimitWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) CreateUsageLimitWithContext(_a0 context.Context, _a1 *redshift.CreateUsageLimitInput, _a2 ...request.Option) (*redshift.CreateUsageLimitOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.CreateUsageLimitOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.CreateUsageLimitInput, ...request.Option) *redshift.CreateUsageLimitOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.CreateUsageLimitOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.CreateUsageLimitInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeauthorizeDataShare provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeauthorizeDataShare(_a0 *redshift.DeauthorizeDataShareInput) (*redshift.DeauthorizeDataShareOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeauthorizeDataShareOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeauthorizeDataShareInput) *redshift.DeauthorizeDataShareOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeauthorizeDataShareOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeauthorizeDataShareInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeauthorizeDataShareRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeauthorizeDataShareRequest(_a0 *redshift.DeauthorizeDataShareInput) (*request.Request, *redshift.DeauthorizeDataShareOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeauthorizeDataShareInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeauthorizeDataShareOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeauthorizeDataShareInput) *redshift.DeauthorizeDataShareOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeauthorizeDataShareOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeauthorizeDataShareWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeauthorizeDataShareWithContext(_a0 context.Context, _a1 *redshift.DeauthorizeDataShareInput, _a2 ...request.Option) (*redshift.DeauthorizeDataShareOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeauthorizeDataShareOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeauthorizeDataShareInput, ...request.Option) *redshift.DeauthorizeDataShareOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeauthorizeDataShareOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeauthorizeDataShareInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAuthenticationProfile provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteAuthenticationProfile(_a0 *redshift.DeleteAuthenticationProfileInput) (*redshift.DeleteAuthenticationProfileOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeleteAuthenticationProfileOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteAuthenticationProfileInput) *redshift.DeleteAuthenticationProfileOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteAuthenticationProfileOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteAuthenticationProfileInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAuthenticationProfileRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteAuthenticationProfileRequest(_a0 *redshift.DeleteAuthenticationProfileInput) (*request.Request, *redshift.DeleteAuthenticationProfileOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteAuthenticationProfileInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeleteAuthenticationProfileOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteAuthenticationProfileInput) *redshift.DeleteAuthenticationProfileOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeleteAuthenticationProfileOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteAuthenticationProfileWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeleteAuthenticationProfileWithContext(_a0 context.Context, _a1 *redshift.DeleteAuthenticationProfileInput, _a2 ...request.Option) (*redshift.DeleteAuthenticationProfileOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeleteAuthenticationProfileOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeleteAuthenticationProfileInput, ...request.Option) *redshift.DeleteAuthenticationProfileOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteAuthenticationProfileOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeleteAuthenticationProfileInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCluster provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteCluster(_a0 *redshift.DeleteClusterInput) (*redshift.DeleteClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeleteClusterOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteClusterInput) *redshift.DeleteClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteClusterParameterGroup provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteClusterParameterGroup(_a0 *redshift.DeleteClusterParameterGroupInput) (*redshift.DeleteClusterParameterGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeleteClusterParameterGroupOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteClusterParameterGroupInput) *redshift.DeleteClusterParameterGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteClusterParameterGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteClusterParameterGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteClusterParameterGroupRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteClusterParameterGroupRequest(_a0 *redshift.DeleteClusterParameterGroupInput) (*request.Request, *redshift.DeleteClusterParameterGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteClusterParameterGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeleteClusterParameterGroupOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteClusterParameterGroupInput) *redshift.DeleteClusterParameterGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeleteClusterParameterGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteClusterParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeleteClusterParameterGroupWithContext(_a0 context.Context, _a1 *redshift.DeleteClusterParameterGroupInput, _a2 ...request.Option) (*redshift.DeleteClusterParameterGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeleteClusterParameterGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeleteClusterParameterGroupInput, ...request.Option) *redshift.DeleteClusterParameterGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteClusterParameterGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeleteClusterParameterGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteClusterRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteClusterRequest(_a0 *redshift.DeleteClusterInput) (*request.Request, *redshift.DeleteClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeleteClusterOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteClusterInput) *redshift.DeleteClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeleteClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteClusterSecurityGroup provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteClusterSecurityGroup(_a0 *redshift.DeleteClusterSecurityGroupInput) (*redshift.DeleteClusterSecurityGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeleteClusterSecurityGroupOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteClusterSecurityGroupInput) *redshift.DeleteClusterSecurityGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteClusterSecurityGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteClusterSecurityGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteClusterSecurityGroupRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteClusterSecurityGroupRequest(_a0 *redshift.DeleteClusterSecurityGroupInput) (*request.Request, *redshift.DeleteClusterSecurityGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteClusterSecurityGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeleteClusterSecurityGroupOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteClusterSecurityGroupInput) *redshift.DeleteClusterSecurityGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeleteClusterSecurityGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteClusterSecurityGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeleteClusterSecurityGroupWithContext(_a0 context.Context, _a1 *redshift.DeleteClusterSecurityGroupInput, _a2 ...request.Option) (*redshift.DeleteClusterSecurityGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeleteClusterSecurityGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeleteClusterSecurityGroupInput, ...request.Option) *redshift.DeleteClusterSecurityGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteClusterSecurityGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeleteClusterSecurityGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteClusterSnapshot provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteClusterSnapshot(_a0 *redshift.DeleteClusterSnapshotInput) (*redshift.DeleteClusterSnapshotOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeleteClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteClusterSnapshotInput) *redshift.DeleteClusterSnapshotOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteClusterSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteClusterSnapshotInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteClusterSnapshotRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteClusterSnapshotRequest(_a0 *redshift.DeleteClusterSnapshotInput) (*request.Request, *redshift.DeleteClusterSnapshotOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteClusterSnapshotInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeleteClusterSnapshotOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteClusterSnapshotInput) *redshift.DeleteClusterSnapshotOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeleteClusterSnapshotOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteClusterSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeleteClusterSnapshotWithContext(_a0 context.Context, _a1 *redshift.DeleteClusterSnapshotInput, _a2 ...request.Option) (*redshift.DeleteClusterSnapshotOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeleteClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeleteClusterSnapshotInput, ...request.Option) *redshift.DeleteClusterSnapshotOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteClusterSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeleteClusterSnapshotInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteClusterSubnetGroup provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteClusterSubnetGroup(_a0 *redshift.DeleteClusterSubnetGroupInput) (*redshift.DeleteClusterSubnetGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeleteClusterSubnetGroupOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteClusterSubnetGroupInput) *redshift.DeleteClusterSubnetGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteClusterSubnetGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteClusterSubnetGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteClusterSubnetGroupRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteClusterSubnetGroupRequest(_a0 *redshift.DeleteClusterSubnetGroupInput) (*request.Request, *redshift.DeleteClusterSubnetGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteClusterSubnetGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeleteClusterSubnetGroupOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteClusterSubnetGroupInput) *redshift.DeleteClusterSubnetGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeleteClusterSubnetGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteClusterSubnetGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeleteClusterSubnetGroupWithContext(_a0 context.Context, _a1 *redshift.DeleteClusterSubnetGroupInput, _a2 ...request.Option) (*redshift.DeleteClusterSubnetGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeleteClusterSubnetGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeleteClusterSubnetGroupInput, ...request.Option) *redshift.DeleteClusterSubnetGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteClusterSubnetGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeleteClusterSubnetGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeleteClusterWithContext(_a0 context.Context, _a1 *redshift.DeleteClusterInput, _a2 ...request.Option) (*redshift.DeleteClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeleteClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeleteClusterInput, ...request.Option) *redshift.DeleteClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeleteClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCustomDomainAssociation provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteCustomDomainAssociation(_a0 *redshift.DeleteCustomDomainAssociationInput) (*redshift.DeleteCustomDomainAssociationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeleteCustomDomainAssociationOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteCustomDomainAssociationInput) *redshift.DeleteCustomDomainAssociationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteCustomDomainAssociationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteCustomDomainAssociationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCustomDomainAssociationRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteCustomDomainAssociationRequest(_a0 *redshift.DeleteCustomDomainAssociationInput) (*request.Request, *redshift.DeleteCustomDomainAssociationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteCustomDomainAssociationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeleteCustomDomainAssociationOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteCustomDomainAssociationInput) *redshift.DeleteCustomDomainAssociationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeleteCustomDomainAssociationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteCustomDomainAssociationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeleteCustomDomainAssociationWithContext(_a0 context.Context, _a1 *redshift.DeleteCustomDomainAssociationInput, _a2 ...request.Option) (*redshift.DeleteCustomDomainAssociationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeleteCustomDomainAssociationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeleteCustomDomainAssociationInput, ...request.Option) *redshift.DeleteCustomDomainAssociationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteCustomDomainAssociationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeleteCustomDomainAssociationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteEndpointAccess provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteEndpointAccess(_a0 *redshift.DeleteEndpointAccessInput) (*redshift.DeleteEndpointAccessOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeleteEndpointAccessOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteEndpointAccessInput) *redshift.DeleteEndpointAccessOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteEndpointAccessOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteEndpointAccessInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteEndpointAccessRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteEndpointAccessRequest(_a0 *redshift.DeleteEndpointAccessInput) (*request.Request, *redshift.DeleteEndpointAccessOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteEndpointAccessInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeleteEndpointAccessOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteEndpointAccessInput) *redshift.DeleteEndpointAccessOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeleteEndpointAccessOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteEndpointAccessWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeleteEndpointAccessWithContext(_a0 context.Context, _a1 *redshift.DeleteEndpointAccessInput, _a2 ...request.Option) (*redshift.DeleteEndpointAccessOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeleteEndpointAccessOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeleteEndpointAccessInput, ...request.Option) *redshift.DeleteEndpointAccessOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteEndpointAccessOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeleteEndpointAccessInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteEventSubscription provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteEventSubscription(_a0 *redshift.DeleteEventSubscriptionInput) (*redshift.DeleteEventSubscriptionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeleteEventSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteEventSubscriptionInput) *redshift.DeleteEventSubscriptionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteEventSubscriptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteEventSubscriptionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteEventSubscriptionRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteEventSubscriptionRequest(_a0 *redshift.DeleteEventSubscriptionInput) (*request.Request, *redshift.DeleteEventSubscriptionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteEventSubscriptionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeleteEventSubscriptionOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteEventSubscriptionInput) *redshift.DeleteEventSubscriptionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeleteEventSubscriptionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteEventSubscriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeleteEventSubscriptionWithContext(_a0 context.Context, _a1 *redshift.DeleteEventSubscriptionInput, _a2 ...request.Option) (*redshift.DeleteEventSubscriptionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeleteEventSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeleteEventSubscriptionInput, ...request.Option) *redshift.DeleteEventSubscriptionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteEventSubscriptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeleteEventSubscriptionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteHsmClientCertificate provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteHsmClientCertificate(_a0 *redshift.DeleteHsmClientCertificateInput) (*redshift.DeleteHsmClientCertificateOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeleteHsmClientCertificateOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteHsmClientCertificateInput) *redshift.DeleteHsmClientCertificateOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteHsmClientCertificateOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteHsmClientCertificateInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteHsmClientCertificateRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteHsmClientCertificateRequest(_a0 *redshift.DeleteHsmClientCertificateInput) (*request.Request, *redshift.DeleteHsmClientCertificateOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteHsmClientCertificateInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeleteHsmClientCertificateOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteHsmClientCertificateInput) *redshift.DeleteHsmClientCertificateOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeleteHsmClientCertificateOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteHsmClientCertificateWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeleteHsmClientCertificateWithContext(_a0 context.Context, _a1 *redshift.DeleteHsmClientCertificateInput, _a2 ...request.Option) (*redshift.DeleteHsmClientCertificateOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeleteHsmClientCertificateOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeleteHsmClientCertificateInput, ...request.Option) *redshift.DeleteHsmClientCertificateOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteHsmClientCertificateOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeleteHsmClientCertificateInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteHsmConfiguration provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteHsmConfiguration(_a0 *redshift.DeleteHsmConfigurationInput) (*redshift.DeleteHsmConfigurationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeleteHsmConfigurationOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteHsmConfigurationInput) *redshift.DeleteHsmConfigurationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteHsmConfigurationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteHsmConfigurationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteHsmConfigurationRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteHsmConfigurationRequest(_a0 *redshift.DeleteHsmConfigurationInput) (*request.Request, *redshift.DeleteHsmConfigurationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteHsmConfigurationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeleteHsmConfigurationOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteHsmConfigurationInput) *redshift.DeleteHsmConfigurationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeleteHsmConfigurationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteHsmConfigurationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeleteHsmConfigurationWithContext(_a0 context.Context, _a1 *redshift.DeleteHsmConfigurationInput, _a2 ...request.Option) (*redshift.DeleteHsmConfigurationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeleteHsmConfigurationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeleteHsmConfigurationInput, ...request.Option) *redshift.DeleteHsmConfigurationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteHsmConfigurationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeleteHsmConfigurationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeletePartner provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeletePartner(_a0 *redshift.DeletePartnerInput) (*redshift.DeletePartnerOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeletePartnerOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeletePartnerInput) *redshift.DeletePartnerOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeletePartnerOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeletePartnerInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeletePartnerRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeletePartnerRequest(_a0 *redshift.DeletePartnerInput) (*request.Request, *redshift.DeletePartnerOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeletePartnerInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeletePartnerOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeletePartnerInput) *redshift.DeletePartnerOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeletePartnerOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeletePartnerWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeletePartnerWithContext(_a0 context.Context, _a1 *redshift.DeletePartnerInput, _a2 ...request.Option) (*redshift.DeletePartnerOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeletePartnerOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeletePartnerInput, ...request.Option) *redshift.DeletePartnerOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeletePartnerOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeletePartnerInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRedshiftIdcApplication provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteRedshiftIdcApplication(_a0 *redshift.DeleteRedshiftIdcApplicationInput) (*redshift.DeleteRedshiftIdcApplicationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeleteRedshiftIdcApplicationOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteRedshiftIdcApplicationInput) *redshift.DeleteRedshiftIdcApplicationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteRedshiftIdcApplicationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteRedshiftIdcApplicationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRedshiftIdcApplicationRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteRedshiftIdcApplicationRequest(_a0 *redshift.DeleteRedshiftIdcApplicationInput) (*request.Request, *redshift.DeleteRedshiftIdcApplicationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteRedshiftIdcApplicationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeleteRedshiftIdcApplicationOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteRedshiftIdcApplicationInput) *redshift.DeleteRedshiftIdcApplicationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeleteRedshiftIdcApplicationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteRedshiftIdcApplicationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeleteRedshiftIdcApplicationWithContext(_a0 context.Context, _a1 *redshift.DeleteRedshiftIdcApplicationInput, _a2 ...request.Option) (*redshift.DeleteRedshiftIdcApplicationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeleteRedshiftIdcApplicationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeleteRedshiftIdcApplicationInput, ...request.Option) *redshift.DeleteRedshiftIdcApplicationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteRedshiftIdcApplicationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeleteRedshiftIdcApplicationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteResourcePolicy provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteResourcePolicy(_a0 *redshift.DeleteResourcePolicyInput) (*redshift.DeleteResourcePolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeleteResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteResourcePolicyInput) *redshift.DeleteResourcePolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteResourcePolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteResourcePolicyRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteResourcePolicyRequest(_a0 *redshift.DeleteResourcePolicyInput) (*request.Request, *redshift.DeleteResourcePolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteResourcePolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeleteResourcePolicyOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteResourcePolicyInput) *redshift.DeleteResourcePolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeleteResourcePolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteResourcePolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeleteResourcePolicyWithContext(_a0 context.Context, _a1 *redshift.DeleteResourcePolicyInput, _a2 ...request.Option) (*redshift.DeleteResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeleteResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeleteResourcePolicyInput, ...request.Option) *redshift.DeleteResourcePolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeleteResourcePolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteScheduledAction provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteScheduledAction(_a0 *redshift.DeleteScheduledActionInput) (*redshift.DeleteScheduledActionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeleteScheduledActionOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteScheduledActionInput) *redshift.DeleteScheduledActionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteScheduledActionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteScheduledActionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteScheduledActionRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteScheduledActionRequest(_a0 *redshift.DeleteScheduledActionInput) (*request.Request, *redshift.DeleteScheduledActionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteScheduledActionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeleteScheduledActionOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteScheduledActionInput) *redshift.DeleteScheduledActionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeleteScheduledActionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteScheduledActionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeleteScheduledActionWithContext(_a0 context.Context, _a1 *redshift.DeleteScheduledActionInput, _a2 ...request.Option) (*redshift.DeleteScheduledActionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeleteScheduledActionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeleteScheduledActionInput, ...request.Option) *redshift.DeleteScheduledActionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteScheduledActionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeleteScheduledActionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteSnapshotCopyGrant provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteSnapshotCopyGrant(_a0 *redshift.DeleteSnapshotCopyGrantInput) (*redshift.DeleteSnapshotCopyGrantOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeleteSnapshotCopyGrantOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteSnapshotCopyGrantInput) *redshift.DeleteSnapshotCopyGrantOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteSnapshotCopyGrantOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteSnapshotCopyGrantInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteSnapshotCopyGrantRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteSnapshotCopyGrantRequest(_a0 *redshift.DeleteSnapshotCopyGrantInput) (*request.Request, *redshift.DeleteSnapshotCopyGrantOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteSnapshotCopyGrantInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeleteSnapshotCopyGrantOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteSnapshotCopyGrantInput) *redshift.DeleteSnapshotCopyGrantOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeleteSnapshotCopyGrantOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteSnapshotCopyGrantWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeleteSnapshotCopyGrantWithContext(_a0 context.Context, _a1 *redshift.DeleteSnapshotCopyGrantInput, _a2 ...request.Option) (*redshift.DeleteSnapshotCopyGrantOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeleteSnapshotCopyGrantOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeleteSnapshotCopyGrantInput, ...request.Option) *redshift.DeleteSnapshotCopyGrantOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteSnapshotCopyGrantOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeleteSnapshotCopyGrantInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteSnapshotSchedule provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteSnapshotSchedule(_a0 *redshift.DeleteSnapshotScheduleInput) (*redshift.DeleteSnapshotScheduleOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeleteSnapshotScheduleOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteSnapshotScheduleInput) *redshift.DeleteSnapshotScheduleOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteSnapshotScheduleOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteSnapshotScheduleInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteSnapshotScheduleRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteSnapshotScheduleRequest(_a0 *redshift.DeleteSnapshotScheduleInput) (*request.Request, *redshift.DeleteSnapshotScheduleOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteSnapshotScheduleInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeleteSnapshotScheduleOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteSnapshotScheduleInput) *redshift.DeleteSnapshotScheduleOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeleteSnapshotScheduleOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteSnapshotScheduleWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeleteSnapshotScheduleWithContext(_a0 context.Context, _a1 *redshift.DeleteSnapshotScheduleInput, _a2 ...request.Option) (*redshift.DeleteSnapshotScheduleOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeleteSnapshotScheduleOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeleteSnapshotScheduleInput, ...request.Option) *redshift.DeleteSnapshotScheduleOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteSnapshotScheduleOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeleteSnapshotScheduleInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTags provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteTags(_a0 *redshift.DeleteTagsInput) (*redshift.DeleteTagsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeleteTagsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteTagsInput) *redshift.DeleteTagsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteTagsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteTagsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTagsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteTagsRequest(_a0 *redshift.DeleteTagsInput) (*request.Request, *redshift.DeleteTagsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteTagsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeleteTagsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteTagsInput) *redshift.DeleteTagsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeleteTagsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteTagsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeleteTagsWithContext(_a0 context.Context, _a1 *redshift.DeleteTagsInput, _a2 ...request.Option) (*redshift.DeleteTagsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeleteTagsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeleteTagsInput, ...request.Option) *redshift.DeleteTagsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteTagsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeleteTagsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteUsageLimit provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteUsageLimit(_a0 *redshift.DeleteUsageLimitInput) (*redshift.DeleteUsageLimitOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DeleteUsageLimitOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteUsageLimitInput) *redshift.DeleteUsageLimitOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteUsageLimitOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteUsageLimitInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteUsageLimitRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DeleteUsageLimitRequest(_a0 *redshift.DeleteUsageLimitInput) (*request.Request, *redshift.DeleteUsageLimitOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DeleteUsageLimitInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DeleteUsageLimitOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DeleteUsageLimitInput) *redshift.DeleteUsageLimitOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DeleteUsageLimitOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteUsageLimitWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DeleteUsageLimitWithContext(_a0 context.Context, _a1 *redshift.DeleteUsageLimitInput, _a2 ...request.Option) (*redshift.DeleteUsageLimitOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DeleteUsageLimitOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DeleteUsageLimitInput, ...request.Option) *redshift.DeleteUsageLimitOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DeleteUsageLimitOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DeleteUsageLimitInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeAccountAttributes provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeAccountAttributes(_a0 *redshift.DescribeAccountAttributesInput) (*redshift.DescribeAccountAttributesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeAccountAttributesOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeAccountAttributesInput) *redshift.DescribeAccountAttributesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeAccountAttributesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeAccountAttributesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeAccountAttributesRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeAccountAttributesRequest(_a0 *redshift.DescribeAccountAttributesInput) (*request.Request, *redshift.DescribeAccountAttributesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeAccountAttributesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeAccountAttributesOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeAccountAttributesInput) *redshift.DescribeAccountAttributesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeAccountAttributesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeAccountAttributesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeAccountAttributesWithContext(_a0 context.Context, _a1 *redshift.DescribeAccountAttributesInput, _a2 ...request.Option) (*redshift.DescribeAccountAttributesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeAccountAttributesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeAccountAttributesInput, ...request.Option) *redshift.DescribeAccountAttributesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeAccountAttributesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeAccountAttributesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeAuthenticationProfiles provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeAuthenticationProfiles(_a0 *redshift.DescribeAuthenticationProfilesInput) (*redshift.DescribeAuthenticationProfilesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeAuthenticationProfilesOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeAuthenticationProfilesInput) *redshift.DescribeAuthenticationProfilesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeAuthenticationProfilesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeAuthenticationProfilesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeAuthenticationProfilesRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeAuthenticationProfilesRequest(_a0 *redshift.DescribeAuthenticationProfilesInput) (*request.Request, *redshift.DescribeAuthenticationProfilesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeAuthenticationProfilesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeAuthenticationProfilesOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeAuthenticationProfilesInput) *redshift.DescribeAuthenticationProfilesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeAuthenticationProfilesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeAuthenticationProfilesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeAuthenticationProfilesWithContext(_a0 context.Context, _a1 *redshift.DescribeAuthenticationProfilesInput, _a2 ...request.Option) (*redshift.DescribeAuthenticationProfilesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeAuthenticationProfilesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeAuthenticationProfilesInput, ...request.Option) *redshift.DescribeAuthenticationProfilesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeAuthenticationProfilesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeAuthenticationProfilesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterDbRevisions provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeClusterDbRevisions(_a0 *redshift.DescribeClusterDbRevisionsInput) (*redshift.DescribeClusterDbRevisionsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeClusterDbRevisionsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterDbRevisionsInput) *redshift.DescribeClusterDbRevisionsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeClusterDbRevisionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeClusterDbRevisionsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterDbRevisionsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeClusterDbRevisionsPages(_a0 *redshift.DescribeClusterDbRevisionsInput, _a1 func(*redshift.DescribeClusterDbRevisionsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterDbRevisionsInput, func(*redshift.DescribeClusterDbRevisionsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeClusterDbRevisionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeClusterDbRevisionsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeClusterDbRevisionsInput, _a2 func(*redshift.DescribeClusterDbRevisionsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClusterDbRevisionsInput, func(*redshift.DescribeClusterDbRevisionsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeClusterDbRevisionsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeClusterDbRevisionsRequest(_a0 *redshift.DescribeClusterDbRevisionsInput) (*request.Request, *redshift.DescribeClusterDbRevisionsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterDbRevisionsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeClusterDbRevisionsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeClusterDbRevisionsInput) *redshift.DescribeClusterDbRevisionsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeClusterDbRevisionsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterDbRevisionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeClusterDbRevisionsWithContext(_a0 context.Context, _a1 *redshift.DescribeClusterDbRevisionsInput, _a2 ...request.Option) (*redshift.DescribeClusterDbRevisionsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeClusterDbRevisionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClusterDbRevisionsInput, ...request.Option) *redshift.DescribeClusterDbRevisionsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeClusterDbRevisionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeClusterDbRevisionsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterParameterGroups provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeClusterParameterGroups(_a0 *redshift.DescribeClusterParameterGroupsInput) (*redshift.DescribeClusterParameterGroupsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeClusterParameterGroupsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterParameterGroupsInput) *redshift.DescribeClusterParameterGroupsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeClusterParameterGroupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeClusterParameterGroupsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterParameterGroupsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeClusterParameterGroupsPages(_a0 *redshift.DescribeClusterParameterGroupsInput, _a1 func(*redshift.DescribeClusterParameterGroupsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterParameterGroupsInput, func(*redshift.DescribeClusterParameterGroupsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeClusterParameterGroupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeClusterParameterGroupsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeClusterParameterGroupsInput, _a2 func(*redshift.DescribeClusterParameterGroupsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClusterParameterGroupsInput, func(*redshift.DescribeClusterParameterGroupsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeClusterParameterGroupsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeClusterParameterGroupsRequest(_a0 *redshift.DescribeClusterParameterGroupsInput) (*request.Request, *redshift.DescribeClusterParameterGroupsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterParameterGroupsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeClusterParameterGroupsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeClusterParameterGroupsInput) *redshift.DescribeClusterParameterGroupsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeClusterParameterGroupsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterParameterGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeClusterParameterGroupsWithContext(_a0 context.Context, _a1 *redshift.DescribeClusterParameterGroupsInput, _a2 ...request.Option) (*redshift.DescribeClusterParameterGroupsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeClusterParameterGroupsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClusterParameterGroupsInput, ...request.Option) *redshift.DescribeClusterParameterGroupsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeClusterParameterGroupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeClusterParameterGroupsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterParameters provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeClusterParameters(_a0 *redshift.DescribeClusterParametersInput) (*redshift.DescribeClusterParametersOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeClusterParametersOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterParametersInput) *redshift.DescribeClusterParametersOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeClusterParametersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeClusterParametersInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterParametersPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeClusterParametersPages(_a0 *redshift.DescribeClusterParametersInput, _a1 func(*redshift.DescribeClusterParametersOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterParametersInput, func(*redshift.DescribeClusterParametersOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeClusterParametersPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeClusterParametersPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeClusterParametersInput, _a2 func(*redshift.DescribeClusterParametersOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClusterParametersInput, func(*redshift.DescribeClusterParametersOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeClusterParametersRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeClusterParametersRequest(_a0 *redshift.DescribeClusterParametersInput) (*request.Request, *redshift.DescribeClusterParametersOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterParametersInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeClusterParametersOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeClusterParametersInput) *redshift.DescribeClusterParametersOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeClusterParametersOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterParametersWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeClusterParametersWithContext(_a0 context.Context, _a1 *redshift.DescribeClusterParametersInput, _a2 ...request.Option) (*redshift.DescribeClusterParametersOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeClusterParametersOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClusterParametersInput, ...request.Option) *redshift.DescribeClusterParametersOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeClusterParametersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeClusterParametersInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterSecurityGroups provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeClusterSecurityGroups(_a0 *redshift.DescribeClusterSecurityGroupsInput) (*redshift.DescribeClusterSecurityGroupsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeClusterSecurityGroupsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterSecurityGroupsInput) *redshift.DescribeClusterSecurityGroupsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeClusterSecurityGroupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeClusterSecurityGroupsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterSecurityGroupsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeClusterSecurityGroupsPages(_a0 *redshift.DescribeClusterSecurityGroupsInput, _a1 func(*redshift.DescribeClusterSecurityGroupsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterSecurityGroupsInput, func(*redshift.DescribeClusterSecurityGroupsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeClusterSecurityGroupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeClusterSecurityGroupsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeClusterSecurityGroupsInput, _a2 func(*redshift.DescribeClusterSecurityGroupsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClusterSecurityGroupsInput, func(*redshift.DescribeClusterSecurityGroupsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeClusterSecurityGroupsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeClusterSecurityGroupsRequest(_a0 *redshift.DescribeClusterSecurityGroupsInput) (*request.Request, *redshift.DescribeClusterSecurityGroupsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterSecurityGroupsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeClusterSecurityGroupsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeClusterSecurityGroupsInput) *redshift.DescribeClusterSecurityGroupsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeClusterSecurityGroupsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterSecurityGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeClusterSecurityGroupsWithContext(_a0 context.Context, _a1 *redshift.DescribeClusterSecurityGroupsInput, _a2 ...request.Option) (*redshift.DescribeClusterSecurityGroupsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeClusterSecurityGroupsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClusterSecurityGroupsInput, ...request.Option) *redshift.DescribeClusterSecurityGroupsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeClusterSecurityGroupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeClusterSecurityGroupsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterSnapshots provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeClusterSnapshots(_a0 *redshift.DescribeClusterSnapshotsInput) (*redshift.DescribeClusterSnapshotsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeClusterSnapshotsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterSnapshotsInput) *redshift.DescribeClusterSnapshotsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeClusterSnapshotsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeClusterSnapshotsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterSnapshotsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeClusterSnapshotsPages(_a0 *redshift.DescribeClusterSnapshotsInput, _a1 func(*redshift.DescribeClusterSnapshotsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterSnapshotsInput, func(*redshift.DescribeClusterSnapshotsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeClusterSnapshotsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeClusterSnapshotsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeClusterSnapshotsInput, _a2 func(*redshift.DescribeClusterSnapshotsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClusterSnapshotsInput, func(*redshift.DescribeClusterSnapshotsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeClusterSnapshotsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeClusterSnapshotsRequest(_a0 *redshift.DescribeClusterSnapshotsInput) (*request.Request, *redshift.DescribeClusterSnapshotsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterSnapshotsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeClusterSnapshotsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeClusterSnapshotsInput) *redshift.DescribeClusterSnapshotsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeClusterSnapshotsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterSnapshotsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeClusterSnapshotsWithContext(_a0 context.Context, _a1 *redshift.DescribeClusterSnapshotsInput, _a2 ...request.Option) (*redshift.DescribeClusterSnapshotsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeClusterSnapshotsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClusterSnapshotsInput, ...request.Option) *redshift.DescribeClusterSnapshotsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeClusterSnapshotsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeClusterSnapshotsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterSubnetGroups provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeClusterSubnetGroups(_a0 *redshift.DescribeClusterSubnetGroupsInput) (*redshift.DescribeClusterSubnetGroupsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeClusterSubnetGroupsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterSubnetGroupsInput) *redshift.DescribeClusterSubnetGroupsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeClusterSubnetGroupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeClusterSubnetGroupsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterSubnetGroupsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeClusterSubnetGroupsPages(_a0 *redshift.DescribeClusterSubnetGroupsInput, _a1 func(*redshift.DescribeClusterSubnetGroupsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterSubnetGroupsInput, func(*redshift.DescribeClusterSubnetGroupsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeClusterSubnetGroupsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeClusterSubnetGroupsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeClusterSubnetGroupsInput, _a2 func(*redshift.DescribeClusterSubnetGroupsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClusterSubnetGroupsInput, func(*redshift.DescribeClusterSubnetGroupsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeClusterSubnetGroupsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeClusterSubnetGroupsRequest(_a0 *redshift.DescribeClusterSubnetGroupsInput) (*request.Request, *redshift.DescribeClusterSubnetGroupsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterSubnetGroupsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeClusterSubnetGroupsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeClusterSubnetGroupsInput) *redshift.DescribeClusterSubnetGroupsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeClusterSubnetGroupsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterSubnetGroupsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeClusterSubnetGroupsWithContext(_a0 context.Context, _a1 *redshift.DescribeClusterSubnetGroupsInput, _a2 ...request.Option) (*redshift.DescribeClusterSubnetGroupsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeClusterSubnetGroupsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClusterSubnetGroupsInput, ...request.Option) *redshift.DescribeClusterSubnetGroupsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeClusterSubnetGroupsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeClusterSubnetGroupsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterTracks provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeClusterTracks(_a0 *redshift.DescribeClusterTracksInput) (*redshift.DescribeClusterTracksOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeClusterTracksOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterTracksInput) *redshift.DescribeClusterTracksOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeClusterTracksOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeClusterTracksInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterTracksPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeClusterTracksPages(_a0 *redshift.DescribeClusterTracksInput, _a1 func(*redshift.DescribeClusterTracksOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterTracksInput, func(*redshift.DescribeClusterTracksOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeClusterTracksPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeClusterTracksPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeClusterTracksInput, _a2 func(*redshift.DescribeClusterTracksOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClusterTracksInput, func(*redshift.DescribeClusterTracksOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeClusterTracksRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeClusterTracksRequest(_a0 *redshift.DescribeClusterTracksInput) (*request.Request, *redshift.DescribeClusterTracksOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterTracksInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeClusterTracksOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeClusterTracksInput) *redshift.DescribeClusterTracksOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeClusterTracksOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterTracksWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeClusterTracksWithContext(_a0 context.Context, _a1 *redshift.DescribeClusterTracksInput, _a2 ...request.Option) (*redshift.DescribeClusterTracksOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeClusterTracksOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClusterTracksInput, ...request.Option) *redshift.DescribeClusterTracksOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeClusterTracksOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeClusterTracksInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterVersions provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeClusterVersions(_a0 *redshift.DescribeClusterVersionsInput) (*redshift.DescribeClusterVersionsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeClusterVersionsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterVersionsInput) *redshift.DescribeClusterVersionsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeClusterVersionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeClusterVersionsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterVersionsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeClusterVersionsPages(_a0 *redshift.DescribeClusterVersionsInput, _a1 func(*redshift.DescribeClusterVersionsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterVersionsInput, func(*redshift.DescribeClusterVersionsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeClusterVersionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeClusterVersionsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeClusterVersionsInput, _a2 func(*redshift.DescribeClusterVersionsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClusterVersionsInput, func(*redshift.DescribeClusterVersionsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeClusterVersionsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeClusterVersionsRequest(_a0 *redshift.DescribeClusterVersionsInput) (*request.Request, *redshift.DescribeClusterVersionsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterVersionsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeClusterVersionsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeClusterVersionsInput) *redshift.DescribeClusterVersionsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeClusterVersionsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeClusterVersionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeClusterVersionsWithContext(_a0 context.Context, _a1 *redshift.DescribeClusterVersionsInput, _a2 ...request.Option) (*redshift.DescribeClusterVersionsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeClusterVersionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClusterVersionsInput, ...request.Option) *redshift.DescribeClusterVersionsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeClusterVersionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeClusterVersionsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeClusters provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeClusters(_a0 *redshift.DescribeClustersInput) (*redshift.DescribeClustersOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeClustersOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClustersInput) *redshift.DescribeClustersOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeClustersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeClustersInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeClustersPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeClustersPages(_a0 *redshift.DescribeClustersInput, _a1 func(*redshift.DescribeClustersOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClustersInput, func(*redshift.DescribeClustersOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeClustersPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeClustersPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeClustersInput, _a2 func(*redshift.DescribeClustersOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClustersInput, func(*redshift.DescribeClustersOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeClustersRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeClustersRequest(_a0 *redshift.DescribeClustersInput) (*request.Request, *redshift.DescribeClustersOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClustersInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeClustersOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeClustersInput) *redshift.DescribeClustersOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeClustersOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeClustersWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeClustersWithContext(_a0 context.Context, _a1 *redshift.DescribeClustersInput, _a2 ...request.Option) (*redshift.DescribeClustersOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeClustersOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClustersInput, ...request.Option) *redshift.DescribeClustersOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeClustersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeClustersInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeCustomDomainAssociations provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeCustomDomainAssociations(_a0 *redshift.DescribeCustomDomainAssociationsInput) (*redshift.DescribeCustomDomainAssociationsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeCustomDomainAssociationsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeCustomDomainAssociationsInput) *redshift.DescribeCustomDomainAssociationsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeCustomDomainAssociationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeCustomDomainAssociationsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeCustomDomainAssociationsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeCustomDomainAssociationsPages(_a0 *redshift.DescribeCustomDomainAssociationsInput, _a1 func(*redshift.DescribeCustomDomainAssociationsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeCustomDomainAssociationsInput, func(*redshift.DescribeCustomDomainAssociationsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeCustomDomainAssociationsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeCustomDomainAssociationsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeCustomDomainAssociationsInput, _a2 func(*redshift.DescribeCustomDomainAssociationsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeCustomDomainAssociationsInput, func(*redshift.DescribeCustomDomainAssociationsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeCustomDomainAssociationsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeCustomDomainAssociationsRequest(_a0 *redshift.DescribeCustomDomainAssociationsInput) (*request.Request, *redshift.DescribeCustomDomainAssociationsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeCustomDomainAssociationsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeCustomDomainAssociationsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeCustomDomainAssociationsInput) *redshift.DescribeCustomDomainAssociationsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeCustomDomainAssociationsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeCustomDomainAssociationsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeCustomDomainAssociationsWithContext(_a0 context.Context, _a1 *redshift.DescribeCustomDomainAssociationsInput, _a2 ...request.Option) (*redshift.DescribeCustomDomainAssociationsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeCustomDomainAssociationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeCustomDomainAssociationsInput, ...request.Option) *redshift.DescribeCustomDomainAssociationsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeCustomDomainAssociationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeCustomDomainAssociationsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDataShares provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeDataShares(_a0 *redshift.DescribeDataSharesInput) (*redshift.DescribeDataSharesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeDataSharesOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeDataSharesInput) *redshift.DescribeDataSharesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeDataSharesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeDataSharesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDataSharesForConsumer provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeDataSharesForConsumer(_a0 *redshift.DescribeDataSharesForConsumerInput) (*redshift.DescribeDataSharesForConsumerOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeDataSharesForConsumerOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeDataSharesForConsumerInput) *redshift.DescribeDataSharesForConsumerOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeDataSharesForConsumerOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeDataSharesForConsumerInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDataSharesForConsumerPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeDataSharesForConsumerPages(_a0 *redshift.DescribeDataSharesForConsumerInput, _a1 func(*redshift.DescribeDataSharesForConsumerOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeDataSharesForConsumerInput, func(*redshift.DescribeDataSharesForConsumerOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDataSharesForConsumerPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeDataSharesForConsumerPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeDataSharesForConsumerInput, _a2 func(*redshift.DescribeDataSharesForConsumerOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeDataSharesForConsumerInput, func(*redshift.DescribeDataSharesForConsumerOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDataSharesForConsumerRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeDataSharesForConsumerRequest(_a0 *redshift.DescribeDataSharesForConsumerInput) (*request.Request, *redshift.DescribeDataSharesForConsumerOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeDataSharesForConsumerInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeDataSharesForConsumerOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeDataSharesForConsumerInput) *redshift.DescribeDataSharesForConsumerOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeDataSharesForConsumerOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeDataSharesForConsumerWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeDataSharesForConsumerWithContext(_a0 context.Context, _a1 *redshift.DescribeDataSharesForConsumerInput, _a2 ...request.Option) (*redshift.DescribeDataSharesForConsumerOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeDataSharesForConsumerOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeDataSharesForConsumerInput, ...request.Option) *redshift.DescribeDataSharesForConsumerOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeDataSharesForConsumerOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeDataSharesForConsumerInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDataSharesForProducer provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeDataSharesForProducer(_a0 *redshift.DescribeDataSharesForProducerInput) (*redshift.DescribeDataSharesForProducerOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeDataSharesForProducerOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeDataSharesForProducerInput) *redshift.DescribeDataSharesForProducerOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeDataSharesForProducerOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeDataSharesForProducerInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDataSharesForProducerPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeDataSharesForProducerPages(_a0 *redshift.DescribeDataSharesForProducerInput, _a1 func(*redshift.DescribeDataSharesForProducerOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeDataSharesForProducerInput, func(*redshift.DescribeDataSharesForProducerOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDataSharesForProducerPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeDataSharesForProducerPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeDataSharesForProducerInput, _a2 func(*redshift.DescribeDataSharesForProducerOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeDataSharesForProducerInput, func(*redshift.DescribeDataSharesForProducerOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDataSharesForProducerRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeDataSharesForProducerRequest(_a0 *redshift.DescribeDataSharesForProducerInput) (*request.Request, *redshift.DescribeDataSharesForProducerOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeDataSharesForProducerInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeDataSharesForProducerOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeDataSharesForProducerInput) *redshift.DescribeDataSharesForProducerOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeDataSharesForProducerOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeDataSharesForProducerWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeDataSharesForProducerWithContext(_a0 context.Context, _a1 *redshift.DescribeDataSharesForProducerInput, _a2 ...request.Option) (*redshift.DescribeDataSharesForProducerOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeDataSharesForProducerOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeDataSharesForProducerInput, ...request.Option) *redshift.DescribeDataSharesForProducerOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeDataSharesForProducerOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeDataSharesForProducerInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDataSharesPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeDataSharesPages(_a0 *redshift.DescribeDataSharesInput, _a1 func(*redshift.DescribeDataSharesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeDataSharesInput, func(*redshift.DescribeDataSharesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDataSharesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeDataSharesPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeDataSharesInput, _a2 func(*redshift.DescribeDataSharesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeDataSharesInput, func(*redshift.DescribeDataSharesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDataSharesRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeDataSharesRequest(_a0 *redshift.DescribeDataSharesInput) (*request.Request, *redshift.DescribeDataSharesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeDataSharesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeDataSharesOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeDataSharesInput) *redshift.DescribeDataSharesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeDataSharesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeDataSharesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeDataSharesWithContext(_a0 context.Context, _a1 *redshift.DescribeDataSharesInput, _a2 ...request.Option) (*redshift.DescribeDataSharesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeDataSharesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeDataSharesInput, ...request.Option) *redshift.DescribeDataSharesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeDataSharesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeDataSharesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDefaultClusterParameters provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeDefaultClusterParameters(_a0 *redshift.DescribeDefaultClusterParametersInput) (*redshift.DescribeDefaultClusterParametersOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeDefaultClusterParametersOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeDefaultClusterParametersInput) *redshift.DescribeDefaultClusterParametersOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeDefaultClusterParametersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeDefaultClusterParametersInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeDefaultClusterParametersPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeDefaultClusterParametersPages(_a0 *redshift.DescribeDefaultClusterParametersInput, _a1 func(*redshift.DescribeDefaultClusterParametersOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeDefaultClusterParametersInput, func(*redshift.DescribeDefaultClusterParametersOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDefaultClusterParametersPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeDefaultClusterParametersPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeDefaultClusterParametersInput, _a2 func(*redshift.DescribeDefaultClusterParametersOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeDefaultClusterParametersInput, func(*redshift.DescribeDefaultClusterParametersOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeDefaultClusterParametersRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeDefaultClusterParametersRequest(_a0 *redshift.DescribeDefaultClusterParametersInput) (*request.Request, *redshift.DescribeDefaultClusterParametersOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeDefaultClusterParametersInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeDefaultClusterParametersOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeDefaultClusterParametersInput) *redshift.DescribeDefaultClusterParametersOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeDefaultClusterParametersOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeDefaultClusterParametersWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeDefaultClusterParametersWithContext(_a0 context.Context, _a1 *redshift.DescribeDefaultClusterParametersInput, _a2 ...request.Option) (*redshift.DescribeDefaultClusterParametersOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeDefaultClusterParametersOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeDefaultClusterParametersInput, ...request.Option) *redshift.DescribeDefaultClusterParametersOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeDefaultClusterParametersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeDefaultClusterParametersInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEndpointAccess provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeEndpointAccess(_a0 *redshift.DescribeEndpointAccessInput) (*redshift.DescribeEndpointAccessOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeEndpointAccessOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeEndpointAccessInput) *redshift.DescribeEndpointAccessOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeEndpointAccessOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeEndpointAccessInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEndpointAccessPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeEndpointAccessPages(_a0 *redshift.DescribeEndpointAccessInput, _a1 func(*redshift.DescribeEndpointAccessOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeEndpointAccessInput, func(*redshift.DescribeEndpointAccessOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeEndpointAccessPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeEndpointAccessPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeEndpointAccessInput, _a2 func(*redshift.DescribeEndpointAccessOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeEndpointAccessInput, func(*redshift.DescribeEndpointAccessOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeEndpointAccessRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeEndpointAccessRequest(_a0 *redshift.DescribeEndpointAccessInput) (*request.Request, *redshift.DescribeEndpointAccessOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeEndpointAccessInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeEndpointAccessOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeEndpointAccessInput) *redshift.DescribeEndpointAccessOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeEndpointAccessOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeEndpointAccessWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeEndpointAccessWithContext(_a0 context.Context, _a1 *redshift.DescribeEndpointAccessInput, _a2 ...request.Option) (*redshift.DescribeEndpointAccessOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeEndpointAccessOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeEndpointAccessInput, ...request.Option) *redshift.DescribeEndpointAccessOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeEndpointAccessOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeEndpointAccessInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEndpointAuthorization provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeEndpointAuthorization(_a0 *redshift.DescribeEndpointAuthorizationInput) (*redshift.DescribeEndpointAuthorizationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeEndpointAuthorizationOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeEndpointAuthorizationInput) *redshift.DescribeEndpointAuthorizationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeEndpointAuthorizationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeEndpointAuthorizationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEndpointAuthorizationPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeEndpointAuthorizationPages(_a0 *redshift.DescribeEndpointAuthorizationInput, _a1 func(*redshift.DescribeEndpointAuthorizationOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeEndpointAuthorizationInput, func(*redshift.DescribeEndpointAuthorizationOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeEndpointAuthorizationPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeEndpointAuthorizationPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeEndpointAuthorizationInput, _a2 func(*redshift.DescribeEndpointAuthorizationOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeEndpointAuthorizationInput, func(*redshift.DescribeEndpointAuthorizationOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeEndpointAuthorizationRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeEndpointAuthorizationRequest(_a0 *redshift.DescribeEndpointAuthorizationInput) (*request.Request, *redshift.DescribeEndpointAuthorizationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeEndpointAuthorizationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeEndpointAuthorizationOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeEndpointAuthorizationInput) *redshift.DescribeEndpointAuthorizationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeEndpointAuthorizationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeEndpointAuthorizationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeEndpointAuthorizationWithContext(_a0 context.Context, _a1 *redshift.DescribeEndpointAuthorizationInput, _a2 ...request.Option) (*redshift.DescribeEndpointAuthorizationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeEndpointAuthorizationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeEndpointAuthorizationInput, ...request.Option) *redshift.DescribeEndpointAuthorizationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeEndpointAuthorizationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeEndpointAuthorizationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEventCategories provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeEventCategories(_a0 *redshift.DescribeEventCategoriesInput) (*redshift.DescribeEventCategoriesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeEventCategoriesOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeEventCategoriesInput) *redshift.DescribeEventCategoriesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeEventCategoriesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeEventCategoriesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEventCategoriesRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeEventCategoriesRequest(_a0 *redshift.DescribeEventCategoriesInput) (*request.Request, *redshift.DescribeEventCategoriesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeEventCategoriesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeEventCategoriesOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeEventCategoriesInput) *redshift.DescribeEventCategoriesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeEventCategoriesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeEventCategoriesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeEventCategoriesWithContext(_a0 context.Context, _a1 *redshift.DescribeEventCategoriesInput, _a2 ...request.Option) (*redshift.DescribeEventCategoriesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeEventCategoriesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeEventCategoriesInput, ...request.Option) *redshift.DescribeEventCategoriesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeEventCategoriesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeEventCategoriesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEventSubscriptions provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeEventSubscriptions(_a0 *redshift.DescribeEventSubscriptionsInput) (*redshift.DescribeEventSubscriptionsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeEventSubscriptionsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeEventSubscriptionsInput) *redshift.DescribeEventSubscriptionsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeEventSubscriptionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeEventSubscriptionsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEventSubscriptionsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeEventSubscriptionsPages(_a0 *redshift.DescribeEventSubscriptionsInput, _a1 func(*redshift.DescribeEventSubscriptionsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeEventSubscriptionsInput, func(*redshift.DescribeEventSubscriptionsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeEventSubscriptionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeEventSubscriptionsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeEventSubscriptionsInput, _a2 func(*redshift.DescribeEventSubscriptionsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeEventSubscriptionsInput, func(*redshift.DescribeEventSubscriptionsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeEventSubscriptionsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeEventSubscriptionsRequest(_a0 *redshift.DescribeEventSubscriptionsInput) (*request.Request, *redshift.DescribeEventSubscriptionsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeEventSubscriptionsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeEventSubscriptionsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeEventSubscriptionsInput) *redshift.DescribeEventSubscriptionsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeEventSubscriptionsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeEventSubscriptionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeEventSubscriptionsWithContext(_a0 context.Context, _a1 *redshift.DescribeEventSubscriptionsInput, _a2 ...request.Option) (*redshift.DescribeEventSubscriptionsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeEventSubscriptionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeEventSubscriptionsInput, ...request.Option) *redshift.DescribeEventSubscriptionsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeEventSubscriptionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeEventSubscriptionsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEvents provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeEvents(_a0 *redshift.DescribeEventsInput) (*redshift.DescribeEventsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeEventsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeEventsInput) *redshift.DescribeEventsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeEventsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeEventsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeEventsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeEventsPages(_a0 *redshift.DescribeEventsInput, _a1 func(*redshift.DescribeEventsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeEventsInput, func(*redshift.DescribeEventsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeEventsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeEventsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeEventsInput, _a2 func(*redshift.DescribeEventsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeEventsInput, func(*redshift.DescribeEventsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeEventsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeEventsRequest(_a0 *redshift.DescribeEventsInput) (*request.Request, *redshift.DescribeEventsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeEventsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeEventsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeEventsInput) *redshift.DescribeEventsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeEventsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeEventsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeEventsWithContext(_a0 context.Context, _a1 *redshift.DescribeEventsInput, _a2 ...request.Option) (*redshift.DescribeEventsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeEventsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeEventsInput, ...request.Option) *redshift.DescribeEventsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeEventsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeEventsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeHsmClientCertificates provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeHsmClientCertificates(_a0 *redshift.DescribeHsmClientCertificatesInput) (*redshift.DescribeHsmClientCertificatesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeHsmClientCertificatesOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeHsmClientCertificatesInput) *redshift.DescribeHsmClientCertificatesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeHsmClientCertificatesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeHsmClientCertificatesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeHsmClientCertificatesPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeHsmClientCertificatesPages(_a0 *redshift.DescribeHsmClientCertificatesInput, _a1 func(*redshift.DescribeHsmClientCertificatesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeHsmClientCertificatesInput, func(*redshift.DescribeHsmClientCertificatesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeHsmClientCertificatesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeHsmClientCertificatesPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeHsmClientCertificatesInput, _a2 func(*redshift.DescribeHsmClientCertificatesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeHsmClientCertificatesInput, func(*redshift.DescribeHsmClientCertificatesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeHsmClientCertificatesRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeHsmClientCertificatesRequest(_a0 *redshift.DescribeHsmClientCertificatesInput) (*request.Request, *redshift.DescribeHsmClientCertificatesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeHsmClientCertificatesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeHsmClientCertificatesOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeHsmClientCertificatesInput) *redshift.DescribeHsmClientCertificatesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeHsmClientCertificatesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeHsmClientCertificatesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeHsmClientCertificatesWithContext(_a0 context.Context, _a1 *redshift.DescribeHsmClientCertificatesInput, _a2 ...request.Option) (*redshift.DescribeHsmClientCertificatesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeHsmClientCertificatesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeHsmClientCertificatesInput, ...request.Option) *redshift.DescribeHsmClientCertificatesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeHsmClientCertificatesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeHsmClientCertificatesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeHsmConfigurations provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeHsmConfigurations(_a0 *redshift.DescribeHsmConfigurationsInput) (*redshift.DescribeHsmConfigurationsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeHsmConfigurationsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeHsmConfigurationsInput) *redshift.DescribeHsmConfigurationsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeHsmConfigurationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeHsmConfigurationsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeHsmConfigurationsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeHsmConfigurationsPages(_a0 *redshift.DescribeHsmConfigurationsInput, _a1 func(*redshift.DescribeHsmConfigurationsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeHsmConfigurationsInput, func(*redshift.DescribeHsmConfigurationsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeHsmConfigurationsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeHsmConfigurationsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeHsmConfigurationsInput, _a2 func(*redshift.DescribeHsmConfigurationsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeHsmConfigurationsInput, func(*redshift.DescribeHsmConfigurationsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeHsmConfigurationsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeHsmConfigurationsRequest(_a0 *redshift.DescribeHsmConfigurationsInput) (*request.Request, *redshift.DescribeHsmConfigurationsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeHsmConfigurationsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeHsmConfigurationsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeHsmConfigurationsInput) *redshift.DescribeHsmConfigurationsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeHsmConfigurationsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeHsmConfigurationsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeHsmConfigurationsWithContext(_a0 context.Context, _a1 *redshift.DescribeHsmConfigurationsInput, _a2 ...request.Option) (*redshift.DescribeHsmConfigurationsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeHsmConfigurationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeHsmConfigurationsInput, ...request.Option) *redshift.DescribeHsmConfigurationsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeHsmConfigurationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeHsmConfigurationsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeInboundIntegrations provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeInboundIntegrations(_a0 *redshift.DescribeInboundIntegrationsInput) (*redshift.DescribeInboundIntegrationsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeInboundIntegrationsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeInboundIntegrationsInput) *redshift.DescribeInboundIntegrationsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeInboundIntegrationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeInboundIntegrationsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeInboundIntegrationsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeInboundIntegrationsPages(_a0 *redshift.DescribeInboundIntegrationsInput, _a1 func(*redshift.DescribeInboundIntegrationsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeInboundIntegrationsInput, func(*redshift.DescribeInboundIntegrationsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeInboundIntegrationsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeInboundIntegrationsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeInboundIntegrationsInput, _a2 func(*redshift.DescribeInboundIntegrationsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeInboundIntegrationsInput, func(*redshift.DescribeInboundIntegrationsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeInboundIntegrationsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeInboundIntegrationsRequest(_a0 *redshift.DescribeInboundIntegrationsInput) (*request.Request, *redshift.DescribeInboundIntegrationsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeInboundIntegrationsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeInboundIntegrationsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeInboundIntegrationsInput) *redshift.DescribeInboundIntegrationsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeInboundIntegrationsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeInboundIntegrationsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeInboundIntegrationsWithContext(_a0 context.Context, _a1 *redshift.DescribeInboundIntegrationsInput, _a2 ...request.Option) (*redshift.DescribeInboundIntegrationsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeInboundIntegrationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeInboundIntegrationsInput, ...request.Option) *redshift.DescribeInboundIntegrationsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeInboundIntegrationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeInboundIntegrationsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeLoggingStatus provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeLoggingStatus(_a0 *redshift.DescribeLoggingStatusInput) (*redshift.LoggingStatus, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.LoggingStatus
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeLoggingStatusInput) *redshift.LoggingStatus); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.LoggingStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeLoggingStatusInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeLoggingStatusRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeLoggingStatusRequest(_a0 *redshift.DescribeLoggingStatusInput) (*request.Request, *redshift.LoggingStatus) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeLoggingStatusInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.LoggingStatus
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeLoggingStatusInput) *redshift.LoggingStatus); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.LoggingStatus)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeLoggingStatusWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeLoggingStatusWithContext(_a0 context.Context, _a1 *redshift.DescribeLoggingStatusInput, _a2 ...request.Option) (*redshift.LoggingStatus, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.LoggingStatus
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeLoggingStatusInput, ...request.Option) *redshift.LoggingStatus); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.LoggingStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeLoggingStatusInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeNodeConfigurationOptions provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeNodeConfigurationOptions(_a0 *redshift.DescribeNodeConfigurationOptionsInput) (*redshift.DescribeNodeConfigurationOptionsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeNodeConfigurationOptionsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeNodeConfigurationOptionsInput) *redshift.DescribeNodeConfigurationOptionsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeNodeConfigurationOptionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeNodeConfigurationOptionsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeNodeConfigurationOptionsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeNodeConfigurationOptionsPages(_a0 *redshift.DescribeNodeConfigurationOptionsInput, _a1 func(*redshift.DescribeNodeConfigurationOptionsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeNodeConfigurationOptionsInput, func(*redshift.DescribeNodeConfigurationOptionsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeNodeConfigurationOptionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeNodeConfigurationOptionsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeNodeConfigurationOptionsInput, _a2 func(*redshift.DescribeNodeConfigurationOptionsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeNodeConfigurationOptionsInput, func(*redshift.DescribeNodeConfigurationOptionsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeNodeConfigurationOptionsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeNodeConfigurationOptionsRequest(_a0 *redshift.DescribeNodeConfigurationOptionsInput) (*request.Request, *redshift.DescribeNodeConfigurationOptionsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeNodeConfigurationOptionsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeNodeConfigurationOptionsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeNodeConfigurationOptionsInput) *redshift.DescribeNodeConfigurationOptionsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeNodeConfigurationOptionsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeNodeConfigurationOptionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeNodeConfigurationOptionsWithContext(_a0 context.Context, _a1 *redshift.DescribeNodeConfigurationOptionsInput, _a2 ...request.Option) (*redshift.DescribeNodeConfigurationOptionsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeNodeConfigurationOptionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeNodeConfigurationOptionsInput, ...request.Option) *redshift.DescribeNodeConfigurationOptionsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeNodeConfigurationOptionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeNodeConfigurationOptionsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeOrderableClusterOptions provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeOrderableClusterOptions(_a0 *redshift.DescribeOrderableClusterOptionsInput) (*redshift.DescribeOrderableClusterOptionsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeOrderableClusterOptionsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeOrderableClusterOptionsInput) *redshift.DescribeOrderableClusterOptionsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeOrderableClusterOptionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeOrderableClusterOptionsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeOrderableClusterOptionsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeOrderableClusterOptionsPages(_a0 *redshift.DescribeOrderableClusterOptionsInput, _a1 func(*redshift.DescribeOrderableClusterOptionsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeOrderableClusterOptionsInput, func(*redshift.DescribeOrderableClusterOptionsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeOrderableClusterOptionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeOrderableClusterOptionsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeOrderableClusterOptionsInput, _a2 func(*redshift.DescribeOrderableClusterOptionsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeOrderableClusterOptionsInput, func(*redshift.DescribeOrderableClusterOptionsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeOrderableClusterOptionsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeOrderableClusterOptionsRequest(_a0 *redshift.DescribeOrderableClusterOptionsInput) (*request.Request, *redshift.DescribeOrderableClusterOptionsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeOrderableClusterOptionsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeOrderableClusterOptionsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeOrderableClusterOptionsInput) *redshift.DescribeOrderableClusterOptionsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeOrderableClusterOptionsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeOrderableClusterOptionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeOrderableClusterOptionsWithContext(_a0 context.Context, _a1 *redshift.DescribeOrderableClusterOptionsInput, _a2 ...request.Option) (*redshift.DescribeOrderableClusterOptionsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeOrderableClusterOptionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeOrderableClusterOptionsInput, ...request.Option) *redshift.DescribeOrderableClusterOptionsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeOrderableClusterOptionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeOrderableClusterOptionsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribePartners provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribePartners(_a0 *redshift.DescribePartnersInput) (*redshift.DescribePartnersOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribePartnersOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribePartnersInput) *redshift.DescribePartnersOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribePartnersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribePartnersInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribePartnersRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribePartnersRequest(_a0 *redshift.DescribePartnersInput) (*request.Request, *redshift.DescribePartnersOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribePartnersInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribePartnersOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribePartnersInput) *redshift.DescribePartnersOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribePartnersOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribePartnersWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribePartnersWithContext(_a0 context.Context, _a1 *redshift.DescribePartnersInput, _a2 ...request.Option) (*redshift.DescribePartnersOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribePartnersOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribePartnersInput, ...request.Option) *redshift.DescribePartnersOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribePartnersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribePartnersInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeRedshiftIdcApplications provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeRedshiftIdcApplications(_a0 *redshift.DescribeRedshiftIdcApplicationsInput) (*redshift.DescribeRedshiftIdcApplicationsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeRedshiftIdcApplicationsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeRedshiftIdcApplicationsInput) *redshift.DescribeRedshiftIdcApplicationsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeRedshiftIdcApplicationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeRedshiftIdcApplicationsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeRedshiftIdcApplicationsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeRedshiftIdcApplicationsPages(_a0 *redshift.DescribeRedshiftIdcApplicationsInput, _a1 func(*redshift.DescribeRedshiftIdcApplicationsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeRedshiftIdcApplicationsInput, func(*redshift.DescribeRedshiftIdcApplicationsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeRedshiftIdcApplicationsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeRedshiftIdcApplicationsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeRedshiftIdcApplicationsInput, _a2 func(*redshift.DescribeRedshiftIdcApplicationsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeRedshiftIdcApplicationsInput, func(*redshift.DescribeRedshiftIdcApplicationsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeRedshiftIdcApplicationsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeRedshiftIdcApplicationsRequest(_a0 *redshift.DescribeRedshiftIdcApplicationsInput) (*request.Request, *redshift.DescribeRedshiftIdcApplicationsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeRedshiftIdcApplicationsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeRedshiftIdcApplicationsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeRedshiftIdcApplicationsInput) *redshift.DescribeRedshiftIdcApplicationsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeRedshiftIdcApplicationsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeRedshiftIdcApplicationsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeRedshiftIdcApplicationsWithContext(_a0 context.Context, _a1 *redshift.DescribeRedshiftIdcApplicationsInput, _a2 ...request.Option) (*redshift.DescribeRedshiftIdcApplicationsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeRedshiftIdcApplicationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeRedshiftIdcApplicationsInput, ...request.Option) *redshift.DescribeRedshiftIdcApplicationsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeRedshiftIdcApplicationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeRedshiftIdcApplicationsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeReservedNodeExchangeStatus provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeReservedNodeExchangeStatus(_a0 *redshift.DescribeReservedNodeExchangeStatusInput) (*redshift.DescribeReservedNodeExchangeStatusOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeReservedNodeExchangeStatusOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeReservedNodeExchangeStatusInput) *redshift.DescribeReservedNodeExchangeStatusOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeReservedNodeExchangeStatusOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeReservedNodeExchangeStatusInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeReservedNodeExchangeStatusPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeReservedNodeExchangeStatusPages(_a0 *redshift.DescribeReservedNodeExchangeStatusInput, _a1 func(*redshift.DescribeReservedNodeExchangeStatusOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeReservedNodeExchangeStatusInput, func(*redshift.DescribeReservedNodeExchangeStatusOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeReservedNodeExchangeStatusPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeReservedNodeExchangeStatusPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeReservedNodeExchangeStatusInput, _a2 func(*redshift.DescribeReservedNodeExchangeStatusOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeReservedNodeExchangeStatusInput, func(*redshift.DescribeReservedNodeExchangeStatusOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeReservedNodeExchangeStatusRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeReservedNodeExchangeStatusRequest(_a0 *redshift.DescribeReservedNodeExchangeStatusInput) (*request.Request, *redshift.DescribeReservedNodeExchangeStatusOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeReservedNodeExchangeStatusInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeReservedNodeExchangeStatusOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeReservedNodeExchangeStatusInput) *redshift.DescribeReservedNodeExchangeStatusOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeReservedNodeExchangeStatusOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeReservedNodeExchangeStatusWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeReservedNodeExchangeStatusWithContext(_a0 context.Context, _a1 *redshift.DescribeReservedNodeExchangeStatusInput, _a2 ...request.Option) (*redshift.DescribeReservedNodeExchangeStatusOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeReservedNodeExchangeStatusOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeReservedNodeExchangeStatusInput, ...request.Option) *redshift.DescribeReservedNodeExchangeStatusOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeReservedNodeExchangeStatusOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeReservedNodeExchangeStatusInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeReservedNodeOfferings provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeReservedNodeOfferings(_a0 *redshift.DescribeReservedNodeOfferingsInput) (*redshift.DescribeReservedNodeOfferingsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeReservedNodeOfferingsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeReservedNodeOfferingsInput) *redshift.DescribeReservedNodeOfferingsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeReservedNodeOfferingsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeReservedNodeOfferingsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeReservedNodeOfferingsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeReservedNodeOfferingsPages(_a0 *redshift.DescribeReservedNodeOfferingsInput, _a1 func(*redshift.DescribeReservedNodeOfferingsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeReservedNodeOfferingsInput, func(*redshift.DescribeReservedNodeOfferingsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeReservedNodeOfferingsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeReservedNodeOfferingsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeReservedNodeOfferingsInput, _a2 func(*redshift.DescribeReservedNodeOfferingsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeReservedNodeOfferingsInput, func(*redshift.DescribeReservedNodeOfferingsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeReservedNodeOfferingsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeReservedNodeOfferingsRequest(_a0 *redshift.DescribeReservedNodeOfferingsInput) (*request.Request, *redshift.DescribeReservedNodeOfferingsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeReservedNodeOfferingsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeReservedNodeOfferingsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeReservedNodeOfferingsInput) *redshift.DescribeReservedNodeOfferingsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeReservedNodeOfferingsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeReservedNodeOfferingsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeReservedNodeOfferingsWithContext(_a0 context.Context, _a1 *redshift.DescribeReservedNodeOfferingsInput, _a2 ...request.Option) (*redshift.DescribeReservedNodeOfferingsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeReservedNodeOfferingsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeReservedNodeOfferingsInput, ...request.Option) *redshift.DescribeReservedNodeOfferingsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeReservedNodeOfferingsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeReservedNodeOfferingsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeReservedNodes provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeReservedNodes(_a0 *redshift.DescribeReservedNodesInput) (*redshift.DescribeReservedNodesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeReservedNodesOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeReservedNodesInput) *redshift.DescribeReservedNodesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeReservedNodesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeReservedNodesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeReservedNodesPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeReservedNodesPages(_a0 *redshift.DescribeReservedNodesInput, _a1 func(*redshift.DescribeReservedNodesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeReservedNodesInput, func(*redshift.DescribeReservedNodesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeReservedNodesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeReservedNodesPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeReservedNodesInput, _a2 func(*redshift.DescribeReservedNodesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeReservedNodesInput, func(*redshift.DescribeReservedNodesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeReservedNodesRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeReservedNodesRequest(_a0 *redshift.DescribeReservedNodesInput) (*request.Request, *redshift.DescribeReservedNodesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeReservedNodesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeReservedNodesOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeReservedNodesInput) *redshift.DescribeReservedNodesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeReservedNodesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeReservedNodesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeReservedNodesWithContext(_a0 context.Context, _a1 *redshift.DescribeReservedNodesInput, _a2 ...request.Option) (*redshift.DescribeReservedNodesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeReservedNodesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeReservedNodesInput, ...request.Option) *redshift.DescribeReservedNodesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeReservedNodesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeReservedNodesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeResize provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeResize(_a0 *redshift.DescribeResizeInput) (*redshift.DescribeResizeOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeResizeOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeResizeInput) *redshift.DescribeResizeOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeResizeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeResizeInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeResizeRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeResizeRequest(_a0 *redshift.DescribeResizeInput) (*request.Request, *redshift.DescribeResizeOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeResizeInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeResizeOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeResizeInput) *redshift.DescribeResizeOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeResizeOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeResizeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeResizeWithContext(_a0 context.Context, _a1 *redshift.DescribeResizeInput, _a2 ...request.Option) (*redshift.DescribeResizeOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeResizeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeResizeInput, ...request.Option) *redshift.DescribeResizeOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeResizeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeResizeInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeScheduledActions provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeScheduledActions(_a0 *redshift.DescribeScheduledActionsInput) (*redshift.DescribeScheduledActionsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeScheduledActionsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeScheduledActionsInput) *redshift.DescribeScheduledActionsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeScheduledActionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeScheduledActionsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeScheduledActionsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeScheduledActionsPages(_a0 *redshift.DescribeScheduledActionsInput, _a1 func(*redshift.DescribeScheduledActionsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeScheduledActionsInput, func(*redshift.DescribeScheduledActionsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeScheduledActionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeScheduledActionsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeScheduledActionsInput, _a2 func(*redshift.DescribeScheduledActionsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeScheduledActionsInput, func(*redshift.DescribeScheduledActionsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeScheduledActionsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeScheduledActionsRequest(_a0 *redshift.DescribeScheduledActionsInput) (*request.Request, *redshift.DescribeScheduledActionsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeScheduledActionsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeScheduledActionsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeScheduledActionsInput) *redshift.DescribeScheduledActionsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeScheduledActionsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeScheduledActionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeScheduledActionsWithContext(_a0 context.Context, _a1 *redshift.DescribeScheduledActionsInput, _a2 ...request.Option) (*redshift.DescribeScheduledActionsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeScheduledActionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeScheduledActionsInput, ...request.Option) *redshift.DescribeScheduledActionsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeScheduledActionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeScheduledActionsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeSnapshotCopyGrants provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeSnapshotCopyGrants(_a0 *redshift.DescribeSnapshotCopyGrantsInput) (*redshift.DescribeSnapshotCopyGrantsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeSnapshotCopyGrantsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeSnapshotCopyGrantsInput) *redshift.DescribeSnapshotCopyGrantsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeSnapshotCopyGrantsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeSnapshotCopyGrantsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeSnapshotCopyGrantsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeSnapshotCopyGrantsPages(_a0 *redshift.DescribeSnapshotCopyGrantsInput, _a1 func(*redshift.DescribeSnapshotCopyGrantsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeSnapshotCopyGrantsInput, func(*redshift.DescribeSnapshotCopyGrantsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeSnapshotCopyGrantsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeSnapshotCopyGrantsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeSnapshotCopyGrantsInput, _a2 func(*redshift.DescribeSnapshotCopyGrantsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeSnapshotCopyGrantsInput, func(*redshift.DescribeSnapshotCopyGrantsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeSnapshotCopyGrantsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeSnapshotCopyGrantsRequest(_a0 *redshift.DescribeSnapshotCopyGrantsInput) (*request.Request, *redshift.DescribeSnapshotCopyGrantsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeSnapshotCopyGrantsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeSnapshotCopyGrantsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeSnapshotCopyGrantsInput) *redshift.DescribeSnapshotCopyGrantsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeSnapshotCopyGrantsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeSnapshotCopyGrantsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeSnapshotCopyGrantsWithContext(_a0 context.Context, _a1 *redshift.DescribeSnapshotCopyGrantsInput, _a2 ...request.Option) (*redshift.DescribeSnapshotCopyGrantsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeSnapshotCopyGrantsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeSnapshotCopyGrantsInput, ...request.Option) *redshift.DescribeSnapshotCopyGrantsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeSnapshotCopyGrantsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeSnapshotCopyGrantsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeSnapshotSchedules provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeSnapshotSchedules(_a0 *redshift.DescribeSnapshotSchedulesInput) (*redshift.DescribeSnapshotSchedulesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeSnapshotSchedulesOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeSnapshotSchedulesInput) *redshift.DescribeSnapshotSchedulesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeSnapshotSchedulesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeSnapshotSchedulesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeSnapshotSchedulesPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeSnapshotSchedulesPages(_a0 *redshift.DescribeSnapshotSchedulesInput, _a1 func(*redshift.DescribeSnapshotSchedulesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeSnapshotSchedulesInput, func(*redshift.DescribeSnapshotSchedulesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeSnapshotSchedulesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeSnapshotSchedulesPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeSnapshotSchedulesInput, _a2 func(*redshift.DescribeSnapshotSchedulesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeSnapshotSchedulesInput, func(*redshift.DescribeSnapshotSchedulesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeSnapshotSchedulesRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeSnapshotSchedulesRequest(_a0 *redshift.DescribeSnapshotSchedulesInput) (*request.Request, *redshift.DescribeSnapshotSchedulesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeSnapshotSchedulesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeSnapshotSchedulesOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeSnapshotSchedulesInput) *redshift.DescribeSnapshotSchedulesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeSnapshotSchedulesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeSnapshotSchedulesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeSnapshotSchedulesWithContext(_a0 context.Context, _a1 *redshift.DescribeSnapshotSchedulesInput, _a2 ...request.Option) (*redshift.DescribeSnapshotSchedulesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeSnapshotSchedulesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeSnapshotSchedulesInput, ...request.Option) *redshift.DescribeSnapshotSchedulesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeSnapshotSchedulesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeSnapshotSchedulesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeStorage provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeStorage(_a0 *redshift.DescribeStorageInput) (*redshift.DescribeStorageOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeStorageOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeStorageInput) *redshift.DescribeStorageOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeStorageOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeStorageInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeStorageRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeStorageRequest(_a0 *redshift.DescribeStorageInput) (*request.Request, *redshift.DescribeStorageOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeStorageInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeStorageOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeStorageInput) *redshift.DescribeStorageOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeStorageOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeStorageWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeStorageWithContext(_a0 context.Context, _a1 *redshift.DescribeStorageInput, _a2 ...request.Option) (*redshift.DescribeStorageOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeStorageOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeStorageInput, ...request.Option) *redshift.DescribeStorageOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeStorageOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeStorageInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTableRestoreStatus provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeTableRestoreStatus(_a0 *redshift.DescribeTableRestoreStatusInput) (*redshift.DescribeTableRestoreStatusOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeTableRestoreStatusOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeTableRestoreStatusInput) *redshift.DescribeTableRestoreStatusOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeTableRestoreStatusOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeTableRestoreStatusInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTableRestoreStatusPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeTableRestoreStatusPages(_a0 *redshift.DescribeTableRestoreStatusInput, _a1 func(*redshift.DescribeTableRestoreStatusOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeTableRestoreStatusInput, func(*redshift.DescribeTableRestoreStatusOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeTableRestoreStatusPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeTableRestoreStatusPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeTableRestoreStatusInput, _a2 func(*redshift.DescribeTableRestoreStatusOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeTableRestoreStatusInput, func(*redshift.DescribeTableRestoreStatusOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeTableRestoreStatusRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeTableRestoreStatusRequest(_a0 *redshift.DescribeTableRestoreStatusInput) (*request.Request, *redshift.DescribeTableRestoreStatusOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeTableRestoreStatusInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeTableRestoreStatusOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeTableRestoreStatusInput) *redshift.DescribeTableRestoreStatusOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeTableRestoreStatusOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeTableRestoreStatusWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeTableRestoreStatusWithContext(_a0 context.Context, _a1 *redshift.DescribeTableRestoreStatusInput, _a2 ...request.Option) (*redshift.DescribeTableRestoreStatusOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeTableRestoreStatusOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeTableRestoreStatusInput, ...request.Option) *redshift.DescribeTableRestoreStatusOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeTableRestoreStatusOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeTableRestoreStatusInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTags provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeTags(_a0 *redshift.DescribeTagsInput) (*redshift.DescribeTagsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeTagsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeTagsInput) *redshift.DescribeTagsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeTagsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeTagsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTagsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeTagsPages(_a0 *redshift.DescribeTagsInput, _a1 func(*redshift.DescribeTagsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeTagsInput, func(*redshift.DescribeTagsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeTagsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeTagsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeTagsInput, _a2 func(*redshift.DescribeTagsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeTagsInput, func(*redshift.DescribeTagsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeTagsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeTagsRequest(_a0 *redshift.DescribeTagsInput) (*request.Request, *redshift.DescribeTagsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeTagsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeTagsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeTagsInput) *redshift.DescribeTagsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeTagsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeTagsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeTagsWithContext(_a0 context.Context, _a1 *redshift.DescribeTagsInput, _a2 ...request.Option) (*redshift.DescribeTagsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeTagsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeTagsInput, ...request.Option) *redshift.DescribeTagsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeTagsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeTagsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeUsageLimits provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeUsageLimits(_a0 *redshift.DescribeUsageLimitsInput) (*redshift.DescribeUsageLimitsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DescribeUsageLimitsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeUsageLimitsInput) *redshift.DescribeUsageLimitsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeUsageLimitsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeUsageLimitsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeUsageLimitsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) DescribeUsageLimitsPages(_a0 *redshift.DescribeUsageLimitsInput, _a1 func(*redshift.DescribeUsageLimitsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeUsageLimitsInput, func(*redshift.DescribeUsageLimitsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeUsageLimitsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) DescribeUsageLimitsPagesWithContext(_a0 context.Context, _a1 *redshift.DescribeUsageLimitsInput, _a2 func(*redshift.DescribeUsageLimitsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeUsageLimitsInput, func(*redshift.DescribeUsageLimitsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeUsageLimitsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DescribeUsageLimitsRequest(_a0 *redshift.DescribeUsageLimitsInput) (*request.Request, *redshift.DescribeUsageLimitsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeUsageLimitsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DescribeUsageLimitsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DescribeUsageLimitsInput) *redshift.DescribeUsageLimitsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DescribeUsageLimitsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeUsageLimitsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DescribeUsageLimitsWithContext(_a0 context.Context, _a1 *redshift.DescribeUsageLimitsInput, _a2 ...request.Option) (*redshift.DescribeUsageLimitsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DescribeUsageLimitsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeUsageLimitsInput, ...request.Option) *redshift.DescribeUsageLimitsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DescribeUsageLimitsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DescribeUsageLimitsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableLogging provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DisableLogging(_a0 *redshift.DisableLoggingInput) (*redshift.LoggingStatus, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.LoggingStatus
+	if rf, ok := ret.Get(0).(func(*redshift.DisableLoggingInput) *redshift.LoggingStatus); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.LoggingStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DisableLoggingInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableLoggingRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DisableLoggingRequest(_a0 *redshift.DisableLoggingInput) (*request.Request, *redshift.LoggingStatus) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DisableLoggingInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.LoggingStatus
+	if rf, ok := ret.Get(1).(func(*redshift.DisableLoggingInput) *redshift.LoggingStatus); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.LoggingStatus)
+		}
+	}
+
+	return r0, r1
+}
+
+// DisableLoggingWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DisableLoggingWithContext(_a0 context.Context, _a1 *redshift.DisableLoggingInput, _a2 ...request.Option) (*redshift.LoggingStatus, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.LoggingStatus
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DisableLoggingInput, ...request.Option) *redshift.LoggingStatus); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.LoggingStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DisableLoggingInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableSnapshotCopy provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DisableSnapshotCopy(_a0 *redshift.DisableSnapshotCopyInput) (*redshift.DisableSnapshotCopyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DisableSnapshotCopyOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DisableSnapshotCopyInput) *redshift.DisableSnapshotCopyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DisableSnapshotCopyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DisableSnapshotCopyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableSnapshotCopyRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DisableSnapshotCopyRequest(_a0 *redshift.DisableSnapshotCopyInput) (*request.Request, *redshift.DisableSnapshotCopyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DisableSnapshotCopyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DisableSnapshotCopyOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DisableSnapshotCopyInput) *redshift.DisableSnapshotCopyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DisableSnapshotCopyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DisableSnapshotCopyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DisableSnapshotCopyWithContext(_a0 context.Context, _a1 *redshift.DisableSnapshotCopyInput, _a2 ...request.Option) (*redshift.DisableSnapshotCopyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DisableSnapshotCopyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DisableSnapshotCopyInput, ...request.Option) *redshift.DisableSnapshotCopyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DisableSnapshotCopyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DisableSnapshotCopyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisassociateDataShareConsumer provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DisassociateDataShareConsumer(_a0 *redshift.DisassociateDataShareConsumerInput) (*redshift.DisassociateDataShareConsumerOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.DisassociateDataShareConsumerOutput
+	if rf, ok := ret.Get(0).(func(*redshift.DisassociateDataShareConsumerInput) *redshift.DisassociateDataShareConsumerOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DisassociateDataShareConsumerOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.DisassociateDataShareConsumerInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisassociateDataShareConsumerRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) DisassociateDataShareConsumerRequest(_a0 *redshift.DisassociateDataShareConsumerInput) (*request.Request, *redshift.DisassociateDataShareConsumerOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.DisassociateDataShareConsumerInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.DisassociateDataShareConsumerOutput
+	if rf, ok := ret.Get(1).(func(*redshift.DisassociateDataShareConsumerInput) *redshift.DisassociateDataShareConsumerOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.DisassociateDataShareConsumerOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DisassociateDataShareConsumerWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) DisassociateDataShareConsumerWithContext(_a0 context.Context, _a1 *redshift.DisassociateDataShareConsumerInput, _a2 ...request.Option) (*redshift.DisassociateDataShareConsumerOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.DisassociateDataShareConsumerOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DisassociateDataShareConsumerInput, ...request.Option) *redshift.DisassociateDataShareConsumerOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.DisassociateDataShareConsumerOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.DisassociateDataShareConsumerInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnableLogging provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) EnableLogging(_a0 *redshift.EnableLoggingInput) (*redshift.LoggingStatus, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.LoggingStatus
+	if rf, ok := ret.Get(0).(func(*redshift.EnableLoggingInput) *redshift.LoggingStatus); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.LoggingStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.EnableLoggingInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnableLoggingRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) EnableLoggingRequest(_a0 *redshift.EnableLoggingInput) (*request.Request, *redshift.LoggingStatus) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.EnableLoggingInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.LoggingStatus
+	if rf, ok := ret.Get(1).(func(*redshift.EnableLoggingInput) *redshift.LoggingStatus); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.LoggingStatus)
+		}
+	}
+
+	return r0, r1
+}
+
+// EnableLoggingWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) EnableLoggingWithContext(_a0 context.Context, _a1 *redshift.EnableLoggingInput, _a2 ...request.Option) (*redshift.LoggingStatus, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.LoggingStatus
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.EnableLoggingInput, ...request.Option) *redshift.LoggingStatus); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.LoggingStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.EnableLoggingInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnableSnapshotCopy provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) EnableSnapshotCopy(_a0 *redshift.EnableSnapshotCopyInput) (*redshift.EnableSnapshotCopyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.EnableSnapshotCopyOutput
+	if rf, ok := ret.Get(0).(func(*redshift.EnableSnapshotCopyInput) *redshift.EnableSnapshotCopyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.EnableSnapshotCopyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.EnableSnapshotCopyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnableSnapshotCopyRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) EnableSnapshotCopyRequest(_a0 *redshift.EnableSnapshotCopyInput) (*request.Request, *redshift.EnableSnapshotCopyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.EnableSnapshotCopyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.EnableSnapshotCopyOutput
+	if rf, ok := ret.Get(1).(func(*redshift.EnableSnapshotCopyInput) *redshift.EnableSnapshotCopyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.EnableSnapshotCopyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// EnableSnapshotCopyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) EnableSnapshotCopyWithContext(_a0 context.Context, _a1 *redshift.EnableSnapshotCopyInput, _a2 ...request.Option) (*redshift.EnableSnapshotCopyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.EnableSnapshotCopyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.EnableSnapshotCopyInput, ...request.Option) *redshift.EnableSnapshotCopyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.EnableSnapshotCopyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.EnableSnapshotCopyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FailoverPrimaryCompute provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) FailoverPrimaryCompute(_a0 *redshift.FailoverPrimaryComputeInput) (*redshift.FailoverPrimaryComputeOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.FailoverPrimaryComputeOutput
+	if rf, ok := ret.Get(0).(func(*redshift.FailoverPrimaryComputeInput) *redshift.FailoverPrimaryComputeOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.FailoverPrimaryComputeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.FailoverPrimaryComputeInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FailoverPrimaryComputeRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) FailoverPrimaryComputeRequest(_a0 *redshift.FailoverPrimaryComputeInput) (*request.Request, *redshift.FailoverPrimaryComputeOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.FailoverPrimaryComputeInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.FailoverPrimaryComputeOutput
+	if rf, ok := ret.Get(1).(func(*redshift.FailoverPrimaryComputeInput) *redshift.FailoverPrimaryComputeOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.FailoverPrimaryComputeOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// FailoverPrimaryComputeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) FailoverPrimaryComputeWithContext(_a0 context.Context, _a1 *redshift.FailoverPrimaryComputeInput, _a2 ...request.Option) (*redshift.FailoverPrimaryComputeOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.FailoverPrimaryComputeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.FailoverPrimaryComputeInput, ...request.Option) *redshift.FailoverPrimaryComputeOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.FailoverPrimaryComputeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.FailoverPrimaryComputeInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetClusterCredentials provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) GetClusterCredentials(_a0 *redshift.GetClusterCredentialsInput) (*redshift.GetClusterCredentialsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.GetClusterCredentialsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.GetClusterCredentialsInput) *redshift.GetClusterCredentialsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.GetClusterCredentialsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.GetClusterCredentialsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetClusterCredentialsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) GetClusterCredentialsRequest(_a0 *redshift.GetClusterCredentialsInput) (*request.Request, *redshift.GetClusterCredentialsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.GetClusterCredentialsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.GetClusterCredentialsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.GetClusterCredentialsInput) *redshift.GetClusterCredentialsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.GetClusterCredentialsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetClusterCredentialsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) GetClusterCredentialsWithContext(_a0 context.Context, _a1 *redshift.GetClusterCredentialsInput, _a2 ...request.Option) (*redshift.GetClusterCredentialsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.GetClusterCredentialsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.GetClusterCredentialsInput, ...request.Option) *redshift.GetClusterCredentialsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.GetClusterCredentialsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.GetClusterCredentialsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetClusterCredentialsWithIAM provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) GetClusterCredentialsWithIAM(_a0 *redshift.GetClusterCredentialsWithIAMInput) (*redshift.GetClusterCredentialsWithIAMOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.GetClusterCredentialsWithIAMOutput
+	if rf, ok := ret.Get(0).(func(*redshift.GetClusterCredentialsWithIAMInput) *redshift.GetClusterCredentialsWithIAMOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.GetClusterCredentialsWithIAMOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.GetClusterCredentialsWithIAMInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetClusterCredentialsWithIAMRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) GetClusterCredentialsWithIAMRequest(_a0 *redshift.GetClusterCredentialsWithIAMInput) (*request.Request, *redshift.GetClusterCredentialsWithIAMOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.GetClusterCredentialsWithIAMInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.GetClusterCredentialsWithIAMOutput
+	if rf, ok := ret.Get(1).(func(*redshift.GetClusterCredentialsWithIAMInput) *redshift.GetClusterCredentialsWithIAMOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.GetClusterCredentialsWithIAMOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetClusterCredentialsWithIAMWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) GetClusterCredentialsWithIAMWithContext(_a0 context.Context, _a1 *redshift.GetClusterCredentialsWithIAMInput, _a2 ...request.Option) (*redshift.GetClusterCredentialsWithIAMOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.GetClusterCredentialsWithIAMOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.GetClusterCredentialsWithIAMInput, ...request.Option) *redshift.GetClusterCredentialsWithIAMOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.GetClusterCredentialsWithIAMOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.GetClusterCredentialsWithIAMInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReservedNodeExchangeConfigurationOptions provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) GetReservedNodeExchangeConfigurationOptions(_a0 *redshift.GetReservedNodeExchangeConfigurationOptionsInput) (*redshift.GetReservedNodeExchangeConfigurationOptionsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.GetReservedNodeExchangeConfigurationOptionsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.GetReservedNodeExchangeConfigurationOptionsInput) *redshift.GetReservedNodeExchangeConfigurationOptionsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.GetReservedNodeExchangeConfigurationOptionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.GetReservedNodeExchangeConfigurationOptionsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReservedNodeExchangeConfigurationOptionsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) GetReservedNodeExchangeConfigurationOptionsPages(_a0 *redshift.GetReservedNodeExchangeConfigurationOptionsInput, _a1 func(*redshift.GetReservedNodeExchangeConfigurationOptionsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.GetReservedNodeExchangeConfigurationOptionsInput, func(*redshift.GetReservedNodeExchangeConfigurationOptionsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetReservedNodeExchangeConfigurationOptionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) GetReservedNodeExchangeConfigurationOptionsPagesWithContext(_a0 context.Context, _a1 *redshift.GetReservedNodeExchangeConfigurationOptionsInput, _a2 func(*redshift.GetReservedNodeExchangeConfigurationOptionsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.GetReservedNodeExchangeConfigurationOptionsInput, func(*redshift.GetReservedNodeExchangeConfigurationOptionsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetReservedNodeExchangeConfigurationOptionsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) GetReservedNodeExchangeConfigurationOptionsRequest(_a0 *redshift.GetReservedNodeExchangeConfigurationOptionsInput) (*request.Request, *redshift.GetReservedNodeExchangeConfigurationOptionsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.GetReservedNodeExchangeConfigurationOptionsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.GetReservedNodeExchangeConfigurationOptionsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.GetReservedNodeExchangeConfigurationOptionsInput) *redshift.GetReservedNodeExchangeConfigurationOptionsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.GetReservedNodeExchangeConfigurationOptionsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetReservedNodeExchangeConfigurationOptionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) GetReservedNodeExchangeConfigurationOptionsWithContext(_a0 context.Context, _a1 *redshift.GetReservedNodeExchangeConfigurationOptionsInput, _a2 ...request.Option) (*redshift.GetReservedNodeExchangeConfigurationOptionsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.GetReservedNodeExchangeConfigurationOptionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.GetReservedNodeExchangeConfigurationOptionsInput, ...request.Option) *redshift.GetReservedNodeExchangeConfigurationOptionsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.GetReservedNodeExchangeConfigurationOptionsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.GetReservedNodeExchangeConfigurationOptionsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReservedNodeExchangeOfferings provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) GetReservedNodeExchangeOfferings(_a0 *redshift.GetReservedNodeExchangeOfferingsInput) (*redshift.GetReservedNodeExchangeOfferingsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.GetReservedNodeExchangeOfferingsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.GetReservedNodeExchangeOfferingsInput) *redshift.GetReservedNodeExchangeOfferingsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.GetReservedNodeExchangeOfferingsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.GetReservedNodeExchangeOfferingsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReservedNodeExchangeOfferingsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) GetReservedNodeExchangeOfferingsPages(_a0 *redshift.GetReservedNodeExchangeOfferingsInput, _a1 func(*redshift.GetReservedNodeExchangeOfferingsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.GetReservedNodeExchangeOfferingsInput, func(*redshift.GetReservedNodeExchangeOfferingsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetReservedNodeExchangeOfferingsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) GetReservedNodeExchangeOfferingsPagesWithContext(_a0 context.Context, _a1 *redshift.GetReservedNodeExchangeOfferingsInput, _a2 func(*redshift.GetReservedNodeExchangeOfferingsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.GetReservedNodeExchangeOfferingsInput, func(*redshift.GetReservedNodeExchangeOfferingsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetReservedNodeExchangeOfferingsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) GetReservedNodeExchangeOfferingsRequest(_a0 *redshift.GetReservedNodeExchangeOfferingsInput) (*request.Request, *redshift.GetReservedNodeExchangeOfferingsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.GetReservedNodeExchangeOfferingsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.GetReservedNodeExchangeOfferingsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.GetReservedNodeExchangeOfferingsInput) *redshift.GetReservedNodeExchangeOfferingsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.GetReservedNodeExchangeOfferingsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetReservedNodeExchangeOfferingsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) GetReservedNodeExchangeOfferingsWithContext(_a0 context.Context, _a1 *redshift.GetReservedNodeExchangeOfferingsInput, _a2 ...request.Option) (*redshift.GetReservedNodeExchangeOfferingsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.GetReservedNodeExchangeOfferingsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.GetReservedNodeExchangeOfferingsInput, ...request.Option) *redshift.GetReservedNodeExchangeOfferingsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.GetReservedNodeExchangeOfferingsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.GetReservedNodeExchangeOfferingsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetResourcePolicy provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) GetResourcePolicy(_a0 *redshift.GetResourcePolicyInput) (*redshift.GetResourcePolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.GetResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(*redshift.GetResourcePolicyInput) *redshift.GetResourcePolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.GetResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.GetResourcePolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetResourcePolicyRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) GetResourcePolicyRequest(_a0 *redshift.GetResourcePolicyInput) (*request.Request, *redshift.GetResourcePolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.GetResourcePolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.GetResourcePolicyOutput
+	if rf, ok := ret.Get(1).(func(*redshift.GetResourcePolicyInput) *redshift.GetResourcePolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.GetResourcePolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetResourcePolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) GetResourcePolicyWithContext(_a0 context.Context, _a1 *redshift.GetResourcePolicyInput, _a2 ...request.Option) (*redshift.GetResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.GetResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.GetResourcePolicyInput, ...request.Option) *redshift.GetResourcePolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.GetResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.GetResourcePolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRecommendations provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ListRecommendations(_a0 *redshift.ListRecommendationsInput) (*redshift.ListRecommendationsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ListRecommendationsOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ListRecommendationsInput) *redshift.ListRecommendationsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ListRecommendationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ListRecommendationsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRecommendationsPages provides a mock function with given fields: _a0, _a1
+func (_m *RedshiftAPI) ListRecommendationsPages(_a0 *redshift.ListRecommendationsInput, _a1 func(*redshift.ListRecommendationsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.ListRecommendationsInput, func(*redshift.ListRecommendationsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListRecommendationsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RedshiftAPI) ListRecommendationsPagesWithContext(_a0 context.Context, _a1 *redshift.ListRecommendationsInput, _a2 func(*redshift.ListRecommendationsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ListRecommendationsInput, func(*redshift.ListRecommendationsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListRecommendationsRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ListRecommendationsRequest(_a0 *redshift.ListRecommendationsInput) (*request.Request, *redshift.ListRecommendationsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ListRecommendationsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ListRecommendationsOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ListRecommendationsInput) *redshift.ListRecommendationsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ListRecommendationsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListRecommendationsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ListRecommendationsWithContext(_a0 context.Context, _a1 *redshift.ListRecommendationsInput, _a2 ...request.Option) (*redshift.ListRecommendationsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ListRecommendationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ListRecommendationsInput, ...request.Option) *redshift.ListRecommendationsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ListRecommendationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ListRecommendationsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyAquaConfiguration provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyAquaConfiguration(_a0 *redshift.ModifyAquaConfigurationInput) (*redshift.ModifyAquaConfigurationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ModifyAquaConfigurationOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyAquaConfigurationInput) *redshift.ModifyAquaConfigurationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyAquaConfigurationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyAquaConfigurationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyAquaConfigurationRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyAquaConfigurationRequest(_a0 *redshift.ModifyAquaConfigurationInput) (*request.Request, *redshift.ModifyAquaConfigurationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyAquaConfigurationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ModifyAquaConfigurationOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyAquaConfigurationInput) *redshift.ModifyAquaConfigurationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ModifyAquaConfigurationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyAquaConfigurationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ModifyAquaConfigurationWithContext(_a0 context.Context, _a1 *redshift.ModifyAquaConfigurationInput, _a2 ...request.Option) (*redshift.ModifyAquaConfigurationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ModifyAquaConfigurationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ModifyAquaConfigurationInput, ...request.Option) *redshift.ModifyAquaConfigurationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyAquaConfigurationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ModifyAquaConfigurationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyAuthenticationProfile provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyAuthenticationProfile(_a0 *redshift.ModifyAuthenticationProfileInput) (*redshift.ModifyAuthenticationProfileOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ModifyAuthenticationProfileOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyAuthenticationProfileInput) *redshift.ModifyAuthenticationProfileOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyAuthenticationProfileOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyAuthenticationProfileInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyAuthenticationProfileRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyAuthenticationProfileRequest(_a0 *redshift.ModifyAuthenticationProfileInput) (*request.Request, *redshift.ModifyAuthenticationProfileOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyAuthenticationProfileInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ModifyAuthenticationProfileOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyAuthenticationProfileInput) *redshift.ModifyAuthenticationProfileOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ModifyAuthenticationProfileOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyAuthenticationProfileWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ModifyAuthenticationProfileWithContext(_a0 context.Context, _a1 *redshift.ModifyAuthenticationProfileInput, _a2 ...request.Option) (*redshift.ModifyAuthenticationProfileOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ModifyAuthenticationProfileOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ModifyAuthenticationProfileInput, ...request.Option) *redshift.ModifyAuthenticationProfileOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyAuthenticationProfileOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ModifyAuthenticationProfileInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyCluster provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyCluster(_a0 *redshift.ModifyClusterInput) (*redshift.ModifyClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ModifyClusterOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyClusterInput) *redshift.ModifyClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterDbRevision provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyClusterDbRevision(_a0 *redshift.ModifyClusterDbRevisionInput) (*redshift.ModifyClusterDbRevisionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ModifyClusterDbRevisionOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyClusterDbRevisionInput) *redshift.ModifyClusterDbRevisionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyClusterDbRevisionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyClusterDbRevisionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterDbRevisionRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyClusterDbRevisionRequest(_a0 *redshift.ModifyClusterDbRevisionInput) (*request.Request, *redshift.ModifyClusterDbRevisionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyClusterDbRevisionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ModifyClusterDbRevisionOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyClusterDbRevisionInput) *redshift.ModifyClusterDbRevisionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ModifyClusterDbRevisionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterDbRevisionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ModifyClusterDbRevisionWithContext(_a0 context.Context, _a1 *redshift.ModifyClusterDbRevisionInput, _a2 ...request.Option) (*redshift.ModifyClusterDbRevisionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ModifyClusterDbRevisionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ModifyClusterDbRevisionInput, ...request.Option) *redshift.ModifyClusterDbRevisionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyClusterDbRevisionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ModifyClusterDbRevisionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterIamRoles provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyClusterIamRoles(_a0 *redshift.ModifyClusterIamRolesInput) (*redshift.ModifyClusterIamRolesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ModifyClusterIamRolesOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyClusterIamRolesInput) *redshift.ModifyClusterIamRolesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyClusterIamRolesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyClusterIamRolesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterIamRolesRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyClusterIamRolesRequest(_a0 *redshift.ModifyClusterIamRolesInput) (*request.Request, *redshift.ModifyClusterIamRolesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyClusterIamRolesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ModifyClusterIamRolesOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyClusterIamRolesInput) *redshift.ModifyClusterIamRolesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ModifyClusterIamRolesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterIamRolesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ModifyClusterIamRolesWithContext(_a0 context.Context, _a1 *redshift.ModifyClusterIamRolesInput, _a2 ...request.Option) (*redshift.ModifyClusterIamRolesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ModifyClusterIamRolesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ModifyClusterIamRolesInput, ...request.Option) *redshift.ModifyClusterIamRolesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyClusterIamRolesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ModifyClusterIamRolesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterMaintenance provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyClusterMaintenance(_a0 *redshift.ModifyClusterMaintenanceInput) (*redshift.ModifyClusterMaintenanceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ModifyClusterMaintenanceOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyClusterMaintenanceInput) *redshift.ModifyClusterMaintenanceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyClusterMaintenanceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyClusterMaintenanceInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterMaintenanceRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyClusterMaintenanceRequest(_a0 *redshift.ModifyClusterMaintenanceInput) (*request.Request, *redshift.ModifyClusterMaintenanceOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyClusterMaintenanceInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ModifyClusterMaintenanceOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyClusterMaintenanceInput) *redshift.ModifyClusterMaintenanceOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ModifyClusterMaintenanceOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterMaintenanceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ModifyClusterMaintenanceWithContext(_a0 context.Context, _a1 *redshift.ModifyClusterMaintenanceInput, _a2 ...request.Option) (*redshift.ModifyClusterMaintenanceOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ModifyClusterMaintenanceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ModifyClusterMaintenanceInput, ...request.Option) *redshift.ModifyClusterMaintenanceOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyClusterMaintenanceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ModifyClusterMaintenanceInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterParameterGroup provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyClusterParameterGroup(_a0 *redshift.ModifyClusterParameterGroupInput) (*redshift.ClusterParameterGroupNameMessage, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ClusterParameterGroupNameMessage
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyClusterParameterGroupInput) *redshift.ClusterParameterGroupNameMessage); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ClusterParameterGroupNameMessage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyClusterParameterGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterParameterGroupRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyClusterParameterGroupRequest(_a0 *redshift.ModifyClusterParameterGroupInput) (*request.Request, *redshift.ClusterParameterGroupNameMessage) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyClusterParameterGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ClusterParameterGroupNameMessage
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyClusterParameterGroupInput) *redshift.ClusterParameterGroupNameMessage); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ClusterParameterGroupNameMessage)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ModifyClusterParameterGroupWithContext(_a0 context.Context, _a1 *redshift.ModifyClusterParameterGroupInput, _a2 ...request.Option) (*redshift.ClusterParameterGroupNameMessage, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ClusterParameterGroupNameMessage
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ModifyClusterParameterGroupInput, ...request.Option) *redshift.ClusterParameterGroupNameMessage); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ClusterParameterGroupNameMessage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ModifyClusterParameterGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyClusterRequest(_a0 *redshift.ModifyClusterInput) (*request.Request, *redshift.ModifyClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ModifyClusterOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyClusterInput) *redshift.ModifyClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ModifyClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterSnapshot provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyClusterSnapshot(_a0 *redshift.ModifyClusterSnapshotInput) (*redshift.ModifyClusterSnapshotOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ModifyClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyClusterSnapshotInput) *redshift.ModifyClusterSnapshotOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyClusterSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyClusterSnapshotInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterSnapshotRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyClusterSnapshotRequest(_a0 *redshift.ModifyClusterSnapshotInput) (*request.Request, *redshift.ModifyClusterSnapshotOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyClusterSnapshotInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ModifyClusterSnapshotOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyClusterSnapshotInput) *redshift.ModifyClusterSnapshotOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ModifyClusterSnapshotOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterSnapshotSchedule provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyClusterSnapshotSchedule(_a0 *redshift.ModifyClusterSnapshotScheduleInput) (*redshift.ModifyClusterSnapshotScheduleOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ModifyClusterSnapshotScheduleOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyClusterSnapshotScheduleInput) *redshift.ModifyClusterSnapshotScheduleOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyClusterSnapshotScheduleOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyClusterSnapshotScheduleInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterSnapshotScheduleRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyClusterSnapshotScheduleRequest(_a0 *redshift.ModifyClusterSnapshotScheduleInput) (*request.Request, *redshift.ModifyClusterSnapshotScheduleOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyClusterSnapshotScheduleInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ModifyClusterSnapshotScheduleOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyClusterSnapshotScheduleInput) *redshift.ModifyClusterSnapshotScheduleOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ModifyClusterSnapshotScheduleOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterSnapshotScheduleWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ModifyClusterSnapshotScheduleWithContext(_a0 context.Context, _a1 *redshift.ModifyClusterSnapshotScheduleInput, _a2 ...request.Option) (*redshift.ModifyClusterSnapshotScheduleOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ModifyClusterSnapshotScheduleOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ModifyClusterSnapshotScheduleInput, ...request.Option) *redshift.ModifyClusterSnapshotScheduleOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyClusterSnapshotScheduleOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ModifyClusterSnapshotScheduleInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ModifyClusterSnapshotWithContext(_a0 context.Context, _a1 *redshift.ModifyClusterSnapshotInput, _a2 ...request.Option) (*redshift.ModifyClusterSnapshotOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ModifyClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ModifyClusterSnapshotInput, ...request.Option) *redshift.ModifyClusterSnapshotOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyClusterSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ModifyClusterSnapshotInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterSubnetGroup provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyClusterSubnetGroup(_a0 *redshift.ModifyClusterSubnetGroupInput) (*redshift.ModifyClusterSubnetGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ModifyClusterSubnetGroupOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyClusterSubnetGroupInput) *redshift.ModifyClusterSubnetGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyClusterSubnetGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyClusterSubnetGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterSubnetGroupRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyClusterSubnetGroupRequest(_a0 *redshift.ModifyClusterSubnetGroupInput) (*request.Request, *redshift.ModifyClusterSubnetGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyClusterSubnetGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ModifyClusterSubnetGroupOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyClusterSubnetGroupInput) *redshift.ModifyClusterSubnetGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ModifyClusterSubnetGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterSubnetGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ModifyClusterSubnetGroupWithContext(_a0 context.Context, _a1 *redshift.ModifyClusterSubnetGroupInput, _a2 ...request.Option) (*redshift.ModifyClusterSubnetGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ModifyClusterSubnetGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ModifyClusterSubnetGroupInput, ...request.Option) *redshift.ModifyClusterSubnetGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyClusterSubnetGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ModifyClusterSubnetGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ModifyClusterWithContext(_a0 context.Context, _a1 *redshift.ModifyClusterInput, _a2 ...request.Option) (*redshift.ModifyClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ModifyClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ModifyClusterInput, ...request.Option) *redshift.ModifyClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ModifyClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyCustomDomainAssociation provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyCustomDomainAssociation(_a0 *redshift.ModifyCustomDomainAssociationInput) (*redshift.ModifyCustomDomainAssociationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ModifyCustomDomainAssociationOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyCustomDomainAssociationInput) *redshift.ModifyCustomDomainAssociationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyCustomDomainAssociationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyCustomDomainAssociationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyCustomDomainAssociationRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyCustomDomainAssociationRequest(_a0 *redshift.ModifyCustomDomainAssociationInput) (*request.Request, *redshift.ModifyCustomDomainAssociationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyCustomDomainAssociationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ModifyCustomDomainAssociationOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyCustomDomainAssociationInput) *redshift.ModifyCustomDomainAssociationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ModifyCustomDomainAssociationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyCustomDomainAssociationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ModifyCustomDomainAssociationWithContext(_a0 context.Context, _a1 *redshift.ModifyCustomDomainAssociationInput, _a2 ...request.Option) (*redshift.ModifyCustomDomainAssociationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ModifyCustomDomainAssociationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ModifyCustomDomainAssociationInput, ...request.Option) *redshift.ModifyCustomDomainAssociationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyCustomDomainAssociationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ModifyCustomDomainAssociationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyEndpointAccess provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyEndpointAccess(_a0 *redshift.ModifyEndpointAccessInput) (*redshift.ModifyEndpointAccessOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ModifyEndpointAccessOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyEndpointAccessInput) *redshift.ModifyEndpointAccessOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyEndpointAccessOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyEndpointAccessInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyEndpointAccessRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyEndpointAccessRequest(_a0 *redshift.ModifyEndpointAccessInput) (*request.Request, *redshift.ModifyEndpointAccessOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyEndpointAccessInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ModifyEndpointAccessOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyEndpointAccessInput) *redshift.ModifyEndpointAccessOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ModifyEndpointAccessOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyEndpointAccessWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ModifyEndpointAccessWithContext(_a0 context.Context, _a1 *redshift.ModifyEndpointAccessInput, _a2 ...request.Option) (*redshift.ModifyEndpointAccessOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ModifyEndpointAccessOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ModifyEndpointAccessInput, ...request.Option) *redshift.ModifyEndpointAccessOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyEndpointAccessOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ModifyEndpointAccessInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyEventSubscription provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyEventSubscription(_a0 *redshift.ModifyEventSubscriptionInput) (*redshift.ModifyEventSubscriptionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ModifyEventSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyEventSubscriptionInput) *redshift.ModifyEventSubscriptionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyEventSubscriptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyEventSubscriptionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyEventSubscriptionRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyEventSubscriptionRequest(_a0 *redshift.ModifyEventSubscriptionInput) (*request.Request, *redshift.ModifyEventSubscriptionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyEventSubscriptionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ModifyEventSubscriptionOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyEventSubscriptionInput) *redshift.ModifyEventSubscriptionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ModifyEventSubscriptionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyEventSubscriptionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ModifyEventSubscriptionWithContext(_a0 context.Context, _a1 *redshift.ModifyEventSubscriptionInput, _a2 ...request.Option) (*redshift.ModifyEventSubscriptionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ModifyEventSubscriptionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ModifyEventSubscriptionInput, ...request.Option) *redshift.ModifyEventSubscriptionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyEventSubscriptionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ModifyEventSubscriptionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyRedshiftIdcApplication provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyRedshiftIdcApplication(_a0 *redshift.ModifyRedshiftIdcApplicationInput) (*redshift.ModifyRedshiftIdcApplicationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ModifyRedshiftIdcApplicationOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyRedshiftIdcApplicationInput) *redshift.ModifyRedshiftIdcApplicationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyRedshiftIdcApplicationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyRedshiftIdcApplicationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyRedshiftIdcApplicationRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyRedshiftIdcApplicationRequest(_a0 *redshift.ModifyRedshiftIdcApplicationInput) (*request.Request, *redshift.ModifyRedshiftIdcApplicationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyRedshiftIdcApplicationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ModifyRedshiftIdcApplicationOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyRedshiftIdcApplicationInput) *redshift.ModifyRedshiftIdcApplicationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ModifyRedshiftIdcApplicationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyRedshiftIdcApplicationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ModifyRedshiftIdcApplicationWithContext(_a0 context.Context, _a1 *redshift.ModifyRedshiftIdcApplicationInput, _a2 ...request.Option) (*redshift.ModifyRedshiftIdcApplicationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ModifyRedshiftIdcApplicationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ModifyRedshiftIdcApplicationInput, ...request.Option) *redshift.ModifyRedshiftIdcApplicationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyRedshiftIdcApplicationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ModifyRedshiftIdcApplicationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyScheduledAction provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyScheduledAction(_a0 *redshift.ModifyScheduledActionInput) (*redshift.ModifyScheduledActionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ModifyScheduledActionOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyScheduledActionInput) *redshift.ModifyScheduledActionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyScheduledActionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyScheduledActionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyScheduledActionRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyScheduledActionRequest(_a0 *redshift.ModifyScheduledActionInput) (*request.Request, *redshift.ModifyScheduledActionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyScheduledActionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ModifyScheduledActionOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyScheduledActionInput) *redshift.ModifyScheduledActionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ModifyScheduledActionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyScheduledActionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ModifyScheduledActionWithContext(_a0 context.Context, _a1 *redshift.ModifyScheduledActionInput, _a2 ...request.Option) (*redshift.ModifyScheduledActionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ModifyScheduledActionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ModifyScheduledActionInput, ...request.Option) *redshift.ModifyScheduledActionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyScheduledActionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ModifyScheduledActionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifySnapshotCopyRetentionPeriod provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifySnapshotCopyRetentionPeriod(_a0 *redshift.ModifySnapshotCopyRetentionPeriodInput) (*redshift.ModifySnapshotCopyRetentionPeriodOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ModifySnapshotCopyRetentionPeriodOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ModifySnapshotCopyRetentionPeriodInput) *redshift.ModifySnapshotCopyRetentionPeriodOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifySnapshotCopyRetentionPeriodOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ModifySnapshotCopyRetentionPeriodInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifySnapshotCopyRetentionPeriodRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifySnapshotCopyRetentionPeriodRequest(_a0 *redshift.ModifySnapshotCopyRetentionPeriodInput) (*request.Request, *redshift.ModifySnapshotCopyRetentionPeriodOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ModifySnapshotCopyRetentionPeriodInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ModifySnapshotCopyRetentionPeriodOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ModifySnapshotCopyRetentionPeriodInput) *redshift.ModifySnapshotCopyRetentionPeriodOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ModifySnapshotCopyRetentionPeriodOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifySnapshotCopyRetentionPeriodWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ModifySnapshotCopyRetentionPeriodWithContext(_a0 context.Context, _a1 *redshift.ModifySnapshotCopyRetentionPeriodInput, _a2 ...request.Option) (*redshift.ModifySnapshotCopyRetentionPeriodOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ModifySnapshotCopyRetentionPeriodOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ModifySnapshotCopyRetentionPeriodInput, ...request.Option) *redshift.ModifySnapshotCopyRetentionPeriodOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifySnapshotCopyRetentionPeriodOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ModifySnapshotCopyRetentionPeriodInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifySnapshotSchedule provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifySnapshotSchedule(_a0 *redshift.ModifySnapshotScheduleInput) (*redshift.ModifySnapshotScheduleOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ModifySnapshotScheduleOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ModifySnapshotScheduleInput) *redshift.ModifySnapshotScheduleOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifySnapshotScheduleOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ModifySnapshotScheduleInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifySnapshotScheduleRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifySnapshotScheduleRequest(_a0 *redshift.ModifySnapshotScheduleInput) (*request.Request, *redshift.ModifySnapshotScheduleOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ModifySnapshotScheduleInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ModifySnapshotScheduleOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ModifySnapshotScheduleInput) *redshift.ModifySnapshotScheduleOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ModifySnapshotScheduleOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifySnapshotScheduleWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ModifySnapshotScheduleWithContext(_a0 context.Context, _a1 *redshift.ModifySnapshotScheduleInput, _a2 ...request.Option) (*redshift.ModifySnapshotScheduleOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ModifySnapshotScheduleOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ModifySnapshotScheduleInput, ...request.Option) *redshift.ModifySnapshotScheduleOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifySnapshotScheduleOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ModifySnapshotScheduleInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyUsageLimit provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyUsageLimit(_a0 *redshift.ModifyUsageLimitInput) (*redshift.ModifyUsageLimitOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ModifyUsageLimitOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyUsageLimitInput) *redshift.ModifyUsageLimitOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyUsageLimitOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyUsageLimitInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModifyUsageLimitRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ModifyUsageLimitRequest(_a0 *redshift.ModifyUsageLimitInput) (*request.Request, *redshift.ModifyUsageLimitOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ModifyUsageLimitInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ModifyUsageLimitOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ModifyUsageLimitInput) *redshift.ModifyUsageLimitOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ModifyUsageLimitOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ModifyUsageLimitWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ModifyUsageLimitWithContext(_a0 context.Context, _a1 *redshift.ModifyUsageLimitInput, _a2 ...request.Option) (*redshift.ModifyUsageLimitOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ModifyUsageLimitOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ModifyUsageLimitInput, ...request.Option) *redshift.ModifyUsageLimitOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ModifyUsageLimitOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ModifyUsageLimitInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PauseCluster provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) PauseCluster(_a0 *redshift.PauseClusterInput) (*redshift.PauseClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.PauseClusterOutput
+	if rf, ok := ret.Get(0).(func(*redshift.PauseClusterInput) *redshift.PauseClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.PauseClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.PauseClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PauseClusterRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) PauseClusterRequest(_a0 *redshift.PauseClusterInput) (*request.Request, *redshift.PauseClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.PauseClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.PauseClusterOutput
+	if rf, ok := ret.Get(1).(func(*redshift.PauseClusterInput) *redshift.PauseClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.PauseClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PauseClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) PauseClusterWithContext(_a0 context.Context, _a1 *redshift.PauseClusterInput, _a2 ...request.Option) (*redshift.PauseClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.PauseClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.PauseClusterInput, ...request.Option) *redshift.PauseClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.PauseClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.PauseClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurchaseReservedNodeOffering provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) PurchaseReservedNodeOffering(_a0 *redshift.PurchaseReservedNodeOfferingInput) (*redshift.PurchaseReservedNodeOfferingOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.PurchaseReservedNodeOfferingOutput
+	if rf, ok := ret.Get(0).(func(*redshift.PurchaseReservedNodeOfferingInput) *redshift.PurchaseReservedNodeOfferingOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.PurchaseReservedNodeOfferingOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.PurchaseReservedNodeOfferingInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurchaseReservedNodeOfferingRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) PurchaseReservedNodeOfferingRequest(_a0 *redshift.PurchaseReservedNodeOfferingInput) (*request.Request, *redshift.PurchaseReservedNodeOfferingOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.PurchaseReservedNodeOfferingInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.PurchaseReservedNodeOfferingOutput
+	if rf, ok := ret.Get(1).(func(*redshift.PurchaseReservedNodeOfferingInput) *redshift.PurchaseReservedNodeOfferingOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.PurchaseReservedNodeOfferingOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PurchaseReservedNodeOfferingWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) PurchaseReservedNodeOfferingWithContext(_a0 context.Context, _a1 *redshift.PurchaseReservedNodeOfferingInput, _a2 ...request.Option) (*redshift.PurchaseReservedNodeOfferingOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.PurchaseReservedNodeOfferingOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.PurchaseReservedNodeOfferingInput, ...request.Option) *redshift.PurchaseReservedNodeOfferingOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.PurchaseReservedNodeOfferingOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.PurchaseReservedNodeOfferingInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutResourcePolicy provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) PutResourcePolicy(_a0 *redshift.PutResourcePolicyInput) (*redshift.PutResourcePolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.PutResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(*redshift.PutResourcePolicyInput) *redshift.PutResourcePolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.PutResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.PutResourcePolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutResourcePolicyRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) PutResourcePolicyRequest(_a0 *redshift.PutResourcePolicyInput) (*request.Request, *redshift.PutResourcePolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.PutResourcePolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.PutResourcePolicyOutput
+	if rf, ok := ret.Get(1).(func(*redshift.PutResourcePolicyInput) *redshift.PutResourcePolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.PutResourcePolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PutResourcePolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) PutResourcePolicyWithContext(_a0 context.Context, _a1 *redshift.PutResourcePolicyInput, _a2 ...request.Option) (*redshift.PutResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.PutResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.PutResourcePolicyInput, ...request.Option) *redshift.PutResourcePolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.PutResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.PutResourcePolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RebootCluster provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) RebootCluster(_a0 *redshift.RebootClusterInput) (*redshift.RebootClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.RebootClusterOutput
+	if rf, ok := ret.Get(0).(func(*redshift.RebootClusterInput) *redshift.RebootClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.RebootClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.RebootClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RebootClusterRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) RebootClusterRequest(_a0 *redshift.RebootClusterInput) (*request.Request, *redshift.RebootClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.RebootClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.RebootClusterOutput
+	if rf, ok := ret.Get(1).(func(*redshift.RebootClusterInput) *redshift.RebootClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.RebootClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RebootClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) RebootClusterWithContext(_a0 context.Context, _a1 *redshift.RebootClusterInput, _a2 ...request.Option) (*redshift.RebootClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.RebootClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.RebootClusterInput, ...request.Option) *redshift.RebootClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.RebootClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.RebootClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RejectDataShare provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) RejectDataShare(_a0 *redshift.RejectDataShareInput) (*redshift.RejectDataShareOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.RejectDataShareOutput
+	if rf, ok := ret.Get(0).(func(*redshift.RejectDataShareInput) *redshift.RejectDataShareOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.RejectDataShareOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.RejectDataShareInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RejectDataShareRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) RejectDataShareRequest(_a0 *redshift.RejectDataShareInput) (*request.Request, *redshift.RejectDataShareOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.RejectDataShareInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.RejectDataShareOutput
+	if rf, ok := ret.Get(1).(func(*redshift.RejectDataShareInput) *redshift.RejectDataShareOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.RejectDataShareOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RejectDataShareWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) RejectDataShareWithContext(_a0 context.Context, _a1 *redshift.RejectDataShareInput, _a2 ...request.Option) (*redshift.RejectDataShareOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.RejectDataShareOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.RejectDataShareInput, ...request.Option) *redshift.RejectDataShareOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.RejectDataShareOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.RejectDataShareInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetClusterParameterGroup provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ResetClusterParameterGroup(_a0 *redshift.ResetClusterParameterGroupInput) (*redshift.ClusterParameterGroupNameMessage, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ClusterParameterGroupNameMessage
+	if rf, ok := ret.Get(0).(func(*redshift.ResetClusterParameterGroupInput) *redshift.ClusterParameterGroupNameMessage); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ClusterParameterGroupNameMessage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ResetClusterParameterGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetClusterParameterGroupRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ResetClusterParameterGroupRequest(_a0 *redshift.ResetClusterParameterGroupInput) (*request.Request, *redshift.ClusterParameterGroupNameMessage) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ResetClusterParameterGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ClusterParameterGroupNameMessage
+	if rf, ok := ret.Get(1).(func(*redshift.ResetClusterParameterGroupInput) *redshift.ClusterParameterGroupNameMessage); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ClusterParameterGroupNameMessage)
+		}
+	}
+
+	return r0, r1
+}
+
+// ResetClusterParameterGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ResetClusterParameterGroupWithContext(_a0 context.Context, _a1 *redshift.ResetClusterParameterGroupInput, _a2 ...request.Option) (*redshift.ClusterParameterGroupNameMessage, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ClusterParameterGroupNameMessage
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ResetClusterParameterGroupInput, ...request.Option) *redshift.ClusterParameterGroupNameMessage); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ClusterParameterGroupNameMessage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ResetClusterParameterGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResizeCluster provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ResizeCluster(_a0 *redshift.ResizeClusterInput) (*redshift.ResizeClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ResizeClusterOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ResizeClusterInput) *redshift.ResizeClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ResizeClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ResizeClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResizeClusterRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ResizeClusterRequest(_a0 *redshift.ResizeClusterInput) (*request.Request, *redshift.ResizeClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ResizeClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ResizeClusterOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ResizeClusterInput) *redshift.ResizeClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ResizeClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ResizeClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ResizeClusterWithContext(_a0 context.Context, _a1 *redshift.ResizeClusterInput, _a2 ...request.Option) (*redshift.ResizeClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ResizeClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ResizeClusterInput, ...request.Option) *redshift.ResizeClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ResizeClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ResizeClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreFromClusterSnapshot provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) RestoreFromClusterSnapshot(_a0 *redshift.RestoreFromClusterSnapshotInput) (*redshift.RestoreFromClusterSnapshotOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.RestoreFromClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(*redshift.RestoreFromClusterSnapshotInput) *redshift.RestoreFromClusterSnapshotOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.RestoreFromClusterSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.RestoreFromClusterSnapshotInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreFromClusterSnapshotRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) RestoreFromClusterSnapshotRequest(_a0 *redshift.RestoreFromClusterSnapshotInput) (*request.Request, *redshift.RestoreFromClusterSnapshotOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.RestoreFromClusterSnapshotInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.RestoreFromClusterSnapshotOutput
+	if rf, ok := ret.Get(1).(func(*redshift.RestoreFromClusterSnapshotInput) *redshift.RestoreFromClusterSnapshotOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.RestoreFromClusterSnapshotOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RestoreFromClusterSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) RestoreFromClusterSnapshotWithContext(_a0 context.Context, _a1 *redshift.RestoreFromClusterSnapshotInput, _a2 ...request.Option) (*redshift.RestoreFromClusterSnapshotOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.RestoreFromClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.RestoreFromClusterSnapshotInput, ...request.Option) *redshift.RestoreFromClusterSnapshotOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.RestoreFromClusterSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.RestoreFromClusterSnapshotInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreTableFromClusterSnapshot provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) RestoreTableFromClusterSnapshot(_a0 *redshift.RestoreTableFromClusterSnapshotInput) (*redshift.RestoreTableFromClusterSnapshotOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.RestoreTableFromClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(*redshift.RestoreTableFromClusterSnapshotInput) *redshift.RestoreTableFromClusterSnapshotOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.RestoreTableFromClusterSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.RestoreTableFromClusterSnapshotInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreTableFromClusterSnapshotRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) RestoreTableFromClusterSnapshotRequest(_a0 *redshift.RestoreTableFromClusterSnapshotInput) (*request.Request, *redshift.RestoreTableFromClusterSnapshotOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.RestoreTableFromClusterSnapshotInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.RestoreTableFromClusterSnapshotOutput
+	if rf, ok := ret.Get(1).(func(*redshift.RestoreTableFromClusterSnapshotInput) *redshift.RestoreTableFromClusterSnapshotOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.RestoreTableFromClusterSnapshotOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RestoreTableFromClusterSnapshotWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) RestoreTableFromClusterSnapshotWithContext(_a0 context.Context, _a1 *redshift.RestoreTableFromClusterSnapshotInput, _a2 ...request.Option) (*redshift.RestoreTableFromClusterSnapshotOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.RestoreTableFromClusterSnapshotOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.RestoreTableFromClusterSnapshotInput, ...request.Option) *redshift.RestoreTableFromClusterSnapshotOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.RestoreTableFromClusterSnapshotOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.RestoreTableFromClusterSnapshotInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResumeCluster provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ResumeCluster(_a0 *redshift.ResumeClusterInput) (*redshift.ResumeClusterOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.ResumeClusterOutput
+	if rf, ok := ret.Get(0).(func(*redshift.ResumeClusterInput) *redshift.ResumeClusterOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ResumeClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.ResumeClusterInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResumeClusterRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) ResumeClusterRequest(_a0 *redshift.ResumeClusterInput) (*request.Request, *redshift.ResumeClusterOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.ResumeClusterInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.ResumeClusterOutput
+	if rf, ok := ret.Get(1).(func(*redshift.ResumeClusterInput) *redshift.ResumeClusterOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.ResumeClusterOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ResumeClusterWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) ResumeClusterWithContext(_a0 context.Context, _a1 *redshift.ResumeClusterInput, _a2 ...request.Option) (*redshift.ResumeClusterOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.ResumeClusterOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.ResumeClusterInput, ...request.Option) *redshift.ResumeClusterOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.ResumeClusterOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.ResumeClusterInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeClusterSecurityGroupIngress provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) RevokeClusterSecurityGroupIngress(_a0 *redshift.RevokeClusterSecurityGroupIngressInput) (*redshift.RevokeClusterSecurityGroupIngressOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.RevokeClusterSecurityGroupIngressOutput
+	if rf, ok := ret.Get(0).(func(*redshift.RevokeClusterSecurityGroupIngressInput) *redshift.RevokeClusterSecurityGroupIngressOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.RevokeClusterSecurityGroupIngressOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.RevokeClusterSecurityGroupIngressInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeClusterSecurityGroupIngressRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) RevokeClusterSecurityGroupIngressRequest(_a0 *redshift.RevokeClusterSecurityGroupIngressInput) (*request.Request, *redshift.RevokeClusterSecurityGroupIngressOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.RevokeClusterSecurityGroupIngressInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.RevokeClusterSecurityGroupIngressOutput
+	if rf, ok := ret.Get(1).(func(*redshift.RevokeClusterSecurityGroupIngressInput) *redshift.RevokeClusterSecurityGroupIngressOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.RevokeClusterSecurityGroupIngressOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RevokeClusterSecurityGroupIngressWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) RevokeClusterSecurityGroupIngressWithContext(_a0 context.Context, _a1 *redshift.RevokeClusterSecurityGroupIngressInput, _a2 ...request.Option) (*redshift.RevokeClusterSecurityGroupIngressOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.RevokeClusterSecurityGroupIngressOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.RevokeClusterSecurityGroupIngressInput, ...request.Option) *redshift.RevokeClusterSecurityGroupIngressOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.RevokeClusterSecurityGroupIngressOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.RevokeClusterSecurityGroupIngressInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeEndpointAccess provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) RevokeEndpointAccess(_a0 *redshift.RevokeEndpointAccessInput) (*redshift.RevokeEndpointAccessOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.RevokeEndpointAccessOutput
+	if rf, ok := ret.Get(0).(func(*redshift.RevokeEndpointAccessInput) *redshift.RevokeEndpointAccessOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.RevokeEndpointAccessOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.RevokeEndpointAccessInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeEndpointAccessRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) RevokeEndpointAccessRequest(_a0 *redshift.RevokeEndpointAccessInput) (*request.Request, *redshift.RevokeEndpointAccessOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.RevokeEndpointAccessInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.RevokeEndpointAccessOutput
+	if rf, ok := ret.Get(1).(func(*redshift.RevokeEndpointAccessInput) *redshift.RevokeEndpointAccessOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.RevokeEndpointAccessOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RevokeEndpointAccessWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) RevokeEndpointAccessWithContext(_a0 context.Context, _a1 *redshift.RevokeEndpointAccessInput, _a2 ...request.Option) (*redshift.RevokeEndpointAccessOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.RevokeEndpointAccessOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.RevokeEndpointAccessInput, ...request.Option) *redshift.RevokeEndpointAccessOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.RevokeEndpointAccessOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.RevokeEndpointAccessInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeSnapshotAccess provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) RevokeSnapshotAccess(_a0 *redshift.RevokeSnapshotAccessInput) (*redshift.RevokeSnapshotAccessOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.RevokeSnapshotAccessOutput
+	if rf, ok := ret.Get(0).(func(*redshift.RevokeSnapshotAccessInput) *redshift.RevokeSnapshotAccessOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.RevokeSnapshotAccessOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.RevokeSnapshotAccessInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeSnapshotAccessRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) RevokeSnapshotAccessRequest(_a0 *redshift.RevokeSnapshotAccessInput) (*request.Request, *redshift.RevokeSnapshotAccessOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.RevokeSnapshotAccessInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.RevokeSnapshotAccessOutput
+	if rf, ok := ret.Get(1).(func(*redshift.RevokeSnapshotAccessInput) *redshift.RevokeSnapshotAccessOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.RevokeSnapshotAccessOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RevokeSnapshotAccessWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) RevokeSnapshotAccessWithContext(_a0 context.Context, _a1 *redshift.RevokeSnapshotAccessInput, _a2 ...request.Option) (*redshift.RevokeSnapshotAccessOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.RevokeSnapshotAccessOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.RevokeSnapshotAccessInput, ...request.Option) *redshift.RevokeSnapshotAccessOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.RevokeSnapshotAccessOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.RevokeSnapshotAccessInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RotateEncryptionKey provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) RotateEncryptionKey(_a0 *redshift.RotateEncryptionKeyInput) (*redshift.RotateEncryptionKeyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.RotateEncryptionKeyOutput
+	if rf, ok := ret.Get(0).(func(*redshift.RotateEncryptionKeyInput) *redshift.RotateEncryptionKeyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.RotateEncryptionKeyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.RotateEncryptionKeyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RotateEncryptionKeyRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) RotateEncryptionKeyRequest(_a0 *redshift.RotateEncryptionKeyInput) (*request.Request, *redshift.RotateEncryptionKeyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.RotateEncryptionKeyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.RotateEncryptionKeyOutput
+	if rf, ok := ret.Get(1).(func(*redshift.RotateEncryptionKeyInput) *redshift.RotateEncryptionKeyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.RotateEncryptionKeyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RotateEncryptionKeyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) RotateEncryptionKeyWithContext(_a0 context.Context, _a1 *redshift.RotateEncryptionKeyInput, _a2 ...request.Option) (*redshift.RotateEncryptionKeyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.RotateEncryptionKeyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.RotateEncryptionKeyInput, ...request.Option) *redshift.RotateEncryptionKeyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.RotateEncryptionKeyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.RotateEncryptionKeyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePartnerStatus provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) UpdatePartnerStatus(_a0 *redshift.UpdatePartnerStatusInput) (*redshift.UpdatePartnerStatusOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *redshift.UpdatePartnerStatusOutput
+	if rf, ok := ret.Get(0).(func(*redshift.UpdatePartnerStatusInput) *redshift.UpdatePartnerStatusOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.UpdatePartnerStatusOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*redshift.UpdatePartnerStatusInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePartnerStatusRequest provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) UpdatePartnerStatusRequest(_a0 *redshift.UpdatePartnerStatusInput) (*request.Request, *redshift.UpdatePartnerStatusOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*redshift.UpdatePartnerStatusInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *redshift.UpdatePartnerStatusOutput
+	if rf, ok := ret.Get(1).(func(*redshift.UpdatePartnerStatusInput) *redshift.UpdatePartnerStatusOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*redshift.UpdatePartnerStatusOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdatePartnerStatusWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) UpdatePartnerStatusWithContext(_a0 context.Context, _a1 *redshift.UpdatePartnerStatusInput, _a2 ...request.Option) (*redshift.UpdatePartnerStatusOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *redshift.UpdatePartnerStatusOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.UpdatePartnerStatusInput, ...request.Option) *redshift.UpdatePartnerStatusOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redshift.UpdatePartnerStatusOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *redshift.UpdatePartnerStatusInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WaitUntilClusterAvailable provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) WaitUntilClusterAvailable(_a0 *redshift.DescribeClustersInput) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClustersInput) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilClusterAvailableWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) WaitUntilClusterAvailableWithContext(_a0 context.Context, _a1 *redshift.DescribeClustersInput, _a2 ...request.WaiterOption) error {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClustersInput, ...request.WaiterOption) error); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilClusterDeleted provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) WaitUntilClusterDeleted(_a0 *redshift.DescribeClustersInput) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClustersInput) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilClusterDeletedWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) WaitUntilClusterDeletedWithContext(_a0 context.Context, _a1 *redshift.DescribeClustersInput, _a2 ...request.WaiterOption) error {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClustersInput, ...request.WaiterOption) error); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilClusterRestored provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) WaitUntilClusterRestored(_a0 *redshift.DescribeClustersInput) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClustersInput) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilClusterRestoredWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) WaitUntilClusterRestoredWithContext(_a0 context.Context, _a1 *redshift.DescribeClustersInput, _a2 ...request.WaiterOption) error {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClustersInput, ...request.WaiterOption) error); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilSnapshotAvailable provides a mock function with given fields: _a0
+func (_m *RedshiftAPI) WaitUntilSnapshotAvailable(_a0 *redshift.DescribeClusterSnapshotsInput) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*redshift.DescribeClusterSnapshotsInput) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilSnapshotAvailableWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RedshiftAPI) WaitUntilSnapshotAvailableWithContext(_a0 context.Context, _a1 *redshift.DescribeClusterSnapshotsInput, _a2 ...request.WaiterOption) error {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *redshift.DescribeClusterSnapshotsInput, ...request.WaiterOption) error); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,118 @@
+// Code generated by mockery v2.0.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	redshift "github.com/aws/aws-sdk-go/service/redshift"
+)
+
+// RedshiftModelAPI is an autogenerated mock type for the RedshiftModelAPI type
+type RedshiftModelAPI struct {
+	mock.Mock
+}
+
+// GetRedshiftClustersForTags provides a mock function with given fields: ctx, repository, branch
+func (_m *RedshiftModelAPI) GetRedshiftClustersForTags(ctx context.Context, repository string, branch string) ([]*redshift.Cluster, []*redshift.Cluster, error) {
+	ret := _m.Called(ctx, repository, branch)
+
+	var r0 []*redshift.Cluster
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*redshift.Cluster); ok {
+		r0 = rf(ctx, repository, branch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*redshift.Cluster)
+		}
+	}
+
+	var r1 []*redshift.Cluster
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) []*redshift.Cluster); ok {
+		r1 = rf(ctx, repository, branch)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*redshift.Cluster)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, repository, branch)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PauseRedshiftCluster provides a mock function with given fields: ctx, clusterIdentifier, clusterStatus
+func (_m *RedshiftModelAPI) PauseRedshiftCluster(ctx context.Context, clusterIdentifier *string, clusterStatus *string) (bool, error) {
+	ret := _m.Called(ctx, clusterIdentifier, clusterStatus)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *string) bool); ok {
+		r0 = rf(ctx, clusterIdentifier, clusterStatus)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *string) error); ok {
+		r1 = rf(ctx, clusterIdentifier, clusterStatus)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResumeRedshiftCluster provides a mock function with given fields: ctx, clusterIdentifier, clusterStatus
+func (_m *RedshiftModelAPI) ResumeRedshiftCluster(ctx context.Context, clusterIdentifier *string, clusterStatus *string) (bool, error) {
+	ret := _m.Called(ctx, clusterIdentifier, clusterStatus)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, *string, *string) bool); ok {
+		r0 = rf(ctx, clusterIdentifier, clusterStatus)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *string, *string) error); ok {
+		r1 = rf(ctx, clusterIdentifier, clusterStatus)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WaitUntilRedshiftClusterAvailable provides a mock function with given fields: ctx, clusterIdentifier
+func (_m *RedshiftModelAPI) WaitUntilRedshiftClusterAvailable(ctx context.Context, clusterIdentifier *string) error {
+	ret := _m.Called(ctx, clusterIdentifier)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) error); ok {
+		r0 = rf(ctx, clusterIdentifier)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilRedshiftClusterPaused provides a mock function with given fields: ctx, clusterIdentifier
+func (_m *RedshiftModelAPI) WaitUntilRedshiftClusterPaused(ctx context.Context, clusterIdentifier *string) error {
+	ret := _m.Called(ctx, clusterIdentifier)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) error); ok {
+		r0 = rf(ctx, clusterIdentifier)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
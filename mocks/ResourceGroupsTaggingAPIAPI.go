@@ -0,0 +1,780 @@
+// Code generated by mockery v2.0.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	request "github.com/aws/aws-sdk-go/aws/request"
+	resourcegroupstaggingapi "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ResourceGroupsTaggingAPIAPI is an autogenerated mock type for the ResourceGroupsTaggingAPIAPI type
+type ResourceGroupsTaggingAPIAPI struct {
+	mock.Mock
+}
+
+// DescribeReportCreation provides a mock function with given fields: _a0
+func (_m *ResourceGroupsTaggingAPIAPI) DescribeReportCreation(_a0 *resourcegroupstaggingapi.DescribeReportCreationInput) (*resourcegroupstaggingapi.DescribeReportCreationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *resourcegroupstaggingapi.DescribeReportCreationOutput
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.DescribeReportCreationInput) *resourcegroupstaggingapi.DescribeReportCreationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resourcegroupstaggingapi.DescribeReportCreationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*resourcegroupstaggingapi.DescribeReportCreationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeReportCreationRequest provides a mock function with given fields: _a0
+func (_m *ResourceGroupsTaggingAPIAPI) DescribeReportCreationRequest(_a0 *resourcegroupstaggingapi.DescribeReportCreationInput) (*request.Request, *resourcegroupstaggingapi.DescribeReportCreationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.DescribeReportCreationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *resourcegroupstaggingapi.DescribeReportCreationOutput
+	if rf, ok := ret.Get(1).(func(*resourcegroupstaggingapi.DescribeReportCreationInput) *resourcegroupstaggingapi.DescribeReportCreationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*resourcegroupstaggingapi.DescribeReportCreationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeReportCreationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ResourceGroupsTaggingAPIAPI) DescribeReportCreationWithContext(_a0 context.Context, _a1 *resourcegroupstaggingapi.DescribeReportCreationInput, _a2 ...request.Option) (*resourcegroupstaggingapi.DescribeReportCreationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *resourcegroupstaggingapi.DescribeReportCreationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *resourcegroupstaggingapi.DescribeReportCreationInput, ...request.Option) *resourcegroupstaggingapi.DescribeReportCreationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resourcegroupstaggingapi.DescribeReportCreationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *resourcegroupstaggingapi.DescribeReportCreationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetComplianceSummary provides a mock function with given fields: _a0
+func (_m *ResourceGroupsTaggingAPIAPI) GetComplianceSummary(_a0 *resourcegroupstaggingapi.GetComplianceSummaryInput) (*resourcegroupstaggingapi.GetComplianceSummaryOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *resourcegroupstaggingapi.GetComplianceSummaryOutput
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.GetComplianceSummaryInput) *resourcegroupstaggingapi.GetComplianceSummaryOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resourcegroupstaggingapi.GetComplianceSummaryOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*resourcegroupstaggingapi.GetComplianceSummaryInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetComplianceSummaryPages provides a mock function with given fields: _a0, _a1
+func (_m *ResourceGroupsTaggingAPIAPI) GetComplianceSummaryPages(_a0 *resourcegroupstaggingapi.GetComplianceSummaryInput, _a1 func(*resourcegroupstaggingapi.GetComplianceSummaryOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.GetComplianceSummaryInput, func(*resourcegroupstaggingapi.GetComplianceSummaryOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetComplianceSummaryPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *ResourceGroupsTaggingAPIAPI) GetComplianceSummaryPagesWithContext(_a0 context.Context, _a1 *resourcegroupstaggingapi.GetComplianceSummaryInput, _a2 func(*resourcegroupstaggingapi.GetComplianceSummaryOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *resourcegroupstaggingapi.GetComplianceSummaryInput, func(*resourcegroupstaggingapi.GetComplianceSummaryOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetComplianceSummaryRequest provides a mock function with given fields: _a0
+func (_m *ResourceGroupsTaggingAPIAPI) GetComplianceSummaryRequest(_a0 *resourcegroupstaggingapi.GetComplianceSummaryInput) (*request.Request, *resourcegroupstaggingapi.GetComplianceSummaryOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.GetComplianceSummaryInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *resourcegroupstaggingapi.GetComplianceSummaryOutput
+	if rf, ok := ret.Get(1).(func(*resourcegroupstaggingapi.GetComplianceSummaryInput) *resourcegroupstaggingapi.GetComplianceSummaryOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*resourcegroupstaggingapi.GetComplianceSummaryOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetComplianceSummaryWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ResourceGroupsTaggingAPIAPI) GetComplianceSummaryWithContext(_a0 context.Context, _a1 *resourcegroupstaggingapi.GetComplianceSummaryInput, _a2 ...request.Option) (*resourcegroupstaggingapi.GetComplianceSummaryOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *resourcegroupstaggingapi.GetComplianceSummaryOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *resourcegroupstaggingapi.GetComplianceSummaryInput, ...request.Option) *resourcegroupstaggingapi.GetComplianceSummaryOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resourcegroupstaggingapi.GetComplianceSummaryOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *resourcegroupstaggingapi.GetComplianceSummaryInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetResources provides a mock function with given fields: _a0
+func (_m *ResourceGroupsTaggingAPIAPI) GetResources(_a0 *resourcegroupstaggingapi.GetResourcesInput) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *resourcegroupstaggingapi.GetResourcesOutput
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.GetResourcesInput) *resourcegroupstaggingapi.GetResourcesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resourcegroupstaggingapi.GetResourcesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*resourcegroupstaggingapi.GetResourcesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetResourcesPages provides a mock function with given fields: _a0, _a1
+func (_m *ResourceGroupsTaggingAPIAPI) GetResourcesPages(_a0 *resourcegroupstaggingapi.GetResourcesInput, _a1 func(*resourcegroupstaggingapi.GetResourcesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.GetResourcesInput, func(*resourcegroupstaggingapi.GetResourcesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetResourcesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *ResourceGroupsTaggingAPIAPI) GetResourcesPagesWithContext(_a0 context.Context, _a1 *resourcegroupstaggingapi.GetResourcesInput, _a2 func(*resourcegroupstaggingapi.GetResourcesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *resourcegroupstaggingapi.GetResourcesInput, func(*resourcegroupstaggingapi.GetResourcesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetResourcesRequest provides a mock function with given fields: _a0
+func (_m *ResourceGroupsTaggingAPIAPI) GetResourcesRequest(_a0 *resourcegroupstaggingapi.GetResourcesInput) (*request.Request, *resourcegroupstaggingapi.GetResourcesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.GetResourcesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *resourcegroupstaggingapi.GetResourcesOutput
+	if rf, ok := ret.Get(1).(func(*resourcegroupstaggingapi.GetResourcesInput) *resourcegroupstaggingapi.GetResourcesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*resourcegroupstaggingapi.GetResourcesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetResourcesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ResourceGroupsTaggingAPIAPI) GetResourcesWithContext(_a0 context.Context, _a1 *resourcegroupstaggingapi.GetResourcesInput, _a2 ...request.Option) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *resourcegroupstaggingapi.GetResourcesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *resourcegroupstaggingapi.GetResourcesInput, ...request.Option) *resourcegroupstaggingapi.GetResourcesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resourcegroupstaggingapi.GetResourcesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *resourcegroupstaggingapi.GetResourcesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTagKeys provides a mock function with given fields: _a0
+func (_m *ResourceGroupsTaggingAPIAPI) GetTagKeys(_a0 *resourcegroupstaggingapi.GetTagKeysInput) (*resourcegroupstaggingapi.GetTagKeysOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *resourcegroupstaggingapi.GetTagKeysOutput
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.GetTagKeysInput) *resourcegroupstaggingapi.GetTagKeysOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resourcegroupstaggingapi.GetTagKeysOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*resourcegroupstaggingapi.GetTagKeysInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTagKeysPages provides a mock function with given fields: _a0, _a1
+func (_m *ResourceGroupsTaggingAPIAPI) GetTagKeysPages(_a0 *resourcegroupstaggingapi.GetTagKeysInput, _a1 func(*resourcegroupstaggingapi.GetTagKeysOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.GetTagKeysInput, func(*resourcegroupstaggingapi.GetTagKeysOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetTagKeysPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *ResourceGroupsTaggingAPIAPI) GetTagKeysPagesWithContext(_a0 context.Context, _a1 *resourcegroupstaggingapi.GetTagKeysInput, _a2 func(*resourcegroupstaggingapi.GetTagKeysOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *resourcegroupstaggingapi.GetTagKeysInput, func(*resourcegroupstaggingapi.GetTagKeysOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetTagKeysRequest provides a mock function with given fields: _a0
+func (_m *ResourceGroupsTaggingAPIAPI) GetTagKeysRequest(_a0 *resourcegroupstaggingapi.GetTagKeysInput) (*request.Request, *resourcegroupstaggingapi.GetTagKeysOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.GetTagKeysInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *resourcegroupstaggingapi.GetTagKeysOutput
+	if rf, ok := ret.Get(1).(func(*resourcegroupstaggingapi.GetTagKeysInput) *resourcegroupstaggingapi.GetTagKeysOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*resourcegroupstaggingapi.GetTagKeysOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetTagKeysWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ResourceGroupsTaggingAPIAPI) GetTagKeysWithContext(_a0 context.Context, _a1 *resourcegroupstaggingapi.GetTagKeysInput, _a2 ...request.Option) (*resourcegroupstaggingapi.GetTagKeysOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *resourcegroupstaggingapi.GetTagKeysOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *resourcegroupstaggingapi.GetTagKeysInput, ...request.Option) *resourcegroupstaggingapi.GetTagKeysOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resourcegroupstaggingapi.GetTagKeysOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *resourcegroupstaggingapi.GetTagKeysInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTagValues provides a mock function with given fields: _a0
+func (_m *ResourceGroupsTaggingAPIAPI) GetTagValues(_a0 *resourcegroupstaggingapi.GetTagValuesInput) (*resourcegroupstaggingapi.GetTagValuesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *resourcegroupstaggingapi.GetTagValuesOutput
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.GetTagValuesInput) *resourcegroupstaggingapi.GetTagValuesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resourcegroupstaggingapi.GetTagValuesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*resourcegroupstaggingapi.GetTagValuesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTagValuesPages provides a mock function with given fields: _a0, _a1
+func (_m *ResourceGroupsTaggingAPIAPI) GetTagValuesPages(_a0 *resourcegroupstaggingapi.GetTagValuesInput, _a1 func(*resourcegroupstaggingapi.GetTagValuesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.GetTagValuesInput, func(*resourcegroupstaggingapi.GetTagValuesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetTagValuesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *ResourceGroupsTaggingAPIAPI) GetTagValuesPagesWithContext(_a0 context.Context, _a1 *resourcegroupstaggingapi.GetTagValuesInput, _a2 func(*resourcegroupstaggingapi.GetTagValuesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *resourcegroupstaggingapi.GetTagValuesInput, func(*resourcegroupstaggingapi.GetTagValuesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetTagValuesRequest provides a mock function with given fields: _a0
+func (_m *ResourceGroupsTaggingAPIAPI) GetTagValuesRequest(_a0 *resourcegroupstaggingapi.GetTagValuesInput) (*request.Request, *resourcegroupstaggingapi.GetTagValuesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.GetTagValuesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *resourcegroupstaggingapi.GetTagValuesOutput
+	if rf, ok := ret.Get(1).(func(*resourcegroupstaggingapi.GetTagValuesInput) *resourcegroupstaggingapi.GetTagValuesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*resourcegroupstaggingapi.GetTagValuesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetTagValuesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ResourceGroupsTaggingAPIAPI) GetTagValuesWithContext(_a0 context.Context, _a1 *resourcegroupstaggingapi.GetTagValuesInput, _a2 ...request.Option) (*resourcegroupstaggingapi.GetTagValuesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *resourcegroupstaggingapi.GetTagValuesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *resourcegroupstaggingapi.GetTagValuesInput, ...request.Option) *resourcegroupstaggingapi.GetTagValuesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resourcegroupstaggingapi.GetTagValuesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *resourcegroupstaggingapi.GetTagValuesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartReportCreation provides a mock function with given fields: _a0
+func (_m *ResourceGroupsTaggingAPIAPI) StartReportCreation(_a0 *resourcegroupstaggingapi.StartReportCreationInput) (*resourcegroupstaggingapi.StartReportCreationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *resourcegroupstaggingapi.StartReportCreationOutput
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.StartReportCreationInput) *resourcegroupstaggingapi.StartReportCreationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resourcegroupstaggingapi.StartReportCreationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*resourcegroupstaggingapi.StartReportCreationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartReportCreationRequest provides a mock function with given fields: _a0
+func (_m *ResourceGroupsTaggingAPIAPI) StartReportCreationRequest(_a0 *resourcegroupstaggingapi.StartReportCreationInput) (*request.Request, *resourcegroupstaggingapi.StartReportCreationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.StartReportCreationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *resourcegroupstaggingapi.StartReportCreationOutput
+	if rf, ok := ret.Get(1).(func(*resourcegroupstaggingapi.StartReportCreationInput) *resourcegroupstaggingapi.StartReportCreationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*resourcegroupstaggingapi.StartReportCreationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// StartReportCreationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ResourceGroupsTaggingAPIAPI) StartReportCreationWithContext(_a0 context.Context, _a1 *resourcegroupstaggingapi.StartReportCreationInput, _a2 ...request.Option) (*resourcegroupstaggingapi.StartReportCreationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *resourcegroupstaggingapi.StartReportCreationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *resourcegroupstaggingapi.StartReportCreationInput, ...request.Option) *resourcegroupstaggingapi.StartReportCreationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resourcegroupstaggingapi.StartReportCreationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *resourcegroupstaggingapi.StartReportCreationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagResources provides a mock function with given fields: _a0
+func (_m *ResourceGroupsTaggingAPIAPI) TagResources(_a0 *resourcegroupstaggingapi.TagResourcesInput) (*resourcegroupstaggingapi.TagResourcesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *resourcegroupstaggingapi.TagResourcesOutput
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.TagResourcesInput) *resourcegroupstaggingapi.TagResourcesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resourcegroupstaggingapi.TagResourcesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*resourcegroupstaggingapi.TagResourcesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagResourcesRequest provides a mock function with given fields: _a0
+func (_m *ResourceGroupsTaggingAPIAPI) TagResourcesRequest(_a0 *resourcegroupstaggingapi.TagResourcesInput) (*request.Request, *resourcegroupstaggingapi.TagResourcesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.TagResourcesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *resourcegroupstaggingapi.TagResourcesOutput
+	if rf, ok := ret.Get(1).(func(*resourcegroupstaggingapi.TagResourcesInput) *resourcegroupstaggingapi.TagResourcesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*resourcegroupstaggingapi.TagResourcesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// TagResourcesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ResourceGroupsTaggingAPIAPI) TagResourcesWithContext(_a0 context.Context, _a1 *resourcegroupstaggingapi.TagResourcesInput, _a2 ...request.Option) (*resourcegroupstaggingapi.TagResourcesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *resourcegroupstaggingapi.TagResourcesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *resourcegroupstaggingapi.TagResourcesInput, ...request.Option) *resourcegroupstaggingapi.TagResourcesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resourcegroupstaggingapi.TagResourcesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *resourcegroupstaggingapi.TagResourcesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UntagResources provides a mock function with given fields: _a0
+func (_m *ResourceGroupsTaggingAPIAPI) UntagResources(_a0 *resourcegroupstaggingapi.UntagResourcesInput) (*resourcegroupstaggingapi.UntagResourcesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *resourcegroupstaggingapi.UntagResourcesOutput
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.UntagResourcesInput) *resourcegroupstaggingapi.UntagResourcesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resourcegroupstaggingapi.UntagResourcesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*resourcegroupstaggingapi.UntagResourcesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UntagResourcesRequest provides a mock function with given fields: _a0
+func (_m *ResourceGroupsTaggingAPIAPI) UntagResourcesRequest(_a0 *resourcegroupstaggingapi.UntagResourcesInput) (*request.Request, *resourcegroupstaggingapi.UntagResourcesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*resourcegroupstaggingapi.UntagResourcesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *resourcegroupstaggingapi.UntagResourcesOutput
+	if rf, ok := ret.Get(1).(func(*resourcegroupstaggingapi.UntagResourcesInput) *resourcegroupstaggingapi.UntagResourcesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*resourcegroupstaggingapi.UntagResourcesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// UntagResourcesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ResourceGroupsTaggingAPIAPI) UntagResourcesWithContext(_a0 context.Context, _a1 *resourcegroupstaggingapi.UntagResourcesInput, _a2 ...request.Option) (*resourcegroupstaggingapi.UntagResourcesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *resourcegroupstaggingapi.UntagResourcesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *resourcegroupstaggingapi.UntagResourcesInput, ...request.Option) *resourcegroupstaggingapi.UntagResourcesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resourcegroupstaggingapi.UntagResourcesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *resourcegroupstaggingapi.UntagResourcesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
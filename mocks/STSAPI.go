@@ -6,9 +6,8 @@ import (
 	context "context"
 
 	request "github.com/aws/aws-sdk-go/aws/request"
-	mock "github.com/stretchr/testify/mock"
-
 	sts "github.com/aws/aws-sdk-go/service/sts"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // STSAPI is an autogenerated mock type for the STSAPI type
@@ -2,13 +2,45 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/auto-staging/scheduler/types"
+)
 
 // StatusModelAPI is an autogenerated mock type for the StatusModelAPI type
 type StatusModelAPI struct {
 	mock.Mock
 }
 
+// AddHistoryEntry provides a mock function with given fields: repository, branch, entry
+func (_m *StatusModelAPI) AddHistoryEntry(repository string, branch string, entry types.HistoryEntry) error {
+	ret := _m.Called(repository, branch, entry)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, types.HistoryEntry) error); ok {
+		r0 = rf(repository, branch, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetFailedStatusForEnvironment provides a mock function with given fields: repository, branch, status, errorMessage
+func (_m *StatusModelAPI) SetFailedStatusForEnvironment(repository string, branch string, status string, errorMessage string) error {
+	ret := _m.Called(repository, branch, status, errorMessage)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) error); ok {
+		r0 = rf(repository, branch, status, errorMessage)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetStatusForEnvironment provides a mock function with given fields: repository, branch, status
 func (_m *StatusModelAPI) SetStatusForEnvironment(repository string, branch string, status string) error {
 	ret := _m.Called(repository, branch, status)
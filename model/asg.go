@@ -1,10 +1,13 @@
 package model
 
 import (
+	"context"
 	"errors"
 	"log"
 	"strconv"
+	"time"
 
+	"github.com/auto-staging/scheduler/types"
 	"github.com/aws/aws-sdk-go/aws"
 
 	"github.com/aws/aws-sdk-go/service/autoscaling"
@@ -13,126 +16,301 @@ import (
 
 // ASGModelAPI is an interface including all ASG model functions
 type ASGModelAPI interface {
-	DescribeAutoScalingGroupForTagsAndAction(repository, branch, action string) (*string, error)
-	SetASGMinToPreviousValue(asgName *string) error
-	SetASGMinToZero(asgName *string) error
-	GetPreviousMinValueOfASG(asgName *string) (int, error)
+	DescribeAutoScalingGroupsForTags(ctx context.Context, repository, branch string) ([]*autoscaling.Group, []*autoscaling.Group, error)
+	SetASGSizeToPreviousValue(ctx context.Context, asgName *string) (*types.ASGSize, error)
+	SetASGSizeToZero(ctx context.Context, asgName *string) (*types.ASGSize, error)
+	GetPreviousSizeOfASG(ctx context.Context, asgName *string) (*types.ASGSize, error)
+	WaitUntilASGInService(ctx context.Context, asgName *string) error
 }
 
-// ASGModel is a struct including the AWS SDK ASG interface, all ASG model functions are called on this struct and the included AWS SDK ASG service
+// asgPollInterval is the time between two checks of the instances while waiting for an autoscaling group
+var asgPollInterval = 15 * time.Second
+
+const (
+	minSizeTag         = "minSize"
+	maxSizeTag         = "maxSize"
+	desiredCapacityTag = "desiredCapacity"
+)
+
+// ASGModel is a struct including the AWS SDK ASG interface, all ASG model functions are called on this struct and the included AWS SDK ASG service.
+// The autoscaling groups of an Environment are found by the tags configured in the TagConfig.
 type ASGModel struct {
 	autoscalingiface.AutoScalingAPI
+	tags types.TagConfig
 }
 
-// NewASGModel takes the AWS SDK ASG Interface as parameter and returns the pointer to an ASGModel struct, on which all ASG model functions can be called
-func NewASGModel(svc autoscalingiface.AutoScalingAPI) *ASGModel {
+// NewASGModel takes the AWS SDK ASG Interface and the tag config as parameter and returns the pointer to an ASGModel struct, on which all ASG model functions can be called
+func NewASGModel(svc autoscalingiface.AutoScalingAPI, tags types.TagConfig) *ASGModel {
 	return &ASGModel{
 		AutoScalingAPI: svc,
+		tags:           tags,
 	}
 }
 
-// DescribeAutoScalingGroupForTagsAndAction gets the name of the autoscaling group matching the repository and branch name (the autoscaling group gets found by tags).
-// Additionally the function checks if an action is required based on the current min size and only then returns the name.
+// DescribeAutoScalingGroupsForTags gets all autoscaling groups matching the repository and branch name (the autoscaling groups get found by the
+// repository, branch and required tags). Groups with the exclude tag are returned separately as second value and must not be started or stopped.
+// Whether a group must be started or stopped can be checked with IsASGActionRequired.
+// All result pages of the DescribeAutoScalingGroups call are processed.
 // If an error occurs, it gets logged and then returned.
-func (asgModel *ASGModel) DescribeAutoScalingGroupForTagsAndAction(repository, branch, action string) (*string, error) {
-	asgs, err := asgModel.AutoScalingAPI.DescribeAutoScalingGroups(nil)
+func (asgModel *ASGModel) DescribeAutoScalingGroupsForTags(ctx context.Context, repository, branch string) ([]*autoscaling.Group, []*autoscaling.Group, error) {
+	groups := []*autoscaling.Group{}
+	excluded := []*autoscaling.Group{}
+	err := asgModel.AutoScalingAPI.DescribeAutoScalingGroupsPagesWithContext(ctx, &autoscaling.DescribeAutoScalingGroupsInput{}, func(asgs *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
+		for _, asg := range asgs.AutoScalingGroups {
+			tagMap := map[string]string{}
+			for _, tag := range asg.Tags {
+				tagMap[*tag.Key] = *tag.Value
+			}
+			if !asgModel.tags.MatchesEnvironment(tagMap, repository, branch) {
+				continue
+			}
+			if asgModel.tags.IsExcluded(tagMap) {
+				log.Printf("Autoscaling group %s is excluded by tag \n", *asg.AutoScalingGroupName)
+				excluded = append(excluded, asg)
+				continue
+			}
+			groups = append(groups, asg)
+		}
+		return true
+	})
 	if err != nil {
 		log.Println(err)
-		return nil, err
+		return []*autoscaling.Group{}, []*autoscaling.Group{}, err
 	}
 
-	for _, asg := range asgs.AutoScalingGroups {
-		foundBranch := false
-		foundRepository := false
-		for _, tag := range asg.Tags {
-			switch *tag.Key {
-			case "branch_raw":
-				if *tag.Value == branch {
-					foundBranch = true
-				}
-			case "repository":
-				if *tag.Value == repository {
-					foundRepository = true
-				}
-			}
-		}
-		if foundBranch && foundRepository && *asg.MinSize != 0 && action == "stop" {
-			return asg.AutoScalingGroupName, nil
-		}
-		if foundBranch && foundRepository && *asg.MinSize == 0 && action == "start" {
-			return asg.AutoScalingGroupName, nil
-		}
+	return groups, excluded, nil
+}
+
+// IsASGActionRequired returns true, if the given autoscaling group has to be changed for the given action ("start" or "stop").
+// Only stopped groups (see IsASGStopped) can be started, so a group which normally runs with a min size of 0 isn't mistaken for a stopped group.
+// Groups with a max size greater than 0, which aren't stopped, can be stopped.
+func IsASGActionRequired(action string, asg *autoscaling.Group) bool {
+	switch action {
+	case "stop":
+		return *asg.MaxSize != 0 && !IsASGStopped(asg)
+	case "start":
+		return IsASGStopped(asg)
 	}
+	return false
+}
 
-	return nil, nil
+// IsASGStopped returns true, if the given autoscaling group was stopped by the scheduler. This is the case, if its min size and desired capacity are 0
+// and its previous size is stored in the tags. The max size isn't checked, because previous versions of the scheduler kept the max size of stopped groups.
+func IsASGStopped(asg *autoscaling.Group) bool {
+	return aws.Int64Value(asg.MinSize) == 0 && aws.Int64Value(asg.DesiredCapacity) == 0 && HasPreviousASGSize(asg)
 }
 
-// SetASGMinToPreviousValue sets the min size for the autoscaling group matching the given name to its previous value received from the GetPreviousMinValueOfASG function.
+// HasPreviousASGSize returns true, if the previous size of the given autoscaling group is stored in its tags (at least the "minSize" tag exists).
+func HasPreviousASGSize(asg *autoscaling.Group) bool {
+	for _, tag := range asg.Tags {
+		if *tag.Key == minSizeTag {
+			return true
+		}
+	}
+	return false
+}
+
+// SetASGSizeToPreviousValue sets the min size, max size and desired capacity for the autoscaling group matching the given name to their previous values
+// received from the GetPreviousSizeOfASG function. Afterwards the tags storing the previous size get deleted, so a later manual resize of the group isn't
+// overwritten by stale values on the next start. The restored size gets returned.
 // If an error occurs, it gets logged and then returned.
-func (asgModel *ASGModel) SetASGMinToPreviousValue(asgName *string) error {
+func (asgModel *ASGModel) SetASGSizeToPreviousValue(ctx context.Context, asgName *string) (*types.ASGSize, error) {
 	log.Println("Starting ASG")
-	minSize, err := asgModel.GetPreviousMinValueOfASG(asgName)
+	size, err := asgModel.GetPreviousSizeOfASG(ctx, asgName)
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
-	_, err = asgModel.AutoScalingAPI.UpdateAutoScalingGroup(&autoscaling.UpdateAutoScalingGroupInput{
+	_, err = asgModel.AutoScalingAPI.UpdateAutoScalingGroupWithContext(ctx, &autoscaling.UpdateAutoScalingGroupInput{
 		AutoScalingGroupName: asgName,
-		MinSize:              aws.Int64(int64(minSize)),
+		MinSize:              aws.Int64(size.MinSize),
+		MaxSize:              aws.Int64(size.MaxSize),
+		DesiredCapacity:      aws.Int64(size.DesiredCapacity),
 	})
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
 	}
-	return nil
+
+	_, err = asgModel.AutoScalingAPI.DeleteTagsWithContext(ctx, &autoscaling.DeleteTagsInput{
+		Tags: []*autoscaling.Tag{
+			newASGTagKey(asgName, minSizeTag),
+			newASGTagKey(asgName, maxSizeTag),
+			newASGTagKey(asgName, desiredCapacityTag),
+		},
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return size, nil
 }
 
-// SetASGMinToZero sets the min size for the autoscaling group matching the given name to 0.
+// SetASGSizeToZero stores the current min size, max size and desired capacity of the autoscaling group matching the given name as tags on the group
+// and then sets all three values to 0. An existing "minSize" tag isn't overwritten by a current min size of 0, because it still holds the value to restore.
+// The stored size gets returned.
 // If an error occurs, it gets logged and then returned.
-func (asgModel *ASGModel) SetASGMinToZero(asgName *string) error {
+func (asgModel *ASGModel) SetASGSizeToZero(ctx context.Context, asgName *string) (*types.ASGSize, error) {
 	log.Println("Stopping ASG")
-	_, err := asgModel.AutoScalingAPI.UpdateAutoScalingGroup(&autoscaling.UpdateAutoScalingGroupInput{
+	asg, err := asgModel.describeAutoScalingGroup(ctx, asgName)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	size := types.ASGSize{
+		MinSize:         *asg.MinSize,
+		MaxSize:         *asg.MaxSize,
+		DesiredCapacity: *asg.DesiredCapacity,
+	}
+
+	tags := []*autoscaling.Tag{
+		newASGTag(asgName, maxSizeTag, size.MaxSize),
+		newASGTag(asgName, desiredCapacityTag, size.DesiredCapacity),
+	}
+	if size.MinSize != 0 || !HasPreviousASGSize(asg) {
+		tags = append([]*autoscaling.Tag{newASGTag(asgName, minSizeTag, size.MinSize)}, tags...)
+	}
+	_, err = asgModel.AutoScalingAPI.CreateOrUpdateTagsWithContext(ctx, &autoscaling.CreateOrUpdateTagsInput{
+		Tags: tags,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	_, err = asgModel.AutoScalingAPI.UpdateAutoScalingGroupWithContext(ctx, &autoscaling.UpdateAutoScalingGroupInput{
 		AutoScalingGroupName: asgName,
 		MinSize:              aws.Int64(0),
+		MaxSize:              aws.Int64(0),
 		DesiredCapacity:      aws.Int64(0),
 	})
 	if err != nil {
 		log.Println(err)
-		return err
+		return nil, err
+	}
+	return &size, nil
+}
+
+// GetPreviousSizeOfASG returns the previous size for the autoscaling group matching the given name. The previous size is determined by the tags "minSize", "maxSize" and
+// "desiredCapacity" attached to the autoscaling group by SetASGSizeToZero. The "minSize" tag is required, if only this tag exists (because it was added manually),
+// the desired capacity defaults to the min size and the max size to the current max size of the group.
+// If an error occurs, it gets logged and then nil plus the error will be returned.
+func (asgModel *ASGModel) GetPreviousSizeOfASG(ctx context.Context, asgName *string) (*types.ASGSize, error) {
+	asg, err := asgModel.describeAutoScalingGroup(ctx, asgName)
+	if err != nil {
+		log.Println(err)
+		return nil, err
 	}
-	return nil
+
+	tagMap := map[string]int64{}
+	for _, tag := range asg.Tags {
+		switch *tag.Key {
+		case minSizeTag, maxSizeTag, desiredCapacityTag:
+			value, err := strconv.ParseInt(*tag.Value, 10, 64)
+			if err != nil {
+				log.Println(err)
+				return nil, err
+			}
+			tagMap[*tag.Key] = value
+		}
+	}
+
+	minSize, ok := tagMap[minSizeTag]
+	if !ok {
+		err = errors.New("found no previous size for autoscaling group " + *asgName)
+		log.Println(err)
+		return nil, err
+	}
+	size := types.ASGSize{
+		MinSize:         minSize,
+		MaxSize:         *asg.MaxSize,
+		DesiredCapacity: minSize,
+	}
+	if maxSize, ok := tagMap[maxSizeTag]; ok {
+		size.MaxSize = maxSize
+	}
+	if desiredCapacity, ok := tagMap[desiredCapacityTag]; ok {
+		size.DesiredCapacity = desiredCapacity
+	}
+
+	return &size, nil
 }
 
-// GetPreviousMinValueOfASG returns the previous min value for the autoscaling group matching the given name. The previous min value is determined by a tag attached to the autoscaling group, the tag has the key "minSize"
-// and the previous min size as value (example = 2).
-// If an error occurs, it gets logged and then 0 plus the error will be returned.
-func (asgModel *ASGModel) GetPreviousMinValueOfASG(asgName *string) (int, error) {
-	asgs, err := asgModel.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+// WaitUntilASGInService polls the autoscaling group matching the given name until the number of InService instances equals the desired capacity.
+// For stopped groups this is the case, once all instances are terminated. Waiting is aborted, when the context is done.
+// If an error occurs, it gets logged and then returned.
+func (asgModel *ASGModel) WaitUntilASGInService(ctx context.Context, asgName *string) error {
+	for {
+		asgs, err := asgModel.DescribeAutoScalingGroupsWithContext(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: []*string{
+				asgName,
+			},
+			MaxRecords: aws.Int64(1),
+		})
+		if err != nil {
+			log.Println(err)
+			return err
+		}
+		if len(asgs.AutoScalingGroups) == 0 {
+			err = errors.New("found no autoscaling group for " + *asgName)
+			log.Println(err)
+			return err
+		}
+
+		asg := asgs.AutoScalingGroups[0]
+		inService := int64(0)
+		for _, instance := range asg.Instances {
+			if *instance.LifecycleState == autoscaling.LifecycleStateInService {
+				inService++
+			}
+		}
+		if inService == *asg.DesiredCapacity && int64(len(asg.Instances)) == inService {
+			log.Printf("Autoscaling group %s has %d instances in service \n", *asgName, inService)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Printf("Stopped waiting for autoscaling group %s, %s \n", *asgName, ctx.Err())
+			return ctx.Err()
+		case <-time.After(asgPollInterval):
+		}
+	}
+}
+
+// describeAutoScalingGroup returns the autoscaling group matching the given name.
+func (asgModel *ASGModel) describeAutoScalingGroup(ctx context.Context, asgName *string) (*autoscaling.Group, error) {
+	asgs, err := asgModel.DescribeAutoScalingGroupsWithContext(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
 		AutoScalingGroupNames: []*string{
 			asgName,
 		},
 		MaxRecords: aws.Int64(1),
 	})
 	if err != nil {
-		log.Println(err)
-		return 0, err
+		return nil, err
 	}
 	if len(asgs.AutoScalingGroups) == 0 {
-		err = errors.New("found no autoscaling group for " + *asgName)
-		log.Println(err)
-		return 0, err
+		return nil, errors.New("found no autoscaling group for " + *asgName)
 	}
-	asg := asgs.AutoScalingGroups[0]
-	minSize := 0
-	for _, tag := range asg.Tags {
-		if *tag.Key == "minSize" {
-			minSize, err = strconv.Atoi(*tag.Value)
-			if err != nil {
-				log.Println(err)
-				return 0, err
-			}
-		}
+	return asgs.AutoScalingGroups[0], nil
+}
+
+// newASGTag returns a tag for the autoscaling group matching the given name, the tag doesn't get propagated to the instances launched by the group.
+func newASGTag(asgName *string, key string, value int64) *autoscaling.Tag {
+	return &autoscaling.Tag{
+		ResourceId:        asgName,
+		ResourceType:      aws.String("auto-scaling-group"),
+		Key:               aws.String(key),
+		Value:             aws.String(strconv.FormatInt(value, 10)),
+		PropagateAtLaunch: aws.Bool(false),
 	}
+}
 
-	return minSize, nil
+// newASGTagKey returns the key of a tag of the autoscaling group matching the given name, it is used to delete the tag.
+func newASGTagKey(asgName *string, key string) *autoscaling.Tag {
+	return &autoscaling.Tag{
+		ResourceId:   asgName,
+		ResourceType: aws.String("auto-scaling-group"),
+		Key:          aws.String(key),
+	}
 }
@@ -1,12 +1,16 @@
 package model
 
 import (
+	"context"
 	"errors"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/auto-staging/scheduler/mocks"
+	"github.com/auto-staging/scheduler/types"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 
 	"github.com/stretchr/testify/assert"
@@ -15,22 +19,74 @@ import (
 
 func TestNewASGModel(t *testing.T) {
 	svc := new(mocks.AutoScalingAPI)
-	model := NewASGModel(svc)
+	model := NewASGModel(svc, testTagConfig)
 
 	assert.NotEmpty(t, model, "Expected not empty")
 	assert.Equal(t, svc, model.AutoScalingAPI, "ASG service from model is not matching the one used as parameter")
 }
 
-func TestGetPreviousMinValueOfASG(t *testing.T) {
+// mockDescribeAutoScalingGroupsPages mocks DescribeAutoScalingGroupsPages, the given pages are passed one after another to the callback function
+func mockDescribeAutoScalingGroupsPages(svc *mocks.AutoScalingAPI, err error, pages ...*autoscaling.DescribeAutoScalingGroupsOutput) {
+	svc.On("DescribeAutoScalingGroupsPagesWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput"), mock.AnythingOfType("func(*autoscaling.DescribeAutoScalingGroupsOutput, bool) bool")).Run(func(args mock.Arguments) {
+		if err != nil {
+			return
+		}
+		fn := args.Get(2).(func(*autoscaling.DescribeAutoScalingGroupsOutput, bool) bool)
+		for i := range pages {
+			if !fn(pages[i], i == len(pages)-1) {
+				return
+			}
+		}
+	}).Return(err)
+}
+
+func TestGetPreviousSizeOfASG(t *testing.T) {
 	svc := new(mocks.AutoScalingAPI)
-	model := NewASGModel(svc)
+	model := NewASGModel(svc, testTagConfig)
+
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []*autoscaling.Group{
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String("testASG"),
+				MaxSize:              aws.Int64(0),
+				Tags: []*autoscaling.TagDescription{
+					&autoscaling.TagDescription{
+						Key:   aws.String("minSize"),
+						Value: aws.String("2"),
+					},
+					&autoscaling.TagDescription{
+						Key:   aws.String("maxSize"),
+						Value: aws.String("6"),
+					},
+					&autoscaling.TagDescription{
+						Key:   aws.String("desiredCapacity"),
+						Value: aws.String("3"),
+					},
+				},
+			},
+		},
+	}, nil)
+
+	size, err := model.GetPreviousSizeOfASG(context.Background(), aws.String("testASG"))
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, &types.ASGSize{
+		MinSize:         2,
+		MaxSize:         6,
+		DesiredCapacity: 3,
+	}, size)
+}
+
+func TestGetPreviousSizeOfASGOnlyMinSizeTag(t *testing.T) {
+	svc := new(mocks.AutoScalingAPI)
+	model := NewASGModel(svc, testTagConfig)
 
 	expectedMinSize := 2
 
-	svc.On("DescribeAutoScalingGroups", mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
 		AutoScalingGroups: []*autoscaling.Group{
 			&autoscaling.Group{
 				AutoScalingGroupName: aws.String("testASG"),
+				MaxSize:              aws.Int64(4),
 				Tags: []*autoscaling.TagDescription{
 					&autoscaling.TagDescription{
 						Key:   aws.String("minSize"),
@@ -41,29 +97,53 @@ func TestGetPreviousMinValueOfASG(t *testing.T) {
 		},
 	}, nil)
 
-	min, err := model.GetPreviousMinValueOfASG(aws.String("testASG"))
+	size, err := model.GetPreviousSizeOfASG(context.Background(), aws.String("testASG"))
 	assert.Nil(t, err, "Expected no error")
-	assert.Equal(t, expectedMinSize, min)
+	assert.Equal(t, &types.ASGSize{
+		MinSize:         2,
+		MaxSize:         4,
+		DesiredCapacity: 2,
+	}, size)
 }
 
-func TestGetPreviousMinValueOfASGNoASGFound(t *testing.T) {
+func TestGetPreviousSizeOfASGNoTags(t *testing.T) {
 	svc := new(mocks.AutoScalingAPI)
-	model := NewASGModel(svc)
+	model := NewASGModel(svc, testTagConfig)
 
-	svc.On("DescribeAutoScalingGroups", mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []*autoscaling.Group{
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String("testASG"),
+				MaxSize:              aws.Int64(4),
+				Tags:                 []*autoscaling.TagDescription{},
+			},
+		},
+	}, nil)
+
+	size, err := model.GetPreviousSizeOfASG(context.Background(), aws.String("testASG"))
+	assert.Error(t, err)
+	assert.Nil(t, size)
+	assert.Equal(t, errors.New("found no previous size for autoscaling group testASG"), err)
+}
+
+func TestGetPreviousSizeOfASGNoASGFound(t *testing.T) {
+	svc := new(mocks.AutoScalingAPI)
+	model := NewASGModel(svc, testTagConfig)
+
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
 		AutoScalingGroups: []*autoscaling.Group{},
 	}, nil)
 
-	_, err := model.GetPreviousMinValueOfASG(aws.String("testASG"))
+	_, err := model.GetPreviousSizeOfASG(context.Background(), aws.String("testASG"))
 	assert.Error(t, err)
 	assert.Equal(t, errors.New("found no autoscaling group for testASG"), err)
 }
 
-func TestGetPreviousMinValueOfASGNoInteger(t *testing.T) {
+func TestGetPreviousSizeOfASGNoInteger(t *testing.T) {
 	svc := new(mocks.AutoScalingAPI)
-	model := NewASGModel(svc)
+	model := NewASGModel(svc, testTagConfig)
 
-	svc.On("DescribeAutoScalingGroups", mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
 		AutoScalingGroups: []*autoscaling.Group{
 			&autoscaling.Group{
 				AutoScalingGroupName: aws.String("testASG"),
@@ -77,29 +157,136 @@ func TestGetPreviousMinValueOfASGNoInteger(t *testing.T) {
 		},
 	}, nil)
 
-	_, err := model.GetPreviousMinValueOfASG(aws.String("testASG"))
+	_, err := model.GetPreviousSizeOfASG(context.Background(), aws.String("testASG"))
 	assert.Error(t, err)
 }
 
-func TestGetPreviousMinValueOfASGAwsError(t *testing.T) {
+func TestGetPreviousSizeOfASGAwsError(t *testing.T) {
 	svc := new(mocks.AutoScalingAPI)
-	model := NewASGModel(svc)
+	model := NewASGModel(svc, testTagConfig)
 
-	svc.On("DescribeAutoScalingGroups", mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
 		AutoScalingGroups: []*autoscaling.Group{},
 	}, errors.New("aws-error"))
 
-	_, err := model.GetPreviousMinValueOfASG(aws.String("testASG"))
+	_, err := model.GetPreviousSizeOfASG(context.Background(), aws.String("testASG"))
 	assert.Error(t, err)
 	assert.Equal(t, errors.New("aws-error"), err)
 }
 
-// SetASGMinToZero
+// SetASGSizeToPreviousValue
+
+func TestSetASGSizeToPreviousValue(t *testing.T) {
+	svc := new(mocks.AutoScalingAPI)
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []*autoscaling.Group{
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String("testASG"),
+				MaxSize:              aws.Int64(0),
+				Tags: []*autoscaling.TagDescription{
+					&autoscaling.TagDescription{
+						Key:   aws.String("minSize"),
+						Value: aws.String("2"),
+					},
+					&autoscaling.TagDescription{
+						Key:   aws.String("maxSize"),
+						Value: aws.String("6"),
+					},
+					&autoscaling.TagDescription{
+						Key:   aws.String("desiredCapacity"),
+						Value: aws.String("3"),
+					},
+				},
+			},
+		},
+	}, nil)
+	svc.On("UpdateAutoScalingGroupWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.UpdateAutoScalingGroupInput")).Return(nil, nil)
+	svc.On("DeleteTagsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DeleteTagsInput")).Return(nil, nil)
+
+	model := NewASGModel(svc, testTagConfig)
+	size, err := model.SetASGSizeToPreviousValue(context.Background(), aws.String("testASG"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, &types.ASGSize{
+		MinSize:         2,
+		MaxSize:         6,
+		DesiredCapacity: 3,
+	}, size)
+	svc.AssertCalled(t, "UpdateAutoScalingGroupWithContext", mock.Anything, &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String("testASG"),
+		MinSize:              aws.Int64(2),
+		MaxSize:              aws.Int64(6),
+		DesiredCapacity:      aws.Int64(3),
+	})
+	svc.AssertCalled(t, "DeleteTagsWithContext", mock.Anything, &autoscaling.DeleteTagsInput{
+		Tags: []*autoscaling.Tag{
+			&autoscaling.Tag{ResourceId: aws.String("testASG"), ResourceType: aws.String("auto-scaling-group"), Key: aws.String("minSize")},
+			&autoscaling.Tag{ResourceId: aws.String("testASG"), ResourceType: aws.String("auto-scaling-group"), Key: aws.String("maxSize")},
+			&autoscaling.Tag{ResourceId: aws.String("testASG"), ResourceType: aws.String("auto-scaling-group"), Key: aws.String("desiredCapacity")},
+		},
+	})
+}
+
+func TestSetASGSizeToPreviousValueDeleteTagsError(t *testing.T) {
+	svc := new(mocks.AutoScalingAPI)
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []*autoscaling.Group{
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String("testASG"),
+				MaxSize:              aws.Int64(0),
+				Tags: []*autoscaling.TagDescription{
+					&autoscaling.TagDescription{
+						Key:   aws.String("minSize"),
+						Value: aws.String("2"),
+					},
+				},
+			},
+		},
+	}, nil)
+	svc.On("UpdateAutoScalingGroupWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.UpdateAutoScalingGroupInput")).Return(nil, nil)
+	svc.On("DeleteTagsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DeleteTagsInput")).Return(nil, errors.New("Test error"))
+
+	model := NewASGModel(svc, testTagConfig)
+	_, err := model.SetASGSizeToPreviousValue(context.Background(), aws.String("testASG"))
+
+	assert.EqualError(t, err, "Test error")
+}
+
+func TestSetASGSizeToPreviousValueNoSnapshot(t *testing.T) {
+	svc := new(mocks.AutoScalingAPI)
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []*autoscaling.Group{
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String("testASG"),
+				MaxSize:              aws.Int64(0),
+			},
+		},
+	}, nil)
+
+	model := NewASGModel(svc, testTagConfig)
+	_, err := model.SetASGSizeToPreviousValue(context.Background(), aws.String("testASG"))
+
+	assert.Error(t, err)
+	svc.AssertNotCalled(t, "UpdateAutoScalingGroupWithContext", mock.Anything, mock.Anything)
+}
+
+// SetASGSizeToZero
 
-func TestSetASGMinToZero(t *testing.T) {
+func TestSetASGSizeToZero(t *testing.T) {
 	asgName := "testASG"
 	svc := new(mocks.AutoScalingAPI)
-	checkInput := func(input *autoscaling.UpdateAutoScalingGroupInput) error {
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []*autoscaling.Group{
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String(asgName),
+				MinSize:              aws.Int64(2),
+				MaxSize:              aws.Int64(6),
+				DesiredCapacity:      aws.Int64(3),
+			},
+		},
+	}, nil)
+	svc.On("CreateOrUpdateTagsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.CreateOrUpdateTagsInput")).Return(nil, nil)
+	checkInput := func(_ aws.Context, input *autoscaling.UpdateAutoScalingGroupInput, _ ...request.Option) error {
 		if *input.AutoScalingGroupName != asgName {
 			t.Error("Exptected asg name to be " + asgName + ", was " + *input.AutoScalingGroupName)
 			t.FailNow()
@@ -110,37 +297,402 @@ func TestSetASGMinToZero(t *testing.T) {
 			t.FailNow()
 			return errors.New("")
 		}
+		if *input.MaxSize != 0 {
+			t.Error("Exptected maxSize to be 0, was " + strconv.Itoa(int(*input.MaxSize)))
+			t.FailNow()
+			return errors.New("")
+		}
+		if *input.DesiredCapacity != 0 {
+			t.Error("Exptected desiredCapacity to be 0, was " + strconv.Itoa(int(*input.DesiredCapacity)))
+			t.FailNow()
+			return errors.New("")
+		}
 		return nil
 	}
-	svc.On("UpdateAutoScalingGroup", mock.AnythingOfType("*autoscaling.UpdateAutoScalingGroupInput")).Return(nil, checkInput)
+	svc.On("UpdateAutoScalingGroupWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.UpdateAutoScalingGroupInput")).Return(nil, checkInput)
+
+	model := NewASGModel(svc, testTagConfig)
+	size, err := model.SetASGSizeToZero(context.Background(), aws.String("testASG"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, &types.ASGSize{
+		MinSize:         2,
+		MaxSize:         6,
+		DesiredCapacity: 3,
+	}, size)
+	svc.AssertCalled(t, "CreateOrUpdateTagsWithContext", mock.Anything, &autoscaling.CreateOrUpdateTagsInput{
+		Tags: []*autoscaling.Tag{
+			&autoscaling.Tag{
+				ResourceId:        aws.String(asgName),
+				ResourceType:      aws.String("auto-scaling-group"),
+				Key:               aws.String("minSize"),
+				Value:             aws.String("2"),
+				PropagateAtLaunch: aws.Bool(false),
+			},
+			&autoscaling.Tag{
+				ResourceId:        aws.String(asgName),
+				ResourceType:      aws.String("auto-scaling-group"),
+				Key:               aws.String("maxSize"),
+				Value:             aws.String("6"),
+				PropagateAtLaunch: aws.Bool(false),
+			},
+			&autoscaling.Tag{
+				ResourceId:        aws.String(asgName),
+				ResourceType:      aws.String("auto-scaling-group"),
+				Key:               aws.String("desiredCapacity"),
+				Value:             aws.String("3"),
+				PropagateAtLaunch: aws.Bool(false),
+			},
+		},
+	})
+}
+
+func TestSetASGSizeToZeroKeepsMinSizeTag(t *testing.T) {
+	svc := new(mocks.AutoScalingAPI)
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []*autoscaling.Group{
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String("testASG"),
+				MinSize:              aws.Int64(0),
+				MaxSize:              aws.Int64(4),
+				DesiredCapacity:      aws.Int64(1),
+				Tags: []*autoscaling.TagDescription{
+					&autoscaling.TagDescription{Key: aws.String("minSize"), Value: aws.String("2")},
+				},
+			},
+		},
+	}, nil)
+	svc.On("CreateOrUpdateTagsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.CreateOrUpdateTagsInput")).Return(nil, nil)
+	svc.On("UpdateAutoScalingGroupWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.UpdateAutoScalingGroupInput")).Return(nil, nil)
 
-	model := NewASGModel(svc)
-	err := model.SetASGMinToZero(aws.String("testASG"))
+	model := NewASGModel(svc, testTagConfig)
+	_, err := model.SetASGSizeToZero(context.Background(), aws.String("testASG"))
 
 	assert.Nil(t, err)
+	svc.AssertCalled(t, "CreateOrUpdateTagsWithContext", mock.Anything, &autoscaling.CreateOrUpdateTagsInput{
+		Tags: []*autoscaling.Tag{
+			newASGTag(aws.String("testASG"), "maxSize", 4),
+			newASGTag(aws.String("testASG"), "desiredCapacity", 1),
+		},
+	})
+}
+
+func TestSetASGSizeToZeroTagError(t *testing.T) {
+	svc := new(mocks.AutoScalingAPI)
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []*autoscaling.Group{
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String("testASG"),
+				MinSize:              aws.Int64(2),
+				MaxSize:              aws.Int64(6),
+				DesiredCapacity:      aws.Int64(3),
+			},
+		},
+	}, nil)
+	svc.On("CreateOrUpdateTagsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.CreateOrUpdateTagsInput")).Return(nil, errors.New("aws-error"))
+
+	model := NewASGModel(svc, testTagConfig)
+	_, err := model.SetASGSizeToZero(context.Background(), aws.String("testASG"))
+
+	assert.Error(t, err)
+	assert.Equal(t, errors.New("aws-error"), err)
+	svc.AssertNotCalled(t, "UpdateAutoScalingGroupWithContext", mock.Anything, mock.Anything)
 }
 
-func TestSetASGMinToZeroAwsError(t *testing.T) {
+func TestSetASGSizeToZeroAwsError(t *testing.T) {
 	svc := new(mocks.AutoScalingAPI)
-	svc.On("UpdateAutoScalingGroup", mock.AnythingOfType("*autoscaling.UpdateAutoScalingGroupInput")).Return(nil, errors.New("aws-error"))
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []*autoscaling.Group{
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String("testASG"),
+				MinSize:              aws.Int64(2),
+				MaxSize:              aws.Int64(6),
+				DesiredCapacity:      aws.Int64(3),
+			},
+		},
+	}, nil)
+	svc.On("CreateOrUpdateTagsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.CreateOrUpdateTagsInput")).Return(nil, nil)
+	svc.On("UpdateAutoScalingGroupWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.UpdateAutoScalingGroupInput")).Return(nil, errors.New("aws-error"))
 
-	model := NewASGModel(svc)
-	err := model.SetASGMinToZero(aws.String("testASG"))
+	model := NewASGModel(svc, testTagConfig)
+	_, err := model.SetASGSizeToZero(context.Background(), aws.String("testASG"))
 
 	assert.Error(t, err)
 	assert.Equal(t, errors.New("aws-error"), err)
 }
 
-// DescribeAutoScalingGroupForTagsAndAction
+// DescribeAutoScalingGroupsForTags
 
-func TestDescribeAutoScalingGroupForTagsAndActionAwsError(t *testing.T) {
+func TestDescribeAutoScalingGroupsForTagsAwsError(t *testing.T) {
 	svc := new(mocks.AutoScalingAPI)
-	svc.On("DescribeAutoScalingGroups", mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(nil, errors.New("aws-error"))
+	mockDescribeAutoScalingGroupsPages(svc, errors.New("aws-error"))
 
-	model := NewASGModel(svc)
-	asgName, err := model.DescribeAutoScalingGroupForTagsAndAction("repo", "branch", "action")
+	model := NewASGModel(svc, testTagConfig)
+	groups, _, err := model.DescribeAutoScalingGroupsForTags(context.Background(), "repo", "branch")
 
 	assert.Error(t, err)
-	assert.Nil(t, asgName)
+	assert.Len(t, groups, 0)
 	assert.Equal(t, errors.New("aws-error"), err)
 }
+
+func TestDescribeAutoScalingGroupsForTagsMultiplePages(t *testing.T) {
+	svc := new(mocks.AutoScalingAPI)
+	mockDescribeAutoScalingGroupsPages(svc, nil, &autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []*autoscaling.Group{
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String("otherASG"),
+				MinSize:              aws.Int64(2),
+				Tags: []*autoscaling.TagDescription{
+					&autoscaling.TagDescription{
+						Key:   aws.String("repository"),
+						Value: aws.String("other-repo"),
+					},
+				},
+			},
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String("webASG"),
+				MinSize:              aws.Int64(2),
+				Tags: []*autoscaling.TagDescription{
+					&autoscaling.TagDescription{
+						Key:   aws.String("repository"),
+						Value: aws.String("repo"),
+					},
+					&autoscaling.TagDescription{
+						Key:   aws.String("branch_raw"),
+						Value: aws.String("branch"),
+					},
+				},
+			},
+		},
+		NextToken: aws.String("token"),
+	}, &autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []*autoscaling.Group{
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String("workerASG"),
+				MinSize:              aws.Int64(2),
+				Tags: []*autoscaling.TagDescription{
+					&autoscaling.TagDescription{
+						Key:   aws.String("repository"),
+						Value: aws.String("repo"),
+					},
+					&autoscaling.TagDescription{
+						Key:   aws.String("branch_raw"),
+						Value: aws.String("branch"),
+					},
+				},
+			},
+		},
+	})
+
+	model := NewASGModel(svc, testTagConfig)
+	groups, _, err := model.DescribeAutoScalingGroupsForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err)
+	assert.Len(t, groups, 2)
+	assert.Equal(t, "webASG", *groups[0].AutoScalingGroupName)
+	assert.Equal(t, "workerASG", *groups[1].AutoScalingGroupName)
+}
+
+func TestIsASGActionRequired(t *testing.T) {
+	running := &autoscaling.Group{MinSize: aws.Int64(2), MaxSize: aws.Int64(4)}
+	runningWithMinSizeZero := &autoscaling.Group{MinSize: aws.Int64(0), MaxSize: aws.Int64(4)}
+	stopped := &autoscaling.Group{
+		MinSize: aws.Int64(0),
+		MaxSize: aws.Int64(0),
+		Tags: []*autoscaling.TagDescription{
+			&autoscaling.TagDescription{Key: aws.String("minSize"), Value: aws.String("2")},
+		},
+	}
+	withoutPreviousSize := &autoscaling.Group{MinSize: aws.Int64(0), MaxSize: aws.Int64(0)}
+	stoppedByPreviousVersion := &autoscaling.Group{
+		MinSize:         aws.Int64(0),
+		MaxSize:         aws.Int64(4),
+		DesiredCapacity: aws.Int64(0),
+		Tags: []*autoscaling.TagDescription{
+			&autoscaling.TagDescription{Key: aws.String("minSize"), Value: aws.String("2")},
+		},
+	}
+
+	assert.True(t, IsASGActionRequired("stop", running), "Expected running group to require stop")
+	assert.True(t, IsASGActionRequired("stop", runningWithMinSizeZero), "Expected running group with min size 0 to require stop")
+	assert.False(t, IsASGActionRequired("stop", stopped), "Expected stopped group to require no stop")
+	assert.True(t, IsASGActionRequired("start", stopped), "Expected stopped group to require start")
+	assert.False(t, IsASGActionRequired("start", runningWithMinSizeZero), "Expected running group with min size 0 to require no start")
+	assert.False(t, IsASGActionRequired("start", withoutPreviousSize), "Expected group without previous size to require no start")
+	assert.True(t, IsASGActionRequired("start", stoppedByPreviousVersion), "Expected group stopped by a previous version to require start")
+	assert.False(t, IsASGActionRequired("stop", stoppedByPreviousVersion), "Expected group stopped by a previous version to require no stop")
+	assert.False(t, IsASGActionRequired("restart", running), "Expected unknown action to require nothing")
+}
+
+// newASGWithInstances returns an autoscaling group with the given desired capacity and instances in the given lifecycle states
+func newASGWithInstances(desiredCapacity int64, lifecycleStates ...string) *autoscaling.DescribeAutoScalingGroupsOutput {
+	instances := []*autoscaling.Instance{}
+	for _, lifecycleState := range lifecycleStates {
+		instances = append(instances, &autoscaling.Instance{
+			LifecycleState: aws.String(lifecycleState),
+		})
+	}
+	return &autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []*autoscaling.Group{
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String("testASG"),
+				DesiredCapacity:      aws.Int64(desiredCapacity),
+				Instances:            instances,
+			},
+		},
+	}
+}
+
+func TestWaitUntilASGInService(t *testing.T) {
+	defer func(previous time.Duration) { asgPollInterval = previous }(asgPollInterval)
+	asgPollInterval = time.Millisecond
+
+	svc := new(mocks.AutoScalingAPI)
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(newASGWithInstances(2, "InService", "Pending"), nil).Once()
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(newASGWithInstances(2, "InService", "InService"), nil).Once()
+
+	model := NewASGModel(svc, testTagConfig)
+	err := model.WaitUntilASGInService(context.Background(), aws.String("testASG"))
+
+	assert.Nil(t, err)
+	svc.AssertNumberOfCalls(t, "DescribeAutoScalingGroupsWithContext", 2)
+}
+
+func TestWaitUntilASGInServiceStopped(t *testing.T) {
+	defer func(previous time.Duration) { asgPollInterval = previous }(asgPollInterval)
+	asgPollInterval = time.Millisecond
+
+	svc := new(mocks.AutoScalingAPI)
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(newASGWithInstances(0, "Terminating"), nil).Once()
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(newASGWithInstances(0), nil).Once()
+
+	model := NewASGModel(svc, testTagConfig)
+	err := model.WaitUntilASGInService(context.Background(), aws.String("testASG"))
+
+	assert.Nil(t, err)
+	svc.AssertNumberOfCalls(t, "DescribeAutoScalingGroupsWithContext", 2)
+}
+
+func TestWaitUntilASGInServiceCancelled(t *testing.T) {
+	svc := new(mocks.AutoScalingAPI)
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(newASGWithInstances(2, "Pending"), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	model := NewASGModel(svc, testTagConfig)
+	err := model.WaitUntilASGInService(ctx, aws.String("testASG"))
+
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestWaitUntilASGInServiceNotFound(t *testing.T) {
+	svc := new(mocks.AutoScalingAPI)
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+
+	model := NewASGModel(svc, testTagConfig)
+	err := model.WaitUntilASGInService(context.Background(), aws.String("testASG"))
+
+	assert.EqualError(t, err, "found no autoscaling group for testASG")
+}
+
+func TestWaitUntilASGInServiceAwsError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.AutoScalingAPI)
+	svc.On("DescribeAutoScalingGroupsWithContext", mock.Anything, mock.AnythingOfType("*autoscaling.DescribeAutoScalingGroupsInput")).Return(nil, errorMsg)
+
+	model := NewASGModel(svc, testTagConfig)
+	err := model.WaitUntilASGInService(context.Background(), aws.String("testASG"))
+
+	assert.Equal(t, errorMsg, err)
+}
+
+func TestDescribeAutoScalingGroupsForTagsCustomTags(t *testing.T) {
+	svc := new(mocks.AutoScalingAPI)
+	mockDescribeAutoScalingGroupsPages(svc, nil, &autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []*autoscaling.Group{
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String("productionASG"),
+				MinSize:              aws.Int64(2),
+				Tags: []*autoscaling.TagDescription{
+					&autoscaling.TagDescription{
+						Key:   aws.String("app"),
+						Value: aws.String("repo"),
+					},
+					&autoscaling.TagDescription{
+						Key:   aws.String("git-branch"),
+						Value: aws.String("branch"),
+					},
+					&autoscaling.TagDescription{
+						Key:   aws.String("environment"),
+						Value: aws.String("production"),
+					},
+				},
+			},
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String("stagingASG"),
+				MinSize:              aws.Int64(2),
+				Tags: []*autoscaling.TagDescription{
+					&autoscaling.TagDescription{
+						Key:   aws.String("app"),
+						Value: aws.String("repo"),
+					},
+					&autoscaling.TagDescription{
+						Key:   aws.String("git-branch"),
+						Value: aws.String("branch"),
+					},
+					&autoscaling.TagDescription{
+						Key:   aws.String("environment"),
+						Value: aws.String("staging"),
+					},
+				},
+			},
+		},
+	})
+
+	model := NewASGModel(svc, types.TagConfig{
+		RepositoryKey: "app",
+		BranchKey:     "git-branch",
+		RequiredTags:  map[string]string{"environment": "staging"},
+	})
+	groups, _, err := model.DescribeAutoScalingGroupsForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err)
+	assert.Len(t, groups, 1)
+	assert.Equal(t, "stagingASG", *groups[0].AutoScalingGroupName)
+}
+
+func TestDescribeAutoScalingGroupsForTagsExcluded(t *testing.T) {
+	svc := new(mocks.AutoScalingAPI)
+	mockDescribeAutoScalingGroupsPages(svc, nil, &autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []*autoscaling.Group{
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String("webASG"),
+				MinSize:              aws.Int64(2),
+				Tags: []*autoscaling.TagDescription{
+					&autoscaling.TagDescription{Key: aws.String("repository"), Value: aws.String("repo")},
+					&autoscaling.TagDescription{Key: aws.String("branch_raw"), Value: aws.String("branch")},
+				},
+			},
+			&autoscaling.Group{
+				AutoScalingGroupName: aws.String("cacheASG"),
+				MinSize:              aws.Int64(1),
+				Tags: []*autoscaling.TagDescription{
+					&autoscaling.TagDescription{Key: aws.String("repository"), Value: aws.String("repo")},
+					&autoscaling.TagDescription{Key: aws.String("branch_raw"), Value: aws.String("branch")},
+					&autoscaling.TagDescription{Key: aws.String("auto-staging:schedule"), Value: aws.String("ignore")},
+				},
+			},
+		},
+	})
+
+	model := NewASGModel(svc, testExcludeTagConfig)
+	groups, excluded, err := model.DescribeAutoScalingGroupsForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err)
+	assert.Len(t, groups, 1)
+	assert.Equal(t, "webASG", *groups[0].AutoScalingGroupName)
+	assert.Len(t, excluded, 1)
+	assert.Equal(t, "cacheASG", *excluded[0].AutoScalingGroupName)
+}
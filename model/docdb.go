@@ -0,0 +1,231 @@
+package model
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/auto-staging/scheduler/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/docdb/docdbiface"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+)
+
+// DocDBModelAPI is an interface including all DocumentDB model functions
+type DocDBModelAPI interface {
+	GetDocDBClustersForTags(ctx context.Context, repository, branch string) ([]*docdb.DBCluster, []*docdb.DBCluster, error)
+	StopDocDBCluster(ctx context.Context, clusterARN, clusterStatus *string) (bool, error)
+	StartDocDBCluster(ctx context.Context, clusterARN, clusterStatus *string) (bool, error)
+	WaitUntilDocDBClusterAvailable(ctx context.Context, clusterARN *string) error
+	WaitUntilDocDBClusterStopped(ctx context.Context, clusterARN *string) error
+}
+
+// docDBEngine is the engine of DocumentDB Clusters, the RDS API also returns these Clusters, but they are only changed by the DocDBModel
+const docDBEngine = "docdb"
+
+// DocDBModel is a struct including the AWS SDK DocumentDB and Resource Groups Tagging interfaces, all DocumentDB model functions are called on this struct and
+// the included AWS SDK services. The Resource Groups Tagging service is used to find the Clusters of an Environment by the tags configured in the TagConfig,
+// if it is not set or the lookup fails, the tags of every Cluster are checked instead.
+type DocDBModel struct {
+	docdbiface.DocDBAPI
+	resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+	tags types.TagConfig
+}
+
+// NewDocDBModel takes the AWS SDK DocumentDB and Resource Groups Tagging Interfaces and the tag config as parameter and returns the pointer to a DocDBModel
+// struct, on which all DocumentDB model functions can be called
+func NewDocDBModel(svc docdbiface.DocDBAPI, taggingSvc resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI, tags types.TagConfig) *DocDBModel {
+	return &DocDBModel{
+		DocDBAPI:                    svc,
+		ResourceGroupsTaggingAPIAPI: taggingSvc,
+		tags:                        tags,
+	}
+}
+
+// GetDocDBClustersForTags returns all DocumentDB Clusters found for the given repository and branch tag values. The Clusters are looked up with the Resource
+// Groups Tagging API, if this lookup isn't possible the tags of all Clusters get checked instead. Clusters with the exclude tag are returned separately as
+// second value and must not be started or stopped.
+// If an error occurs, the error gets logged and then returned.
+func (docdbModel *DocDBModel) GetDocDBClustersForTags(ctx context.Context, repository, branch string) ([]*docdb.DBCluster, []*docdb.DBCluster, error) {
+	if docdbModel.ResourceGroupsTaggingAPIAPI != nil {
+		clusterARNs, excludedARNs, err := getResourceARNsForTags(ctx, docdbModel.ResourceGroupsTaggingAPIAPI, docdbModel.tags, "rds:cluster", repository, branch)
+		if err == nil {
+			return docdbModel.describeDocDBClusters(ctx, clusterARNs, excludedARNs)
+		}
+		log.Println("Tagging API lookup for DocumentDB Clusters failed, falling back to checking the tags of every Cluster")
+	}
+
+	return docdbModel.listDocDBClustersForTags(ctx, repository, branch)
+}
+
+// describeDocDBClusters returns the DocumentDB Clusters matching the given ARNs, the Tagging API also returns the ARNs of RDS Clusters, which are skipped.
+// Clusters contained in the excludedARNs set are returned separately as second value.
+func (docdbModel *DocDBModel) describeDocDBClusters(ctx context.Context, clusterARNs []*string, excludedARNs map[string]bool) ([]*docdb.DBCluster, []*docdb.DBCluster, error) {
+	clusters := []*docdb.DBCluster{}
+	excluded := []*docdb.DBCluster{}
+	if len(clusterARNs) == 0 {
+		log.Println("Found no matching DocumentDB Cluster")
+		return clusters, excluded, nil
+	}
+
+	err := docdbModel.DocDBAPI.DescribeDBClustersPagesWithContext(ctx, &docdb.DescribeDBClustersInput{
+		Filters: []*docdb.Filter{
+			{
+				Name:   aws.String("db-cluster-id"),
+				Values: clusterARNs,
+			},
+			{
+				Name:   aws.String("engine"),
+				Values: []*string{aws.String(docDBEngine)},
+			},
+		},
+	}, func(result *docdb.DescribeDBClustersOutput, lastPage bool) bool {
+		for _, cluster := range result.DBClusters {
+			if excludedARNs[*cluster.DBClusterArn] {
+				log.Printf("DocumentDB cluster %s is excluded by tag \n", *cluster.DBClusterArn)
+				excluded = append(excluded, cluster)
+				continue
+			}
+			log.Printf("Found DocumentDB cluster %s matching the tags with status %s \n", *cluster.DBClusterArn, *cluster.Status)
+			clusters = append(clusters, cluster)
+		}
+		return true
+	})
+	if err != nil {
+		log.Println(err)
+		return []*docdb.DBCluster{}, []*docdb.DBCluster{}, err
+	}
+
+	if len(clusters) == 0 {
+		log.Println("Found no matching DocumentDB Cluster")
+	}
+	return clusters, excluded, nil
+}
+
+// listDocDBClustersForTags returns all DocumentDB Clusters and the excluded Clusters found for the given repository and branch tag values by checking the
+// tags of every Cluster.
+// All result pages of the DescribeDBClusters call are processed.
+func (docdbModel *DocDBModel) listDocDBClustersForTags(ctx context.Context, repository, branch string) ([]*docdb.DBCluster, []*docdb.DBCluster, error) {
+	clusters := []*docdb.DBCluster{}
+	excluded := []*docdb.DBCluster{}
+	var tagErr error
+	err := docdbModel.DocDBAPI.DescribeDBClustersPagesWithContext(ctx, &docdb.DescribeDBClustersInput{
+		Filters: []*docdb.Filter{
+			{
+				Name:   aws.String("engine"),
+				Values: []*string{aws.String(docDBEngine)},
+			},
+		},
+	}, func(result *docdb.DescribeDBClustersOutput, lastPage bool) bool {
+		for _, cluster := range result.DBClusters {
+			tags, err := docdbModel.DocDBAPI.ListTagsForResourceWithContext(ctx, &docdb.ListTagsForResourceInput{
+				ResourceName: cluster.DBClusterArn,
+			})
+			if err != nil {
+				tagErr = err
+				return false
+			}
+			tagMap := map[string]string{}
+			for _, tag := range tags.TagList {
+				tagMap[*tag.Key] = *tag.Value
+			}
+
+			if !docdbModel.tags.MatchesEnvironment(tagMap, repository, branch) {
+				continue
+			}
+			if docdbModel.tags.IsExcluded(tagMap) {
+				log.Printf("DocumentDB cluster %s is excluded by tag \n", *cluster.DBClusterArn)
+				excluded = append(excluded, cluster)
+				continue
+			}
+			log.Printf("Found DocumentDB cluster %s matching the tags with status %s \n", *cluster.DBClusterArn, *cluster.Status)
+			clusters = append(clusters, cluster)
+		}
+		return true
+	})
+	if err == nil {
+		err = tagErr
+	}
+	if err != nil {
+		log.Println(err)
+		return []*docdb.DBCluster{}, []*docdb.DBCluster{}, err
+	}
+
+	if len(clusters) == 0 {
+		log.Println("Found no matching DocumentDB Cluster")
+	}
+	return clusters, excluded, nil
+}
+
+// StopDocDBCluster stops the DocumentDB Cluster for the given Cluster ARN and status. It returns true, if the state of the Cluster was changed and false if not.
+// If an error occurs, the error gets logged and then returned.
+func (docdbModel *DocDBModel) StopDocDBCluster(ctx context.Context, clusterARN, clusterStatus *string) (bool, error) {
+	if IsRDSActionRequired("stop", *clusterStatus) {
+		log.Println("Stopping DOCUMENTDB CLUSTER")
+		_, err := docdbModel.DocDBAPI.StopDBClusterWithContext(ctx, &docdb.StopDBClusterInput{
+			DBClusterIdentifier: clusterARN,
+		})
+		if err != nil {
+			log.Println(err)
+			return false, err
+		}
+		return true, nil
+	}
+	log.Println("DocumentDB - No action required")
+	return false, nil
+}
+
+// StartDocDBCluster starts the DocumentDB Cluster for the given Cluster ARN and status. It returns true, if the state of the Cluster was changed and false if not.
+// If an error occurs, the error gets logged and then returned.
+func (docdbModel *DocDBModel) StartDocDBCluster(ctx context.Context, clusterARN, clusterStatus *string) (bool, error) {
+	if IsRDSActionRequired("start", *clusterStatus) {
+		log.Println("Starting DOCUMENTDB CLUSTER")
+		_, err := docdbModel.DocDBAPI.StartDBClusterWithContext(ctx, &docdb.StartDBClusterInput{
+			DBClusterIdentifier: clusterARN,
+		})
+		if err != nil {
+			log.Println(err)
+			return false, err
+		}
+		return true, nil
+	}
+	log.Println("DocumentDB - No action required")
+	return false, nil
+}
+
+// WaitUntilDocDBClusterAvailable polls the status of the DocumentDB Cluster for the given Cluster ARN until it is available. Waiting is aborted, when the context is done.
+// If an error occurs, the error gets logged and then returned.
+func (docdbModel *DocDBModel) WaitUntilDocDBClusterAvailable(ctx context.Context, clusterARN *string) error {
+	return docdbModel.waitForDocDBClusterStatus(ctx, clusterARN, "available")
+}
+
+// WaitUntilDocDBClusterStopped polls the status of the DocumentDB Cluster for the given Cluster ARN until it is stopped. Waiting is aborted, when the context is done.
+// If an error occurs, the error gets logged and then returned.
+func (docdbModel *DocDBModel) WaitUntilDocDBClusterStopped(ctx context.Context, clusterARN *string) error {
+	return docdbModel.waitForDocDBClusterStatus(ctx, clusterARN, "stopped")
+}
+
+// waitForDocDBClusterStatus polls the status of the DocumentDB Cluster for the given Cluster ARN every rdsPollInterval until it matches the given status.
+func (docdbModel *DocDBModel) waitForDocDBClusterStatus(ctx context.Context, clusterARN *string, status string) error {
+	for {
+		result, err := docdbModel.DocDBAPI.DescribeDBClustersWithContext(ctx, &docdb.DescribeDBClustersInput{
+			DBClusterIdentifier: clusterARN,
+		})
+		if err != nil {
+			log.Println(err)
+			return err
+		}
+		if len(result.DBClusters) > 0 && *result.DBClusters[0].Status == status {
+			log.Printf("DocumentDB cluster %s is %s \n", *clusterARN, status)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Printf("Stopped waiting for DocumentDB cluster %s, %s \n", *clusterARN, ctx.Err())
+			return ctx.Err()
+		case <-time.After(rdsPollInterval):
+		}
+	}
+}
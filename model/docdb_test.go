@@ -0,0 +1,285 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/auto-staging/scheduler/mocks"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewDocDBModel(t *testing.T) {
+	svc := new(mocks.DocDBAPI)
+
+	model := NewDocDBModel(svc, nil, testTagConfig)
+
+	assert.NotEmpty(t, model, "Expected not empty")
+	assert.Equal(t, svc, model.DocDBAPI, "DocumentDB service from model is not matching the one used as parameter")
+	assert.Nil(t, model.ResourceGroupsTaggingAPIAPI, "Expected no tagging service")
+	assert.Equal(t, testTagConfig, model.tags, "Tag config from model is not matching the one used as parameter")
+}
+
+// mockDocDBDescribeDBClustersPages mocks DescribeDBClustersPages, the given pages are passed one after another to the callback function
+func mockDocDBDescribeDBClustersPages(svc *mocks.DocDBAPI, err error, pages ...*docdb.DescribeDBClustersOutput) {
+	svc.On("DescribeDBClustersPagesWithContext", mock.Anything, mock.AnythingOfType("*docdb.DescribeDBClustersInput"), mock.AnythingOfType("func(*docdb.DescribeDBClustersOutput, bool) bool")).Run(func(args mock.Arguments) {
+		if err != nil {
+			return
+		}
+		fn := args.Get(2).(func(*docdb.DescribeDBClustersOutput, bool) bool)
+		for i := range pages {
+			if !fn(pages[i], i == len(pages)-1) {
+				return
+			}
+		}
+	}).Return(err)
+}
+
+// mockDocDBListTagsForResource mocks ListTagsForResource for the Cluster with the given ARN to return the given tags
+func mockDocDBListTagsForResource(svc *mocks.DocDBAPI, clusterARN string, tags map[string]string) {
+	output := &docdb.ListTagsForResourceOutput{TagList: []*docdb.Tag{}}
+	for key, value := range tags {
+		output.TagList = append(output.TagList, &docdb.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+	svc.On("ListTagsForResourceWithContext", mock.Anything, &docdb.ListTagsForResourceInput{ResourceName: aws.String(clusterARN)}).Return(output, nil)
+}
+
+func TestGetDocDBClustersForTags(t *testing.T) {
+	svc := new(mocks.DocDBAPI)
+	mockDocDBDescribeDBClustersPages(svc, nil, &docdb.DescribeDBClustersOutput{
+		DBClusters: []*docdb.DBCluster{
+			{DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:docdb"), Status: aws.String("available")},
+			{DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:other"), Status: aws.String("available")},
+		},
+	}, &docdb.DescribeDBClustersOutput{
+		DBClusters: []*docdb.DBCluster{
+			{DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:shared"), Status: aws.String("stopped")},
+		},
+	})
+	mockDocDBListTagsForResource(svc, "arn:aws:rds:eu-west-1:123456789012:cluster:docdb", map[string]string{"repository": "repo", "branch_raw": "branch"})
+	mockDocDBListTagsForResource(svc, "arn:aws:rds:eu-west-1:123456789012:cluster:other", map[string]string{"repository": "repo", "branch_raw": "other"})
+	mockDocDBListTagsForResource(svc, "arn:aws:rds:eu-west-1:123456789012:cluster:shared", map[string]string{"repository": "repo", "branch_raw": "branch", "auto-staging:schedule": "ignore"})
+
+	model := NewDocDBModel(svc, nil, testExcludeTagConfig)
+	clusters, excluded, err := model.GetDocDBClustersForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, clusters, 1)
+	assert.Equal(t, "arn:aws:rds:eu-west-1:123456789012:cluster:docdb", *clusters[0].DBClusterArn)
+	assert.Len(t, excluded, 1)
+	assert.Equal(t, "arn:aws:rds:eu-west-1:123456789012:cluster:shared", *excluded[0].DBClusterArn)
+	svc.AssertCalled(t, "DescribeDBClustersPagesWithContext", mock.Anything, &docdb.DescribeDBClustersInput{
+		Filters: []*docdb.Filter{
+			{Name: aws.String("engine"), Values: []*string{aws.String("docdb")}},
+		},
+	}, mock.Anything)
+}
+
+func TestGetDocDBClustersForTagsListTagsError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.DocDBAPI)
+	mockDocDBDescribeDBClustersPages(svc, nil, &docdb.DescribeDBClustersOutput{
+		DBClusters: []*docdb.DBCluster{
+			{DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:docdb"), Status: aws.String("available")},
+		},
+	})
+	svc.On("ListTagsForResourceWithContext", mock.Anything, mock.AnythingOfType("*docdb.ListTagsForResourceInput")).Return(nil, errorMsg)
+
+	model := NewDocDBModel(svc, nil, testTagConfig)
+	clusters, _, err := model.GetDocDBClustersForTags(context.Background(), "repo", "branch")
+
+	assert.Equal(t, errorMsg, err)
+	assert.Empty(t, clusters)
+}
+
+func TestGetDocDBClustersForTagsDescribeError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.DocDBAPI)
+	mockDocDBDescribeDBClustersPages(svc, errorMsg)
+
+	model := NewDocDBModel(svc, nil, testTagConfig)
+	_, _, err := model.GetDocDBClustersForTags(context.Background(), "repo", "branch")
+
+	assert.Equal(t, errorMsg, err)
+}
+
+func TestGetDocDBClustersForTagsTaggingAPI(t *testing.T) {
+	clusterARN := "arn:aws:rds:eu-west-1:123456789012:cluster:docdb"
+	excludedARN := "arn:aws:rds:eu-west-1:123456789012:cluster:shared"
+
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+	mockGetResourcesPages(taggingSvc, nil, &resourcegroupstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+			{ResourceARN: aws.String(clusterARN)},
+			{
+				ResourceARN: aws.String(excludedARN),
+				Tags: []*resourcegroupstaggingapi.Tag{
+					{Key: aws.String("auto-staging:schedule"), Value: aws.String("ignore")},
+				},
+			},
+		},
+	})
+
+	svc := new(mocks.DocDBAPI)
+	mockDocDBDescribeDBClustersPages(svc, nil, &docdb.DescribeDBClustersOutput{
+		DBClusters: []*docdb.DBCluster{
+			{DBClusterArn: aws.String(clusterARN), Status: aws.String("available")},
+			{DBClusterArn: aws.String(excludedARN), Status: aws.String("stopped")},
+		},
+	})
+
+	model := NewDocDBModel(svc, taggingSvc, testExcludeTagConfig)
+	clusters, excluded, err := model.GetDocDBClustersForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, clusters, 1)
+	assert.Equal(t, clusterARN, *clusters[0].DBClusterArn)
+	assert.Len(t, excluded, 1)
+	assert.Equal(t, excludedARN, *excluded[0].DBClusterArn)
+	svc.AssertCalled(t, "DescribeDBClustersPagesWithContext", mock.Anything, &docdb.DescribeDBClustersInput{
+		Filters: []*docdb.Filter{
+			{Name: aws.String("db-cluster-id"), Values: aws.StringSlice([]string{clusterARN, excludedARN})},
+			{Name: aws.String("engine"), Values: []*string{aws.String("docdb")}},
+		},
+	}, mock.Anything)
+	svc.AssertNotCalled(t, "ListTagsForResourceWithContext", mock.Anything, mock.Anything)
+	taggingSvc.AssertCalled(t, "GetResourcesPagesWithContext", mock.Anything, mock.MatchedBy(func(input *resourcegroupstaggingapi.GetResourcesInput) bool {
+		return *input.ResourceTypeFilters[0] == "rds:cluster"
+	}), mock.Anything)
+}
+
+func TestGetDocDBClustersForTagsTaggingAPINoClusters(t *testing.T) {
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+	mockGetResourcesPages(taggingSvc, nil, &resourcegroupstaggingapi.GetResourcesOutput{})
+
+	svc := new(mocks.DocDBAPI)
+
+	model := NewDocDBModel(svc, taggingSvc, testTagConfig)
+	clusters, excluded, err := model.GetDocDBClustersForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Empty(t, clusters)
+	assert.Empty(t, excluded)
+	svc.AssertNotCalled(t, "DescribeDBClustersPagesWithContext", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetDocDBClustersForTagsTaggingAPIError(t *testing.T) {
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+	mockGetResourcesPages(taggingSvc, errors.New("Test error"))
+
+	svc := new(mocks.DocDBAPI)
+	mockDocDBDescribeDBClustersPages(svc, nil, &docdb.DescribeDBClustersOutput{
+		DBClusters: []*docdb.DBCluster{
+			{DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:docdb"), Status: aws.String("available")},
+		},
+	})
+	mockDocDBListTagsForResource(svc, "arn:aws:rds:eu-west-1:123456789012:cluster:docdb", map[string]string{"repository": "repo", "branch_raw": "branch"})
+
+	model := NewDocDBModel(svc, taggingSvc, testTagConfig)
+	clusters, _, err := model.GetDocDBClustersForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected the lookup to fall back to checking the tags of every Cluster")
+	assert.Len(t, clusters, 1)
+}
+
+func TestStopDocDBCluster(t *testing.T) {
+	clusterARN := aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:docdb")
+
+	svc := new(mocks.DocDBAPI)
+	svc.On("StopDBClusterWithContext", mock.Anything, mock.AnythingOfType("*docdb.StopDBClusterInput")).Return(&docdb.StopDBClusterOutput{}, nil)
+
+	model := NewDocDBModel(svc, nil, testTagConfig)
+	changed, err := model.StopDocDBCluster(context.Background(), clusterARN, aws.String("available"))
+
+	assert.Nil(t, err, "Expected no error")
+	assert.True(t, changed, "Expected changed to be true")
+	svc.AssertCalled(t, "StopDBClusterWithContext", mock.Anything, &docdb.StopDBClusterInput{
+		DBClusterIdentifier: clusterARN,
+	})
+}
+
+func TestStopDocDBClusterWrongStatus(t *testing.T) {
+	svc := new(mocks.DocDBAPI)
+
+	model := NewDocDBModel(svc, nil, testTagConfig)
+	changed, err := model.StopDocDBCluster(context.Background(), aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:docdb"), aws.String("stopped"))
+
+	assert.Nil(t, err, "Expected no error")
+	assert.False(t, changed, "Expected changed to be false")
+	svc.AssertNotCalled(t, "StopDBClusterWithContext", mock.Anything, mock.Anything)
+}
+
+func TestStartDocDBCluster(t *testing.T) {
+	clusterARN := aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:docdb")
+
+	svc := new(mocks.DocDBAPI)
+	svc.On("StartDBClusterWithContext", mock.Anything, mock.AnythingOfType("*docdb.StartDBClusterInput")).Return(&docdb.StartDBClusterOutput{}, nil)
+
+	model := NewDocDBModel(svc, nil, testTagConfig)
+	changed, err := model.StartDocDBCluster(context.Background(), clusterARN, aws.String("stopped"))
+
+	assert.Nil(t, err, "Expected no error")
+	assert.True(t, changed, "Expected changed to be true")
+	svc.AssertCalled(t, "StartDBClusterWithContext", mock.Anything, &docdb.StartDBClusterInput{
+		DBClusterIdentifier: clusterARN,
+	})
+}
+
+func TestStartDocDBClusterError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.DocDBAPI)
+	svc.On("StartDBClusterWithContext", mock.Anything, mock.AnythingOfType("*docdb.StartDBClusterInput")).Return(nil, errorMsg)
+
+	model := NewDocDBModel(svc, nil, testTagConfig)
+	changed, err := model.StartDocDBCluster(context.Background(), aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:docdb"), aws.String("stopped"))
+
+	assert.Equal(t, errorMsg, err)
+	assert.False(t, changed, "Expected changed to be false")
+}
+
+func TestWaitUntilDocDBClusterStopped(t *testing.T) {
+	defer func(previous time.Duration) { rdsPollInterval = previous }(rdsPollInterval)
+	rdsPollInterval = time.Millisecond
+
+	clusterARN := aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:docdb")
+
+	svc := new(mocks.DocDBAPI)
+	svc.On("DescribeDBClustersWithContext", mock.Anything, mock.AnythingOfType("*docdb.DescribeDBClustersInput")).Return(&docdb.DescribeDBClustersOutput{
+		DBClusters: []*docdb.DBCluster{{DBClusterArn: clusterARN, Status: aws.String("stopping")}},
+	}, nil).Once()
+	svc.On("DescribeDBClustersWithContext", mock.Anything, mock.AnythingOfType("*docdb.DescribeDBClustersInput")).Return(&docdb.DescribeDBClustersOutput{
+		DBClusters: []*docdb.DBCluster{{DBClusterArn: clusterARN, Status: aws.String("stopped")}},
+	}, nil).Once()
+
+	model := NewDocDBModel(svc, nil, testTagConfig)
+	err := model.WaitUntilDocDBClusterStopped(context.Background(), clusterARN)
+
+	assert.Nil(t, err, "Expected no error")
+	svc.AssertNumberOfCalls(t, "DescribeDBClustersWithContext", 2)
+	svc.AssertCalled(t, "DescribeDBClustersWithContext", mock.Anything, &docdb.DescribeDBClustersInput{
+		DBClusterIdentifier: clusterARN,
+	})
+}
+
+func TestWaitUntilDocDBClusterAvailableCancelled(t *testing.T) {
+	svc := new(mocks.DocDBAPI)
+	svc.On("DescribeDBClustersWithContext", mock.Anything, mock.AnythingOfType("*docdb.DescribeDBClustersInput")).Return(&docdb.DescribeDBClustersOutput{
+		DBClusters: []*docdb.DBCluster{{Status: aws.String("starting")}},
+	}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	model := NewDocDBModel(svc, nil, testTagConfig)
+	err := model.WaitUntilDocDBClusterAvailable(ctx, aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:docdb"))
+
+	assert.Equal(t, context.Canceled, err, "Expected context error")
+}
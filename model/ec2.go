@@ -1,9 +1,11 @@
 package model
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/auto-staging/scheduler/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
@@ -11,84 +13,142 @@ import (
 
 // EC2ModelAPI is an interface including all EC2 model functions
 type EC2ModelAPI interface {
-	DescribeInstancesForTagsAndAction(repository, branch, action string) ([]*string, error)
-	StartEC2Instances(instanceIDs []*string) error
-	StopEC2Instances(instanceIDs []*string) error
+	DescribeInstancesForTags(ctx context.Context, repository, branch string) ([]*ec2.Instance, []*ec2.Instance, error)
+	StartEC2Instances(ctx context.Context, instanceIDs []*string) ([]*ec2.InstanceStateChange, error)
+	StopEC2Instances(ctx context.Context, instanceIDs []*string) ([]*ec2.InstanceStateChange, error)
+	WaitUntilEC2InstancesRunning(ctx context.Context, instanceIDs []*string) error
+	WaitUntilEC2InstancesStopped(ctx context.Context, instanceIDs []*string) error
 }
 
-// EC2Model is a struct including the AWS SDK EC2 interface, all EC2 model functions are called on this struct and the included AWS SDK EC2 service
+// EC2Model is a struct including the AWS SDK EC2 interface, all EC2 model functions are called on this struct and the included AWS SDK EC2 service.
+// The instances of an Environment are found by the tags configured in the TagConfig.
 type EC2Model struct {
 	ec2iface.EC2API
+	tags types.TagConfig
 }
 
-// NewEC2Model takes the AWS SDK EC2 Interface as parameter and returns the pointer to an EC2Model struct, on which all EC2 model functions can be called
-func NewEC2Model(svc ec2iface.EC2API) *EC2Model {
+// NewEC2Model takes the AWS SDK EC2 Interface and the tag config as parameter and returns the pointer to an EC2Model struct, on which all EC2 model functions can be called
+func NewEC2Model(svc ec2iface.EC2API, tags types.TagConfig) *EC2Model {
 	return &EC2Model{
 		EC2API: svc,
+		tags:   tags,
 	}
 }
 
-// DescribeInstancesForTagsAndAction takes a repository name, a branch name and an action (which can be "start" or "stop"). The function filters all EC2 Instances by
-// repository and branch_raw tag and then writes all instanceIDs of instances to the *string array, which must get adapted based on the given action.
+// DescribeInstancesForTags takes a repository name and a branch name. The function filters all EC2 Instances by the repository, branch and required tags and returns
+// all instances of all reservations. Instances with the exclude tag are returned separately as second value and must not be started or stopped.
+// Whether an instance must be started or stopped can be checked with IsEC2ActionRequired.
+// All result pages of the DescribeInstances call are processed.
 // If an error occurs, it gets logged and then returned
-func (ec2Model *EC2Model) DescribeInstancesForTagsAndAction(repository, branch, action string) ([]*string, error) {
-	result, err := ec2Model.EC2API.DescribeInstances(&ec2.DescribeInstancesInput{
-		Filters: []*ec2.Filter{
-			{
-				Name:   aws.String("tag:repository"),
-				Values: []*string{aws.String(repository)},
-			},
-			{
-				Name:   aws.String("tag:branch_raw"),
-				Values: []*string{aws.String(branch)},
-			},
-		},
+func (ec2Model *EC2Model) DescribeInstancesForTags(ctx context.Context, repository, branch string) ([]*ec2.Instance, []*ec2.Instance, error) {
+	instances := []*ec2.Instance{}
+	excluded := []*ec2.Instance{}
+	tags := ec2Model.tags.TagsForEnvironment(repository, branch)
+	filters := []*ec2.Filter{}
+	for _, key := range ec2Model.tags.TagKeysForEnvironment() {
+		filters = append(filters, &ec2.Filter{
+			Name:   aws.String("tag:" + key),
+			Values: []*string{aws.String(tags[key])},
+		})
+	}
+	err := ec2Model.EC2API.DescribeInstancesPagesWithContext(ctx, &ec2.DescribeInstancesInput{
+		Filters: filters,
+	}, func(result *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, reservation := range result.Reservations {
+			for _, instance := range reservation.Instances {
+				fmt.Printf("Found instance with id = %s and state = %s \n", *instance.InstanceId, *instance.State.Name)
+				tagMap := map[string]string{}
+				for _, tag := range instance.Tags {
+					tagMap[*tag.Key] = *tag.Value
+				}
+				if ec2Model.tags.IsExcluded(tagMap) {
+					log.Printf("Instance %s is excluded by tag \n", *instance.InstanceId)
+					excluded = append(excluded, instance)
+					continue
+				}
+				instances = append(instances, instance)
+			}
+		}
+		return true
 	})
 	if err != nil {
 		log.Println(err)
-		return []*string{}, err
+		return []*ec2.Instance{}, []*ec2.Instance{}, err
 	}
 
-	instanceIDs := []*string{}
-	for i := range result.Reservations {
-		fmt.Printf("Found instance with id = %s and state = %s \n", *result.Reservations[i].Instances[0].InstanceId, *result.Reservations[i].Instances[0].State.Name)
-		if *result.Reservations[i].Instances[0].State.Name == "running" && action == "stop" {
-			instanceIDs = append(instanceIDs, result.Reservations[i].Instances[0].InstanceId)
-		}
-		if *result.Reservations[i].Instances[0].State.Name == "stopped" && action == "start" {
-			instanceIDs = append(instanceIDs, result.Reservations[i].Instances[0].InstanceId)
-		}
-	}
+	return instances, excluded, nil
+}
 
-	return instanceIDs, nil
+// IsEC2ActionRequired returns true, if an instance in the given state has to be changed for the given action ("start" or "stop").
+// Only running instances can be stopped and only stopped instances can be started.
+func IsEC2ActionRequired(action, state string) bool {
+	switch action {
+	case "stop":
+		return state == "running"
+	case "start":
+		return state == "stopped"
+	}
+	return false
 }
 
-// StartEC2Instances starts all EC2 instances given in the instanceIDs array by using the AWS SDK.
+// StartEC2Instances starts all EC2 instances given in the instanceIDs array by using the AWS SDK. The state change of every instance gets logged and returned.
 // If an error occurs, it gets logged and then returned
-func (ec2Model *EC2Model) StartEC2Instances(instanceIDs []*string) error {
+func (ec2Model *EC2Model) StartEC2Instances(ctx context.Context, instanceIDs []*string) ([]*ec2.InstanceStateChange, error) {
 	log.Println("Starting EC2")
-	startResult, err := ec2Model.EC2API.StartInstances(&ec2.StartInstancesInput{
+	startResult, err := ec2Model.EC2API.StartInstancesWithContext(ctx, &ec2.StartInstancesInput{
+		InstanceIds: instanceIDs,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	for _, stateChange := range startResult.StartingInstances {
+		log.Printf("Changed state of %s from %s to %s \n", *stateChange.InstanceId, *stateChange.PreviousState.Name, *stateChange.CurrentState.Name)
+	}
+	return startResult.StartingInstances, nil
+}
+
+// StopEC2Instances stops all EC2 instances given in the instanceIDs array by using the AWS SDK. The state change of every instance gets logged and returned.
+// If an error occurs, it gets logged and then returned
+func (ec2Model *EC2Model) StopEC2Instances(ctx context.Context, instanceIDs []*string) ([]*ec2.InstanceStateChange, error) {
+	log.Println("Stopping EC2")
+	stopResult, err := ec2Model.EC2API.StopInstancesWithContext(ctx, &ec2.StopInstancesInput{
+		InstanceIds: instanceIDs,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	for _, stateChange := range stopResult.StoppingInstances {
+		log.Printf("Changed state of %s from %s to %s \n", *stateChange.InstanceId, *stateChange.PreviousState.Name, *stateChange.CurrentState.Name)
+	}
+	return stopResult.StoppingInstances, nil
+}
+
+// WaitUntilEC2InstancesRunning waits until all EC2 instances given in the instanceIDs array are running. Waiting is aborted, when the context is done.
+// If an error occurs, it gets logged and then returned
+func (ec2Model *EC2Model) WaitUntilEC2InstancesRunning(ctx context.Context, instanceIDs []*string) error {
+	err := ec2Model.EC2API.WaitUntilInstanceRunningWithContext(ctx, &ec2.DescribeInstancesInput{
 		InstanceIds: instanceIDs,
 	})
 	if err != nil {
 		log.Println(err)
 		return err
 	}
-	log.Printf("Changed state from %s to %s \n", *startResult.StartingInstances[0].PreviousState.Name, *startResult.StartingInstances[0].CurrentState.Name)
+	log.Println("EC2 instances are running")
 	return nil
 }
 
-// StopEC2Instances stops all EC2 instances given in the instanceIDs array by using the AWS SDK.
+// WaitUntilEC2InstancesStopped waits until all EC2 instances given in the instanceIDs array are stopped. Waiting is aborted, when the context is done.
 // If an error occurs, it gets logged and then returned
-func (ec2Model *EC2Model) StopEC2Instances(instanceIDs []*string) error {
-	log.Println("Stopping EC2")
-	stopResult, err := ec2Model.EC2API.StopInstances(&ec2.StopInstancesInput{
+func (ec2Model *EC2Model) WaitUntilEC2InstancesStopped(ctx context.Context, instanceIDs []*string) error {
+	err := ec2Model.EC2API.WaitUntilInstanceStoppedWithContext(ctx, &ec2.DescribeInstancesInput{
 		InstanceIds: instanceIDs,
 	})
 	if err != nil {
 		log.Println(err)
 		return err
 	}
-	log.Printf("Changed state from %s to %s \n", *stopResult.StoppingInstances[0].PreviousState.Name, *stopResult.StoppingInstances[0].CurrentState.Name)
+	log.Println("EC2 instances are stopped")
 	return nil
 }
@@ -1,29 +1,61 @@
 package model
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 
 	"github.com/auto-staging/scheduler/mocks"
+	"github.com/auto-staging/scheduler/types"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
+// testTagConfig is the tag config of the auto-staging installation, used by all model tests
+var testTagConfig = types.TagConfig{
+	RepositoryKey: "repository",
+	BranchKey:     "branch_raw",
+}
+
+// testExcludeTagConfig is the tag config of the auto-staging installation with the default exclude tag
+var testExcludeTagConfig = types.TagConfig{
+	RepositoryKey:   "repository",
+	BranchKey:       "branch_raw",
+	ExcludeTagKey:   "auto-staging:schedule",
+	ExcludeTagValue: "ignore",
+}
+
 func TestNewEC2Model(t *testing.T) {
 	svc := new(mocks.EC2API)
 
-	model := NewEC2Model(svc)
+	model := NewEC2Model(svc, testTagConfig)
 
 	assert.NotEmpty(t, model, "Expected not empty")
 	assert.Equal(t, svc, model.EC2API, "EC2 service from model is not matching the one used as parameter")
+	assert.Equal(t, testTagConfig, model.tags, "Tag config from model is not matching the one used as parameter")
+}
+
+// mockDescribeInstancesPages mocks DescribeInstancesPages, the given pages are passed one after another to the callback function
+func mockDescribeInstancesPages(svc *mocks.EC2API, err error, pages ...*ec2.DescribeInstancesOutput) {
+	svc.On("DescribeInstancesPagesWithContext", mock.Anything, mock.AnythingOfType("*ec2.DescribeInstancesInput"), mock.AnythingOfType("func(*ec2.DescribeInstancesOutput, bool) bool")).Run(func(args mock.Arguments) {
+		if err != nil {
+			return
+		}
+		fn := args.Get(2).(func(*ec2.DescribeInstancesOutput, bool) bool)
+		for i := range pages {
+			if !fn(pages[i], i == len(pages)-1) {
+				return
+			}
+		}
+	}).Return(err)
 }
 
-func TestDescribeInstancesStopAction(t *testing.T) {
+func TestDescribeInstancesForTags(t *testing.T) {
 	svc := new(mocks.EC2API)
-	svc.On("DescribeInstances", mock.AnythingOfType("*ec2.DescribeInstancesInput")).Return(&ec2.DescribeInstancesOutput{
+	mockDescribeInstancesPages(svc, nil, &ec2.DescribeInstancesOutput{
 		Reservations: []*ec2.Reservation{
 			&ec2.Reservation{
 				Instances: []*ec2.Instance{
@@ -48,21 +80,80 @@ func TestDescribeInstancesStopAction(t *testing.T) {
 				},
 			},
 		},
-	}, nil)
+	})
 
 	ec2Model := EC2Model{
 		EC2API: svc,
+		tags:   testTagConfig,
 	}
 
-	result, err := ec2Model.DescribeInstancesForTagsAndAction("", "", "stop")
+	result, _, err := ec2Model.DescribeInstancesForTags(context.Background(), "repo", "branch")
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, result, 2, "Expect two instances")
+	assert.Equal(t, "i-1234567890abcdef0", *result[0].InstanceId, "Expected i-1234567890abcdef0")
+	assert.Equal(t, "i-1234567890abcdef1", *result[1].InstanceId, "Expected i-1234567890abcdef1")
+	svc.AssertCalled(t, "DescribeInstancesPagesWithContext", mock.Anything, &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			&ec2.Filter{
+				Name:   aws.String("tag:repository"),
+				Values: []*string{aws.String("repo")},
+			},
+			&ec2.Filter{
+				Name:   aws.String("tag:branch_raw"),
+				Values: []*string{aws.String("branch")},
+			},
+		},
+	}, mock.Anything)
+}
+
+func TestDescribeInstancesForTagsCustomTags(t *testing.T) {
+	svc := new(mocks.EC2API)
+	mockDescribeInstancesPages(svc, nil, &ec2.DescribeInstancesOutput{})
+
+	ec2Model := NewEC2Model(svc, types.TagConfig{
+		RepositoryKey: "app",
+		BranchKey:     "git-branch",
+		RequiredTags: map[string]string{
+			"team":        "platform",
+			"environment": "staging",
+		},
+	})
+
+	_, _, err := ec2Model.DescribeInstancesForTags(context.Background(), "repo", "branch")
 	assert.Nil(t, err, "Expected no error")
-	assert.Len(t, result, 1, "Expect one instance")
-	assert.Equal(t, *result[0], "i-1234567890abcdef0", "Expected i-1234567890abcdef0")
+	svc.AssertCalled(t, "DescribeInstancesPagesWithContext", mock.Anything, &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			&ec2.Filter{
+				Name:   aws.String("tag:app"),
+				Values: []*string{aws.String("repo")},
+			},
+			&ec2.Filter{
+				Name:   aws.String("tag:git-branch"),
+				Values: []*string{aws.String("branch")},
+			},
+			&ec2.Filter{
+				Name:   aws.String("tag:environment"),
+				Values: []*string{aws.String("staging")},
+			},
+			&ec2.Filter{
+				Name:   aws.String("tag:team"),
+				Values: []*string{aws.String("platform")},
+			},
+		},
+	}, mock.Anything)
+}
+
+func TestIsEC2ActionRequired(t *testing.T) {
+	assert.True(t, IsEC2ActionRequired("stop", "running"), "Expected running instance to require stop")
+	assert.False(t, IsEC2ActionRequired("stop", "stopped"), "Expected stopped instance to require no stop")
+	assert.True(t, IsEC2ActionRequired("start", "stopped"), "Expected stopped instance to require start")
+	assert.False(t, IsEC2ActionRequired("start", "pending"), "Expected pending instance to require no start")
+	assert.False(t, IsEC2ActionRequired("restart", "running"), "Expected unknown action to require nothing")
 }
 
-func TestDescribeInstancesStartAction(t *testing.T) {
+func TestDescribeInstancesMultiplePages(t *testing.T) {
 	svc := new(mocks.EC2API)
-	svc.On("DescribeInstances", mock.AnythingOfType("*ec2.DescribeInstancesInput")).Return(&ec2.DescribeInstancesOutput{
+	mockDescribeInstancesPages(svc, nil, &ec2.DescribeInstancesOutput{
 		Reservations: []*ec2.Reservation{
 			&ec2.Reservation{
 				Instances: []*ec2.Instance{
@@ -75,10 +166,58 @@ func TestDescribeInstancesStartAction(t *testing.T) {
 					},
 				},
 			},
+		},
+		NextToken: aws.String("token"),
+	}, &ec2.DescribeInstancesOutput{
+		Reservations: []*ec2.Reservation{
 			&ec2.Reservation{
 				Instances: []*ec2.Instance{
 					&ec2.Instance{
 						InstanceId: aws.String("i-1234567890abcdef1"),
+						State: &ec2.InstanceState{
+							Code: aws.Int64(16),
+							Name: aws.String("running"),
+						},
+					},
+				},
+			},
+		},
+	})
+
+	ec2Model := EC2Model{
+		EC2API: svc,
+		tags:   testTagConfig,
+	}
+
+	result, _, err := ec2Model.DescribeInstancesForTags(context.Background(), "", "")
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, result, 2, "Expected instances of both pages")
+	assert.Equal(t, "i-1234567890abcdef0", *result[0].InstanceId, "Expected i-1234567890abcdef0")
+	assert.Equal(t, "i-1234567890abcdef1", *result[1].InstanceId, "Expected i-1234567890abcdef1")
+}
+
+func TestDescribeInstancesMultipleInstancesPerReservation(t *testing.T) {
+	svc := new(mocks.EC2API)
+	mockDescribeInstancesPages(svc, nil, &ec2.DescribeInstancesOutput{
+		Reservations: []*ec2.Reservation{
+			&ec2.Reservation{
+				Instances: []*ec2.Instance{
+					&ec2.Instance{
+						InstanceId: aws.String("i-1234567890abcdef0"),
+						State: &ec2.InstanceState{
+							Code: aws.Int64(80),
+							Name: aws.String("stopped"),
+						},
+					},
+					&ec2.Instance{
+						InstanceId: aws.String("i-1234567890abcdef1"),
+						State: &ec2.InstanceState{
+							Code: aws.Int64(16),
+							Name: aws.String("running"),
+						},
+					},
+					&ec2.Instance{
+						InstanceId: aws.String("i-1234567890abcdef2"),
 						State: &ec2.InstanceState{
 							Code: aws.Int64(80),
 							Name: aws.String("stopped"),
@@ -87,36 +226,40 @@ func TestDescribeInstancesStartAction(t *testing.T) {
 				},
 			},
 		},
-	}, nil)
+	})
 
 	ec2Model := EC2Model{
 		EC2API: svc,
+		tags:   testTagConfig,
 	}
 
-	result, err := ec2Model.DescribeInstancesForTagsAndAction("", "", "start")
+	result, _, err := ec2Model.DescribeInstancesForTags(context.Background(), "", "")
 	assert.Nil(t, err, "Expected no error")
-	assert.Len(t, result, 1, "Expect one instance")
-	assert.Equal(t, *result[0], "i-1234567890abcdef1", "Expected i-1234567890abcdef1")
+	assert.Len(t, result, 3, "Expected all instances of the reservation")
+	assert.Equal(t, "i-1234567890abcdef0", *result[0].InstanceId, "Expected i-1234567890abcdef0")
+	assert.Equal(t, "i-1234567890abcdef1", *result[1].InstanceId, "Expected i-1234567890abcdef1")
+	assert.Equal(t, "i-1234567890abcdef2", *result[2].InstanceId, "Expected i-1234567890abcdef2")
 }
 
 func TestDescribeInstancesError(t *testing.T) {
 	svc := new(mocks.EC2API)
-	svc.On("DescribeInstances", mock.AnythingOfType("*ec2.DescribeInstancesInput")).Return(&ec2.DescribeInstancesOutput{
+	mockDescribeInstancesPages(svc, errors.New("Test error"), &ec2.DescribeInstancesOutput{
 		Reservations: []*ec2.Reservation{},
-	}, errors.New("Test error"))
+	})
 
 	ec2Model := EC2Model{
 		EC2API: svc,
+		tags:   testTagConfig,
 	}
 
-	result, err := ec2Model.DescribeInstancesForTagsAndAction("", "", "start")
+	result, _, err := ec2Model.DescribeInstancesForTags(context.Background(), "", "")
 	assert.Error(t, err, "Expected error")
 	assert.Len(t, result, 0, "Expected no instance")
 }
 
 func TestStartEC2Instances(t *testing.T) {
 	svc := new(mocks.EC2API)
-	svc.On("StartInstances", mock.AnythingOfType("*ec2.StartInstancesInput")).Return(&ec2.StartInstancesOutput{
+	svc.On("StartInstancesWithContext", mock.Anything, mock.AnythingOfType("*ec2.StartInstancesInput")).Return(&ec2.StartInstancesOutput{
 		StartingInstances: []*ec2.InstanceStateChange{
 			&ec2.InstanceStateChange{
 				CurrentState: &ec2.InstanceState{
@@ -134,27 +277,77 @@ func TestStartEC2Instances(t *testing.T) {
 
 	ec2Model := EC2Model{
 		EC2API: svc,
+		tags:   testTagConfig,
+	}
+
+	stateChanges, err := ec2Model.StartEC2Instances(context.Background(), []*string{})
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, stateChanges, 1, "Expected one state change")
+}
+
+func TestStartEC2InstancesMultipleInstances(t *testing.T) {
+	instanceIDs := []*string{
+		aws.String("i-1234567890abcdef0"),
+		aws.String("i-1234567890abcdef1"),
+	}
+
+	svc := new(mocks.EC2API)
+	svc.On("StartInstancesWithContext", mock.Anything, mock.AnythingOfType("*ec2.StartInstancesInput")).Return(&ec2.StartInstancesOutput{
+		StartingInstances: []*ec2.InstanceStateChange{
+			&ec2.InstanceStateChange{
+				CurrentState: &ec2.InstanceState{
+					Code: aws.Int64(0),
+					Name: aws.String("pending"),
+				},
+				PreviousState: &ec2.InstanceState{
+					Code: aws.Int64(80),
+					Name: aws.String("stopped"),
+				},
+				InstanceId: instanceIDs[0],
+			},
+			&ec2.InstanceStateChange{
+				CurrentState: &ec2.InstanceState{
+					Code: aws.Int64(0),
+					Name: aws.String("pending"),
+				},
+				PreviousState: &ec2.InstanceState{
+					Code: aws.Int64(80),
+					Name: aws.String("stopped"),
+				},
+				InstanceId: instanceIDs[1],
+			},
+		},
+	}, nil)
+
+	ec2Model := EC2Model{
+		EC2API: svc,
+		tags:   testTagConfig,
 	}
 
-	err := ec2Model.StartEC2Instances([]*string{})
+	stateChanges, err := ec2Model.StartEC2Instances(context.Background(), instanceIDs)
 	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, stateChanges, 2, "Expected a state change for every instance")
+	svc.AssertCalled(t, "StartInstancesWithContext", mock.Anything, &ec2.StartInstancesInput{
+		InstanceIds: instanceIDs,
+	})
 }
 
 func TestStartEC2InstancesError(t *testing.T) {
 	svc := new(mocks.EC2API)
-	svc.On("StartInstances", mock.AnythingOfType("*ec2.StartInstancesInput")).Return(&ec2.StartInstancesOutput{}, errors.New("Test error"))
+	svc.On("StartInstancesWithContext", mock.Anything, mock.AnythingOfType("*ec2.StartInstancesInput")).Return(&ec2.StartInstancesOutput{}, errors.New("Test error"))
 
 	ec2Model := EC2Model{
 		EC2API: svc,
+		tags:   testTagConfig,
 	}
 
-	err := ec2Model.StartEC2Instances([]*string{})
+	_, err := ec2Model.StartEC2Instances(context.Background(), []*string{})
 	assert.Error(t, err, "Expected error")
 }
 
 func TestStopEC2Instances(t *testing.T) {
 	svc := new(mocks.EC2API)
-	svc.On("StopInstances", mock.AnythingOfType("*ec2.StopInstancesInput")).Return(&ec2.StopInstancesOutput{
+	svc.On("StopInstancesWithContext", mock.Anything, mock.AnythingOfType("*ec2.StopInstancesInput")).Return(&ec2.StopInstancesOutput{
 		StoppingInstances: []*ec2.InstanceStateChange{
 			&ec2.InstanceStateChange{
 				CurrentState: &ec2.InstanceState{
@@ -172,20 +365,177 @@ func TestStopEC2Instances(t *testing.T) {
 
 	ec2Model := EC2Model{
 		EC2API: svc,
+		tags:   testTagConfig,
 	}
 
-	err := ec2Model.StopEC2Instances([]*string{})
+	stateChanges, err := ec2Model.StopEC2Instances(context.Background(), []*string{})
 	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, stateChanges, 1, "Expected one state change")
+}
+
+func TestStopEC2InstancesMultipleInstances(t *testing.T) {
+	instanceIDs := []*string{
+		aws.String("i-1234567890abcdef0"),
+		aws.String("i-1234567890abcdef1"),
+	}
+
+	svc := new(mocks.EC2API)
+	svc.On("StopInstancesWithContext", mock.Anything, mock.AnythingOfType("*ec2.StopInstancesInput")).Return(&ec2.StopInstancesOutput{
+		StoppingInstances: []*ec2.InstanceStateChange{
+			&ec2.InstanceStateChange{
+				CurrentState: &ec2.InstanceState{
+					Code: aws.Int64(64),
+					Name: aws.String("stopping"),
+				},
+				PreviousState: &ec2.InstanceState{
+					Code: aws.Int64(16),
+					Name: aws.String("running"),
+				},
+				InstanceId: instanceIDs[0],
+			},
+			&ec2.InstanceStateChange{
+				CurrentState: &ec2.InstanceState{
+					Code: aws.Int64(64),
+					Name: aws.String("stopping"),
+				},
+				PreviousState: &ec2.InstanceState{
+					Code: aws.Int64(16),
+					Name: aws.String("running"),
+				},
+				InstanceId: instanceIDs[1],
+			},
+		},
+	}, nil)
+
+	ec2Model := EC2Model{
+		EC2API: svc,
+		tags:   testTagConfig,
+	}
+
+	stateChanges, err := ec2Model.StopEC2Instances(context.Background(), instanceIDs)
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, stateChanges, 2, "Expected a state change for every instance")
+	svc.AssertCalled(t, "StopInstancesWithContext", mock.Anything, &ec2.StopInstancesInput{
+		InstanceIds: instanceIDs,
+	})
 }
 
 func TestStopEC2InstancesError(t *testing.T) {
 	svc := new(mocks.EC2API)
-	svc.On("StopInstances", mock.AnythingOfType("*ec2.StopInstancesInput")).Return(&ec2.StopInstancesOutput{}, errors.New("Test error"))
+	svc.On("StopInstancesWithContext", mock.Anything, mock.AnythingOfType("*ec2.StopInstancesInput")).Return(&ec2.StopInstancesOutput{}, errors.New("Test error"))
+
+	ec2Model := EC2Model{
+		EC2API: svc,
+		tags:   testTagConfig,
+	}
+
+	_, err := ec2Model.StopEC2Instances(context.Background(), []*string{})
+	assert.Error(t, err, "Expected error")
+}
+
+func TestWaitUntilEC2InstancesRunning(t *testing.T) {
+	instanceIDs := []*string{aws.String("i-1234567890abcdef0"), aws.String("i-1234567890abcdef1")}
+
+	svc := new(mocks.EC2API)
+	svc.On("WaitUntilInstanceRunningWithContext", mock.Anything, mock.AnythingOfType("*ec2.DescribeInstancesInput")).Return(nil)
+
+	ec2Model := EC2Model{
+		EC2API: svc,
+		tags:   testTagConfig,
+	}
+
+	err := ec2Model.WaitUntilEC2InstancesRunning(context.Background(), instanceIDs)
+
+	assert.Nil(t, err, "Expected no error")
+	svc.AssertCalled(t, "WaitUntilInstanceRunningWithContext", mock.Anything, &ec2.DescribeInstancesInput{
+		InstanceIds: instanceIDs,
+	})
+}
+
+func TestWaitUntilEC2InstancesRunningError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.EC2API)
+	svc.On("WaitUntilInstanceRunningWithContext", mock.Anything, mock.AnythingOfType("*ec2.DescribeInstancesInput")).Return(errorMsg)
+
+	ec2Model := EC2Model{
+		EC2API: svc,
+		tags:   testTagConfig,
+	}
+
+	err := ec2Model.WaitUntilEC2InstancesRunning(context.Background(), []*string{})
+
+	assert.Error(t, err, "Expected error")
+	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+}
+
+func TestWaitUntilEC2InstancesStopped(t *testing.T) {
+	instanceIDs := []*string{aws.String("i-1234567890abcdef0"), aws.String("i-1234567890abcdef1")}
+
+	svc := new(mocks.EC2API)
+	svc.On("WaitUntilInstanceStoppedWithContext", mock.Anything, mock.AnythingOfType("*ec2.DescribeInstancesInput")).Return(nil)
+
+	ec2Model := EC2Model{
+		EC2API: svc,
+		tags:   testTagConfig,
+	}
+
+	err := ec2Model.WaitUntilEC2InstancesStopped(context.Background(), instanceIDs)
+
+	assert.Nil(t, err, "Expected no error")
+	svc.AssertCalled(t, "WaitUntilInstanceStoppedWithContext", mock.Anything, &ec2.DescribeInstancesInput{
+		InstanceIds: instanceIDs,
+	})
+}
+
+func TestWaitUntilEC2InstancesStoppedError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.EC2API)
+	svc.On("WaitUntilInstanceStoppedWithContext", mock.Anything, mock.AnythingOfType("*ec2.DescribeInstancesInput")).Return(errorMsg)
 
 	ec2Model := EC2Model{
 		EC2API: svc,
+		tags:   testTagConfig,
 	}
 
-	err := ec2Model.StopEC2Instances([]*string{})
+	err := ec2Model.WaitUntilEC2InstancesStopped(context.Background(), []*string{})
+
 	assert.Error(t, err, "Expected error")
+	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+}
+
+func TestDescribeInstancesForTagsExcluded(t *testing.T) {
+	svc := new(mocks.EC2API)
+	mockDescribeInstancesPages(svc, nil, &ec2.DescribeInstancesOutput{
+		Reservations: []*ec2.Reservation{
+			&ec2.Reservation{
+				Instances: []*ec2.Instance{
+					&ec2.Instance{
+						InstanceId: aws.String("i-1234567890abcdef0"),
+						State:      &ec2.InstanceState{Name: aws.String("running")},
+					},
+					&ec2.Instance{
+						InstanceId: aws.String("i-1234567890abcdef1"),
+						State:      &ec2.InstanceState{Name: aws.String("running")},
+						Tags: []*ec2.Tag{
+							&ec2.Tag{
+								Key:   aws.String("auto-staging:schedule"),
+								Value: aws.String("ignore"),
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	ec2Model := NewEC2Model(svc, testExcludeTagConfig)
+
+	instances, excluded, err := ec2Model.DescribeInstancesForTags(context.Background(), "repo", "branch")
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, instances, 1, "Expected one instance")
+	assert.Equal(t, "i-1234567890abcdef0", *instances[0].InstanceId)
+	assert.Len(t, excluded, 1, "Expected one excluded instance")
+	assert.Equal(t, "i-1234567890abcdef1", *excluded[0].InstanceId)
 }
@@ -0,0 +1,372 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/auto-staging/scheduler/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+)
+
+// ECSModelAPI is an interface including all ECS model functions
+type ECSModelAPI interface {
+	DescribeServicesForTags(ctx context.Context, repository, branch string) ([]*ecs.Service, []*ecs.Service, error)
+	SetECSServiceDesiredCountToPreviousValue(ctx context.Context, serviceARN *string) (int64, error)
+	SetECSServiceDesiredCountToZero(ctx context.Context, serviceARN *string) (int64, error)
+	GetPreviousDesiredCountOfECSService(ctx context.Context, serviceARN *string) (int64, error)
+	WaitUntilECSServiceStable(ctx context.Context, serviceARN *string) error
+}
+
+const (
+	ecsDesiredCountTag = "desiredCount"
+
+	// describeServicesBatchSize is the maximum number of services which can be described in one DescribeServices call
+	describeServicesBatchSize = 10
+)
+
+// ECSModel is a struct including the AWS SDK ECS and Resource Groups Tagging interfaces, all ECS model functions are called on this struct and the included
+// AWS SDK services. The Resource Groups Tagging service is used to find the services of an Environment by the tags configured in the TagConfig, if it is
+// not set or the lookup fails, the tags of every service of every cluster are checked instead.
+type ECSModel struct {
+	ecsiface.ECSAPI
+	resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+	tags types.TagConfig
+}
+
+// NewECSModel takes the AWS SDK ECS and Resource Groups Tagging Interfaces and the tag config as parameter and returns the pointer to an ECSModel struct, on
+// which all ECS model functions can be called
+func NewECSModel(svc ecsiface.ECSAPI, taggingSvc resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI, tags types.TagConfig) *ECSModel {
+	return &ECSModel{
+		ECSAPI:                      svc,
+		ResourceGroupsTaggingAPIAPI: taggingSvc,
+		tags:                        tags,
+	}
+}
+
+// DescribeServicesForTags gets all ECS services matching the repository and branch name (the services get found by the repository, branch and required tags).
+// The services are looked up with the Resource Groups Tagging API, if this lookup isn't possible the tags of all services of all clusters get checked
+// instead. Services with the exclude tag are returned separately as second value and must not be started or stopped.
+// Whether a service must be started or stopped can be checked with IsECSActionRequired.
+// If an error occurs, it gets logged and then returned.
+func (ecsModel *ECSModel) DescribeServicesForTags(ctx context.Context, repository, branch string) ([]*ecs.Service, []*ecs.Service, error) {
+	if ecsModel.ResourceGroupsTaggingAPIAPI != nil {
+		serviceARNs, excludedARNs, err := getResourceARNsForTags(ctx, ecsModel.ResourceGroupsTaggingAPIAPI, ecsModel.tags, "ecs:service", repository, branch)
+		if err == nil {
+			return ecsModel.describeServices(ctx, serviceARNs, excludedARNs)
+		}
+		log.Println("Tagging API lookup for ECS services failed, falling back to checking the tags of every service")
+	}
+
+	return ecsModel.listServicesForTags(ctx, repository, branch)
+}
+
+// listServicesForTags returns all services and the excluded services found for the given repository and branch tag values by checking the tags of every
+// service of every cluster.
+func (ecsModel *ECSModel) listServicesForTags(ctx context.Context, repository, branch string) ([]*ecs.Service, []*ecs.Service, error) {
+	services := []*ecs.Service{}
+	excluded := []*ecs.Service{}
+
+	clusterARNs := []*string{}
+	err := ecsModel.ECSAPI.ListClustersPagesWithContext(ctx, &ecs.ListClustersInput{}, func(result *ecs.ListClustersOutput, lastPage bool) bool {
+		clusterARNs = append(clusterARNs, result.ClusterArns...)
+		return true
+	})
+	if err != nil {
+		log.Println(err)
+		return []*ecs.Service{}, []*ecs.Service{}, err
+	}
+
+	for _, clusterARN := range clusterARNs {
+		clusterServices, err := ecsModel.describeServicesOfCluster(ctx, clusterARN)
+		if err != nil {
+			log.Println(err)
+			return []*ecs.Service{}, []*ecs.Service{}, err
+		}
+
+		for _, service := range clusterServices {
+			tagMap := map[string]string{}
+			for _, tag := range service.Tags {
+				tagMap[*tag.Key] = *tag.Value
+			}
+			if !ecsModel.tags.MatchesEnvironment(tagMap, repository, branch) {
+				continue
+			}
+			if ecsModel.tags.IsExcluded(tagMap) {
+				log.Printf("ECS service %s is excluded by tag \n", *service.ServiceArn)
+				excluded = append(excluded, service)
+				continue
+			}
+			log.Printf("Found ECS service %s with desired count %d \n", *service.ServiceArn, *service.DesiredCount)
+			services = append(services, service)
+		}
+	}
+
+	return services, excluded, nil
+}
+
+// IsECSActionRequired returns true, if the given service has to be changed for the given action ("start" or "stop").
+// Only services with a desired count greater than 0 can be stopped. Only services with a desired count of 0 and a previous desired count stored in their
+// tags (see HasPreviousECSDesiredCount) can be started, so a service which was scaled to 0 manually isn't mistaken for a stopped service.
+func IsECSActionRequired(action string, service *ecs.Service) bool {
+	desiredCount := aws.Int64Value(service.DesiredCount)
+	switch action {
+	case "stop":
+		return desiredCount != 0
+	case "start":
+		return desiredCount == 0 && HasPreviousECSDesiredCount(service)
+	}
+	return false
+}
+
+// HasPreviousECSDesiredCount returns true, if the previous desired count of the given service is stored in its "desiredCount" tag.
+func HasPreviousECSDesiredCount(service *ecs.Service) bool {
+	for _, tag := range service.Tags {
+		if aws.StringValue(tag.Key) == ecsDesiredCountTag {
+			return true
+		}
+	}
+	return false
+}
+
+// SetECSServiceDesiredCountToPreviousValue sets the desired count of the service matching the given ARN to the previous value received from the
+// GetPreviousDesiredCountOfECSService function. Afterwards the "desiredCount" tag gets deleted, so a service scaled to 0 manually later isn't restored to
+// a stale value on the next start. The restored desired count gets returned.
+// If an error occurs, it gets logged and then returned.
+func (ecsModel *ECSModel) SetECSServiceDesiredCountToPreviousValue(ctx context.Context, serviceARN *string) (int64, error) {
+	log.Println("Starting ECS service")
+	desiredCount, err := ecsModel.GetPreviousDesiredCountOfECSService(ctx, serviceARN)
+	if err != nil {
+		log.Println(err)
+		return 0, err
+	}
+
+	err = ecsModel.updateDesiredCount(ctx, serviceARN, desiredCount)
+	if err != nil {
+		log.Println(err)
+		return 0, err
+	}
+
+	_, err = ecsModel.ECSAPI.UntagResourceWithContext(ctx, &ecs.UntagResourceInput{
+		ResourceArn: serviceARN,
+		TagKeys:     []*string{aws.String(ecsDesiredCountTag)},
+	})
+	if err != nil {
+		log.Println(err)
+		return 0, err
+	}
+	return desiredCount, nil
+}
+
+// SetECSServiceDesiredCountToZero stores the current desired count of the service matching the given ARN as tag on the service and then sets the
+// desired count to 0. The stored desired count gets returned.
+// If an error occurs, it gets logged and then returned.
+func (ecsModel *ECSModel) SetECSServiceDesiredCountToZero(ctx context.Context, serviceARN *string) (int64, error) {
+	log.Println("Stopping ECS service")
+	cluster, err := clusterOfECSService(serviceARN)
+	if err != nil {
+		log.Println(err)
+		return 0, err
+	}
+
+	result, err := ecsModel.ECSAPI.DescribeServicesWithContext(ctx, &ecs.DescribeServicesInput{
+		Cluster:  cluster,
+		Services: []*string{serviceARN},
+	})
+	if err != nil {
+		log.Println(err)
+		return 0, err
+	}
+	if len(result.Services) == 0 {
+		err = errors.New("found no ECS service for " + *serviceARN)
+		log.Println(err)
+		return 0, err
+	}
+	desiredCount := *result.Services[0].DesiredCount
+
+	_, err = ecsModel.ECSAPI.TagResourceWithContext(ctx, &ecs.TagResourceInput{
+		ResourceArn: serviceARN,
+		Tags: []*ecs.Tag{
+			{
+				Key:   aws.String(ecsDesiredCountTag),
+				Value: aws.String(strconv.FormatInt(desiredCount, 10)),
+			},
+		},
+	})
+	if err != nil {
+		log.Println(err)
+		return 0, err
+	}
+
+	err = ecsModel.updateDesiredCount(ctx, serviceARN, 0)
+	if err != nil {
+		log.Println(err)
+		return 0, err
+	}
+	return desiredCount, nil
+}
+
+// GetPreviousDesiredCountOfECSService returns the previous desired count of the service matching the given ARN. The previous desired count is determined by
+// the "desiredCount" tag attached to the service by SetECSServiceDesiredCountToZero.
+// If an error occurs, it gets logged and then 0 plus the error will be returned.
+func (ecsModel *ECSModel) GetPreviousDesiredCountOfECSService(ctx context.Context, serviceARN *string) (int64, error) {
+	result, err := ecsModel.ECSAPI.ListTagsForResourceWithContext(ctx, &ecs.ListTagsForResourceInput{
+		ResourceArn: serviceARN,
+	})
+	if err != nil {
+		log.Println(err)
+		return 0, err
+	}
+
+	for _, tag := range result.Tags {
+		if *tag.Key != ecsDesiredCountTag {
+			continue
+		}
+		desiredCount, err := strconv.ParseInt(*tag.Value, 10, 64)
+		if err != nil {
+			log.Println(err)
+			return 0, err
+		}
+		return desiredCount, nil
+	}
+
+	err = errors.New("found no previous desired count for ECS service " + *serviceARN)
+	log.Println(err)
+	return 0, err
+}
+
+// WaitUntilECSServiceStable waits until the number of running tasks of the service matching the given ARN equals its desired count.
+// For stopped services this is the case, once all tasks are stopped. Waiting is aborted, when the context is done.
+// If an error occurs, it gets logged and then returned.
+func (ecsModel *ECSModel) WaitUntilECSServiceStable(ctx context.Context, serviceARN *string) error {
+	cluster, err := clusterOfECSService(serviceARN)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	err = ecsModel.ECSAPI.WaitUntilServicesStableWithContext(ctx, &ecs.DescribeServicesInput{
+		Cluster:  cluster,
+		Services: []*string{serviceARN},
+	})
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	log.Printf("ECS service %s is stable \n", *serviceARN)
+	return nil
+}
+
+// describeServices returns the services matching the given ARNs, services contained in the excludedARNs set are returned separately as second value.
+// The services are described per cluster, services whose ARN contains no cluster are skipped.
+func (ecsModel *ECSModel) describeServices(ctx context.Context, serviceARNs []*string, excludedARNs map[string]bool) ([]*ecs.Service, []*ecs.Service, error) {
+	services := []*ecs.Service{}
+	excluded := []*ecs.Service{}
+
+	clusters := []string{}
+	serviceARNsOfCluster := map[string][]*string{}
+	for _, serviceARN := range serviceARNs {
+		cluster, err := clusterOfECSService(serviceARN)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		if _, ok := serviceARNsOfCluster[*cluster]; !ok {
+			clusters = append(clusters, *cluster)
+		}
+		serviceARNsOfCluster[*cluster] = append(serviceARNsOfCluster[*cluster], serviceARN)
+	}
+
+	for _, cluster := range clusters {
+		clusterServices, err := ecsModel.describeServiceBatches(ctx, aws.String(cluster), serviceARNsOfCluster[cluster])
+		if err != nil {
+			log.Println(err)
+			return []*ecs.Service{}, []*ecs.Service{}, err
+		}
+
+		for _, service := range clusterServices {
+			if excludedARNs[*service.ServiceArn] {
+				log.Printf("ECS service %s is excluded by tag \n", *service.ServiceArn)
+				excluded = append(excluded, service)
+				continue
+			}
+			log.Printf("Found ECS service %s with desired count %d \n", *service.ServiceArn, *service.DesiredCount)
+			services = append(services, service)
+		}
+	}
+
+	return services, excluded, nil
+}
+
+// describeServicesOfCluster returns all services of the cluster matching the given ARN including their tags.
+func (ecsModel *ECSModel) describeServicesOfCluster(ctx context.Context, clusterARN *string) ([]*ecs.Service, error) {
+	serviceARNs := []*string{}
+	err := ecsModel.ECSAPI.ListServicesPagesWithContext(ctx, &ecs.ListServicesInput{
+		Cluster: clusterARN,
+	}, func(result *ecs.ListServicesOutput, lastPage bool) bool {
+		serviceARNs = append(serviceARNs, result.ServiceArns...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ecsModel.describeServiceBatches(ctx, clusterARN, serviceARNs)
+}
+
+// describeServiceBatches returns the services of the given cluster matching the given ARNs including their tags, the services are described in batches of
+// describeServicesBatchSize services.
+func (ecsModel *ECSModel) describeServiceBatches(ctx context.Context, cluster *string, serviceARNs []*string) ([]*ecs.Service, error) {
+	services := []*ecs.Service{}
+	for start := 0; start < len(serviceARNs); start += describeServicesBatchSize {
+		end := start + describeServicesBatchSize
+		if end > len(serviceARNs) {
+			end = len(serviceARNs)
+		}
+
+		result, err := ecsModel.ECSAPI.DescribeServicesWithContext(ctx, &ecs.DescribeServicesInput{
+			Cluster:  cluster,
+			Services: serviceARNs[start:end],
+			Include:  []*string{aws.String(ecs.ServiceFieldTags)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		services = append(services, result.Services...)
+	}
+	return services, nil
+}
+
+// updateDesiredCount sets the desired count of the service matching the given ARN.
+func (ecsModel *ECSModel) updateDesiredCount(ctx context.Context, serviceARN *string, desiredCount int64) error {
+	cluster, err := clusterOfECSService(serviceARN)
+	if err != nil {
+		return err
+	}
+
+	_, err = ecsModel.ECSAPI.UpdateServiceWithContext(ctx, &ecs.UpdateServiceInput{
+		Cluster:      cluster,
+		Service:      serviceARN,
+		DesiredCount: aws.Int64(desiredCount),
+	})
+	return err
+}
+
+// clusterOfECSService returns the name of the cluster contained in the given service ARN. Only the long ARN format
+// (arn:aws:ecs:region:account:service/cluster/service) contains the cluster, it is also required for tagging the service.
+func clusterOfECSService(serviceARN *string) (*string, error) {
+	parsed, err := arn.Parse(*serviceARN)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.Split(parsed.Resource, "/")
+	if len(parts) != 3 || parts[0] != "service" {
+		return nil, errors.New("ECS service ARN " + *serviceARN + " contains no cluster, the long ARN format is required")
+	}
+	return aws.String(parts[1]), nil
+}
@@ -0,0 +1,363 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/auto-staging/scheduler/mocks"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testECSServiceARN = "arn:aws:ecs:eu-central-1:123456789012:service/test-cluster/test-service"
+
+func TestNewECSModel(t *testing.T) {
+	svc := new(mocks.ECSAPI)
+	model := NewECSModel(svc, nil, testTagConfig)
+
+	assert.NotEmpty(t, model, "Expected not empty")
+	assert.Equal(t, svc, model.ECSAPI, "ECS service from model is not matching the one used as parameter")
+	assert.Nil(t, model.ResourceGroupsTaggingAPIAPI, "Expected no tagging service")
+	assert.Equal(t, testTagConfig, model.tags, "Tag config from model is not matching the one used as parameter")
+}
+
+// mockListClustersPages mocks ListClustersPages, the given cluster ARNs are passed as one page to the callback function
+func mockListClustersPages(svc *mocks.ECSAPI, err error, clusterARNs ...string) {
+	svc.On("ListClustersPagesWithContext", mock.Anything, mock.AnythingOfType("*ecs.ListClustersInput"), mock.AnythingOfType("func(*ecs.ListClustersOutput, bool) bool")).Run(func(args mock.Arguments) {
+		if err != nil {
+			return
+		}
+		fn := args.Get(2).(func(*ecs.ListClustersOutput, bool) bool)
+		fn(&ecs.ListClustersOutput{ClusterArns: aws.StringSlice(clusterARNs)}, true)
+	}).Return(err)
+}
+
+// mockListServicesPages mocks ListServicesPages, the given service ARNs are passed as one page to the callback function
+func mockListServicesPages(svc *mocks.ECSAPI, err error, serviceARNs ...string) {
+	svc.On("ListServicesPagesWithContext", mock.Anything, mock.AnythingOfType("*ecs.ListServicesInput"), mock.AnythingOfType("func(*ecs.ListServicesOutput, bool) bool")).Run(func(args mock.Arguments) {
+		if err != nil {
+			return
+		}
+		fn := args.Get(2).(func(*ecs.ListServicesOutput, bool) bool)
+		fn(&ecs.ListServicesOutput{ServiceArns: aws.StringSlice(serviceARNs)}, true)
+	}).Return(err)
+}
+
+// newECSService returns an ECS service with the given ARN, desired count and tags
+func newECSService(serviceARN string, desiredCount int64, tags map[string]string) *ecs.Service {
+	service := &ecs.Service{
+		ServiceArn:   aws.String(serviceARN),
+		DesiredCount: aws.Int64(desiredCount),
+		Tags:         []*ecs.Tag{},
+	}
+	for key, value := range tags {
+		service.Tags = append(service.Tags, &ecs.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+	return service
+}
+
+func TestDescribeServicesForTags(t *testing.T) {
+	svc := new(mocks.ECSAPI)
+	mockListClustersPages(svc, nil, "arn:aws:ecs:eu-central-1:123456789012:cluster/test-cluster")
+	mockListServicesPages(svc, nil, testECSServiceARN, "arn:aws:ecs:eu-central-1:123456789012:service/test-cluster/other-service")
+	svc.On("DescribeServicesWithContext", mock.Anything, mock.AnythingOfType("*ecs.DescribeServicesInput")).Return(&ecs.DescribeServicesOutput{
+		Services: []*ecs.Service{
+			newECSService(testECSServiceARN, 2, map[string]string{"repository": "repo", "branch_raw": "branch"}),
+			newECSService("arn:aws:ecs:eu-central-1:123456789012:service/test-cluster/other-service", 1, map[string]string{"repository": "repo", "branch_raw": "other"}),
+		},
+	}, nil)
+
+	model := NewECSModel(svc, nil, testTagConfig)
+	services, excluded, err := model.DescribeServicesForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, services, 1)
+	assert.Equal(t, testECSServiceARN, *services[0].ServiceArn)
+	assert.Empty(t, excluded)
+
+	input := svc.Calls[2].Arguments.Get(1).(*ecs.DescribeServicesInput)
+	assert.Equal(t, "arn:aws:ecs:eu-central-1:123456789012:cluster/test-cluster", *input.Cluster)
+	assert.Equal(t, []*string{aws.String(ecs.ServiceFieldTags)}, input.Include)
+}
+
+func TestDescribeServicesForTagsTaggingAPI(t *testing.T) {
+	otherClusterARN := "arn:aws:ecs:eu-central-1:123456789012:service/other-cluster/test-service"
+	excludedARN := "arn:aws:ecs:eu-central-1:123456789012:service/test-cluster/ignored-service"
+
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+	mockGetResourcesPages(taggingSvc, nil, &resourcegroupstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+			{ResourceARN: aws.String(testECSServiceARN)},
+			{ResourceARN: aws.String(otherClusterARN)},
+			{
+				ResourceARN: aws.String(excludedARN),
+				Tags: []*resourcegroupstaggingapi.Tag{
+					{Key: aws.String("auto-staging:schedule"), Value: aws.String("ignore")},
+				},
+			},
+		},
+	})
+
+	svc := new(mocks.ECSAPI)
+	svc.On("DescribeServicesWithContext", mock.Anything, mock.MatchedBy(func(input *ecs.DescribeServicesInput) bool {
+		return *input.Cluster == "test-cluster"
+	})).Return(&ecs.DescribeServicesOutput{
+		Services: []*ecs.Service{
+			newECSService(testECSServiceARN, 2, nil),
+			newECSService(excludedARN, 1, nil),
+		},
+	}, nil)
+	svc.On("DescribeServicesWithContext", mock.Anything, mock.MatchedBy(func(input *ecs.DescribeServicesInput) bool {
+		return *input.Cluster == "other-cluster"
+	})).Return(&ecs.DescribeServicesOutput{
+		Services: []*ecs.Service{
+			newECSService(otherClusterARN, 1, nil),
+		},
+	}, nil)
+
+	model := NewECSModel(svc, taggingSvc, testExcludeTagConfig)
+	services, excluded, err := model.DescribeServicesForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, services, 2)
+	assert.Equal(t, testECSServiceARN, *services[0].ServiceArn)
+	assert.Equal(t, otherClusterARN, *services[1].ServiceArn)
+	assert.Len(t, excluded, 1)
+	assert.Equal(t, excludedARN, *excluded[0].ServiceArn)
+	svc.AssertCalled(t, "DescribeServicesWithContext", mock.Anything, &ecs.DescribeServicesInput{
+		Cluster:  aws.String("test-cluster"),
+		Services: aws.StringSlice([]string{testECSServiceARN, excludedARN}),
+		Include:  []*string{aws.String(ecs.ServiceFieldTags)},
+	})
+	svc.AssertNotCalled(t, "ListClustersPagesWithContext", mock.Anything, mock.Anything, mock.Anything)
+	taggingSvc.AssertCalled(t, "GetResourcesPagesWithContext", mock.Anything, mock.MatchedBy(func(input *resourcegroupstaggingapi.GetResourcesInput) bool {
+		return *input.ResourceTypeFilters[0] == "ecs:service"
+	}), mock.Anything)
+}
+
+func TestDescribeServicesForTagsTaggingAPIError(t *testing.T) {
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+	mockGetResourcesPages(taggingSvc, errors.New("Test error"))
+
+	svc := new(mocks.ECSAPI)
+	mockListClustersPages(svc, nil, "arn:aws:ecs:eu-central-1:123456789012:cluster/test-cluster")
+	mockListServicesPages(svc, nil, testECSServiceARN)
+	svc.On("DescribeServicesWithContext", mock.Anything, mock.AnythingOfType("*ecs.DescribeServicesInput")).Return(&ecs.DescribeServicesOutput{
+		Services: []*ecs.Service{
+			newECSService(testECSServiceARN, 2, map[string]string{"repository": "repo", "branch_raw": "branch"}),
+		},
+	}, nil)
+
+	model := NewECSModel(svc, taggingSvc, testTagConfig)
+	services, _, err := model.DescribeServicesForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected the lookup to fall back to listing the services")
+	assert.Len(t, services, 1)
+	svc.AssertCalled(t, "ListClustersPagesWithContext", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDescribeServicesForTagsBatches(t *testing.T) {
+	serviceARNs := []string{}
+	for i := 0; i < 12; i++ {
+		serviceARNs = append(serviceARNs, "arn:aws:ecs:eu-central-1:123456789012:service/test-cluster/service-"+strconv.Itoa(i))
+	}
+
+	svc := new(mocks.ECSAPI)
+	mockListClustersPages(svc, nil, "arn:aws:ecs:eu-central-1:123456789012:cluster/test-cluster")
+	mockListServicesPages(svc, nil, serviceARNs...)
+	svc.On("DescribeServicesWithContext", mock.Anything, mock.AnythingOfType("*ecs.DescribeServicesInput")).Return(&ecs.DescribeServicesOutput{}, nil)
+
+	model := NewECSModel(svc, nil, testTagConfig)
+	_, _, err := model.DescribeServicesForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected no error")
+	svc.AssertNumberOfCalls(t, "DescribeServicesWithContext", 2)
+	assert.Len(t, svc.Calls[2].Arguments.Get(1).(*ecs.DescribeServicesInput).Services, 10)
+	assert.Len(t, svc.Calls[3].Arguments.Get(1).(*ecs.DescribeServicesInput).Services, 2)
+}
+
+func TestDescribeServicesForTagsExcluded(t *testing.T) {
+	svc := new(mocks.ECSAPI)
+	mockListClustersPages(svc, nil, "arn:aws:ecs:eu-central-1:123456789012:cluster/test-cluster")
+	mockListServicesPages(svc, nil, testECSServiceARN)
+	svc.On("DescribeServicesWithContext", mock.Anything, mock.AnythingOfType("*ecs.DescribeServicesInput")).Return(&ecs.DescribeServicesOutput{
+		Services: []*ecs.Service{
+			newECSService(testECSServiceARN, 2, map[string]string{"repository": "repo", "branch_raw": "branch", "auto-staging:schedule": "ignore"}),
+		},
+	}, nil)
+
+	model := NewECSModel(svc, nil, testExcludeTagConfig)
+	services, excluded, err := model.DescribeServicesForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Empty(t, services)
+	assert.Len(t, excluded, 1)
+}
+
+func TestDescribeServicesForTagsAwsError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.ECSAPI)
+	mockListClustersPages(svc, nil, "arn:aws:ecs:eu-central-1:123456789012:cluster/test-cluster")
+	mockListServicesPages(svc, errorMsg)
+
+	model := NewECSModel(svc, nil, testTagConfig)
+	_, _, err := model.DescribeServicesForTags(context.Background(), "repo", "branch")
+
+	assert.Equal(t, errorMsg, err)
+}
+
+func TestIsECSActionRequired(t *testing.T) {
+	stopped := newECSService(testECSServiceARN, 0, map[string]string{"desiredCount": "2"})
+	scaledToZero := newECSService(testECSServiceARN, 0, nil)
+	running := newECSService(testECSServiceARN, 2, nil)
+
+	assert.True(t, IsECSActionRequired("stop", running))
+	assert.False(t, IsECSActionRequired("stop", stopped))
+	assert.True(t, IsECSActionRequired("start", stopped))
+	assert.False(t, IsECSActionRequired("start", scaledToZero), "Expected service without desiredCount tag not to be started")
+	assert.False(t, IsECSActionRequired("start", running))
+	assert.False(t, IsECSActionRequired("restart", stopped))
+}
+
+func TestSetECSServiceDesiredCountToZero(t *testing.T) {
+	svc := new(mocks.ECSAPI)
+	svc.On("DescribeServicesWithContext", mock.Anything, mock.AnythingOfType("*ecs.DescribeServicesInput")).Return(&ecs.DescribeServicesOutput{
+		Services: []*ecs.Service{newECSService(testECSServiceARN, 3, nil)},
+	}, nil)
+	svc.On("TagResourceWithContext", mock.Anything, mock.AnythingOfType("*ecs.TagResourceInput")).Return(nil, nil)
+	svc.On("UpdateServiceWithContext", mock.Anything, mock.AnythingOfType("*ecs.UpdateServiceInput")).Return(nil, nil)
+
+	model := NewECSModel(svc, nil, testTagConfig)
+	desiredCount, err := model.SetECSServiceDesiredCountToZero(context.Background(), aws.String(testECSServiceARN))
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, int64(3), desiredCount)
+	svc.AssertCalled(t, "TagResourceWithContext", mock.Anything, &ecs.TagResourceInput{
+		ResourceArn: aws.String(testECSServiceARN),
+		Tags:        []*ecs.Tag{{Key: aws.String("desiredCount"), Value: aws.String("3")}},
+	})
+	svc.AssertCalled(t, "UpdateServiceWithContext", mock.Anything, &ecs.UpdateServiceInput{
+		Cluster:      aws.String("test-cluster"),
+		Service:      aws.String(testECSServiceARN),
+		DesiredCount: aws.Int64(0),
+	})
+}
+
+func TestSetECSServiceDesiredCountToZeroTagError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.ECSAPI)
+	svc.On("DescribeServicesWithContext", mock.Anything, mock.AnythingOfType("*ecs.DescribeServicesInput")).Return(&ecs.DescribeServicesOutput{
+		Services: []*ecs.Service{newECSService(testECSServiceARN, 3, nil)},
+	}, nil)
+	svc.On("TagResourceWithContext", mock.Anything, mock.AnythingOfType("*ecs.TagResourceInput")).Return(nil, errorMsg)
+
+	model := NewECSModel(svc, nil, testTagConfig)
+	_, err := model.SetECSServiceDesiredCountToZero(context.Background(), aws.String(testECSServiceARN))
+
+	assert.Equal(t, errorMsg, err)
+	svc.AssertNotCalled(t, "UpdateServiceWithContext", mock.Anything, mock.Anything)
+}
+
+func TestSetECSServiceDesiredCountToZeroShortARN(t *testing.T) {
+	svc := new(mocks.ECSAPI)
+
+	model := NewECSModel(svc, nil, testTagConfig)
+	_, err := model.SetECSServiceDesiredCountToZero(context.Background(), aws.String("arn:aws:ecs:eu-central-1:123456789012:service/test-service"))
+
+	assert.EqualError(t, err, "ECS service ARN arn:aws:ecs:eu-central-1:123456789012:service/test-service contains no cluster, the long ARN format is required")
+}
+
+func TestSetECSServiceDesiredCountToPreviousValue(t *testing.T) {
+	svc := new(mocks.ECSAPI)
+	svc.On("ListTagsForResourceWithContext", mock.Anything, mock.AnythingOfType("*ecs.ListTagsForResourceInput")).Return(&ecs.ListTagsForResourceOutput{
+		Tags: []*ecs.Tag{{Key: aws.String("desiredCount"), Value: aws.String("3")}},
+	}, nil)
+	svc.On("UpdateServiceWithContext", mock.Anything, mock.AnythingOfType("*ecs.UpdateServiceInput")).Return(nil, nil)
+	svc.On("UntagResourceWithContext", mock.Anything, mock.AnythingOfType("*ecs.UntagResourceInput")).Return(nil, nil)
+
+	model := NewECSModel(svc, nil, testTagConfig)
+	desiredCount, err := model.SetECSServiceDesiredCountToPreviousValue(context.Background(), aws.String(testECSServiceARN))
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, int64(3), desiredCount)
+	svc.AssertCalled(t, "UpdateServiceWithContext", mock.Anything, &ecs.UpdateServiceInput{
+		Cluster:      aws.String("test-cluster"),
+		Service:      aws.String(testECSServiceARN),
+		DesiredCount: aws.Int64(3),
+	})
+	svc.AssertCalled(t, "UntagResourceWithContext", mock.Anything, &ecs.UntagResourceInput{
+		ResourceArn: aws.String(testECSServiceARN),
+		TagKeys:     []*string{aws.String("desiredCount")},
+	})
+}
+
+func TestSetECSServiceDesiredCountToPreviousValueUntagError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.ECSAPI)
+	svc.On("ListTagsForResourceWithContext", mock.Anything, mock.AnythingOfType("*ecs.ListTagsForResourceInput")).Return(&ecs.ListTagsForResourceOutput{
+		Tags: []*ecs.Tag{{Key: aws.String("desiredCount"), Value: aws.String("3")}},
+	}, nil)
+	svc.On("UpdateServiceWithContext", mock.Anything, mock.AnythingOfType("*ecs.UpdateServiceInput")).Return(nil, nil)
+	svc.On("UntagResourceWithContext", mock.Anything, mock.AnythingOfType("*ecs.UntagResourceInput")).Return(nil, errorMsg)
+
+	model := NewECSModel(svc, nil, testTagConfig)
+	_, err := model.SetECSServiceDesiredCountToPreviousValue(context.Background(), aws.String(testECSServiceARN))
+
+	assert.Equal(t, errorMsg, err)
+}
+
+func TestGetPreviousDesiredCountOfECSServiceNoTag(t *testing.T) {
+	svc := new(mocks.ECSAPI)
+	svc.On("ListTagsForResourceWithContext", mock.Anything, mock.AnythingOfType("*ecs.ListTagsForResourceInput")).Return(&ecs.ListTagsForResourceOutput{}, nil)
+
+	model := NewECSModel(svc, nil, testTagConfig)
+	_, err := model.GetPreviousDesiredCountOfECSService(context.Background(), aws.String(testECSServiceARN))
+
+	assert.EqualError(t, err, "found no previous desired count for ECS service "+testECSServiceARN)
+}
+
+func TestGetPreviousDesiredCountOfECSServiceNoInteger(t *testing.T) {
+	svc := new(mocks.ECSAPI)
+	svc.On("ListTagsForResourceWithContext", mock.Anything, mock.AnythingOfType("*ecs.ListTagsForResourceInput")).Return(&ecs.ListTagsForResourceOutput{
+		Tags: []*ecs.Tag{{Key: aws.String("desiredCount"), Value: aws.String("three")}},
+	}, nil)
+
+	model := NewECSModel(svc, nil, testTagConfig)
+	_, err := model.GetPreviousDesiredCountOfECSService(context.Background(), aws.String(testECSServiceARN))
+
+	assert.Error(t, err, "Expected error")
+}
+
+func TestWaitUntilECSServiceStable(t *testing.T) {
+	svc := new(mocks.ECSAPI)
+	svc.On("WaitUntilServicesStableWithContext", mock.Anything, mock.AnythingOfType("*ecs.DescribeServicesInput")).Return(nil)
+
+	model := NewECSModel(svc, nil, testTagConfig)
+	err := model.WaitUntilECSServiceStable(context.Background(), aws.String(testECSServiceARN))
+
+	assert.Nil(t, err, "Expected no error")
+	input := svc.Calls[0].Arguments.Get(1).(*ecs.DescribeServicesInput)
+	assert.Equal(t, "test-cluster", *input.Cluster)
+	assert.Equal(t, []*string{aws.String(testECSServiceARN)}, input.Services)
+}
+
+func TestWaitUntilECSServiceStableAwsError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.ECSAPI)
+	svc.On("WaitUntilServicesStableWithContext", mock.Anything, mock.AnythingOfType("*ecs.DescribeServicesInput")).Return(errorMsg)
+
+	model := NewECSModel(svc, nil, testTagConfig)
+	err := model.WaitUntilECSServiceStable(context.Background(), aws.String(testECSServiceARN))
+
+	assert.Equal(t, errorMsg, err)
+}
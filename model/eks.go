@@ -0,0 +1,349 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/auto-staging/scheduler/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+)
+
+// EKSModelAPI is an interface including all EKS model functions
+type EKSModelAPI interface {
+	DescribeNodegroupsForTags(ctx context.Context, repository, branch string) ([]*eks.Nodegroup, []*eks.Nodegroup, error)
+	SetNodegroupSizeToPreviousValue(ctx context.Context, nodegroupARN *string) (*types.NodegroupSize, error)
+	SetNodegroupSizeToZero(ctx context.Context, nodegroupARN *string) (*types.NodegroupSize, error)
+	GetPreviousSizeOfNodegroup(ctx context.Context, nodegroupARN *string) (*types.NodegroupSize, error)
+	WaitUntilNodegroupActive(ctx context.Context, nodegroupARN *string) error
+}
+
+const desiredSizeTag = "desiredSize"
+
+// EKSModel is a struct including the AWS SDK EKS and Resource Groups Tagging interfaces, all EKS model functions are called on this struct and the included
+// AWS SDK services. The Resource Groups Tagging service is used to find the managed node groups of an Environment by the tags configured in the TagConfig,
+// if it is not set or the lookup fails, the tags of every node group of every cluster are checked instead.
+type EKSModel struct {
+	eksiface.EKSAPI
+	resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+	tags types.TagConfig
+}
+
+// NewEKSModel takes the AWS SDK EKS and Resource Groups Tagging Interfaces and the tag config as parameter and returns the pointer to an EKSModel struct, on
+// which all EKS model functions can be called
+func NewEKSModel(svc eksiface.EKSAPI, taggingSvc resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI, tags types.TagConfig) *EKSModel {
+	return &EKSModel{
+		EKSAPI:                      svc,
+		ResourceGroupsTaggingAPIAPI: taggingSvc,
+		tags:                        tags,
+	}
+}
+
+// DescribeNodegroupsForTags gets all managed node groups of all EKS clusters matching the repository and branch name (the node groups get found by the
+// repository, branch and required tags). The node groups are looked up with the Resource Groups Tagging API, if this lookup isn't possible the tags of all
+// node groups of all clusters get checked instead. Node groups with the exclude tag are returned separately as second value and must not be started or
+// stopped. Whether a node group must be started or stopped can be checked with IsNodegroupActionRequired.
+// If an error occurs, it gets logged and then returned.
+func (eksModel *EKSModel) DescribeNodegroupsForTags(ctx context.Context, repository, branch string) ([]*eks.Nodegroup, []*eks.Nodegroup, error) {
+	if eksModel.ResourceGroupsTaggingAPIAPI != nil {
+		nodegroupARNs, excludedARNs, err := getResourceARNsForTags(ctx, eksModel.ResourceGroupsTaggingAPIAPI, eksModel.tags, "eks:nodegroup", repository, branch)
+		if err == nil {
+			return eksModel.describeNodegroups(ctx, nodegroupARNs, excludedARNs)
+		}
+		log.Println("Tagging API lookup for EKS node groups failed, falling back to checking the tags of every node group")
+	}
+
+	return eksModel.listNodegroupsForTags(ctx, repository, branch)
+}
+
+// describeNodegroups returns the node groups matching the given ARNs, the node groups whose ARN is contained in the excluded ARNs are returned separately.
+func (eksModel *EKSModel) describeNodegroups(ctx context.Context, nodegroupARNs []*string, excludedARNs map[string]bool) ([]*eks.Nodegroup, []*eks.Nodegroup, error) {
+	nodegroups := []*eks.Nodegroup{}
+	excluded := []*eks.Nodegroup{}
+
+	for _, nodegroupARN := range nodegroupARNs {
+		clusterName, nodegroupName, err := nodegroupOfARN(nodegroupARN)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		nodegroup, err := eksModel.describeNodegroup(ctx, clusterName, nodegroupName)
+		if err != nil {
+			log.Println(err)
+			return []*eks.Nodegroup{}, []*eks.Nodegroup{}, err
+		}
+
+		if excludedARNs[*nodegroupARN] {
+			log.Printf("EKS node group %s is excluded by tag \n", *nodegroup.NodegroupArn)
+			excluded = append(excluded, nodegroup)
+			continue
+		}
+		log.Printf("Found EKS node group %s with desired size %d \n", *nodegroup.NodegroupArn, *nodegroup.ScalingConfig.DesiredSize)
+		nodegroups = append(nodegroups, nodegroup)
+	}
+
+	return nodegroups, excluded, nil
+}
+
+// listNodegroupsForTags returns all node groups and the excluded node groups found for the given repository and branch tag values by checking the tags of
+// every node group of every cluster.
+func (eksModel *EKSModel) listNodegroupsForTags(ctx context.Context, repository, branch string) ([]*eks.Nodegroup, []*eks.Nodegroup, error) {
+	nodegroups := []*eks.Nodegroup{}
+	excluded := []*eks.Nodegroup{}
+
+	clusterNames := []*string{}
+	err := eksModel.EKSAPI.ListClustersPagesWithContext(ctx, &eks.ListClustersInput{}, func(result *eks.ListClustersOutput, lastPage bool) bool {
+		clusterNames = append(clusterNames, result.Clusters...)
+		return true
+	})
+	if err != nil {
+		log.Println(err)
+		return []*eks.Nodegroup{}, []*eks.Nodegroup{}, err
+	}
+
+	for _, clusterName := range clusterNames {
+		nodegroupNames := []*string{}
+		err = eksModel.EKSAPI.ListNodegroupsPagesWithContext(ctx, &eks.ListNodegroupsInput{
+			ClusterName: clusterName,
+		}, func(result *eks.ListNodegroupsOutput, lastPage bool) bool {
+			nodegroupNames = append(nodegroupNames, result.Nodegroups...)
+			return true
+		})
+		if err != nil {
+			log.Println(err)
+			return []*eks.Nodegroup{}, []*eks.Nodegroup{}, err
+		}
+
+		for _, nodegroupName := range nodegroupNames {
+			nodegroup, err := eksModel.describeNodegroup(ctx, clusterName, nodegroupName)
+			if err != nil {
+				log.Println(err)
+				return []*eks.Nodegroup{}, []*eks.Nodegroup{}, err
+			}
+
+			tagMap := aws.StringValueMap(nodegroup.Tags)
+			if !eksModel.tags.MatchesEnvironment(tagMap, repository, branch) {
+				continue
+			}
+			if eksModel.tags.IsExcluded(tagMap) {
+				log.Printf("EKS node group %s is excluded by tag \n", *nodegroup.NodegroupArn)
+				excluded = append(excluded, nodegroup)
+				continue
+			}
+			log.Printf("Found EKS node group %s with desired size %d \n", *nodegroup.NodegroupArn, *nodegroup.ScalingConfig.DesiredSize)
+			nodegroups = append(nodegroups, nodegroup)
+		}
+	}
+
+	return nodegroups, excluded, nil
+}
+
+// IsNodegroupActionRequired returns true, if the given node group has to be changed for the given action ("start" or "stop").
+// Only node groups with a desired size greater than 0 can be stopped. Only node groups with a desired size of 0 and a previous size stored in their tags
+// (see HasPreviousNodegroupSize) can be started, so a node group which was scaled to 0 manually isn't mistaken for a stopped node group.
+func IsNodegroupActionRequired(action string, nodegroup *eks.Nodegroup) bool {
+	desiredSize := aws.Int64Value(nodegroup.ScalingConfig.DesiredSize)
+	switch action {
+	case "stop":
+		return desiredSize != 0
+	case "start":
+		return desiredSize == 0 && HasPreviousNodegroupSize(nodegroup)
+	}
+	return false
+}
+
+// HasPreviousNodegroupSize returns true, if the previous size of the given node group is stored in its tags (at least the "minSize" tag exists).
+func HasPreviousNodegroupSize(nodegroup *eks.Nodegroup) bool {
+	_, ok := nodegroup.Tags[minSizeTag]
+	return ok
+}
+
+// SetNodegroupSizeToPreviousValue sets the min size and desired size of the node group matching the given ARN to their previous values received from the
+// GetPreviousSizeOfNodegroup function. Afterwards the tags storing the previous size get deleted, so a node group scaled to 0 manually later isn't
+// restored to stale values on the next start. The restored size gets returned.
+// If an error occurs, it gets logged and then returned.
+func (eksModel *EKSModel) SetNodegroupSizeToPreviousValue(ctx context.Context, nodegroupARN *string) (*types.NodegroupSize, error) {
+	log.Println("Starting EKS node group")
+	size, err := eksModel.GetPreviousSizeOfNodegroup(ctx, nodegroupARN)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	err = eksModel.updateScalingConfig(ctx, nodegroupARN, size.MinSize, size.DesiredSize)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	_, err = eksModel.EKSAPI.UntagResourceWithContext(ctx, &eks.UntagResourceInput{
+		ResourceArn: nodegroupARN,
+		TagKeys:     aws.StringSlice([]string{minSizeTag, desiredSizeTag}),
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return size, nil
+}
+
+// SetNodegroupSizeToZero stores the current min size and desired size of the node group matching the given ARN as tags on the node group and then sets
+// both values to 0. The node group owns its autoscaling group, so the size is changed through the EKS API and never on the autoscaling group.
+// The stored size gets returned.
+// If an error occurs, it gets logged and then returned.
+func (eksModel *EKSModel) SetNodegroupSizeToZero(ctx context.Context, nodegroupARN *string) (*types.NodegroupSize, error) {
+	log.Println("Stopping EKS node group")
+	clusterName, nodegroupName, err := nodegroupOfARN(nodegroupARN)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	nodegroup, err := eksModel.describeNodegroup(ctx, clusterName, nodegroupName)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	size := types.NodegroupSize{
+		MinSize:     *nodegroup.ScalingConfig.MinSize,
+		MaxSize:     *nodegroup.ScalingConfig.MaxSize,
+		DesiredSize: *nodegroup.ScalingConfig.DesiredSize,
+	}
+
+	_, err = eksModel.EKSAPI.TagResourceWithContext(ctx, &eks.TagResourceInput{
+		ResourceArn: nodegroupARN,
+		Tags: map[string]*string{
+			minSizeTag:     aws.String(strconv.FormatInt(size.MinSize, 10)),
+			desiredSizeTag: aws.String(strconv.FormatInt(size.DesiredSize, 10)),
+		},
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	err = eksModel.updateScalingConfig(ctx, nodegroupARN, 0, 0)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return &size, nil
+}
+
+// GetPreviousSizeOfNodegroup returns the previous size of the node group matching the given ARN. The previous size is determined by the tags "minSize"
+// and "desiredSize" attached to the node group by SetNodegroupSizeToZero. The "minSize" tag is required, if only this tag exists (because it was added
+// manually), the desired size defaults to the min size. The max size is always the current max size of the node group.
+// If an error occurs, it gets logged and then nil plus the error will be returned.
+func (eksModel *EKSModel) GetPreviousSizeOfNodegroup(ctx context.Context, nodegroupARN *string) (*types.NodegroupSize, error) {
+	clusterName, nodegroupName, err := nodegroupOfARN(nodegroupARN)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	nodegroup, err := eksModel.describeNodegroup(ctx, clusterName, nodegroupName)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	tagMap := map[string]int64{}
+	for key, value := range nodegroup.Tags {
+		switch key {
+		case minSizeTag, desiredSizeTag:
+			parsed, err := strconv.ParseInt(*value, 10, 64)
+			if err != nil {
+				log.Println(err)
+				return nil, err
+			}
+			tagMap[key] = parsed
+		}
+	}
+
+	minSize, ok := tagMap[minSizeTag]
+	if !ok {
+		err = errors.New("found no previous size for EKS node group " + *nodegroupARN)
+		log.Println(err)
+		return nil, err
+	}
+	size := types.NodegroupSize{
+		MinSize:     minSize,
+		MaxSize:     *nodegroup.ScalingConfig.MaxSize,
+		DesiredSize: minSize,
+	}
+	if desiredSize, ok := tagMap[desiredSizeTag]; ok {
+		size.DesiredSize = desiredSize
+	}
+
+	return &size, nil
+}
+
+// WaitUntilNodegroupActive waits until the node group matching the given ARN is active again, which is the case once the update of its scaling config
+// is completed. Waiting is aborted, when the context is done.
+// If an error occurs, it gets logged and then returned.
+func (eksModel *EKSModel) WaitUntilNodegroupActive(ctx context.Context, nodegroupARN *string) error {
+	clusterName, nodegroupName, err := nodegroupOfARN(nodegroupARN)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	err = eksModel.EKSAPI.WaitUntilNodegroupActiveWithContext(ctx, &eks.DescribeNodegroupInput{
+		ClusterName:   clusterName,
+		NodegroupName: nodegroupName,
+	})
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	log.Printf("EKS node group %s is active \n", *nodegroupARN)
+	return nil
+}
+
+// describeNodegroup returns the node group with the given name of the cluster with the given name.
+func (eksModel *EKSModel) describeNodegroup(ctx context.Context, clusterName, nodegroupName *string) (*eks.Nodegroup, error) {
+	result, err := eksModel.EKSAPI.DescribeNodegroupWithContext(ctx, &eks.DescribeNodegroupInput{
+		ClusterName:   clusterName,
+		NodegroupName: nodegroupName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Nodegroup, nil
+}
+
+// updateScalingConfig sets the min size and desired size of the node group matching the given ARN, the max size stays unchanged.
+func (eksModel *EKSModel) updateScalingConfig(ctx context.Context, nodegroupARN *string, minSize, desiredSize int64) error {
+	clusterName, nodegroupName, err := nodegroupOfARN(nodegroupARN)
+	if err != nil {
+		return err
+	}
+
+	_, err = eksModel.EKSAPI.UpdateNodegroupConfigWithContext(ctx, &eks.UpdateNodegroupConfigInput{
+		ClusterName:   clusterName,
+		NodegroupName: nodegroupName,
+		ScalingConfig: &eks.NodegroupScalingConfig{
+			MinSize:     aws.Int64(minSize),
+			DesiredSize: aws.Int64(desiredSize),
+		},
+	})
+	return err
+}
+
+// nodegroupOfARN returns the cluster name and node group name contained in the given node group ARN
+// (arn:aws:eks:region:account:nodegroup/cluster/nodegroup/id).
+func nodegroupOfARN(nodegroupARN *string) (*string, *string, error) {
+	parsed, err := arn.Parse(*nodegroupARN)
+	if err != nil {
+		return nil, nil, err
+	}
+	parts := strings.Split(parsed.Resource, "/")
+	if len(parts) != 4 || parts[0] != "nodegroup" {
+		return nil, nil, errors.New("invalid EKS node group ARN " + *nodegroupARN)
+	}
+	return aws.String(parts[1]), aws.String(parts[2]), nil
+}
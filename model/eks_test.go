@@ -0,0 +1,336 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/auto-staging/scheduler/mocks"
+	"github.com/auto-staging/scheduler/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testNodegroupARN = "arn:aws:eks:eu-central-1:123456789012:nodegroup/test-cluster/test-nodegroup/1ab2c3d4-5e6f-7a8b-9c0d-1e2f3a4b5c6d"
+
+func TestNewEKSModel(t *testing.T) {
+	svc := new(mocks.EKSAPI)
+	model := NewEKSModel(svc, nil, testTagConfig)
+
+	assert.NotEmpty(t, model, "Expected not empty")
+	assert.Equal(t, svc, model.EKSAPI, "EKS service from model is not matching the one used as parameter")
+	assert.Nil(t, model.ResourceGroupsTaggingAPIAPI, "Expected no tagging service")
+	assert.Equal(t, testTagConfig, model.tags, "Tag config from model is not matching the one used as parameter")
+}
+
+// mockListEKSPages mocks ListClustersPages and ListNodegroupsPages, the given cluster names and node group names are passed as one page to the callback functions
+func mockListEKSPages(svc *mocks.EKSAPI, clusterNames []string, nodegroupNames []string) {
+	svc.On("ListClustersPagesWithContext", mock.Anything, mock.AnythingOfType("*eks.ListClustersInput"), mock.AnythingOfType("func(*eks.ListClustersOutput, bool) bool")).Run(func(args mock.Arguments) {
+		fn := args.Get(2).(func(*eks.ListClustersOutput, bool) bool)
+		fn(&eks.ListClustersOutput{Clusters: aws.StringSlice(clusterNames)}, true)
+	}).Return(nil)
+	svc.On("ListNodegroupsPagesWithContext", mock.Anything, mock.AnythingOfType("*eks.ListNodegroupsInput"), mock.AnythingOfType("func(*eks.ListNodegroupsOutput, bool) bool")).Run(func(args mock.Arguments) {
+		fn := args.Get(2).(func(*eks.ListNodegroupsOutput, bool) bool)
+		fn(&eks.ListNodegroupsOutput{Nodegroups: aws.StringSlice(nodegroupNames)}, true)
+	}).Return(nil)
+}
+
+// newDescribeNodegroupOutput returns the DescribeNodegroup output of a node group with the given scaling config and tags
+func newDescribeNodegroupOutput(nodegroupARN string, minSize, maxSize, desiredSize int64, tags map[string]string) *eks.DescribeNodegroupOutput {
+	return &eks.DescribeNodegroupOutput{
+		Nodegroup: &eks.Nodegroup{
+			NodegroupArn: aws.String(nodegroupARN),
+			ScalingConfig: &eks.NodegroupScalingConfig{
+				MinSize:     aws.Int64(minSize),
+				MaxSize:     aws.Int64(maxSize),
+				DesiredSize: aws.Int64(desiredSize),
+			},
+			Tags: aws.StringMap(tags),
+		},
+	}
+}
+
+func TestDescribeNodegroupsForTags(t *testing.T) {
+	otherARN := "arn:aws:eks:eu-central-1:123456789012:nodegroup/test-cluster/other-nodegroup/6d5c4b3a-2f1e-0d9c-8b7a-6f5e4d3c2b1a"
+
+	svc := new(mocks.EKSAPI)
+	mockListEKSPages(svc, []string{"test-cluster"}, []string{"test-nodegroup", "other-nodegroup"})
+	svc.On("DescribeNodegroupWithContext", mock.Anything, &eks.DescribeNodegroupInput{
+		ClusterName:   aws.String("test-cluster"),
+		NodegroupName: aws.String("test-nodegroup"),
+	}).Return(newDescribeNodegroupOutput(testNodegroupARN, 1, 4, 2, map[string]string{"repository": "repo", "branch_raw": "branch"}), nil)
+	svc.On("DescribeNodegroupWithContext", mock.Anything, &eks.DescribeNodegroupInput{
+		ClusterName:   aws.String("test-cluster"),
+		NodegroupName: aws.String("other-nodegroup"),
+	}).Return(newDescribeNodegroupOutput(otherARN, 1, 4, 2, map[string]string{"repository": "repo", "branch_raw": "other"}), nil)
+
+	model := NewEKSModel(svc, nil, testTagConfig)
+	nodegroups, excluded, err := model.DescribeNodegroupsForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, nodegroups, 1)
+	assert.Equal(t, testNodegroupARN, *nodegroups[0].NodegroupArn)
+	assert.Empty(t, excluded)
+}
+
+func TestDescribeNodegroupsForTagsExcluded(t *testing.T) {
+	svc := new(mocks.EKSAPI)
+	mockListEKSPages(svc, []string{"test-cluster"}, []string{"test-nodegroup"})
+	svc.On("DescribeNodegroupWithContext", mock.Anything, mock.AnythingOfType("*eks.DescribeNodegroupInput")).Return(newDescribeNodegroupOutput(testNodegroupARN, 1, 4, 2, map[string]string{
+		"repository":            "repo",
+		"branch_raw":            "branch",
+		"auto-staging:schedule": "ignore",
+	}), nil)
+
+	model := NewEKSModel(svc, nil, testExcludeTagConfig)
+	nodegroups, excluded, err := model.DescribeNodegroupsForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Empty(t, nodegroups)
+	assert.Len(t, excluded, 1)
+}
+
+func TestDescribeNodegroupsForTagsAwsError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.EKSAPI)
+	mockListEKSPages(svc, []string{"test-cluster"}, []string{"test-nodegroup"})
+	svc.On("DescribeNodegroupWithContext", mock.Anything, mock.AnythingOfType("*eks.DescribeNodegroupInput")).Return(nil, errorMsg)
+
+	model := NewEKSModel(svc, nil, testTagConfig)
+	_, _, err := model.DescribeNodegroupsForTags(context.Background(), "repo", "branch")
+
+	assert.Equal(t, errorMsg, err)
+}
+
+func TestDescribeNodegroupsForTagsTaggingAPI(t *testing.T) {
+	excludedARN := "arn:aws:eks:eu-central-1:123456789012:nodegroup/other-cluster/ignored-nodegroup/6d5c4b3a-2f1e-0d9c-8b7a-6f5e4d3c2b1a"
+
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+	mockGetResourcesPages(taggingSvc, nil, &resourcegroupstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+			{ResourceARN: aws.String(testNodegroupARN)},
+			{
+				ResourceARN: aws.String(excludedARN),
+				Tags: []*resourcegroupstaggingapi.Tag{
+					{Key: aws.String("auto-staging:schedule"), Value: aws.String("ignore")},
+				},
+			},
+		},
+	})
+
+	svc := new(mocks.EKSAPI)
+	svc.On("DescribeNodegroupWithContext", mock.Anything, &eks.DescribeNodegroupInput{
+		ClusterName:   aws.String("test-cluster"),
+		NodegroupName: aws.String("test-nodegroup"),
+	}).Return(newDescribeNodegroupOutput(testNodegroupARN, 1, 4, 2, nil), nil)
+	svc.On("DescribeNodegroupWithContext", mock.Anything, &eks.DescribeNodegroupInput{
+		ClusterName:   aws.String("other-cluster"),
+		NodegroupName: aws.String("ignored-nodegroup"),
+	}).Return(newDescribeNodegroupOutput(excludedARN, 1, 4, 2, nil), nil)
+
+	model := NewEKSModel(svc, taggingSvc, testExcludeTagConfig)
+	nodegroups, excluded, err := model.DescribeNodegroupsForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, nodegroups, 1)
+	assert.Equal(t, testNodegroupARN, *nodegroups[0].NodegroupArn)
+	assert.Len(t, excluded, 1)
+	assert.Equal(t, excludedARN, *excluded[0].NodegroupArn)
+	svc.AssertNotCalled(t, "ListClustersPagesWithContext", mock.Anything, mock.Anything, mock.Anything)
+	taggingSvc.AssertCalled(t, "GetResourcesPagesWithContext", mock.Anything, mock.MatchedBy(func(input *resourcegroupstaggingapi.GetResourcesInput) bool {
+		return *input.ResourceTypeFilters[0] == "eks:nodegroup"
+	}), mock.Anything)
+}
+
+func TestDescribeNodegroupsForTagsTaggingAPIError(t *testing.T) {
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+	mockGetResourcesPages(taggingSvc, errors.New("Test error"))
+
+	svc := new(mocks.EKSAPI)
+	mockListEKSPages(svc, []string{"test-cluster"}, []string{"test-nodegroup"})
+	svc.On("DescribeNodegroupWithContext", mock.Anything, mock.AnythingOfType("*eks.DescribeNodegroupInput")).Return(newDescribeNodegroupOutput(testNodegroupARN, 1, 4, 2, map[string]string{
+		"repository": "repo",
+		"branch_raw": "branch",
+	}), nil)
+
+	model := NewEKSModel(svc, taggingSvc, testTagConfig)
+	nodegroups, _, err := model.DescribeNodegroupsForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected the lookup to fall back to listing the node groups")
+	assert.Len(t, nodegroups, 1)
+	svc.AssertCalled(t, "ListClustersPagesWithContext", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestIsNodegroupActionRequired(t *testing.T) {
+	stopped := newDescribeNodegroupOutput(testNodegroupARN, 0, 4, 0, map[string]string{"minSize": "1"}).Nodegroup
+	scaledToZero := newDescribeNodegroupOutput(testNodegroupARN, 0, 4, 0, nil).Nodegroup
+	running := newDescribeNodegroupOutput(testNodegroupARN, 1, 4, 2, nil).Nodegroup
+
+	assert.True(t, IsNodegroupActionRequired("stop", running))
+	assert.False(t, IsNodegroupActionRequired("stop", stopped))
+	assert.True(t, IsNodegroupActionRequired("start", stopped))
+	assert.False(t, IsNodegroupActionRequired("start", scaledToZero), "Expected node group without minSize tag not to be started")
+	assert.False(t, IsNodegroupActionRequired("start", running))
+	assert.False(t, IsNodegroupActionRequired("restart", stopped))
+}
+
+func TestSetNodegroupSizeToZero(t *testing.T) {
+	svc := new(mocks.EKSAPI)
+	svc.On("DescribeNodegroupWithContext", mock.Anything, mock.AnythingOfType("*eks.DescribeNodegroupInput")).Return(newDescribeNodegroupOutput(testNodegroupARN, 1, 4, 2, nil), nil)
+	svc.On("TagResourceWithContext", mock.Anything, mock.AnythingOfType("*eks.TagResourceInput")).Return(nil, nil)
+	svc.On("UpdateNodegroupConfigWithContext", mock.Anything, mock.AnythingOfType("*eks.UpdateNodegroupConfigInput")).Return(nil, nil)
+
+	model := NewEKSModel(svc, nil, testTagConfig)
+	size, err := model.SetNodegroupSizeToZero(context.Background(), aws.String(testNodegroupARN))
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, &types.NodegroupSize{MinSize: 1, MaxSize: 4, DesiredSize: 2}, size)
+	svc.AssertCalled(t, "TagResourceWithContext", mock.Anything, &eks.TagResourceInput{
+		ResourceArn: aws.String(testNodegroupARN),
+		Tags: map[string]*string{
+			"minSize":     aws.String("1"),
+			"desiredSize": aws.String("2"),
+		},
+	})
+	svc.AssertCalled(t, "UpdateNodegroupConfigWithContext", mock.Anything, &eks.UpdateNodegroupConfigInput{
+		ClusterName:   aws.String("test-cluster"),
+		NodegroupName: aws.String("test-nodegroup"),
+		ScalingConfig: &eks.NodegroupScalingConfig{
+			MinSize:     aws.Int64(0),
+			DesiredSize: aws.Int64(0),
+		},
+	})
+}
+
+func TestSetNodegroupSizeToZeroTagError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.EKSAPI)
+	svc.On("DescribeNodegroupWithContext", mock.Anything, mock.AnythingOfType("*eks.DescribeNodegroupInput")).Return(newDescribeNodegroupOutput(testNodegroupARN, 1, 4, 2, nil), nil)
+	svc.On("TagResourceWithContext", mock.Anything, mock.AnythingOfType("*eks.TagResourceInput")).Return(nil, errorMsg)
+
+	model := NewEKSModel(svc, nil, testTagConfig)
+	_, err := model.SetNodegroupSizeToZero(context.Background(), aws.String(testNodegroupARN))
+
+	assert.Equal(t, errorMsg, err)
+	svc.AssertNotCalled(t, "UpdateNodegroupConfigWithContext", mock.Anything, mock.Anything)
+}
+
+func TestSetNodegroupSizeToZeroInvalidARN(t *testing.T) {
+	svc := new(mocks.EKSAPI)
+
+	model := NewEKSModel(svc, nil, testTagConfig)
+	_, err := model.SetNodegroupSizeToZero(context.Background(), aws.String("arn:aws:eks:eu-central-1:123456789012:cluster/test-cluster"))
+
+	assert.EqualError(t, err, "invalid EKS node group ARN arn:aws:eks:eu-central-1:123456789012:cluster/test-cluster")
+}
+
+func TestSetNodegroupSizeToPreviousValue(t *testing.T) {
+	svc := new(mocks.EKSAPI)
+	svc.On("DescribeNodegroupWithContext", mock.Anything, mock.AnythingOfType("*eks.DescribeNodegroupInput")).Return(newDescribeNodegroupOutput(testNodegroupARN, 0, 4, 0, map[string]string{
+		"minSize":     "1",
+		"desiredSize": "2",
+	}), nil)
+	svc.On("UpdateNodegroupConfigWithContext", mock.Anything, mock.AnythingOfType("*eks.UpdateNodegroupConfigInput")).Return(nil, nil)
+	svc.On("UntagResourceWithContext", mock.Anything, mock.AnythingOfType("*eks.UntagResourceInput")).Return(nil, nil)
+
+	model := NewEKSModel(svc, nil, testTagConfig)
+	size, err := model.SetNodegroupSizeToPreviousValue(context.Background(), aws.String(testNodegroupARN))
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, &types.NodegroupSize{MinSize: 1, MaxSize: 4, DesiredSize: 2}, size)
+	svc.AssertCalled(t, "UpdateNodegroupConfigWithContext", mock.Anything, &eks.UpdateNodegroupConfigInput{
+		ClusterName:   aws.String("test-cluster"),
+		NodegroupName: aws.String("test-nodegroup"),
+		ScalingConfig: &eks.NodegroupScalingConfig{
+			MinSize:     aws.Int64(1),
+			DesiredSize: aws.Int64(2),
+		},
+	})
+	svc.AssertCalled(t, "UntagResourceWithContext", mock.Anything, &eks.UntagResourceInput{
+		ResourceArn: aws.String(testNodegroupARN),
+		TagKeys:     aws.StringSlice([]string{"minSize", "desiredSize"}),
+	})
+}
+
+func TestSetNodegroupSizeToPreviousValueUntagError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.EKSAPI)
+	svc.On("DescribeNodegroupWithContext", mock.Anything, mock.AnythingOfType("*eks.DescribeNodegroupInput")).Return(newDescribeNodegroupOutput(testNodegroupARN, 0, 4, 0, map[string]string{
+		"minSize": "1",
+	}), nil)
+	svc.On("UpdateNodegroupConfigWithContext", mock.Anything, mock.AnythingOfType("*eks.UpdateNodegroupConfigInput")).Return(nil, nil)
+	svc.On("UntagResourceWithContext", mock.Anything, mock.AnythingOfType("*eks.UntagResourceInput")).Return(nil, errorMsg)
+
+	model := NewEKSModel(svc, nil, testTagConfig)
+	_, err := model.SetNodegroupSizeToPreviousValue(context.Background(), aws.String(testNodegroupARN))
+
+	assert.Equal(t, errorMsg, err)
+}
+
+func TestGetPreviousSizeOfNodegroupOnlyMinSizeTag(t *testing.T) {
+	svc := new(mocks.EKSAPI)
+	svc.On("DescribeNodegroupWithContext", mock.Anything, mock.AnythingOfType("*eks.DescribeNodegroupInput")).Return(newDescribeNodegroupOutput(testNodegroupARN, 0, 4, 0, map[string]string{
+		"minSize": "3",
+	}), nil)
+
+	model := NewEKSModel(svc, nil, testTagConfig)
+	size, err := model.GetPreviousSizeOfNodegroup(context.Background(), aws.String(testNodegroupARN))
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, &types.NodegroupSize{MinSize: 3, MaxSize: 4, DesiredSize: 3}, size)
+}
+
+func TestGetPreviousSizeOfNodegroupNoTags(t *testing.T) {
+	svc := new(mocks.EKSAPI)
+	svc.On("DescribeNodegroupWithContext", mock.Anything, mock.AnythingOfType("*eks.DescribeNodegroupInput")).Return(newDescribeNodegroupOutput(testNodegroupARN, 0, 4, 0, nil), nil)
+
+	model := NewEKSModel(svc, nil, testTagConfig)
+	_, err := model.GetPreviousSizeOfNodegroup(context.Background(), aws.String(testNodegroupARN))
+
+	assert.EqualError(t, err, "found no previous size for EKS node group "+testNodegroupARN)
+}
+
+func TestGetPreviousSizeOfNodegroupNoInteger(t *testing.T) {
+	svc := new(mocks.EKSAPI)
+	svc.On("DescribeNodegroupWithContext", mock.Anything, mock.AnythingOfType("*eks.DescribeNodegroupInput")).Return(newDescribeNodegroupOutput(testNodegroupARN, 0, 4, 0, map[string]string{
+		"minSize": "one",
+	}), nil)
+
+	model := NewEKSModel(svc, nil, testTagConfig)
+	_, err := model.GetPreviousSizeOfNodegroup(context.Background(), aws.String(testNodegroupARN))
+
+	assert.Error(t, err, "Expected error")
+}
+
+func TestWaitUntilNodegroupActive(t *testing.T) {
+	svc := new(mocks.EKSAPI)
+	svc.On("WaitUntilNodegroupActiveWithContext", mock.Anything, mock.AnythingOfType("*eks.DescribeNodegroupInput")).Return(nil)
+
+	model := NewEKSModel(svc, nil, testTagConfig)
+	err := model.WaitUntilNodegroupActive(context.Background(), aws.String(testNodegroupARN))
+
+	assert.Nil(t, err, "Expected no error")
+	svc.AssertCalled(t, "WaitUntilNodegroupActiveWithContext", mock.Anything, &eks.DescribeNodegroupInput{
+		ClusterName:   aws.String("test-cluster"),
+		NodegroupName: aws.String("test-nodegroup"),
+	})
+}
+
+func TestWaitUntilNodegroupActiveAwsError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.EKSAPI)
+	svc.On("WaitUntilNodegroupActiveWithContext", mock.Anything, mock.AnythingOfType("*eks.DescribeNodegroupInput")).Return(errorMsg)
+
+	model := NewEKSModel(svc, nil, testTagConfig)
+	err := model.WaitUntilNodegroupActive(context.Background(), aws.String(testNodegroupARN))
+
+	assert.Equal(t, errorMsg, err)
+}
@@ -0,0 +1,555 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/auto-staging/scheduler/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+)
+
+// ElastiCacheModelAPI is an interface including all ElastiCache model functions
+type ElastiCacheModelAPI interface {
+	GetReplicationGroupsForTags(ctx context.Context, repository, branch string) ([]*elasticache.ReplicationGroup, []*elasticache.ReplicationGroup, []*elasticache.ReplicationGroup, error)
+	GetReplicationGroupSnapshotsForTags(ctx context.Context, repository, branch string) ([]*elasticache.Snapshot, error)
+	DeleteReplicationGroupWithSnapshot(ctx context.Context, replicationGroupID *string) (*string, error)
+	RestoreReplicationGroupFromSnapshot(ctx context.Context, snapshotName *string) error
+	WaitUntilReplicationGroupAvailable(ctx context.Context, replicationGroupID *string) error
+	WaitUntilReplicationGroupDeleted(ctx context.Context, replicationGroupID *string) error
+}
+
+// elastiCachePollInterval is the time between two status checks while waiting for a snapshot
+var elastiCachePollInterval = 15 * time.Second
+
+const (
+	// replicationGroupSnapshotPrefix is the prefix of the snapshots created before a replication group gets deleted,
+	// it is followed by the replication group ID and the creation time
+	replicationGroupSnapshotPrefix = "auto-staging-"
+
+	// The settings of a replication group, which aren't part of its snapshot, are stored as tags on the snapshot
+	numCacheClustersTag  = "auto-staging:numCacheClusters"
+	clusterEnabledTag    = "auto-staging:clusterEnabled"
+	securityGroupIDsTag  = "auto-staging:securityGroupIds"
+	transitEncryptionTag = "auto-staging:transitEncryption"
+	atRestEncryptionTag  = "auto-staging:atRestEncryption"
+	multiAZTag           = "auto-staging:multiAZ"
+	userGroupIDsTag      = "auto-staging:userGroupIds"
+
+	// logDeliveryTagPrefix is followed by the log type, the value of the tag is the destination type, the log format and the destination separated by spaces
+	logDeliveryTagPrefix = "auto-staging:logDelivery:"
+
+	// awsTagPrefix is the prefix of the tags reserved for AWS (e.g. added by CloudFormation), which can't be set on snapshots or replication groups
+	awsTagPrefix = "aws:"
+)
+
+// ElastiCacheModel is a struct including the AWS SDK ElastiCache and Resource Groups Tagging interfaces, all ElastiCache model functions are called on this struct
+// and the included AWS SDK services. The replication groups of an Environment are found by the tags configured in the TagConfig, only replication groups with
+// the snapshot tag are deleted and restored. The tags are read with the Resource Groups Tagging service, if it is not set or the lookup fails, the tags of
+// every replication group and snapshot are listed instead.
+type ElastiCacheModel struct {
+	elasticacheiface.ElastiCacheAPI
+	resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+	tags types.TagConfig
+}
+
+// NewElastiCacheModel takes the AWS SDK ElastiCache and Resource Groups Tagging Interfaces and the tag config as parameter and returns the pointer to an
+// ElastiCacheModel struct, on which all ElastiCache model functions can be called
+func NewElastiCacheModel(svc elasticacheiface.ElastiCacheAPI, taggingSvc resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI, tags types.TagConfig) *ElastiCacheModel {
+	return &ElastiCacheModel{
+		ElastiCacheAPI:              svc,
+		ResourceGroupsTaggingAPIAPI: taggingSvc,
+		tags:                        tags,
+	}
+}
+
+// GetReplicationGroupsForTags returns all replication groups found for the given repository and branch tag values, which have the snapshot tag.
+// Replication groups with the exclude tag are returned separately as second value and replication groups without the snapshot tag as third value, both
+// must not be deleted, but they exist and must not be restored from a snapshot.
+// All result pages of the DescribeReplicationGroups call are processed.
+// If an error occurs, the error gets logged and then returned.
+func (elastiCacheModel *ElastiCacheModel) GetReplicationGroupsForTags(ctx context.Context, repository, branch string) ([]*elasticache.ReplicationGroup, []*elasticache.ReplicationGroup, []*elasticache.ReplicationGroup, error) {
+	groups := []*elasticache.ReplicationGroup{}
+	excluded := []*elasticache.ReplicationGroup{}
+	withoutSnapshotTag := []*elasticache.ReplicationGroup{}
+	tagsForResource := elastiCacheModel.tagsForResourceLookup(ctx, "elasticache:replicationgroup", repository, branch)
+	var tagErr error
+	err := elastiCacheModel.ElastiCacheAPI.DescribeReplicationGroupsPagesWithContext(ctx, &elasticache.DescribeReplicationGroupsInput{}, func(result *elasticache.DescribeReplicationGroupsOutput, lastPage bool) bool {
+		for _, group := range result.ReplicationGroups {
+			tagMap, err := tagsForResource(ctx, group.ARN)
+			if err != nil {
+				tagErr = err
+				return false
+			}
+
+			if !elastiCacheModel.tags.MatchesEnvironment(tagMap, repository, branch) {
+				continue
+			}
+			if elastiCacheModel.tags.IsExcluded(tagMap) {
+				log.Printf("Replication group %s is excluded by tag \n", *group.ReplicationGroupId)
+				excluded = append(excluded, group)
+				continue
+			}
+			if !elastiCacheModel.tags.AllowsSnapshot(tagMap) {
+				log.Printf("Replication group %s has no snapshot tag and won't be deleted \n", *group.ReplicationGroupId)
+				withoutSnapshotTag = append(withoutSnapshotTag, group)
+				continue
+			}
+			log.Printf("Found replication group %s matching the tags with status %s \n", *group.ReplicationGroupId, *group.Status)
+			groups = append(groups, group)
+		}
+		return true
+	})
+	if err == nil {
+		err = tagErr
+	}
+	if err != nil {
+		log.Println(err)
+		return []*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, err
+	}
+
+	if len(groups) == 0 {
+		log.Println("Found no matching Replication Group")
+	}
+	return groups, excluded, withoutSnapshotTag, nil
+}
+
+// GetReplicationGroupSnapshotsForTags returns the latest snapshot of every replication group found for the given repository and branch tag values.
+// The snapshots get the tags of their replication group, when they are created by DeleteReplicationGroupWithSnapshot.
+// If an error occurs, the error gets logged and then returned.
+func (elastiCacheModel *ElastiCacheModel) GetReplicationGroupSnapshotsForTags(ctx context.Context, repository, branch string) ([]*elasticache.Snapshot, error) {
+	latest := map[string]*elasticache.Snapshot{}
+	groupIDs := []string{}
+	tagsForResource := elastiCacheModel.tagsForResourceLookup(ctx, "elasticache:snapshot", repository, branch)
+	var tagErr error
+	err := elastiCacheModel.ElastiCacheAPI.DescribeSnapshotsPagesWithContext(ctx, &elasticache.DescribeSnapshotsInput{
+		SnapshotSource: aws.String("manual"),
+	}, func(result *elasticache.DescribeSnapshotsOutput, lastPage bool) bool {
+		for _, snapshot := range result.Snapshots {
+			if snapshot.ReplicationGroupId == nil || !strings.HasPrefix(*snapshot.SnapshotName, replicationGroupSnapshotPrefix+*snapshot.ReplicationGroupId+"-") {
+				continue
+			}
+			tagMap, err := tagsForResource(ctx, snapshot.ARN)
+			if err != nil {
+				tagErr = err
+				return false
+			}
+			if !elastiCacheModel.tags.MatchesEnvironment(tagMap, repository, branch) {
+				continue
+			}
+
+			groupID := *snapshot.ReplicationGroupId
+			previous, ok := latest[groupID]
+			if !ok {
+				groupIDs = append(groupIDs, groupID)
+			}
+			// The snapshot names end with the creation time, so the latest snapshot has the greatest name
+			if !ok || *snapshot.SnapshotName > *previous.SnapshotName {
+				latest[groupID] = snapshot
+			}
+		}
+		return true
+	})
+	if err == nil {
+		err = tagErr
+	}
+	if err != nil {
+		log.Println(err)
+		return []*elasticache.Snapshot{}, err
+	}
+
+	snapshots := []*elasticache.Snapshot{}
+	for _, groupID := range groupIDs {
+		log.Printf("Found snapshot %s of replication group %s \n", *latest[groupID].SnapshotName, groupID)
+		snapshots = append(snapshots, latest[groupID])
+	}
+	return snapshots, nil
+}
+
+// DeleteReplicationGroupWithSnapshot creates a snapshot of the replication group matching the given ID and deletes the replication group once the snapshot is
+// available. The tags of the replication group and the settings required to restore it, which aren't part of the snapshot, are added as tags to the snapshot.
+// Older snapshots of the replication group created by this function are only deleted after the replication group got deleted. The name of the new snapshot
+// gets returned.
+// If an error occurs, the error gets logged and then returned. The replication group is never deleted without an available snapshot.
+func (elastiCacheModel *ElastiCacheModel) DeleteReplicationGroupWithSnapshot(ctx context.Context, replicationGroupID *string) (*string, error) {
+	log.Println("Deleting REPLICATION GROUP with snapshot")
+	result, err := elastiCacheModel.ElastiCacheAPI.DescribeReplicationGroupsWithContext(ctx, &elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: replicationGroupID,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	if len(result.ReplicationGroups) == 0 || len(result.ReplicationGroups[0].MemberClusters) == 0 {
+		err = errors.New("found no replication group for " + *replicationGroupID)
+		log.Println(err)
+		return nil, err
+	}
+	group := result.ReplicationGroups[0]
+	if !IsReplicationGroupRestorable(group) {
+		err = errors.New("replication group " + *replicationGroupID + " uses an AUTH token and can't be restored from a snapshot")
+		log.Println(err)
+		return nil, err
+	}
+
+	tagMap, err := elastiCacheModel.getTagsForResource(ctx, group.ARN)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	for key := range tagMap {
+		if isAWSTag(key) {
+			delete(tagMap, key)
+		}
+	}
+	clusters, err := elastiCacheModel.ElastiCacheAPI.DescribeCacheClustersWithContext(ctx, &elasticache.DescribeCacheClustersInput{
+		CacheClusterId: group.MemberClusters[0],
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	securityGroupIDs := []string{}
+	for _, cluster := range clusters.CacheClusters {
+		for _, securityGroup := range cluster.SecurityGroups {
+			securityGroupIDs = append(securityGroupIDs, *securityGroup.SecurityGroupId)
+		}
+	}
+	tagMap[numCacheClustersTag] = strconv.Itoa(len(group.MemberClusters))
+	tagMap[clusterEnabledTag] = strconv.FormatBool(aws.BoolValue(group.ClusterEnabled))
+	tagMap[securityGroupIDsTag] = strings.Join(securityGroupIDs, " ")
+	for key, value := range restoreSettingsOfReplicationGroup(group) {
+		tagMap[key] = value
+	}
+
+	tags := []*elasticache.Tag{}
+	for key, value := range tagMap {
+		tags = append(tags, &elasticache.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+	snapshotName := aws.String(replicationGroupSnapshotPrefix + *replicationGroupID + "-" + now().UTC().Format("20060102150405"))
+	_, err = elastiCacheModel.ElastiCacheAPI.CreateSnapshotWithContext(ctx, &elasticache.CreateSnapshotInput{
+		ReplicationGroupId: replicationGroupID,
+		SnapshotName:       snapshotName,
+		Tags:               tags,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	err = elastiCacheModel.waitForSnapshotAvailable(ctx, snapshotName)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	_, err = elastiCacheModel.ElastiCacheAPI.DeleteReplicationGroupWithContext(ctx, &elasticache.DeleteReplicationGroupInput{
+		ReplicationGroupId: replicationGroupID,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	elastiCacheModel.deleteOldSnapshots(ctx, replicationGroupID, snapshotName)
+	return snapshotName, nil
+}
+
+// RestoreReplicationGroupFromSnapshot creates the replication group of the snapshot matching the given name from the snapshot. The settings and tags of the
+// replication group are taken from the snapshot and the tags added to it by DeleteReplicationGroupWithSnapshot.
+// If an error occurs, the error gets logged and then returned.
+func (elastiCacheModel *ElastiCacheModel) RestoreReplicationGroupFromSnapshot(ctx context.Context, snapshotName *string) error {
+	log.Println("Restoring REPLICATION GROUP from snapshot")
+	result, err := elastiCacheModel.ElastiCacheAPI.DescribeSnapshotsWithContext(ctx, &elasticache.DescribeSnapshotsInput{
+		SnapshotName: snapshotName,
+	})
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	if len(result.Snapshots) == 0 {
+		err = errors.New("found no snapshot for " + *snapshotName)
+		log.Println(err)
+		return err
+	}
+	snapshot := result.Snapshots[0]
+
+	tagMap, err := elastiCacheModel.getTagsForResource(ctx, snapshot.ARN)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	numCacheClusters, err := strconv.ParseInt(tagMap[numCacheClustersTag], 10, 64)
+	if err != nil {
+		err = errors.New("found no restore settings for snapshot " + *snapshotName)
+		log.Println(err)
+		return err
+	}
+
+	input := &elasticache.CreateReplicationGroupInput{
+		ReplicationGroupId:          snapshot.ReplicationGroupId,
+		ReplicationGroupDescription: snapshot.ReplicationGroupDescription,
+		SnapshotName:                snapshotName,
+		Engine:                      snapshot.Engine,
+		EngineVersion:               snapshot.EngineVersion,
+		CacheNodeType:               snapshot.CacheNodeType,
+		CacheParameterGroupName:     snapshot.CacheParameterGroupName,
+		CacheSubnetGroupName:        snapshot.CacheSubnetGroupName,
+		Port:                        snapshot.Port,
+		PreferredMaintenanceWindow:  snapshot.PreferredMaintenanceWindow,
+		SnapshotRetentionLimit:      snapshot.SnapshotRetentionLimit,
+		SnapshotWindow:              snapshot.SnapshotWindow,
+		NotificationTopicArn:        snapshot.TopicArn,
+		KmsKeyId:                    snapshot.KmsKeyId,
+		AutomaticFailoverEnabled:    aws.Bool(aws.StringValue(snapshot.AutomaticFailover) == elasticache.AutomaticFailoverStatusEnabled),
+		SecurityGroupIds:            aws.StringSlice(strings.Fields(tagMap[securityGroupIDsTag])),
+		Tags:                        []*elasticache.Tag{},
+	}
+	applyRestoreSettings(input, tagMap)
+	if aws.StringValue(input.ReplicationGroupDescription) == "" {
+		input.ReplicationGroupDescription = snapshot.ReplicationGroupId
+	}
+	if tagMap[clusterEnabledTag] == "true" && aws.Int64Value(snapshot.NumNodeGroups) > 0 {
+		input.NumNodeGroups = snapshot.NumNodeGroups
+		input.ReplicasPerNodeGroup = aws.Int64(numCacheClusters/(*snapshot.NumNodeGroups) - 1)
+	} else {
+		input.NumCacheClusters = aws.Int64(numCacheClusters)
+	}
+	for key, value := range tagMap {
+		if isRestoreSettingTag(key) || isAWSTag(key) {
+			continue
+		}
+		input.Tags = append(input.Tags, &elasticache.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+
+	_, err = elastiCacheModel.ElastiCacheAPI.CreateReplicationGroupWithContext(ctx, input)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	return nil
+}
+
+// IsReplicationGroupRestorable returns true, if the given replication group can be restored from a snapshot created by DeleteReplicationGroupWithSnapshot.
+// Replication groups using an AUTH token can't be restored, because the token can't be read and stored with the snapshot.
+func IsReplicationGroupRestorable(group *elasticache.ReplicationGroup) bool {
+	return !aws.BoolValue(group.AuthTokenEnabled)
+}
+
+// WaitUntilReplicationGroupAvailable waits until the replication group matching the given ID is available. Waiting is aborted, when the context is done.
+// If an error occurs, the error gets logged and then returned.
+func (elastiCacheModel *ElastiCacheModel) WaitUntilReplicationGroupAvailable(ctx context.Context, replicationGroupID *string) error {
+	err := elastiCacheModel.ElastiCacheAPI.WaitUntilReplicationGroupAvailableWithContext(ctx, &elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: replicationGroupID,
+	})
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	log.Printf("Replication group %s is available \n", *replicationGroupID)
+	return nil
+}
+
+// WaitUntilReplicationGroupDeleted waits until the replication group matching the given ID is deleted. Waiting is aborted, when the context is done.
+// If an error occurs, the error gets logged and then returned.
+func (elastiCacheModel *ElastiCacheModel) WaitUntilReplicationGroupDeleted(ctx context.Context, replicationGroupID *string) error {
+	err := elastiCacheModel.ElastiCacheAPI.WaitUntilReplicationGroupDeletedWithContext(ctx, &elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: replicationGroupID,
+	})
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	log.Printf("Replication group %s is deleted \n", *replicationGroupID)
+	return nil
+}
+
+// waitForSnapshotAvailable polls the status of the snapshot matching the given name every elastiCachePollInterval until it is available.
+// Waiting is aborted, when the context is done or the snapshot failed.
+func (elastiCacheModel *ElastiCacheModel) waitForSnapshotAvailable(ctx context.Context, snapshotName *string) error {
+	for {
+		result, err := elastiCacheModel.ElastiCacheAPI.DescribeSnapshotsWithContext(ctx, &elasticache.DescribeSnapshotsInput{
+			SnapshotName: snapshotName,
+		})
+		if err != nil {
+			return err
+		}
+		if len(result.Snapshots) > 0 {
+			switch *result.Snapshots[0].SnapshotStatus {
+			case "available":
+				log.Printf("Snapshot %s is available \n", *snapshotName)
+				return nil
+			case "failed":
+				return errors.New("creating snapshot " + *snapshotName + " failed")
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Printf("Stopped waiting for snapshot %s, %s \n", *snapshotName, ctx.Err())
+			return ctx.Err()
+		case <-time.After(elastiCachePollInterval):
+		}
+	}
+}
+
+// deleteOldSnapshots deletes all snapshots of the replication group matching the given ID created by DeleteReplicationGroupWithSnapshot except the given one.
+// The replication group is already deleted at this point, so errors are only logged and the old snapshots get deleted by the next call.
+func (elastiCacheModel *ElastiCacheModel) deleteOldSnapshots(ctx context.Context, replicationGroupID, keepSnapshotName *string) {
+	snapshotNames := []*string{}
+	err := elastiCacheModel.ElastiCacheAPI.DescribeSnapshotsPagesWithContext(ctx, &elasticache.DescribeSnapshotsInput{
+		ReplicationGroupId: replicationGroupID,
+		SnapshotSource:     aws.String("manual"),
+	}, func(result *elasticache.DescribeSnapshotsOutput, lastPage bool) bool {
+		for _, snapshot := range result.Snapshots {
+			if *snapshot.SnapshotName != *keepSnapshotName && strings.HasPrefix(*snapshot.SnapshotName, replicationGroupSnapshotPrefix+*replicationGroupID+"-") {
+				snapshotNames = append(snapshotNames, snapshot.SnapshotName)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	for _, snapshotName := range snapshotNames {
+		_, err = elastiCacheModel.ElastiCacheAPI.DeleteSnapshotWithContext(ctx, &elasticache.DeleteSnapshotInput{
+			SnapshotName: snapshotName,
+		})
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		log.Printf("Deleted old snapshot %s \n", *snapshotName)
+	}
+}
+
+// restoreSettingsOfReplicationGroup returns the encryption, Multi-AZ, user group and log delivery settings of the given replication group as snapshot tags.
+func restoreSettingsOfReplicationGroup(group *elasticache.ReplicationGroup) map[string]string {
+	tagMap := map[string]string{
+		transitEncryptionTag: strconv.FormatBool(aws.BoolValue(group.TransitEncryptionEnabled)),
+		atRestEncryptionTag:  strconv.FormatBool(aws.BoolValue(group.AtRestEncryptionEnabled)),
+	}
+	if group.MultiAZ != nil {
+		tagMap[multiAZTag] = *group.MultiAZ
+	}
+	if len(group.UserGroupIds) > 0 {
+		tagMap[userGroupIDsTag] = strings.Join(aws.StringValueSlice(group.UserGroupIds), " ")
+	}
+	for _, config := range group.LogDeliveryConfigurations {
+		if aws.StringValue(config.Status) != elasticache.LogDeliveryConfigurationStatusActive || config.DestinationDetails == nil {
+			continue
+		}
+		destination := ""
+		switch {
+		case config.DestinationDetails.CloudWatchLogsDetails != nil:
+			destination = aws.StringValue(config.DestinationDetails.CloudWatchLogsDetails.LogGroup)
+		case config.DestinationDetails.KinesisFirehoseDetails != nil:
+			destination = aws.StringValue(config.DestinationDetails.KinesisFirehoseDetails.DeliveryStream)
+		}
+		tagMap[logDeliveryTagPrefix+*config.LogType] = *config.DestinationType + " " + *config.LogFormat + " " + destination
+	}
+	return tagMap
+}
+
+// applyRestoreSettings sets the settings stored in the given snapshot tags by restoreSettingsOfReplicationGroup on the given input.
+// Settings missing in the tags, because the snapshot was created by an older version, are left to their defaults.
+func applyRestoreSettings(input *elasticache.CreateReplicationGroupInput, tagMap map[string]string) {
+	if value, ok := tagMap[transitEncryptionTag]; ok {
+		input.TransitEncryptionEnabled = aws.Bool(value == "true")
+	}
+	if value, ok := tagMap[atRestEncryptionTag]; ok {
+		input.AtRestEncryptionEnabled = aws.Bool(value == "true")
+	}
+	if value, ok := tagMap[multiAZTag]; ok {
+		input.MultiAZEnabled = aws.Bool(value == elasticache.MultiAZStatusEnabled)
+	}
+	if value, ok := tagMap[userGroupIDsTag]; ok {
+		input.UserGroupIds = aws.StringSlice(strings.Fields(value))
+	}
+	for key, value := range tagMap {
+		if !strings.HasPrefix(key, logDeliveryTagPrefix) {
+			continue
+		}
+		fields := strings.SplitN(value, " ", 3)
+		if len(fields) != 3 {
+			log.Printf("Ignoring invalid log delivery setting %s=%s \n", key, value)
+			continue
+		}
+		config := &elasticache.LogDeliveryConfigurationRequest{
+			LogType:            aws.String(strings.TrimPrefix(key, logDeliveryTagPrefix)),
+			DestinationType:    aws.String(fields[0]),
+			LogFormat:          aws.String(fields[1]),
+			Enabled:            aws.Bool(true),
+			DestinationDetails: &elasticache.DestinationDetails{},
+		}
+		switch fields[0] {
+		case elasticache.DestinationTypeCloudwatchLogs:
+			config.DestinationDetails.CloudWatchLogsDetails = &elasticache.CloudWatchLogsDestinationDetails{LogGroup: aws.String(fields[2])}
+		case elasticache.DestinationTypeKinesisFirehose:
+			config.DestinationDetails.KinesisFirehoseDetails = &elasticache.KinesisFirehoseDestinationDetails{DeliveryStream: aws.String(fields[2])}
+		}
+		input.LogDeliveryConfigurations = append(input.LogDeliveryConfigurations, config)
+	}
+}
+
+// isRestoreSettingTag returns true, if the given tag key is one of the settings stored on a snapshot, which aren't tags of the replication group.
+func isRestoreSettingTag(key string) bool {
+	switch key {
+	case numCacheClustersTag, clusterEnabledTag, securityGroupIDsTag, transitEncryptionTag, atRestEncryptionTag, multiAZTag, userGroupIDsTag:
+		return true
+	}
+	return strings.HasPrefix(key, logDeliveryTagPrefix)
+}
+
+// isAWSTag returns true, if the given tag key is reserved for AWS and can't be copied to another resource.
+func isAWSTag(key string) bool {
+	return strings.HasPrefix(key, awsTagPrefix)
+}
+
+// tagsForResourceLookup returns the function used to get the tags of the ElastiCache resources of the given type (e.g. "elasticache:snapshot") while
+// looking for the resources of the given repository and branch. The tags of all these resources are read at once with the Resource Groups Tagging API, so
+// other resources get no tags. If this lookup isn't possible, the tags of every resource get listed by getTagsForResource instead.
+func (elastiCacheModel *ElastiCacheModel) tagsForResourceLookup(ctx context.Context, resourceType, repository, branch string) func(context.Context, *string) (map[string]string, error) {
+	if elastiCacheModel.ResourceGroupsTaggingAPIAPI == nil {
+		return elastiCacheModel.getTagsForResource
+	}
+	resources, err := getResourceTagsForTags(ctx, elastiCacheModel.ResourceGroupsTaggingAPIAPI, elastiCacheModel.tags, resourceType, repository, branch)
+	if err != nil {
+		log.Printf("Tagging API lookup for %s failed, falling back to listing the tags of every resource \n", resourceType)
+		return elastiCacheModel.getTagsForResource
+	}
+
+	tagMaps := map[string]map[string]string{}
+	for _, resource := range resources {
+		tagMaps[*resource.ARN] = resource.Tags
+	}
+	return func(ctx context.Context, resourceARN *string) (map[string]string, error) {
+		if tagMap, ok := tagMaps[*resourceARN]; ok {
+			return tagMap, nil
+		}
+		return map[string]string{}, nil
+	}
+}
+
+// getTagsForResource returns the tags of the ElastiCache resource matching the given ARN as map.
+func (elastiCacheModel *ElastiCacheModel) getTagsForResource(ctx context.Context, resourceARN *string) (map[string]string, error) {
+	result, err := elastiCacheModel.ElastiCacheAPI.ListTagsForResourceWithContext(ctx, &elasticache.ListTagsForResourceInput{
+		ResourceName: resourceARN,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tagMap := map[string]string{}
+	for _, tag := range result.TagList {
+		tagMap[*tag.Key] = *tag.Value
+	}
+	return tagMap, nil
+}
@@ -0,0 +1,713 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/auto-staging/scheduler/mocks"
+	"github.com/auto-staging/scheduler/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// testSnapshotTagConfig is the tag config of the auto-staging installation with the default exclude and snapshot tags
+var testSnapshotTagConfig = types.TagConfig{
+	RepositoryKey:    "repository",
+	BranchKey:        "branch_raw",
+	ExcludeTagKey:    "auto-staging:schedule",
+	ExcludeTagValue:  "ignore",
+	SnapshotTagKey:   "auto-staging:schedule",
+	SnapshotTagValue: "snapshot",
+}
+
+func TestNewElastiCacheModel(t *testing.T) {
+	svc := new(mocks.ElastiCacheAPI)
+
+	model := NewElastiCacheModel(svc, nil, testSnapshotTagConfig)
+
+	assert.NotEmpty(t, model, "Expected not empty")
+	assert.Equal(t, svc, model.ElastiCacheAPI, "ElastiCache service from model is not matching the one used as parameter")
+	assert.Nil(t, model.ResourceGroupsTaggingAPIAPI, "Expected no tagging service")
+	assert.Equal(t, testSnapshotTagConfig, model.tags, "Tag config from model is not matching the one used as parameter")
+}
+
+// mockDescribeReplicationGroupsPages mocks DescribeReplicationGroupsPages, the given pages are passed one after another to the callback function
+func mockDescribeReplicationGroupsPages(svc *mocks.ElastiCacheAPI, err error, pages ...*elasticache.DescribeReplicationGroupsOutput) {
+	svc.On("DescribeReplicationGroupsPagesWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeReplicationGroupsInput"), mock.AnythingOfType("func(*elasticache.DescribeReplicationGroupsOutput, bool) bool")).Run(func(args mock.Arguments) {
+		if err != nil {
+			return
+		}
+		fn := args.Get(2).(func(*elasticache.DescribeReplicationGroupsOutput, bool) bool)
+		for i := range pages {
+			if !fn(pages[i], i == len(pages)-1) {
+				return
+			}
+		}
+	}).Return(err)
+}
+
+// mockDescribeSnapshotsPages mocks DescribeSnapshotsPages, the given pages are passed one after another to the callback function
+func mockDescribeSnapshotsPages(svc *mocks.ElastiCacheAPI, err error, pages ...*elasticache.DescribeSnapshotsOutput) {
+	svc.On("DescribeSnapshotsPagesWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeSnapshotsInput"), mock.AnythingOfType("func(*elasticache.DescribeSnapshotsOutput, bool) bool")).Run(func(args mock.Arguments) {
+		if err != nil {
+			return
+		}
+		fn := args.Get(2).(func(*elasticache.DescribeSnapshotsOutput, bool) bool)
+		for i := range pages {
+			if !fn(pages[i], i == len(pages)-1) {
+				return
+			}
+		}
+	}).Return(err)
+}
+
+// mockElastiCacheListTagsForResource mocks ListTagsForResource for the resource with the given ARN to return the given tags
+func mockElastiCacheListTagsForResource(svc *mocks.ElastiCacheAPI, resourceARN string, tags map[string]string) {
+	output := &elasticache.TagListMessage{TagList: []*elasticache.Tag{}}
+	for key, value := range tags {
+		output.TagList = append(output.TagList, &elasticache.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+	svc.On("ListTagsForResourceWithContext", mock.Anything, &elasticache.ListTagsForResourceInput{ResourceName: aws.String(resourceARN)}).Return(output, nil)
+}
+
+// newResourceTagMapping returns the Tagging API result of the resource with the given ARN and tags
+func newResourceTagMapping(resourceARN string, tags map[string]string) *resourcegroupstaggingapi.ResourceTagMapping {
+	mapping := &resourcegroupstaggingapi.ResourceTagMapping{ResourceARN: aws.String(resourceARN)}
+	for key, value := range tags {
+		mapping.Tags = append(mapping.Tags, &resourcegroupstaggingapi.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+	return mapping
+}
+
+// newReplicationGroup returns a replication group with the given ID, status and member clusters
+func newReplicationGroup(replicationGroupID, status string, memberClusters ...string) *elasticache.ReplicationGroup {
+	return &elasticache.ReplicationGroup{
+		ARN:                aws.String("arn:aws:elasticache:eu-west-1:123456789012:replicationgroup:" + replicationGroupID),
+		ReplicationGroupId: aws.String(replicationGroupID),
+		Status:             aws.String(status),
+		MemberClusters:     aws.StringSlice(memberClusters),
+		ClusterEnabled:     aws.Bool(false),
+	}
+}
+
+// newReplicationGroupSnapshot returns a snapshot of the replication group with the given ID
+func newReplicationGroupSnapshot(snapshotName, replicationGroupID string) *elasticache.Snapshot {
+	return &elasticache.Snapshot{
+		ARN:                aws.String("arn:aws:elasticache:eu-west-1:123456789012:snapshot:" + snapshotName),
+		SnapshotName:       aws.String(snapshotName),
+		ReplicationGroupId: aws.String(replicationGroupID),
+		SnapshotStatus:     aws.String("available"),
+	}
+}
+
+// tagListToMap converts the given ElastiCache tags to a map
+func tagListToMap(tags []*elasticache.Tag) map[string]string {
+	tagMap := map[string]string{}
+	for _, tag := range tags {
+		tagMap[*tag.Key] = *tag.Value
+	}
+	return tagMap
+}
+
+func TestGetReplicationGroupsForTags(t *testing.T) {
+	svc := new(mocks.ElastiCacheAPI)
+	mockDescribeReplicationGroupsPages(svc, nil, &elasticache.DescribeReplicationGroupsOutput{
+		ReplicationGroups: []*elasticache.ReplicationGroup{
+			newReplicationGroup("demo-app-feat-branch", "available"),
+			newReplicationGroup("demo-app-feat-branch-2", "available"),
+		},
+	}, &elasticache.DescribeReplicationGroupsOutput{
+		ReplicationGroups: []*elasticache.ReplicationGroup{
+			newReplicationGroup("demo-app-shared", "available"),
+			newReplicationGroup("demo-app-other", "available"),
+		},
+	})
+	mockElastiCacheListTagsForResource(svc, "arn:aws:elasticache:eu-west-1:123456789012:replicationgroup:demo-app-feat-branch", map[string]string{"repository": "repo", "branch_raw": "branch", "auto-staging:schedule": "snapshot"})
+	mockElastiCacheListTagsForResource(svc, "arn:aws:elasticache:eu-west-1:123456789012:replicationgroup:demo-app-feat-branch-2", map[string]string{"repository": "repo", "branch_raw": "branch"})
+	mockElastiCacheListTagsForResource(svc, "arn:aws:elasticache:eu-west-1:123456789012:replicationgroup:demo-app-shared", map[string]string{"repository": "repo", "branch_raw": "branch", "auto-staging:schedule": "ignore"})
+	mockElastiCacheListTagsForResource(svc, "arn:aws:elasticache:eu-west-1:123456789012:replicationgroup:demo-app-other", map[string]string{"repository": "repo", "branch_raw": "other", "auto-staging:schedule": "snapshot"})
+
+	model := NewElastiCacheModel(svc, nil, testSnapshotTagConfig)
+	groups, excluded, withoutSnapshotTag, err := model.GetReplicationGroupsForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, groups, 1, "Expected replication groups without snapshot tag to be returned separately")
+	assert.Equal(t, "demo-app-feat-branch", *groups[0].ReplicationGroupId)
+	assert.Len(t, excluded, 1)
+	assert.Equal(t, "demo-app-shared", *excluded[0].ReplicationGroupId)
+	assert.Len(t, withoutSnapshotTag, 1)
+	assert.Equal(t, "demo-app-feat-branch-2", *withoutSnapshotTag[0].ReplicationGroupId)
+}
+
+func TestGetReplicationGroupsForTagsTaggingAPI(t *testing.T) {
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+	mockGetResourcesPages(taggingSvc, nil, &resourcegroupstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+			newResourceTagMapping("arn:aws:elasticache:eu-west-1:123456789012:replicationgroup:demo-app-feat-branch", map[string]string{"repository": "repo", "branch_raw": "branch", "auto-staging:schedule": "snapshot"}),
+			newResourceTagMapping("arn:aws:elasticache:eu-west-1:123456789012:replicationgroup:demo-app-feat-branch-2", map[string]string{"repository": "repo", "branch_raw": "branch"}),
+			newResourceTagMapping("arn:aws:elasticache:eu-west-1:123456789012:replicationgroup:demo-app-shared", map[string]string{"repository": "repo", "branch_raw": "branch", "auto-staging:schedule": "ignore"}),
+		},
+	})
+
+	svc := new(mocks.ElastiCacheAPI)
+	mockDescribeReplicationGroupsPages(svc, nil, &elasticache.DescribeReplicationGroupsOutput{
+		ReplicationGroups: []*elasticache.ReplicationGroup{
+			newReplicationGroup("demo-app-feat-branch", "available"),
+			newReplicationGroup("demo-app-feat-branch-2", "available"),
+			newReplicationGroup("demo-app-shared", "available"),
+			newReplicationGroup("demo-app-other", "available"),
+		},
+	})
+
+	model := NewElastiCacheModel(svc, taggingSvc, testSnapshotTagConfig)
+	groups, excluded, withoutSnapshotTag, err := model.GetReplicationGroupsForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, groups, 1, "Expected replication groups without snapshot tag to be returned separately")
+	assert.Equal(t, "demo-app-feat-branch", *groups[0].ReplicationGroupId)
+	assert.Len(t, excluded, 1)
+	assert.Equal(t, "demo-app-shared", *excluded[0].ReplicationGroupId)
+	assert.Len(t, withoutSnapshotTag, 1)
+	assert.Equal(t, "demo-app-feat-branch-2", *withoutSnapshotTag[0].ReplicationGroupId)
+	svc.AssertNotCalled(t, "ListTagsForResourceWithContext", mock.Anything, mock.Anything)
+	taggingSvc.AssertCalled(t, "GetResourcesPagesWithContext", mock.Anything, mock.MatchedBy(func(input *resourcegroupstaggingapi.GetResourcesInput) bool {
+		return *input.ResourceTypeFilters[0] == "elasticache:replicationgroup"
+	}), mock.Anything)
+}
+
+func TestGetReplicationGroupsForTagsTaggingAPIError(t *testing.T) {
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+	mockGetResourcesPages(taggingSvc, errors.New("Test error"))
+
+	svc := new(mocks.ElastiCacheAPI)
+	mockDescribeReplicationGroupsPages(svc, nil, &elasticache.DescribeReplicationGroupsOutput{
+		ReplicationGroups: []*elasticache.ReplicationGroup{
+			newReplicationGroup("demo-app-feat-branch", "available"),
+		},
+	})
+	mockElastiCacheListTagsForResource(svc, "arn:aws:elasticache:eu-west-1:123456789012:replicationgroup:demo-app-feat-branch", map[string]string{"repository": "repo", "branch_raw": "branch", "auto-staging:schedule": "snapshot"})
+
+	model := NewElastiCacheModel(svc, taggingSvc, testSnapshotTagConfig)
+	groups, _, _, err := model.GetReplicationGroupsForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected the lookup to fall back to listing the tags of every replication group")
+	assert.Len(t, groups, 1)
+}
+
+func TestGetReplicationGroupsForTagsListTagsError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.ElastiCacheAPI)
+	mockDescribeReplicationGroupsPages(svc, nil, &elasticache.DescribeReplicationGroupsOutput{
+		ReplicationGroups: []*elasticache.ReplicationGroup{
+			newReplicationGroup("demo-app-feat-branch", "available"),
+		},
+	})
+	svc.On("ListTagsForResourceWithContext", mock.Anything, mock.AnythingOfType("*elasticache.ListTagsForResourceInput")).Return(nil, errorMsg)
+
+	model := NewElastiCacheModel(svc, nil, testSnapshotTagConfig)
+	groups, _, _, err := model.GetReplicationGroupsForTags(context.Background(), "repo", "branch")
+
+	assert.Equal(t, errorMsg, err)
+	assert.Empty(t, groups)
+}
+
+func TestGetReplicationGroupSnapshotsForTags(t *testing.T) {
+	svc := new(mocks.ElastiCacheAPI)
+	mockDescribeSnapshotsPages(svc, nil, &elasticache.DescribeSnapshotsOutput{
+		Snapshots: []*elasticache.Snapshot{
+			newReplicationGroupSnapshot("auto-staging-demo-app-feat-branch-20261017180000", "demo-app-feat-branch"),
+			newReplicationGroupSnapshot("manual-backup", "demo-app-feat-branch"),
+		},
+	}, &elasticache.DescribeSnapshotsOutput{
+		Snapshots: []*elasticache.Snapshot{
+			newReplicationGroupSnapshot("auto-staging-demo-app-feat-branch-20261016180000", "demo-app-feat-branch"),
+			newReplicationGroupSnapshot("auto-staging-demo-app-other-20261017180000", "demo-app-other"),
+		},
+	})
+	envTags := map[string]string{"repository": "repo", "branch_raw": "branch"}
+	mockElastiCacheListTagsForResource(svc, "arn:aws:elasticache:eu-west-1:123456789012:snapshot:auto-staging-demo-app-feat-branch-20261017180000", envTags)
+	mockElastiCacheListTagsForResource(svc, "arn:aws:elasticache:eu-west-1:123456789012:snapshot:auto-staging-demo-app-feat-branch-20261016180000", envTags)
+	mockElastiCacheListTagsForResource(svc, "arn:aws:elasticache:eu-west-1:123456789012:snapshot:auto-staging-demo-app-other-20261017180000", map[string]string{"repository": "repo", "branch_raw": "other"})
+
+	model := NewElastiCacheModel(svc, nil, testSnapshotTagConfig)
+	snapshots, err := model.GetReplicationGroupSnapshotsForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, snapshots, 1, "Expected only the latest snapshot of the replication group")
+	assert.Equal(t, "auto-staging-demo-app-feat-branch-20261017180000", *snapshots[0].SnapshotName)
+	svc.AssertNotCalled(t, "ListTagsForResourceWithContext", mock.Anything, &elasticache.ListTagsForResourceInput{
+		ResourceName: aws.String("arn:aws:elasticache:eu-west-1:123456789012:snapshot:manual-backup"),
+	})
+}
+
+func TestGetReplicationGroupSnapshotsForTagsTaggingAPI(t *testing.T) {
+	envTags := map[string]string{"repository": "repo", "branch_raw": "branch"}
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+	mockGetResourcesPages(taggingSvc, nil, &resourcegroupstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+			newResourceTagMapping("arn:aws:elasticache:eu-west-1:123456789012:snapshot:auto-staging-demo-app-feat-branch-20261017180000", envTags),
+			newResourceTagMapping("arn:aws:elasticache:eu-west-1:123456789012:snapshot:auto-staging-demo-app-feat-branch-20261016180000", envTags),
+		},
+	})
+
+	svc := new(mocks.ElastiCacheAPI)
+	mockDescribeSnapshotsPages(svc, nil, &elasticache.DescribeSnapshotsOutput{
+		Snapshots: []*elasticache.Snapshot{
+			newReplicationGroupSnapshot("auto-staging-demo-app-feat-branch-20261016180000", "demo-app-feat-branch"),
+			newReplicationGroupSnapshot("auto-staging-demo-app-feat-branch-20261017180000", "demo-app-feat-branch"),
+			newReplicationGroupSnapshot("auto-staging-demo-app-other-20261017180000", "demo-app-other"),
+		},
+	})
+
+	model := NewElastiCacheModel(svc, taggingSvc, testSnapshotTagConfig)
+	snapshots, err := model.GetReplicationGroupSnapshotsForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, snapshots, 1, "Expected only the latest snapshot of the replication group")
+	assert.Equal(t, "auto-staging-demo-app-feat-branch-20261017180000", *snapshots[0].SnapshotName)
+	svc.AssertNotCalled(t, "ListTagsForResourceWithContext", mock.Anything, mock.Anything)
+	taggingSvc.AssertCalled(t, "GetResourcesPagesWithContext", mock.Anything, mock.MatchedBy(func(input *resourcegroupstaggingapi.GetResourcesInput) bool {
+		return *input.ResourceTypeFilters[0] == "elasticache:snapshot"
+	}), mock.Anything)
+}
+
+func TestGetReplicationGroupSnapshotsForTagsDescribeError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.ElastiCacheAPI)
+	mockDescribeSnapshotsPages(svc, errorMsg)
+
+	model := NewElastiCacheModel(svc, nil, testSnapshotTagConfig)
+	_, err := model.GetReplicationGroupSnapshotsForTags(context.Background(), "repo", "branch")
+
+	assert.Equal(t, errorMsg, err)
+}
+
+func TestDeleteReplicationGroupWithSnapshot(t *testing.T) {
+	defer func(previous time.Duration) { elastiCachePollInterval = previous }(elastiCachePollInterval)
+	elastiCachePollInterval = time.Millisecond
+	defer func(previous func() time.Time) { now = previous }(now)
+	now = func() time.Time {
+		return time.Date(2026, 10, 18, 20, 0, 0, 0, time.UTC)
+	}
+
+	groupID := aws.String("demo-app-feat-branch")
+	snapshotName := "auto-staging-demo-app-feat-branch-20261018200000"
+
+	group := newReplicationGroup(*groupID, "available", "demo-app-feat-branch-001", "demo-app-feat-branch-002")
+	group.TransitEncryptionEnabled = aws.Bool(true)
+	group.AtRestEncryptionEnabled = aws.Bool(true)
+	group.MultiAZ = aws.String("enabled")
+	group.UserGroupIds = aws.StringSlice([]string{"demo-app-users"})
+	group.LogDeliveryConfigurations = []*elasticache.LogDeliveryConfiguration{
+		{
+			LogType:            aws.String("slow-log"),
+			DestinationType:    aws.String("cloudwatch-logs"),
+			LogFormat:          aws.String("json"),
+			Status:             aws.String("active"),
+			DestinationDetails: &elasticache.DestinationDetails{CloudWatchLogsDetails: &elasticache.CloudWatchLogsDestinationDetails{LogGroup: aws.String("/elasticache/demo-app")}},
+		},
+		{
+			LogType:            aws.String("engine-log"),
+			DestinationType:    aws.String("kinesis-firehose"),
+			LogFormat:          aws.String("text"),
+			Status:             aws.String("disabling"),
+			DestinationDetails: &elasticache.DestinationDetails{KinesisFirehoseDetails: &elasticache.KinesisFirehoseDestinationDetails{DeliveryStream: aws.String("demo-app")}},
+		},
+	}
+
+	svc := new(mocks.ElastiCacheAPI)
+	svc.On("DescribeReplicationGroupsWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeReplicationGroupsInput")).Return(&elasticache.DescribeReplicationGroupsOutput{
+		ReplicationGroups: []*elasticache.ReplicationGroup{group},
+	}, nil)
+	mockElastiCacheListTagsForResource(svc, "arn:aws:elasticache:eu-west-1:123456789012:replicationgroup:demo-app-feat-branch", map[string]string{
+		"repository":                    "repo",
+		"branch_raw":                    "branch",
+		"auto-staging:schedule":         "snapshot",
+		"aws:cloudformation:stack-name": "demo-app",
+	})
+	svc.On("DescribeCacheClustersWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeCacheClustersInput")).Return(&elasticache.DescribeCacheClustersOutput{
+		CacheClusters: []*elasticache.CacheCluster{
+			{SecurityGroups: []*elasticache.SecurityGroupMembership{
+				{SecurityGroupId: aws.String("sg-1")},
+				{SecurityGroupId: aws.String("sg-2")},
+			}},
+		},
+	}, nil)
+	svc.On("CreateSnapshotWithContext", mock.Anything, mock.AnythingOfType("*elasticache.CreateSnapshotInput")).Return(&elasticache.CreateSnapshotOutput{}, nil)
+	creating := newReplicationGroupSnapshot(snapshotName, *groupID)
+	creating.SnapshotStatus = aws.String("creating")
+	svc.On("DescribeSnapshotsWithContext", mock.Anything, &elasticache.DescribeSnapshotsInput{SnapshotName: aws.String(snapshotName)}).Return(&elasticache.DescribeSnapshotsOutput{
+		Snapshots: []*elasticache.Snapshot{creating},
+	}, nil).Once()
+	svc.On("DescribeSnapshotsWithContext", mock.Anything, &elasticache.DescribeSnapshotsInput{SnapshotName: aws.String(snapshotName)}).Return(&elasticache.DescribeSnapshotsOutput{
+		Snapshots: []*elasticache.Snapshot{newReplicationGroupSnapshot(snapshotName, *groupID)},
+	}, nil)
+	svc.On("DeleteReplicationGroupWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DeleteReplicationGroupInput")).Return(&elasticache.DeleteReplicationGroupOutput{}, nil)
+	mockDescribeSnapshotsPages(svc, nil, &elasticache.DescribeSnapshotsOutput{
+		Snapshots: []*elasticache.Snapshot{
+			newReplicationGroupSnapshot("auto-staging-demo-app-feat-branch-20261017200000", *groupID),
+			newReplicationGroupSnapshot(snapshotName, *groupID),
+			newReplicationGroupSnapshot("manual-backup", *groupID),
+		},
+	})
+	svc.On("DeleteSnapshotWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DeleteSnapshotInput")).Return(&elasticache.DeleteSnapshotOutput{}, nil)
+
+	model := NewElastiCacheModel(svc, nil, testSnapshotTagConfig)
+	name, err := model.DeleteReplicationGroupWithSnapshot(context.Background(), groupID)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, snapshotName, *name)
+	svc.AssertCalled(t, "DescribeCacheClustersWithContext", mock.Anything, &elasticache.DescribeCacheClustersInput{
+		CacheClusterId: aws.String("demo-app-feat-branch-001"),
+	})
+	svc.AssertNumberOfCalls(t, "DescribeSnapshotsWithContext", 2)
+	svc.AssertCalled(t, "DeleteReplicationGroupWithContext", mock.Anything, &elasticache.DeleteReplicationGroupInput{
+		ReplicationGroupId: groupID,
+	})
+
+	var createSnapshotInput *elasticache.CreateSnapshotInput
+	calls := []string{}
+	for _, call := range svc.Calls {
+		calls = append(calls, call.Method)
+		if call.Method == "CreateSnapshotWithContext" {
+			createSnapshotInput = call.Arguments.Get(1).(*elasticache.CreateSnapshotInput)
+		}
+	}
+	assert.Equal(t, []string{
+		"DescribeReplicationGroupsWithContext",
+		"ListTagsForResourceWithContext",
+		"DescribeCacheClustersWithContext",
+		"CreateSnapshotWithContext",
+		"DescribeSnapshotsWithContext",
+		"DescribeSnapshotsWithContext",
+		"DeleteReplicationGroupWithContext",
+		"DescribeSnapshotsPagesWithContext",
+		"DeleteSnapshotWithContext",
+	}, calls, "Expected the replication group to be deleted after the snapshot is available and the old snapshots afterwards")
+	assert.Equal(t, *groupID, *createSnapshotInput.ReplicationGroupId)
+	assert.Equal(t, snapshotName, *createSnapshotInput.SnapshotName)
+	assert.Equal(t, map[string]string{
+		"repository":                        "repo",
+		"branch_raw":                        "branch",
+		"auto-staging:schedule":             "snapshot",
+		"auto-staging:numCacheClusters":     "2",
+		"auto-staging:clusterEnabled":       "false",
+		"auto-staging:securityGroupIds":     "sg-1 sg-2",
+		"auto-staging:transitEncryption":    "true",
+		"auto-staging:atRestEncryption":     "true",
+		"auto-staging:multiAZ":              "enabled",
+		"auto-staging:userGroupIds":         "demo-app-users",
+		"auto-staging:logDelivery:slow-log": "cloudwatch-logs json /elasticache/demo-app",
+	}, tagListToMap(createSnapshotInput.Tags), "Expected the tags reserved for AWS not to be copied to the snapshot")
+
+	svc.AssertNumberOfCalls(t, "DeleteSnapshotWithContext", 1)
+	svc.AssertCalled(t, "DeleteSnapshotWithContext", mock.Anything, &elasticache.DeleteSnapshotInput{
+		SnapshotName: aws.String("auto-staging-demo-app-feat-branch-20261017200000"),
+	})
+}
+
+func TestDeleteReplicationGroupWithSnapshotDeleteError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.ElastiCacheAPI)
+	svc.On("DescribeReplicationGroupsWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeReplicationGroupsInput")).Return(&elasticache.DescribeReplicationGroupsOutput{
+		ReplicationGroups: []*elasticache.ReplicationGroup{
+			newReplicationGroup("demo-app-feat-branch", "available", "demo-app-feat-branch-001"),
+		},
+	}, nil)
+	mockElastiCacheListTagsForResource(svc, "arn:aws:elasticache:eu-west-1:123456789012:replicationgroup:demo-app-feat-branch", map[string]string{})
+	svc.On("DescribeCacheClustersWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeCacheClustersInput")).Return(&elasticache.DescribeCacheClustersOutput{}, nil)
+	svc.On("CreateSnapshotWithContext", mock.Anything, mock.AnythingOfType("*elasticache.CreateSnapshotInput")).Return(&elasticache.CreateSnapshotOutput{}, nil)
+	svc.On("DescribeSnapshotsWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeSnapshotsInput")).Return(&elasticache.DescribeSnapshotsOutput{
+		Snapshots: []*elasticache.Snapshot{newReplicationGroupSnapshot("auto-staging-demo-app-feat-branch-20261018200000", "demo-app-feat-branch")},
+	}, nil)
+	svc.On("DeleteReplicationGroupWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DeleteReplicationGroupInput")).Return(nil, errorMsg)
+
+	model := NewElastiCacheModel(svc, nil, testSnapshotTagConfig)
+	_, err := model.DeleteReplicationGroupWithSnapshot(context.Background(), aws.String("demo-app-feat-branch"))
+
+	assert.Equal(t, errorMsg, err)
+	svc.AssertNotCalled(t, "DescribeSnapshotsPagesWithContext", mock.Anything, mock.Anything, mock.Anything)
+	svc.AssertNotCalled(t, "DeleteSnapshotWithContext", mock.Anything, mock.Anything)
+}
+
+func TestDeleteReplicationGroupWithSnapshotCreateSnapshotError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.ElastiCacheAPI)
+	svc.On("DescribeReplicationGroupsWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeReplicationGroupsInput")).Return(&elasticache.DescribeReplicationGroupsOutput{
+		ReplicationGroups: []*elasticache.ReplicationGroup{
+			newReplicationGroup("demo-app-feat-branch", "available", "demo-app-feat-branch-001"),
+		},
+	}, nil)
+	mockElastiCacheListTagsForResource(svc, "arn:aws:elasticache:eu-west-1:123456789012:replicationgroup:demo-app-feat-branch", map[string]string{})
+	svc.On("DescribeCacheClustersWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeCacheClustersInput")).Return(&elasticache.DescribeCacheClustersOutput{}, nil)
+	svc.On("CreateSnapshotWithContext", mock.Anything, mock.AnythingOfType("*elasticache.CreateSnapshotInput")).Return(nil, errorMsg)
+
+	model := NewElastiCacheModel(svc, nil, testSnapshotTagConfig)
+	_, err := model.DeleteReplicationGroupWithSnapshot(context.Background(), aws.String("demo-app-feat-branch"))
+
+	assert.Equal(t, errorMsg, err)
+	svc.AssertNotCalled(t, "DeleteReplicationGroupWithContext", mock.Anything, mock.Anything)
+}
+
+func TestDeleteReplicationGroupWithSnapshotFailed(t *testing.T) {
+	snapshotName := "auto-staging-demo-app-feat-branch-20261018200000"
+	failed := newReplicationGroupSnapshot(snapshotName, "demo-app-feat-branch")
+	failed.SnapshotStatus = aws.String("failed")
+
+	svc := new(mocks.ElastiCacheAPI)
+	svc.On("DescribeReplicationGroupsWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeReplicationGroupsInput")).Return(&elasticache.DescribeReplicationGroupsOutput{
+		ReplicationGroups: []*elasticache.ReplicationGroup{
+			newReplicationGroup("demo-app-feat-branch", "available", "demo-app-feat-branch-001"),
+		},
+	}, nil)
+	mockElastiCacheListTagsForResource(svc, "arn:aws:elasticache:eu-west-1:123456789012:replicationgroup:demo-app-feat-branch", map[string]string{})
+	svc.On("DescribeCacheClustersWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeCacheClustersInput")).Return(&elasticache.DescribeCacheClustersOutput{}, nil)
+	svc.On("CreateSnapshotWithContext", mock.Anything, mock.AnythingOfType("*elasticache.CreateSnapshotInput")).Return(&elasticache.CreateSnapshotOutput{}, nil)
+	svc.On("DescribeSnapshotsWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeSnapshotsInput")).Return(&elasticache.DescribeSnapshotsOutput{
+		Snapshots: []*elasticache.Snapshot{failed},
+	}, nil)
+
+	model := NewElastiCacheModel(svc, nil, testSnapshotTagConfig)
+	_, err := model.DeleteReplicationGroupWithSnapshot(context.Background(), aws.String("demo-app-feat-branch"))
+
+	assert.Error(t, err)
+	svc.AssertNotCalled(t, "DeleteReplicationGroupWithContext", mock.Anything, mock.Anything)
+}
+
+func TestDeleteReplicationGroupWithSnapshotWaitStopped(t *testing.T) {
+	defer func(previous time.Duration) { elastiCachePollInterval = previous }(elastiCachePollInterval)
+	elastiCachePollInterval = time.Millisecond
+
+	creating := newReplicationGroupSnapshot("auto-staging-demo-app-feat-branch-20261018200000", "demo-app-feat-branch")
+	creating.SnapshotStatus = aws.String("creating")
+
+	svc := new(mocks.ElastiCacheAPI)
+	svc.On("DescribeReplicationGroupsWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeReplicationGroupsInput")).Return(&elasticache.DescribeReplicationGroupsOutput{
+		ReplicationGroups: []*elasticache.ReplicationGroup{
+			newReplicationGroup("demo-app-feat-branch", "available", "demo-app-feat-branch-001"),
+		},
+	}, nil)
+	mockElastiCacheListTagsForResource(svc, "arn:aws:elasticache:eu-west-1:123456789012:replicationgroup:demo-app-feat-branch", map[string]string{})
+	svc.On("DescribeCacheClustersWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeCacheClustersInput")).Return(&elasticache.DescribeCacheClustersOutput{}, nil)
+	svc.On("CreateSnapshotWithContext", mock.Anything, mock.AnythingOfType("*elasticache.CreateSnapshotInput")).Return(&elasticache.CreateSnapshotOutput{}, nil)
+	svc.On("DescribeSnapshotsWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeSnapshotsInput")).Return(&elasticache.DescribeSnapshotsOutput{
+		Snapshots: []*elasticache.Snapshot{creating},
+	}, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	model := NewElastiCacheModel(svc, nil, testSnapshotTagConfig)
+	_, err := model.DeleteReplicationGroupWithSnapshot(ctx, aws.String("demo-app-feat-branch"))
+
+	assert.Equal(t, context.DeadlineExceeded, err)
+	svc.AssertNotCalled(t, "DeleteReplicationGroupWithContext", mock.Anything, mock.Anything)
+}
+
+func TestDeleteReplicationGroupWithSnapshotAuthToken(t *testing.T) {
+	group := newReplicationGroup("demo-app-feat-branch", "available", "demo-app-feat-branch-001")
+	group.AuthTokenEnabled = aws.Bool(true)
+
+	svc := new(mocks.ElastiCacheAPI)
+	svc.On("DescribeReplicationGroupsWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeReplicationGroupsInput")).Return(&elasticache.DescribeReplicationGroupsOutput{
+		ReplicationGroups: []*elasticache.ReplicationGroup{group},
+	}, nil)
+
+	model := NewElastiCacheModel(svc, nil, testSnapshotTagConfig)
+	_, err := model.DeleteReplicationGroupWithSnapshot(context.Background(), aws.String("demo-app-feat-branch"))
+
+	assert.EqualError(t, err, "replication group demo-app-feat-branch uses an AUTH token and can't be restored from a snapshot")
+	svc.AssertNotCalled(t, "CreateSnapshotWithContext", mock.Anything, mock.Anything)
+	svc.AssertNotCalled(t, "DeleteReplicationGroupWithContext", mock.Anything, mock.Anything)
+}
+
+func TestIsReplicationGroupRestorable(t *testing.T) {
+	assert.True(t, IsReplicationGroupRestorable(&elasticache.ReplicationGroup{}))
+	assert.True(t, IsReplicationGroupRestorable(&elasticache.ReplicationGroup{AuthTokenEnabled: aws.Bool(false)}))
+	assert.False(t, IsReplicationGroupRestorable(&elasticache.ReplicationGroup{AuthTokenEnabled: aws.Bool(true)}))
+}
+
+func TestDeleteReplicationGroupWithSnapshotNotFound(t *testing.T) {
+	svc := new(mocks.ElastiCacheAPI)
+	svc.On("DescribeReplicationGroupsWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeReplicationGroupsInput")).Return(&elasticache.DescribeReplicationGroupsOutput{}, nil)
+
+	model := NewElastiCacheModel(svc, nil, testSnapshotTagConfig)
+	_, err := model.DeleteReplicationGroupWithSnapshot(context.Background(), aws.String("demo-app-feat-branch"))
+
+	assert.EqualError(t, err, "found no replication group for demo-app-feat-branch")
+	svc.AssertNotCalled(t, "DeleteReplicationGroupWithContext", mock.Anything, mock.Anything)
+}
+
+func TestRestoreReplicationGroupFromSnapshot(t *testing.T) {
+	snapshotName := aws.String("auto-staging-demo-app-feat-branch-20261018200000")
+	snapshot := newReplicationGroupSnapshot(*snapshotName, "demo-app-feat-branch")
+	snapshot.ReplicationGroupDescription = aws.String("Redis of demo-app")
+	snapshot.Engine = aws.String("redis")
+	snapshot.CacheNodeType = aws.String("cache.t3.micro")
+	snapshot.CacheSubnetGroupName = aws.String("staging")
+	snapshot.AutomaticFailover = aws.String("enabled")
+	snapshot.NumNodeGroups = aws.Int64(1)
+
+	svc := new(mocks.ElastiCacheAPI)
+	svc.On("DescribeSnapshotsWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeSnapshotsInput")).Return(&elasticache.DescribeSnapshotsOutput{
+		Snapshots: []*elasticache.Snapshot{snapshot},
+	}, nil)
+	mockElastiCacheListTagsForResource(svc, *snapshot.ARN, map[string]string{
+		"repository":                    "repo",
+		"aws:cloudformation:stack-name": "demo-app",
+		"auto-staging:numCacheClusters": "2",
+		"auto-staging:clusterEnabled":   "false",
+		"auto-staging:securityGroupIds": "sg-1 sg-2",
+	})
+	svc.On("CreateReplicationGroupWithContext", mock.Anything, mock.AnythingOfType("*elasticache.CreateReplicationGroupInput")).Return(&elasticache.CreateReplicationGroupOutput{}, nil)
+
+	model := NewElastiCacheModel(svc, nil, testSnapshotTagConfig)
+	err := model.RestoreReplicationGroupFromSnapshot(context.Background(), snapshotName)
+
+	assert.Nil(t, err, "Expected no error")
+	svc.AssertCalled(t, "CreateReplicationGroupWithContext", mock.Anything, &elasticache.CreateReplicationGroupInput{
+		ReplicationGroupId:          aws.String("demo-app-feat-branch"),
+		ReplicationGroupDescription: aws.String("Redis of demo-app"),
+		SnapshotName:                snapshotName,
+		Engine:                      aws.String("redis"),
+		CacheNodeType:               aws.String("cache.t3.micro"),
+		CacheSubnetGroupName:        aws.String("staging"),
+		AutomaticFailoverEnabled:    aws.Bool(true),
+		SecurityGroupIds:            aws.StringSlice([]string{"sg-1", "sg-2"}),
+		NumCacheClusters:            aws.Int64(2),
+		Tags: []*elasticache.Tag{
+			{Key: aws.String("repository"), Value: aws.String("repo")},
+		},
+	})
+}
+
+func TestRestoreReplicationGroupFromSnapshotClusterEnabled(t *testing.T) {
+	snapshotName := aws.String("auto-staging-demo-app-feat-branch-20261018200000")
+	snapshot := newReplicationGroupSnapshot(*snapshotName, "demo-app-feat-branch")
+	snapshot.NumNodeGroups = aws.Int64(3)
+
+	svc := new(mocks.ElastiCacheAPI)
+	svc.On("DescribeSnapshotsWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeSnapshotsInput")).Return(&elasticache.DescribeSnapshotsOutput{
+		Snapshots: []*elasticache.Snapshot{snapshot},
+	}, nil)
+	mockElastiCacheListTagsForResource(svc, *snapshot.ARN, map[string]string{
+		"auto-staging:numCacheClusters": "6",
+		"auto-staging:clusterEnabled":   "true",
+	})
+	svc.On("CreateReplicationGroupWithContext", mock.Anything, mock.AnythingOfType("*elasticache.CreateReplicationGroupInput")).Return(&elasticache.CreateReplicationGroupOutput{}, nil)
+
+	model := NewElastiCacheModel(svc, nil, testSnapshotTagConfig)
+	err := model.RestoreReplicationGroupFromSnapshot(context.Background(), snapshotName)
+
+	assert.Nil(t, err, "Expected no error")
+	input := svc.Calls[len(svc.Calls)-1].Arguments.Get(1).(*elasticache.CreateReplicationGroupInput)
+	assert.Equal(t, int64(3), *input.NumNodeGroups)
+	assert.Equal(t, int64(1), *input.ReplicasPerNodeGroup)
+	assert.Nil(t, input.NumCacheClusters)
+	assert.Equal(t, "demo-app-feat-branch", *input.ReplicationGroupDescription, "Expected the ID as description, if the snapshot has none")
+	assert.Empty(t, input.Tags)
+}
+
+func TestRestoreReplicationGroupFromSnapshotSettings(t *testing.T) {
+	snapshotName := aws.String("auto-staging-demo-app-feat-branch-20261018200000")
+	snapshot := newReplicationGroupSnapshot(*snapshotName, "demo-app-feat-branch")
+
+	svc := new(mocks.ElastiCacheAPI)
+	svc.On("DescribeSnapshotsWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeSnapshotsInput")).Return(&elasticache.DescribeSnapshotsOutput{
+		Snapshots: []*elasticache.Snapshot{snapshot},
+	}, nil)
+	mockElastiCacheListTagsForResource(svc, *snapshot.ARN, map[string]string{
+		"repository":                          "repo",
+		"auto-staging:numCacheClusters":       "2",
+		"auto-staging:clusterEnabled":         "false",
+		"auto-staging:transitEncryption":      "true",
+		"auto-staging:atRestEncryption":       "false",
+		"auto-staging:multiAZ":                "enabled",
+		"auto-staging:userGroupIds":           "demo-app-users admins",
+		"auto-staging:logDelivery:slow-log":   "cloudwatch-logs json /elasticache/demo-app",
+		"auto-staging:logDelivery:engine-log": "kinesis-firehose text demo-app",
+	})
+	svc.On("CreateReplicationGroupWithContext", mock.Anything, mock.AnythingOfType("*elasticache.CreateReplicationGroupInput")).Return(&elasticache.CreateReplicationGroupOutput{}, nil)
+
+	model := NewElastiCacheModel(svc, nil, testSnapshotTagConfig)
+	err := model.RestoreReplicationGroupFromSnapshot(context.Background(), snapshotName)
+
+	assert.Nil(t, err, "Expected no error")
+	input := svc.Calls[len(svc.Calls)-1].Arguments.Get(1).(*elasticache.CreateReplicationGroupInput)
+	assert.Equal(t, aws.Bool(true), input.TransitEncryptionEnabled)
+	assert.Equal(t, aws.Bool(false), input.AtRestEncryptionEnabled)
+	assert.Equal(t, aws.Bool(true), input.MultiAZEnabled)
+	assert.Equal(t, aws.StringSlice([]string{"demo-app-users", "admins"}), input.UserGroupIds)
+	assert.ElementsMatch(t, []*elasticache.LogDeliveryConfigurationRequest{
+		{
+			LogType:            aws.String("slow-log"),
+			DestinationType:    aws.String("cloudwatch-logs"),
+			LogFormat:          aws.String("json"),
+			Enabled:            aws.Bool(true),
+			DestinationDetails: &elasticache.DestinationDetails{CloudWatchLogsDetails: &elasticache.CloudWatchLogsDestinationDetails{LogGroup: aws.String("/elasticache/demo-app")}},
+		},
+		{
+			LogType:            aws.String("engine-log"),
+			DestinationType:    aws.String("kinesis-firehose"),
+			LogFormat:          aws.String("text"),
+			Enabled:            aws.Bool(true),
+			DestinationDetails: &elasticache.DestinationDetails{KinesisFirehoseDetails: &elasticache.KinesisFirehoseDestinationDetails{DeliveryStream: aws.String("demo-app")}},
+		},
+	}, input.LogDeliveryConfigurations)
+	assert.Equal(t, []*elasticache.Tag{
+		{Key: aws.String("repository"), Value: aws.String("repo")},
+	}, input.Tags)
+}
+
+func TestRestoreReplicationGroupFromSnapshotMissingSettings(t *testing.T) {
+	snapshotName := aws.String("auto-staging-demo-app-feat-branch-20261018200000")
+	snapshot := newReplicationGroupSnapshot(*snapshotName, "demo-app-feat-branch")
+
+	svc := new(mocks.ElastiCacheAPI)
+	svc.On("DescribeSnapshotsWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeSnapshotsInput")).Return(&elasticache.DescribeSnapshotsOutput{
+		Snapshots: []*elasticache.Snapshot{snapshot},
+	}, nil)
+	mockElastiCacheListTagsForResource(svc, *snapshot.ARN, map[string]string{"repository": "repo"})
+
+	model := NewElastiCacheModel(svc, nil, testSnapshotTagConfig)
+	err := model.RestoreReplicationGroupFromSnapshot(context.Background(), snapshotName)
+
+	assert.EqualError(t, err, "found no restore settings for snapshot "+*snapshotName)
+	svc.AssertNotCalled(t, "CreateReplicationGroupWithContext", mock.Anything, mock.Anything)
+}
+
+func TestWaitUntilReplicationGroupDeleted(t *testing.T) {
+	groupID := aws.String("demo-app-feat-branch")
+
+	svc := new(mocks.ElastiCacheAPI)
+	svc.On("WaitUntilReplicationGroupDeletedWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeReplicationGroupsInput")).Return(nil)
+
+	model := NewElastiCacheModel(svc, nil, testSnapshotTagConfig)
+	err := model.WaitUntilReplicationGroupDeleted(context.Background(), groupID)
+
+	assert.Nil(t, err, "Expected no error")
+	svc.AssertCalled(t, "WaitUntilReplicationGroupDeletedWithContext", mock.Anything, &elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: groupID,
+	})
+}
+
+func TestWaitUntilReplicationGroupAvailableError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.ElastiCacheAPI)
+	svc.On("WaitUntilReplicationGroupAvailableWithContext", mock.Anything, mock.AnythingOfType("*elasticache.DescribeReplicationGroupsInput")).Return(errorMsg)
+
+	model := NewElastiCacheModel(svc, nil, testSnapshotTagConfig)
+	err := model.WaitUntilReplicationGroupAvailable(context.Background(), aws.String("demo-app-feat-branch"))
+
+	assert.Equal(t, errorMsg, err)
+}
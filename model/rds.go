@@ -1,71 +1,112 @@
 package model
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"github.com/auto-staging/scheduler/types"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
 )
 
 // RDSModelAPI is an interface including all RDS model functions
 type RDSModelAPI interface {
-	GetRDSClusterForTags(repository, branch string) (*string, *string, error)
-	StopRDSCluster(clusterARN, clusterStatus *string) (bool, error)
-	StartRDSCluster(clusterARN, clusterStatus *string) (bool, error)
+	GetRDSClustersForTags(ctx context.Context, repository, branch string) ([]*rds.DBCluster, []*rds.DBCluster, error)
+	StopRDSCluster(ctx context.Context, clusterARN, clusterStatus *string) (bool, error)
+	StartRDSCluster(ctx context.Context, clusterARN, clusterStatus *string) (bool, error)
+	GetRDSInstancesForTags(ctx context.Context, repository, branch string) ([]*rds.DBInstance, []*rds.DBInstance, error)
+	StopRDSInstance(ctx context.Context, instanceIdentifier, instanceStatus *string) (bool, error)
+	StartRDSInstance(ctx context.Context, instanceIdentifier, instanceStatus *string) (bool, error)
+	WaitUntilRDSClusterAvailable(ctx context.Context, clusterARN *string) error
+	WaitUntilRDSInstanceAvailable(ctx context.Context, instanceIdentifier *string) error
+	WaitUntilRDSClusterStopped(ctx context.Context, clusterARN *string) error
+	WaitUntilRDSInstanceStopped(ctx context.Context, instanceIdentifier *string) error
 }
 
-// RDSModel is a struct including the AWS SDK RDS interface, all RDS model functions are called on this struct and the included AWS SDK RDS service
+// rdsPollInterval is the time between two status checks while waiting for a Cluster or Instance
+var rdsPollInterval = 15 * time.Second
+
+// RDSModel is a struct including the AWS SDK RDS and Resource Groups Tagging interfaces, all RDS model functions are called on this struct and the included AWS SDK services.
+// The Resource Groups Tagging service is used to find Clusters and Instances by the tags configured in the TagConfig, if it is not set or the lookup fails,
+// the tag lists of every Cluster and Instance are checked instead.
 type RDSModel struct {
 	rdsiface.RDSAPI
+	resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+	tags types.TagConfig
 }
 
-// NewRDSModel takes the AWS SDK RDS Interface as parameter and returns the pointer to an RDSModel struct, on which all RDS model functions can be called
-func NewRDSModel(svc rdsiface.RDSAPI) *RDSModel {
+// NewRDSModel takes the AWS SDK RDS and Resource Groups Tagging Interfaces and the tag config as parameter and returns the pointer to an RDSModel struct, on which all RDS model functions can be called
+func NewRDSModel(svc rdsiface.RDSAPI, taggingSvc resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI, tags types.TagConfig) *RDSModel {
 	return &RDSModel{
-		RDSAPI: svc,
+		RDSAPI:                      svc,
+		ResourceGroupsTaggingAPIAPI: taggingSvc,
+		tags:                        tags,
 	}
 }
 
-// GetRDSClusterForTags returns the ARN and the status of the Cluster found for the given repository and branch tag values.
+// GetRDSClustersForTags returns all Clusters found for the given repository and branch tag values. The Clusters are looked up with the Resource Groups Tagging API,
+// if this lookup isn't possible the tag lists of all Clusters get checked instead. Clusters with the exclude tag are returned separately as second value
+// and must not be started or stopped. DocumentDB Clusters are skipped, they are changed by the DocDBModel.
 // If an error occurs, the error gets logged and then returned.
-func (rdsmodel *RDSModel) GetRDSClusterForTags(repository, branch string) (*string, *string, error) {
-	result, err := rdsmodel.RDSAPI.DescribeDBClusters(nil)
-	if err != nil {
-		return nil, nil, err
+func (rdsmodel *RDSModel) GetRDSClustersForTags(ctx context.Context, repository, branch string) ([]*rds.DBCluster, []*rds.DBCluster, error) {
+	if rdsmodel.ResourceGroupsTaggingAPIAPI != nil {
+		clusterARNs, excludedARNs, err := getResourceARNsForTags(ctx, rdsmodel.ResourceGroupsTaggingAPIAPI, rdsmodel.tags, "rds:cluster", repository, branch)
+		if err == nil {
+			return rdsmodel.describeDBClusters(ctx, clusterARNs, excludedARNs)
+		}
+		log.Println("Tagging API lookup for RDS Clusters failed, falling back to checking the tags of every Cluster")
 	}
 
-	// Check tags for each Cluster
-	for i := range result.DBClusters {
-		clusterARN := result.DBClusters[i].DBClusterArn
-		clusterStatus := result.DBClusters[i].Status
+	return rdsmodel.listRDSClustersForTags(ctx, repository, branch)
+}
 
-		// Get tags for Cluster
-		result, err := rdsmodel.RDSAPI.ListTagsForResource(&rds.ListTagsForResourceInput{
-			ResourceName: clusterARN,
-		})
-		if err != nil {
-			return nil, nil, err
-		}
-		tagMap := map[string]string{}
-		for a := range result.TagList {
-			tagMap[*result.TagList[a].Key] = *result.TagList[a].Value
-		}
+// listRDSClustersForTags returns all Clusters and the excluded Clusters found for the given repository and branch tag values by checking the tag list
+// returned with every Cluster.
+// All result pages of the DescribeDBClusters call are processed.
+func (rdsmodel *RDSModel) listRDSClustersForTags(ctx context.Context, repository, branch string) ([]*rds.DBCluster, []*rds.DBCluster, error) {
+	clusters := []*rds.DBCluster{}
+	excluded := []*rds.DBCluster{}
+	err := rdsmodel.RDSAPI.DescribeDBClustersPagesWithContext(ctx, &rds.DescribeDBClustersInput{}, func(result *rds.DescribeDBClustersOutput, lastPage bool) bool {
+		// Check tags for each Cluster
+		for _, cluster := range result.DBClusters {
+			if aws.StringValue(cluster.Engine) == docDBEngine {
+				continue
+			}
 
-		if tagMap["repository"] == repository && tagMap["branch_raw"] == branch {
-			log.Printf("Found cluster %s matching the tags with status %s \n", *clusterARN, *clusterStatus)
-			return clusterARN, clusterStatus, nil
+			tagMap := rdsTagMap(cluster.TagList)
+			if !rdsmodel.tags.MatchesEnvironment(tagMap, repository, branch) {
+				continue
+			}
+			if rdsmodel.tags.IsExcluded(tagMap) {
+				log.Printf("Cluster %s is excluded by tag \n", *cluster.DBClusterArn)
+				excluded = append(excluded, cluster)
+				continue
+			}
+			log.Printf("Found cluster %s matching the tags with status %s \n", *cluster.DBClusterArn, *cluster.Status)
+			clusters = append(clusters, cluster)
 		}
+		return true
+	})
+	if err != nil {
+		log.Println(err)
+		return []*rds.DBCluster{}, []*rds.DBCluster{}, err
+	}
+
+	if len(clusters) == 0 {
+		log.Println("Found no matching RDS Cluster")
 	}
-	log.Println("Found no matching RDS Cluster")
-	return nil, nil, nil
+	return clusters, excluded, nil
 }
 
 // StopRDSCluster stops the RDS Cluster for the given Cluster ARN and status. It returns true, if the state of the Cluster was changed and false if not.
 // If an error occurs, the error gets logged and then returned.
-func (rdsmodel *RDSModel) StopRDSCluster(clusterARN, clusterStatus *string) (bool, error) {
-	if *clusterStatus == "available" {
+func (rdsmodel *RDSModel) StopRDSCluster(ctx context.Context, clusterARN, clusterStatus *string) (bool, error) {
+	if IsRDSActionRequired("stop", *clusterStatus) {
 		log.Println("Stopping RDS CLUSTER")
-		_, err := rdsmodel.RDSAPI.StopDBCluster(&rds.StopDBClusterInput{
+		_, err := rdsmodel.RDSAPI.StopDBClusterWithContext(ctx, &rds.StopDBClusterInput{
 			DBClusterIdentifier: clusterARN,
 		})
 		if err != nil {
@@ -80,10 +121,10 @@ func (rdsmodel *RDSModel) StopRDSCluster(clusterARN, clusterStatus *string) (boo
 
 // StartRDSCluster starts the RDS Cluster for the given Cluster ARN and status. It returns true, if the state of the Cluster was changed and false if not.
 // If an error occurs, the error gets logged and then returned.
-func (rdsmodel *RDSModel) StartRDSCluster(clusterARN, clusterStatus *string) (bool, error) {
-	if *clusterStatus == "stopped" {
+func (rdsmodel *RDSModel) StartRDSCluster(ctx context.Context, clusterARN, clusterStatus *string) (bool, error) {
+	if IsRDSActionRequired("start", *clusterStatus) {
 		log.Println("Starting RDS CLUSTER")
-		_, err := rdsmodel.RDSAPI.StartDBCluster(&rds.StartDBClusterInput{
+		_, err := rdsmodel.RDSAPI.StartDBClusterWithContext(ctx, &rds.StartDBClusterInput{
 			DBClusterIdentifier: clusterARN,
 		})
 		if err != nil {
@@ -95,3 +136,262 @@ func (rdsmodel *RDSModel) StartRDSCluster(clusterARN, clusterStatus *string) (bo
 	log.Println("RDS - No action required")
 	return false, nil
 }
+
+// GetRDSInstancesForTags returns all DB Instances found for the given repository and branch tag values. Instances which are members of a Cluster are skipped,
+// since they are started and stopped together with their Cluster. The Instances are looked up with the Resource Groups Tagging API,
+// if this lookup isn't possible the tags of all Instances get listed instead. Instances with the exclude tag are returned separately as second value
+// and must not be started or stopped.
+// If an error occurs, the error gets logged and then returned.
+func (rdsmodel *RDSModel) GetRDSInstancesForTags(ctx context.Context, repository, branch string) ([]*rds.DBInstance, []*rds.DBInstance, error) {
+	if rdsmodel.ResourceGroupsTaggingAPIAPI != nil {
+		instanceARNs, excludedARNs, err := getResourceARNsForTags(ctx, rdsmodel.ResourceGroupsTaggingAPIAPI, rdsmodel.tags, "rds:db", repository, branch)
+		if err == nil {
+			return rdsmodel.describeDBInstances(ctx, instanceARNs, excludedARNs)
+		}
+		log.Println("Tagging API lookup for RDS Instances failed, falling back to checking the tags of every Instance")
+	}
+
+	return rdsmodel.listRDSInstancesForTags(ctx, repository, branch)
+}
+
+// listRDSInstancesForTags returns all DB Instances and the excluded DB Instances, which are no Cluster members, found for the given repository and branch tag values
+// by checking the tag list returned with every Instance.
+// All result pages of the DescribeDBInstances call are processed.
+func (rdsmodel *RDSModel) listRDSInstancesForTags(ctx context.Context, repository, branch string) ([]*rds.DBInstance, []*rds.DBInstance, error) {
+	instances := []*rds.DBInstance{}
+	excluded := []*rds.DBInstance{}
+	err := rdsmodel.RDSAPI.DescribeDBInstancesPagesWithContext(ctx, &rds.DescribeDBInstancesInput{}, func(result *rds.DescribeDBInstancesOutput, lastPage bool) bool {
+		for _, instance := range result.DBInstances {
+			if instance.DBClusterIdentifier != nil {
+				continue
+			}
+
+			tagMap := rdsTagMap(instance.TagList)
+			if !rdsmodel.tags.MatchesEnvironment(tagMap, repository, branch) {
+				continue
+			}
+			if rdsmodel.tags.IsExcluded(tagMap) {
+				log.Printf("Instance %s is excluded by tag \n", *instance.DBInstanceIdentifier)
+				excluded = append(excluded, instance)
+				continue
+			}
+			log.Printf("Found instance %s matching the tags with status %s \n", *instance.DBInstanceIdentifier, *instance.DBInstanceStatus)
+			instances = append(instances, instance)
+		}
+		return true
+	})
+	if err != nil {
+		log.Println(err)
+		return []*rds.DBInstance{}, []*rds.DBInstance{}, err
+	}
+
+	return instances, excluded, nil
+}
+
+// StopRDSInstance stops the DB Instance for the given identifier and status. It returns true, if the state of the Instance was changed and false if not.
+// If an error occurs, the error gets logged and then returned.
+func (rdsmodel *RDSModel) StopRDSInstance(ctx context.Context, instanceIdentifier, instanceStatus *string) (bool, error) {
+	if IsRDSActionRequired("stop", *instanceStatus) {
+		log.Println("Stopping RDS INSTANCE")
+		_, err := rdsmodel.RDSAPI.StopDBInstanceWithContext(ctx, &rds.StopDBInstanceInput{
+			DBInstanceIdentifier: instanceIdentifier,
+		})
+		if err != nil {
+			log.Println(err)
+			return false, err
+		}
+		return true, nil
+	}
+	log.Println("RDS - No action required")
+	return false, nil
+}
+
+// StartRDSInstance starts the DB Instance for the given identifier and status. It returns true, if the state of the Instance was changed and false if not.
+// If an error occurs, the error gets logged and then returned.
+func (rdsmodel *RDSModel) StartRDSInstance(ctx context.Context, instanceIdentifier, instanceStatus *string) (bool, error) {
+	if IsRDSActionRequired("start", *instanceStatus) {
+		log.Println("Starting RDS INSTANCE")
+		_, err := rdsmodel.RDSAPI.StartDBInstanceWithContext(ctx, &rds.StartDBInstanceInput{
+			DBInstanceIdentifier: instanceIdentifier,
+		})
+		if err != nil {
+			log.Println(err)
+			return false, err
+		}
+		return true, nil
+	}
+	log.Println("RDS - No action required")
+	return false, nil
+}
+
+// WaitUntilRDSClusterAvailable polls the status of the Cluster for the given Cluster ARN until it is available. Waiting is aborted, when the context is done.
+// If an error occurs, the error gets logged and then returned.
+func (rdsmodel *RDSModel) WaitUntilRDSClusterAvailable(ctx context.Context, clusterARN *string) error {
+	return rdsmodel.waitForRDSClusterStatus(ctx, clusterARN, "available")
+}
+
+// WaitUntilRDSClusterStopped polls the status of the Cluster for the given Cluster ARN until it is stopped. Waiting is aborted, when the context is done.
+// If an error occurs, the error gets logged and then returned.
+func (rdsmodel *RDSModel) WaitUntilRDSClusterStopped(ctx context.Context, clusterARN *string) error {
+	return rdsmodel.waitForRDSClusterStatus(ctx, clusterARN, "stopped")
+}
+
+// waitForRDSClusterStatus polls the status of the Cluster for the given Cluster ARN every rdsPollInterval until it matches the given status.
+func (rdsmodel *RDSModel) waitForRDSClusterStatus(ctx context.Context, clusterARN *string, status string) error {
+	for {
+		result, err := rdsmodel.RDSAPI.DescribeDBClustersWithContext(ctx, &rds.DescribeDBClustersInput{
+			DBClusterIdentifier: clusterARN,
+		})
+		if err != nil {
+			log.Println(err)
+			return err
+		}
+		if len(result.DBClusters) > 0 && *result.DBClusters[0].Status == status {
+			log.Printf("RDS cluster %s is %s \n", *clusterARN, status)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Printf("Stopped waiting for RDS cluster %s, %s \n", *clusterARN, ctx.Err())
+			return ctx.Err()
+		case <-time.After(rdsPollInterval):
+		}
+	}
+}
+
+// WaitUntilRDSInstanceAvailable waits until the DB Instance for the given identifier is available. Waiting is aborted, when the context is done.
+// If an error occurs, the error gets logged and then returned.
+func (rdsmodel *RDSModel) WaitUntilRDSInstanceAvailable(ctx context.Context, instanceIdentifier *string) error {
+	err := rdsmodel.RDSAPI.WaitUntilDBInstanceAvailableWithContext(ctx, &rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: instanceIdentifier,
+	})
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	log.Printf("RDS instance %s is available \n", *instanceIdentifier)
+	return nil
+}
+
+// WaitUntilRDSInstanceStopped polls the status of the DB Instance for the given identifier until it is stopped. Waiting is aborted, when the context is done.
+// If an error occurs, the error gets logged and then returned.
+func (rdsmodel *RDSModel) WaitUntilRDSInstanceStopped(ctx context.Context, instanceIdentifier *string) error {
+	for {
+		result, err := rdsmodel.RDSAPI.DescribeDBInstancesWithContext(ctx, &rds.DescribeDBInstancesInput{
+			DBInstanceIdentifier: instanceIdentifier,
+		})
+		if err != nil {
+			log.Println(err)
+			return err
+		}
+		if len(result.DBInstances) > 0 && *result.DBInstances[0].DBInstanceStatus == "stopped" {
+			log.Printf("RDS instance %s is stopped \n", *instanceIdentifier)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Printf("Stopped waiting for RDS instance %s, %s \n", *instanceIdentifier, ctx.Err())
+			return ctx.Err()
+		case <-time.After(rdsPollInterval):
+		}
+	}
+}
+
+// IsRDSActionRequired returns true, if a Cluster or Instance with the given status has to be changed for the given action ("start" or "stop").
+// Only available resources can be stopped and only stopped resources can be started.
+func IsRDSActionRequired(action, status string) bool {
+	switch action {
+	case "stop":
+		return status == "available"
+	case "start":
+		return status == "stopped"
+	}
+	return false
+}
+
+// describeDBClusters returns the Clusters matching the given ARNs, Clusters contained in the excludedARNs set are returned separately as second value.
+func (rdsmodel *RDSModel) describeDBClusters(ctx context.Context, clusterARNs []*string, excludedARNs map[string]bool) ([]*rds.DBCluster, []*rds.DBCluster, error) {
+	clusters := []*rds.DBCluster{}
+	excluded := []*rds.DBCluster{}
+	if len(clusterARNs) == 0 {
+		log.Println("Found no matching RDS Cluster")
+		return clusters, excluded, nil
+	}
+
+	err := rdsmodel.RDSAPI.DescribeDBClustersPagesWithContext(ctx, &rds.DescribeDBClustersInput{
+		Filters: []*rds.Filter{
+			{
+				Name:   aws.String("db-cluster-id"),
+				Values: clusterARNs,
+			},
+		},
+	}, func(result *rds.DescribeDBClustersOutput, lastPage bool) bool {
+		for _, cluster := range result.DBClusters {
+			if aws.StringValue(cluster.Engine) == docDBEngine {
+				continue
+			}
+			if excludedARNs[*cluster.DBClusterArn] {
+				log.Printf("Cluster %s is excluded by tag \n", *cluster.DBClusterArn)
+				excluded = append(excluded, cluster)
+				continue
+			}
+			log.Printf("Found cluster %s matching the tags with status %s \n", *cluster.DBClusterArn, *cluster.Status)
+			clusters = append(clusters, cluster)
+		}
+		return true
+	})
+	if err != nil {
+		log.Println(err)
+		return []*rds.DBCluster{}, []*rds.DBCluster{}, err
+	}
+	return clusters, excluded, nil
+}
+
+// describeDBInstances returns the DB Instances matching the given ARNs, Instances which are members of a Cluster are skipped.
+// Instances contained in the excludedARNs set are returned separately as second value.
+func (rdsmodel *RDSModel) describeDBInstances(ctx context.Context, instanceARNs []*string, excludedARNs map[string]bool) ([]*rds.DBInstance, []*rds.DBInstance, error) {
+	instances := []*rds.DBInstance{}
+	excluded := []*rds.DBInstance{}
+	if len(instanceARNs) == 0 {
+		return instances, excluded, nil
+	}
+
+	err := rdsmodel.RDSAPI.DescribeDBInstancesPagesWithContext(ctx, &rds.DescribeDBInstancesInput{
+		Filters: []*rds.Filter{
+			{
+				Name:   aws.String("db-instance-id"),
+				Values: instanceARNs,
+			},
+		},
+	}, func(result *rds.DescribeDBInstancesOutput, lastPage bool) bool {
+		for _, instance := range result.DBInstances {
+			if instance.DBClusterIdentifier != nil {
+				continue
+			}
+			if excludedARNs[*instance.DBInstanceArn] {
+				log.Printf("Instance %s is excluded by tag \n", *instance.DBInstanceIdentifier)
+				excluded = append(excluded, instance)
+				continue
+			}
+			log.Printf("Found instance %s matching the tags with status %s \n", *instance.DBInstanceIdentifier, *instance.DBInstanceStatus)
+			instances = append(instances, instance)
+		}
+		return true
+	})
+	if err != nil {
+		log.Println(err)
+		return []*rds.DBInstance{}, []*rds.DBInstance{}, err
+	}
+	return instances, excluded, nil
+}
+
+// rdsTagMap returns the given tag list of an RDS resource as map of tag keys to tag values.
+func rdsTagMap(tagList []*rds.Tag) map[string]string {
+	tagMap := map[string]string{}
+	for _, tag := range tagList {
+		tagMap[*tag.Key] = *tag.Value
+	}
+	return tagMap
+}
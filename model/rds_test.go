@@ -1,142 +1,475 @@
 package model
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/auto-staging/scheduler/mocks"
+	"github.com/auto-staging/scheduler/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
 func TestNewRDSModel(t *testing.T) {
 	svc := new(mocks.RDSAPI)
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
 
-	model := NewRDSModel(svc)
+	model := NewRDSModel(svc, taggingSvc, testTagConfig)
 
 	assert.NotEmpty(t, model, "Expected not empty")
 	assert.Equal(t, svc, model.RDSAPI, "RDS service from model is not matching the one used as parameter")
+	assert.Equal(t, taggingSvc, model.ResourceGroupsTaggingAPIAPI, "Tagging service from model is not matching the one used as parameter")
 }
 
-func TestGetRDSClusterForTags(t *testing.T) {
+// mockDescribeDBClustersPages mocks DescribeDBClustersPages, the given pages are passed one after another to the callback function
+func mockDescribeDBClustersPages(svc *mocks.RDSAPI, err error, pages ...*rds.DescribeDBClustersOutput) {
+	svc.On("DescribeDBClustersPagesWithContext", mock.Anything, mock.AnythingOfType("*rds.DescribeDBClustersInput"), mock.AnythingOfType("func(*rds.DescribeDBClustersOutput, bool) bool")).Run(func(args mock.Arguments) {
+		if err != nil {
+			return
+		}
+		fn := args.Get(2).(func(*rds.DescribeDBClustersOutput, bool) bool)
+		for i := range pages {
+			if !fn(pages[i], i == len(pages)-1) {
+				return
+			}
+		}
+	}).Return(err)
+}
+
+func TestGetRDSClustersForTags(t *testing.T) {
 	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:db:mysql-db")
 	clusterStatus := aws.String("available")
 
 	svc := new(mocks.RDSAPI)
-	svc.On("DescribeDBClusters", mock.Anything).Return(&rds.DescribeDBClustersOutput{
+	mockDescribeDBClustersPages(svc, nil, &rds.DescribeDBClustersOutput{
 		DBClusters: []*rds.DBCluster{
 			&rds.DBCluster{
 				DBClusterArn: clusterArn,
 				Status:       clusterStatus,
+				TagList: []*rds.Tag{
+					&rds.Tag{
+						Key:   aws.String("repository"),
+						Value: aws.String("repo"),
+					},
+					&rds.Tag{
+						Key:   aws.String("branch_raw"),
+						Value: aws.String("branch"),
+					},
+				},
 			},
 		},
-	}, nil)
+	})
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	clusters, _, err := rdsModel.GetRDSClustersForTags(context.Background(), "repo", "branch")
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, clusters, 1, "Expected one cluster")
+	assert.Equal(t, clusters[0].DBClusterArn, clusterArn, "Expected defined clusterARN")
+	assert.Equal(t, clusters[0].Status, clusterStatus, "Expected defined clusterStatus")
+}
 
-	svc.On("ListTagsForResource", mock.AnythingOfType("*rds.ListTagsForResourceInput")).Return(&rds.ListTagsForResourceOutput{
-		TagList: []*rds.Tag{
-			&rds.Tag{
-				Key:   aws.String("repository"),
-				Value: aws.String("repo"),
+func TestGetRDSClustersForTagsMultiplePages(t *testing.T) {
+	otherClusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:other-db")
+	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db")
+	clusterStatus := aws.String("available")
+
+	svc := new(mocks.RDSAPI)
+	mockDescribeDBClustersPages(svc, nil, &rds.DescribeDBClustersOutput{
+		DBClusters: []*rds.DBCluster{
+			&rds.DBCluster{
+				DBClusterArn: otherClusterArn,
+				Status:       clusterStatus,
+				TagList: []*rds.Tag{
+					&rds.Tag{
+						Key:   aws.String("repository"),
+						Value: aws.String("other-repo"),
+					},
+				},
 			},
-			&rds.Tag{
-				Key:   aws.String("branch_raw"),
-				Value: aws.String("branch"),
+		},
+		Marker: aws.String("marker"),
+	}, &rds.DescribeDBClustersOutput{
+		DBClusters: []*rds.DBCluster{
+			&rds.DBCluster{
+				DBClusterArn: clusterArn,
+				Status:       clusterStatus,
+				TagList: []*rds.Tag{
+					&rds.Tag{
+						Key:   aws.String("repository"),
+						Value: aws.String("repo"),
+					},
+					&rds.Tag{
+						Key:   aws.String("branch_raw"),
+						Value: aws.String("branch"),
+					},
+				},
 			},
 		},
-	}, nil)
+	})
 
 	rdsModel := RDSModel{
 		RDSAPI: svc,
+		tags:   testTagConfig,
 	}
 
-	resultArn, resultStatus, err := rdsModel.GetRDSClusterForTags("repo", "branch")
+	clusters, _, err := rdsModel.GetRDSClustersForTags(context.Background(), "repo", "branch")
 	assert.Nil(t, err, "Expected no error")
-	assert.Equal(t, resultArn, clusterArn, "Expected defined clusterARN")
-	assert.Equal(t, resultStatus, clusterStatus, "Expected defined clusterStatus")
+	assert.Len(t, clusters, 1, "Expected one cluster")
+	assert.Equal(t, clusterArn, clusters[0].DBClusterArn, "Expected clusterARN from second page")
+	assert.Equal(t, clusterStatus, clusters[0].Status, "Expected defined clusterStatus")
 }
 
-func TestGetRDSClusterForTagsNoCluster(t *testing.T) {
-	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:db:mysql-db")
+func TestGetRDSClustersForTagsMultipleClusters(t *testing.T) {
+	primaryClusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:primary-db")
+	reportingClusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:reporting-db")
+
+	svc := new(mocks.RDSAPI)
+	mockDescribeDBClustersPages(svc, nil, &rds.DescribeDBClustersOutput{
+		DBClusters: []*rds.DBCluster{
+			&rds.DBCluster{
+				DBClusterArn: primaryClusterArn,
+				Status:       aws.String("available"),
+				TagList: []*rds.Tag{
+					&rds.Tag{
+						Key:   aws.String("repository"),
+						Value: aws.String("repo"),
+					},
+					&rds.Tag{
+						Key:   aws.String("branch_raw"),
+						Value: aws.String("branch"),
+					},
+				},
+			},
+			&rds.DBCluster{
+				DBClusterArn: reportingClusterArn,
+				Status:       aws.String("stopped"),
+				TagList: []*rds.Tag{
+					&rds.Tag{
+						Key:   aws.String("repository"),
+						Value: aws.String("repo"),
+					},
+					&rds.Tag{
+						Key:   aws.String("branch_raw"),
+						Value: aws.String("branch"),
+					},
+				},
+			},
+		},
+	})
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	clusters, _, err := rdsModel.GetRDSClustersForTags(context.Background(), "repo", "branch")
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, clusters, 2, "Expected both clusters")
+	assert.Equal(t, primaryClusterArn, clusters[0].DBClusterArn, "Expected primary cluster")
+	assert.Equal(t, reportingClusterArn, clusters[1].DBClusterArn, "Expected reporting cluster")
+}
+
+func TestGetRDSClustersForTagsTaggingAPI(t *testing.T) {
+	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db")
 	clusterStatus := aws.String("available")
 
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+	mockGetResourcesPages(taggingSvc, nil, &resourcegroupstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+			&resourcegroupstaggingapi.ResourceTagMapping{
+				ResourceARN: clusterArn,
+			},
+		},
+	})
+
 	svc := new(mocks.RDSAPI)
-	svc.On("DescribeDBClusters", mock.Anything).Return(&rds.DescribeDBClustersOutput{
+	mockDescribeDBClustersPages(svc, nil, &rds.DescribeDBClustersOutput{
 		DBClusters: []*rds.DBCluster{
 			&rds.DBCluster{
 				DBClusterArn: clusterArn,
 				Status:       clusterStatus,
 			},
 		},
-	}, nil)
+	})
+
+	rdsModel := NewRDSModel(svc, taggingSvc, testTagConfig)
 
-	svc.On("ListTagsForResource", mock.AnythingOfType("*rds.ListTagsForResourceInput")).Return(&rds.ListTagsForResourceOutput{
-		TagList: []*rds.Tag{
-			&rds.Tag{
-				Key:   aws.String("repository"),
-				Value: aws.String("repo"),
+	clusters, _, err := rdsModel.GetRDSClustersForTags(context.Background(), "repo", "branch")
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, clusters, 1, "Expected one cluster")
+	assert.Equal(t, clusterArn, clusters[0].DBClusterArn, "Expected defined clusterARN")
+	taggingSvc.AssertCalled(t, "GetResourcesPagesWithContext", mock.Anything, &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []*string{aws.String("rds:cluster")},
+		TagFilters: []*resourcegroupstaggingapi.TagFilter{
+			&resourcegroupstaggingapi.TagFilter{
+				Key:    aws.String("repository"),
+				Values: []*string{aws.String("repo")},
 			},
-			&rds.Tag{
-				Key:   aws.String("branch_raw"),
-				Value: aws.String("branch"),
+			&resourcegroupstaggingapi.TagFilter{
+				Key:    aws.String("branch_raw"),
+				Values: []*string{aws.String("branch")},
 			},
 		},
-	}, nil)
+	}, mock.Anything)
+	svc.AssertCalled(t, "DescribeDBClustersPagesWithContext", mock.Anything, &rds.DescribeDBClustersInput{
+		Filters: []*rds.Filter{
+			&rds.Filter{
+				Name:   aws.String("db-cluster-id"),
+				Values: []*string{clusterArn},
+			},
+		},
+	}, mock.Anything)
+	svc.AssertNotCalled(t, "ListTagsForResourceWithContext", mock.Anything, mock.Anything)
+}
 
-	rdsModel := RDSModel{
-		RDSAPI: svc,
-	}
+func TestGetRDSClustersForTagsTaggingAPICustomTags(t *testing.T) {
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+	mockGetResourcesPages(taggingSvc, nil, &resourcegroupstaggingapi.GetResourcesOutput{})
+
+	rdsModel := NewRDSModel(new(mocks.RDSAPI), taggingSvc, types.TagConfig{
+		RepositoryKey: "app",
+		BranchKey:     "git-branch",
+		RequiredTags:  map[string]string{"environment": "staging"},
+	})
+
+	clusters, _, err := rdsModel.GetRDSClustersForTags(context.Background(), "repo", "branch")
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, clusters, 0, "Expected no cluster")
+	taggingSvc.AssertCalled(t, "GetResourcesPagesWithContext", mock.Anything, &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []*string{aws.String("rds:cluster")},
+		TagFilters: []*resourcegroupstaggingapi.TagFilter{
+			&resourcegroupstaggingapi.TagFilter{
+				Key:    aws.String("app"),
+				Values: []*string{aws.String("repo")},
+			},
+			&resourcegroupstaggingapi.TagFilter{
+				Key:    aws.String("git-branch"),
+				Values: []*string{aws.String("branch")},
+			},
+			&resourcegroupstaggingapi.TagFilter{
+				Key:    aws.String("environment"),
+				Values: []*string{aws.String("staging")},
+			},
+		},
+	}, mock.Anything)
+}
+
+func TestGetRDSClustersForTagsRequiredTagMissing(t *testing.T) {
+	svc := new(mocks.RDSAPI)
+	mockDescribeDBClustersPages(svc, nil, &rds.DescribeDBClustersOutput{
+		DBClusters: []*rds.DBCluster{
+			&rds.DBCluster{
+				DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db"),
+				Status:       aws.String("available"),
+				TagList: []*rds.Tag{
+					&rds.Tag{
+						Key:   aws.String("app"),
+						Value: aws.String("repo"),
+					},
+					&rds.Tag{
+						Key:   aws.String("git-branch"),
+						Value: aws.String("branch"),
+					},
+				},
+			},
+		},
+	})
+
+	rdsModel := NewRDSModel(svc, nil, types.TagConfig{
+		RepositoryKey: "app",
+		BranchKey:     "git-branch",
+		RequiredTags:  map[string]string{"environment": "staging"},
+	})
+
+	clusters, _, err := rdsModel.GetRDSClustersForTags(context.Background(), "repo", "branch")
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, clusters, 0, "Expected no cluster, since the environment tag is missing")
+}
+
+func TestGetRDSClustersForTagsTaggingAPINoCluster(t *testing.T) {
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+	mockGetResourcesPages(taggingSvc, nil, &resourcegroupstaggingapi.GetResourcesOutput{})
 
-	resultArn, resultStatus, err := rdsModel.GetRDSClusterForTags("repo", "no_branch")
+	svc := new(mocks.RDSAPI)
+
+	rdsModel := NewRDSModel(svc, taggingSvc, testTagConfig)
+
+	clusters, _, err := rdsModel.GetRDSClustersForTags(context.Background(), "repo", "branch")
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, clusters, 0, "Expected no cluster")
+	svc.AssertNotCalled(t, "DescribeDBClustersPagesWithContext", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetRDSClustersForTagsTaggingAPIFallback(t *testing.T) {
+	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db")
+
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+	mockGetResourcesPages(taggingSvc, errors.New("AccessDeniedException"))
+
+	svc := new(mocks.RDSAPI)
+	mockDescribeDBClustersPages(svc, nil, &rds.DescribeDBClustersOutput{
+		DBClusters: []*rds.DBCluster{
+			&rds.DBCluster{
+				DBClusterArn: clusterArn,
+				Status:       aws.String("available"),
+				TagList: []*rds.Tag{
+					&rds.Tag{
+						Key:   aws.String("repository"),
+						Value: aws.String("repo"),
+					},
+					&rds.Tag{
+						Key:   aws.String("branch_raw"),
+						Value: aws.String("branch"),
+					},
+				},
+			},
+		},
+	})
+
+	rdsModel := NewRDSModel(svc, taggingSvc, testTagConfig)
+
+	clusters, _, err := rdsModel.GetRDSClustersForTags(context.Background(), "repo", "branch")
 	assert.Nil(t, err, "Expected no error")
-	assert.Nil(t, resultArn, "Expected resultArn to be empty")
-	assert.Nil(t, resultStatus, "Expected resultStatus to be empty")
+	assert.Len(t, clusters, 1, "Expected one cluster")
+	svc.AssertNotCalled(t, "ListTagsForResourceWithContext", mock.Anything, mock.Anything)
 }
 
-func TestGetRDSClusterForTagsDescribeError(t *testing.T) {
+func TestGetRDSClustersForTagsTaggingAPIDescribeError(t *testing.T) {
 	errorMsg := errors.New("Test error")
 
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+	mockGetResourcesPages(taggingSvc, nil, &resourcegroupstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+			&resourcegroupstaggingapi.ResourceTagMapping{
+				ResourceARN: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db"),
+			},
+		},
+	})
+
 	svc := new(mocks.RDSAPI)
-	svc.On("DescribeDBClusters", mock.Anything).Return(&rds.DescribeDBClustersOutput{}, errorMsg)
+	mockDescribeDBClustersPages(svc, errorMsg)
 
-	rdsModel := RDSModel{
-		RDSAPI: svc,
-	}
+	rdsModel := NewRDSModel(svc, taggingSvc, testTagConfig)
 
-	resultArn, resultStatus, err := rdsModel.GetRDSClusterForTags("repo", "no_branch")
+	clusters, _, err := rdsModel.GetRDSClustersForTags(context.Background(), "repo", "branch")
 	assert.Error(t, err, "Expected error")
 	assert.Equal(t, errorMsg, err, "Error message didn't match the given one")
-	assert.Nil(t, resultArn, "Expected resultArn to be empty")
-	assert.Nil(t, resultStatus, "Expected resultStatus to be empty")
+	assert.Len(t, clusters, 0, "Expected no cluster")
 }
 
-func TestGetRDSClusterForTagsDescribeTagsError(t *testing.T) {
+func TestGetRDSClustersForTagsNoCluster(t *testing.T) {
 	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:db:mysql-db")
 	clusterStatus := aws.String("available")
-	errorMsg := errors.New("Test error")
 
 	svc := new(mocks.RDSAPI)
-	svc.On("DescribeDBClusters", mock.Anything).Return(&rds.DescribeDBClustersOutput{
+	mockDescribeDBClustersPages(svc, nil, &rds.DescribeDBClustersOutput{
 		DBClusters: []*rds.DBCluster{
 			&rds.DBCluster{
 				DBClusterArn: clusterArn,
 				Status:       clusterStatus,
+				TagList: []*rds.Tag{
+					&rds.Tag{
+						Key:   aws.String("repository"),
+						Value: aws.String("repo"),
+					},
+					&rds.Tag{
+						Key:   aws.String("branch_raw"),
+						Value: aws.String("branch"),
+					},
+				},
 			},
 		},
-	}, nil)
+	})
 
-	svc.On("ListTagsForResource", mock.AnythingOfType("*rds.ListTagsForResourceInput")).Return(&rds.ListTagsForResourceOutput{}, errorMsg)
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	clusters, _, err := rdsModel.GetRDSClustersForTags(context.Background(), "repo", "no_branch")
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, clusters, 0, "Expected no cluster")
+}
+
+func TestGetRDSClustersForTagsDescribeError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.RDSAPI)
+	mockDescribeDBClustersPages(svc, errorMsg, &rds.DescribeDBClustersOutput{})
 
 	rdsModel := RDSModel{
 		RDSAPI: svc,
+		tags:   testTagConfig,
 	}
 
-	resultArn, resultStatus, err := rdsModel.GetRDSClusterForTags("repo", "no_branch")
+	clusters, _, err := rdsModel.GetRDSClustersForTags(context.Background(), "repo", "no_branch")
 	assert.Error(t, err, "Expected error")
 	assert.Equal(t, errorMsg, err, "Error message didn't match the given one")
-	assert.Nil(t, resultArn, "Expected resultArn to be empty")
-	assert.Nil(t, resultStatus, "Expected resultStatus to be empty")
+	assert.Len(t, clusters, 0, "Expected no cluster")
+}
+
+func TestGetRDSClustersForTagsSkipsDocDB(t *testing.T) {
+	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db")
+
+	svc := new(mocks.RDSAPI)
+	mockDescribeDBClustersPages(svc, nil, &rds.DescribeDBClustersOutput{
+		DBClusters: []*rds.DBCluster{
+			&rds.DBCluster{
+				DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:docdb"),
+				Engine:       aws.String("docdb"),
+				Status:       aws.String("available"),
+				TagList: []*rds.Tag{
+					&rds.Tag{
+						Key:   aws.String("repository"),
+						Value: aws.String("repo"),
+					},
+					&rds.Tag{
+						Key:   aws.String("branch_raw"),
+						Value: aws.String("branch"),
+					},
+				},
+			},
+			&rds.DBCluster{
+				DBClusterArn: clusterArn,
+				Engine:       aws.String("aurora-mysql"),
+				Status:       aws.String("available"),
+				TagList: []*rds.Tag{
+					&rds.Tag{
+						Key:   aws.String("repository"),
+						Value: aws.String("repo"),
+					},
+					&rds.Tag{
+						Key:   aws.String("branch_raw"),
+						Value: aws.String("branch"),
+					},
+				},
+			},
+		},
+	})
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	clusters, _, err := rdsModel.GetRDSClustersForTags(context.Background(), "repo", "branch")
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, clusters, 1, "Expected the DocumentDB cluster to be skipped")
+	assert.Equal(t, clusterArn, clusters[0].DBClusterArn)
+	svc.AssertNotCalled(t, "ListTagsForResourceWithContext", mock.Anything, mock.Anything)
 }
 
 func TestStopRDSCluster(t *testing.T) {
@@ -144,16 +477,17 @@ func TestStopRDSCluster(t *testing.T) {
 	clusterStatus := aws.String("available")
 
 	svc := new(mocks.RDSAPI)
-	svc.On("StopDBCluster", mock.AnythingOfType("*rds.StopDBClusterInput")).Return(&rds.StopDBClusterOutput{}, nil)
+	svc.On("StopDBClusterWithContext", mock.Anything, mock.AnythingOfType("*rds.StopDBClusterInput")).Return(&rds.StopDBClusterOutput{}, nil)
 
 	rdsModel := RDSModel{
 		RDSAPI: svc,
+		tags:   testTagConfig,
 	}
 
-	changed, err := rdsModel.StopRDSCluster(clusterArn, clusterStatus)
+	changed, err := rdsModel.StopRDSCluster(context.Background(), clusterArn, clusterStatus)
 
 	assert.Nil(t, err, "Expected no error")
-	svc.AssertCalled(t, "StopDBCluster", &rds.StopDBClusterInput{
+	svc.AssertCalled(t, "StopDBClusterWithContext", mock.Anything, &rds.StopDBClusterInput{
 		DBClusterIdentifier: clusterArn,
 	})
 	assert.Equal(t, true, changed, "Expected changed to be true")
@@ -167,9 +501,10 @@ func TestStopRDSClusterWrongStatus(t *testing.T) {
 
 	rdsModel := RDSModel{
 		RDSAPI: svc,
+		tags:   testTagConfig,
 	}
 
-	changed, err := rdsModel.StopRDSCluster(clusterArn, clusterStatus)
+	changed, err := rdsModel.StopRDSCluster(context.Background(), clusterArn, clusterStatus)
 
 	assert.Nil(t, err, "Expected no error")
 	assert.Equal(t, false, changed, "Expected changed to be false")
@@ -181,16 +516,17 @@ func TestStopRDSClusterError(t *testing.T) {
 	errorMsg := errors.New("Test error")
 
 	svc := new(mocks.RDSAPI)
-	svc.On("StopDBCluster", mock.AnythingOfType("*rds.StopDBClusterInput")).Return(&rds.StopDBClusterOutput{}, errorMsg)
+	svc.On("StopDBClusterWithContext", mock.Anything, mock.AnythingOfType("*rds.StopDBClusterInput")).Return(&rds.StopDBClusterOutput{}, errorMsg)
 
 	rdsModel := RDSModel{
 		RDSAPI: svc,
+		tags:   testTagConfig,
 	}
 
-	changed, err := rdsModel.StopRDSCluster(clusterArn, clusterStatus)
+	changed, err := rdsModel.StopRDSCluster(context.Background(), clusterArn, clusterStatus)
 
 	assert.Error(t, err, "Expected error")
-	svc.AssertCalled(t, "StopDBCluster", &rds.StopDBClusterInput{
+	svc.AssertCalled(t, "StopDBClusterWithContext", mock.Anything, &rds.StopDBClusterInput{
 		DBClusterIdentifier: clusterArn,
 	})
 	assert.Equal(t, errorMsg, err, "Error message didn't match the given one")
@@ -202,16 +538,17 @@ func TestStartRDSCluster(t *testing.T) {
 	clusterStatus := aws.String("stopped")
 
 	svc := new(mocks.RDSAPI)
-	svc.On("StartDBCluster", mock.AnythingOfType("*rds.StartDBClusterInput")).Return(&rds.StartDBClusterOutput{}, nil)
+	svc.On("StartDBClusterWithContext", mock.Anything, mock.AnythingOfType("*rds.StartDBClusterInput")).Return(&rds.StartDBClusterOutput{}, nil)
 
 	rdsModel := RDSModel{
 		RDSAPI: svc,
+		tags:   testTagConfig,
 	}
 
-	changed, err := rdsModel.StartRDSCluster(clusterArn, clusterStatus)
+	changed, err := rdsModel.StartRDSCluster(context.Background(), clusterArn, clusterStatus)
 
 	assert.Nil(t, err, "Expected no error")
-	svc.AssertCalled(t, "StartDBCluster", &rds.StartDBClusterInput{
+	svc.AssertCalled(t, "StartDBClusterWithContext", mock.Anything, &rds.StartDBClusterInput{
 		DBClusterIdentifier: clusterArn,
 	})
 	assert.Equal(t, true, changed, "Expected changed to be true")
@@ -224,9 +561,10 @@ func TestStartRDSClusterWrongStatus(t *testing.T) {
 	svc := new(mocks.RDSAPI)
 	rdsModel := RDSModel{
 		RDSAPI: svc,
+		tags:   testTagConfig,
 	}
 
-	changed, err := rdsModel.StartRDSCluster(clusterArn, clusterStatus)
+	changed, err := rdsModel.StartRDSCluster(context.Background(), clusterArn, clusterStatus)
 
 	assert.Nil(t, err, "Expected no error")
 	assert.Equal(t, false, changed, "Expected changed to be false")
@@ -238,18 +576,599 @@ func TestStartRDSClusterError(t *testing.T) {
 	errorMsg := errors.New("Test error")
 
 	svc := new(mocks.RDSAPI)
-	svc.On("StartDBCluster", mock.AnythingOfType("*rds.StartDBClusterInput")).Return(&rds.StartDBClusterOutput{}, errorMsg)
+	svc.On("StartDBClusterWithContext", mock.Anything, mock.AnythingOfType("*rds.StartDBClusterInput")).Return(&rds.StartDBClusterOutput{}, errorMsg)
 
 	rdsModel := RDSModel{
 		RDSAPI: svc,
+		tags:   testTagConfig,
 	}
 
-	changed, err := rdsModel.StartRDSCluster(clusterArn, clusterStatus)
+	changed, err := rdsModel.StartRDSCluster(context.Background(), clusterArn, clusterStatus)
 
 	assert.Error(t, err, "Expected error")
-	svc.AssertCalled(t, "StartDBCluster", &rds.StartDBClusterInput{
+	svc.AssertCalled(t, "StartDBClusterWithContext", mock.Anything, &rds.StartDBClusterInput{
 		DBClusterIdentifier: clusterArn,
 	})
 	assert.Equal(t, errorMsg, err, "Error message didn't match the given one")
 	assert.Equal(t, false, changed, "Expected changed to be false")
 }
+
+// mockDescribeDBInstancesPages mocks DescribeDBInstancesPages, the given pages are passed one after another to the callback function
+func mockDescribeDBInstancesPages(svc *mocks.RDSAPI, err error, pages ...*rds.DescribeDBInstancesOutput) {
+	svc.On("DescribeDBInstancesPagesWithContext", mock.Anything, mock.AnythingOfType("*rds.DescribeDBInstancesInput"), mock.AnythingOfType("func(*rds.DescribeDBInstancesOutput, bool) bool")).Run(func(args mock.Arguments) {
+		if err != nil {
+			return
+		}
+		fn := args.Get(2).(func(*rds.DescribeDBInstancesOutput, bool) bool)
+		for i := range pages {
+			if !fn(pages[i], i == len(pages)-1) {
+				return
+			}
+		}
+	}).Return(err)
+}
+
+func TestGetRDSInstancesForTags(t *testing.T) {
+	instanceArn := aws.String("arn:aws:rds:eu-west-1:123456789012:db:postgres-db")
+	otherInstanceArn := aws.String("arn:aws:rds:eu-west-1:123456789012:db:other-db")
+
+	svc := new(mocks.RDSAPI)
+	mockDescribeDBInstancesPages(svc, nil, &rds.DescribeDBInstancesOutput{
+		DBInstances: []*rds.DBInstance{
+			&rds.DBInstance{
+				DBInstanceArn:        aws.String("arn:aws:rds:eu-west-1:123456789012:db:aurora-db-1"),
+				DBInstanceIdentifier: aws.String("aurora-db-1"),
+				DBInstanceStatus:     aws.String("available"),
+				DBClusterIdentifier:  aws.String("aurora-db"),
+			},
+			&rds.DBInstance{
+				DBInstanceArn:        otherInstanceArn,
+				DBInstanceIdentifier: aws.String("other-db"),
+				DBInstanceStatus:     aws.String("available"),
+				TagList: []*rds.Tag{
+					&rds.Tag{
+						Key:   aws.String("repository"),
+						Value: aws.String("other-repo"),
+					},
+				},
+			},
+		},
+		Marker: aws.String("marker"),
+	}, &rds.DescribeDBInstancesOutput{
+		DBInstances: []*rds.DBInstance{
+			&rds.DBInstance{
+				DBInstanceArn:        instanceArn,
+				DBInstanceIdentifier: aws.String("postgres-db"),
+				DBInstanceStatus:     aws.String("available"),
+				TagList: []*rds.Tag{
+					&rds.Tag{
+						Key:   aws.String("repository"),
+						Value: aws.String("repo"),
+					},
+					&rds.Tag{
+						Key:   aws.String("branch_raw"),
+						Value: aws.String("branch"),
+					},
+				},
+			},
+		},
+	})
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	instances, _, err := rdsModel.GetRDSInstancesForTags(context.Background(), "repo", "branch")
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, instances, 1, "Expected one instance")
+	assert.Equal(t, "postgres-db", *instances[0].DBInstanceIdentifier, "Expected postgres-db")
+	svc.AssertNotCalled(t, "ListTagsForResourceWithContext", mock.Anything, mock.Anything)
+}
+
+func TestGetRDSInstancesForTagsTaggingAPI(t *testing.T) {
+	instanceArn := aws.String("arn:aws:rds:eu-west-1:123456789012:db:postgres-db")
+	memberArn := aws.String("arn:aws:rds:eu-west-1:123456789012:db:aurora-db-1")
+
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+	mockGetResourcesPages(taggingSvc, nil, &resourcegroupstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+			&resourcegroupstaggingapi.ResourceTagMapping{
+				ResourceARN: instanceArn,
+			},
+			&resourcegroupstaggingapi.ResourceTagMapping{
+				ResourceARN: memberArn,
+			},
+		},
+	})
+
+	svc := new(mocks.RDSAPI)
+	mockDescribeDBInstancesPages(svc, nil, &rds.DescribeDBInstancesOutput{
+		DBInstances: []*rds.DBInstance{
+			&rds.DBInstance{
+				DBInstanceArn:        instanceArn,
+				DBInstanceIdentifier: aws.String("postgres-db"),
+				DBInstanceStatus:     aws.String("available"),
+			},
+			&rds.DBInstance{
+				DBInstanceArn:        memberArn,
+				DBInstanceIdentifier: aws.String("aurora-db-1"),
+				DBInstanceStatus:     aws.String("available"),
+				DBClusterIdentifier:  aws.String("aurora-db"),
+			},
+		},
+	})
+
+	rdsModel := NewRDSModel(svc, taggingSvc, testTagConfig)
+
+	instances, _, err := rdsModel.GetRDSInstancesForTags(context.Background(), "repo", "branch")
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, instances, 1, "Expected one instance")
+	assert.Equal(t, "postgres-db", *instances[0].DBInstanceIdentifier, "Expected postgres-db")
+	svc.AssertCalled(t, "DescribeDBInstancesPagesWithContext", mock.Anything, &rds.DescribeDBInstancesInput{
+		Filters: []*rds.Filter{
+			&rds.Filter{
+				Name:   aws.String("db-instance-id"),
+				Values: []*string{instanceArn, memberArn},
+			},
+		},
+	}, mock.Anything)
+	svc.AssertNotCalled(t, "ListTagsForResourceWithContext", mock.Anything, mock.Anything)
+}
+
+func TestGetRDSInstancesForTagsDescribeError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.RDSAPI)
+	mockDescribeDBInstancesPages(svc, errorMsg)
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	instances, _, err := rdsModel.GetRDSInstancesForTags(context.Background(), "repo", "branch")
+	assert.Error(t, err, "Expected error")
+	assert.Equal(t, errorMsg, err, "Error message didn't match the given one")
+	assert.Len(t, instances, 0, "Expected no instance")
+}
+
+func TestStopRDSInstance(t *testing.T) {
+	instanceIdentifier := aws.String("postgres-db")
+	instanceStatus := aws.String("available")
+
+	svc := new(mocks.RDSAPI)
+	svc.On("StopDBInstanceWithContext", mock.Anything, mock.AnythingOfType("*rds.StopDBInstanceInput")).Return(&rds.StopDBInstanceOutput{}, nil)
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	changed, err := rdsModel.StopRDSInstance(context.Background(), instanceIdentifier, instanceStatus)
+
+	assert.Nil(t, err, "Expected no error")
+	svc.AssertCalled(t, "StopDBInstanceWithContext", mock.Anything, &rds.StopDBInstanceInput{
+		DBInstanceIdentifier: instanceIdentifier,
+	})
+	assert.Equal(t, true, changed, "Expected changed to be true")
+}
+
+func TestStopRDSInstanceWrongStatus(t *testing.T) {
+	svc := new(mocks.RDSAPI)
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	changed, err := rdsModel.StopRDSInstance(context.Background(), aws.String("postgres-db"), aws.String("stopped"))
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, false, changed, "Expected changed to be false")
+}
+
+func TestStopRDSInstanceError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.RDSAPI)
+	svc.On("StopDBInstanceWithContext", mock.Anything, mock.AnythingOfType("*rds.StopDBInstanceInput")).Return(&rds.StopDBInstanceOutput{}, errorMsg)
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	changed, err := rdsModel.StopRDSInstance(context.Background(), aws.String("postgres-db"), aws.String("available"))
+
+	assert.Error(t, err, "Expected error")
+	assert.Equal(t, errorMsg, err, "Error message didn't match the given one")
+	assert.Equal(t, false, changed, "Expected changed to be false")
+}
+
+func TestStartRDSInstance(t *testing.T) {
+	instanceIdentifier := aws.String("postgres-db")
+	instanceStatus := aws.String("stopped")
+
+	svc := new(mocks.RDSAPI)
+	svc.On("StartDBInstanceWithContext", mock.Anything, mock.AnythingOfType("*rds.StartDBInstanceInput")).Return(&rds.StartDBInstanceOutput{}, nil)
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	changed, err := rdsModel.StartRDSInstance(context.Background(), instanceIdentifier, instanceStatus)
+
+	assert.Nil(t, err, "Expected no error")
+	svc.AssertCalled(t, "StartDBInstanceWithContext", mock.Anything, &rds.StartDBInstanceInput{
+		DBInstanceIdentifier: instanceIdentifier,
+	})
+	assert.Equal(t, true, changed, "Expected changed to be true")
+}
+
+func TestStartRDSInstanceWrongStatus(t *testing.T) {
+	svc := new(mocks.RDSAPI)
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	changed, err := rdsModel.StartRDSInstance(context.Background(), aws.String("postgres-db"), aws.String("available"))
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, false, changed, "Expected changed to be false")
+}
+
+func TestStartRDSInstanceError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.RDSAPI)
+	svc.On("StartDBInstanceWithContext", mock.Anything, mock.AnythingOfType("*rds.StartDBInstanceInput")).Return(&rds.StartDBInstanceOutput{}, errorMsg)
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	changed, err := rdsModel.StartRDSInstance(context.Background(), aws.String("postgres-db"), aws.String("stopped"))
+
+	assert.Error(t, err, "Expected error")
+	assert.Equal(t, errorMsg, err, "Error message didn't match the given one")
+	assert.Equal(t, false, changed, "Expected changed to be false")
+}
+
+func TestWaitUntilRDSClusterAvailable(t *testing.T) {
+	defer func(previous time.Duration) { rdsPollInterval = previous }(rdsPollInterval)
+	rdsPollInterval = time.Millisecond
+
+	clusterARN := aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db")
+
+	svc := new(mocks.RDSAPI)
+	svc.On("DescribeDBClustersWithContext", mock.Anything, mock.AnythingOfType("*rds.DescribeDBClustersInput")).Return(&rds.DescribeDBClustersOutput{
+		DBClusters: []*rds.DBCluster{
+			&rds.DBCluster{DBClusterArn: clusterARN, Status: aws.String("starting")},
+		},
+	}, nil).Once()
+	svc.On("DescribeDBClustersWithContext", mock.Anything, mock.AnythingOfType("*rds.DescribeDBClustersInput")).Return(&rds.DescribeDBClustersOutput{
+		DBClusters: []*rds.DBCluster{
+			&rds.DBCluster{DBClusterArn: clusterARN, Status: aws.String("available")},
+		},
+	}, nil).Once()
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	err := rdsModel.WaitUntilRDSClusterAvailable(context.Background(), clusterARN)
+
+	assert.Nil(t, err, "Expected no error")
+	svc.AssertNumberOfCalls(t, "DescribeDBClustersWithContext", 2)
+	svc.AssertCalled(t, "DescribeDBClustersWithContext", mock.Anything, &rds.DescribeDBClustersInput{
+		DBClusterIdentifier: clusterARN,
+	})
+}
+
+func TestWaitUntilRDSClusterAvailableCancelled(t *testing.T) {
+	svc := new(mocks.RDSAPI)
+	svc.On("DescribeDBClustersWithContext", mock.Anything, mock.AnythingOfType("*rds.DescribeDBClustersInput")).Return(&rds.DescribeDBClustersOutput{
+		DBClusters: []*rds.DBCluster{
+			&rds.DBCluster{Status: aws.String("starting")},
+		},
+	}, nil)
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := rdsModel.WaitUntilRDSClusterAvailable(ctx, aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db"))
+
+	assert.Equal(t, context.Canceled, err, "Expected context error")
+}
+
+func TestWaitUntilRDSClusterAvailableError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.RDSAPI)
+	svc.On("DescribeDBClustersWithContext", mock.Anything, mock.AnythingOfType("*rds.DescribeDBClustersInput")).Return(nil, errorMsg)
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	err := rdsModel.WaitUntilRDSClusterAvailable(context.Background(), aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db"))
+
+	assert.Equal(t, errorMsg, err, "Error message didn't match the given one")
+}
+
+func TestWaitUntilRDSInstanceAvailable(t *testing.T) {
+	instanceIdentifier := aws.String("postgres-db")
+
+	svc := new(mocks.RDSAPI)
+	svc.On("WaitUntilDBInstanceAvailableWithContext", mock.Anything, mock.AnythingOfType("*rds.DescribeDBInstancesInput")).Return(nil)
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	err := rdsModel.WaitUntilRDSInstanceAvailable(context.Background(), instanceIdentifier)
+
+	assert.Nil(t, err, "Expected no error")
+	svc.AssertCalled(t, "WaitUntilDBInstanceAvailableWithContext", mock.Anything, &rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: instanceIdentifier,
+	})
+}
+
+func TestWaitUntilRDSInstanceAvailableError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.RDSAPI)
+	svc.On("WaitUntilDBInstanceAvailableWithContext", mock.Anything, mock.AnythingOfType("*rds.DescribeDBInstancesInput")).Return(errorMsg)
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	err := rdsModel.WaitUntilRDSInstanceAvailable(context.Background(), aws.String("postgres-db"))
+
+	assert.Equal(t, errorMsg, err, "Error message didn't match the given one")
+}
+
+func TestWaitUntilRDSClusterStopped(t *testing.T) {
+	defer func(previous time.Duration) { rdsPollInterval = previous }(rdsPollInterval)
+	rdsPollInterval = time.Millisecond
+
+	svc := new(mocks.RDSAPI)
+	svc.On("DescribeDBClustersWithContext", mock.Anything, mock.AnythingOfType("*rds.DescribeDBClustersInput")).Return(&rds.DescribeDBClustersOutput{
+		DBClusters: []*rds.DBCluster{
+			&rds.DBCluster{Status: aws.String("stopping")},
+		},
+	}, nil).Once()
+	svc.On("DescribeDBClustersWithContext", mock.Anything, mock.AnythingOfType("*rds.DescribeDBClustersInput")).Return(&rds.DescribeDBClustersOutput{
+		DBClusters: []*rds.DBCluster{
+			&rds.DBCluster{Status: aws.String("stopped")},
+		},
+	}, nil).Once()
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	err := rdsModel.WaitUntilRDSClusterStopped(context.Background(), aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db"))
+
+	assert.Nil(t, err, "Expected no error")
+	svc.AssertNumberOfCalls(t, "DescribeDBClustersWithContext", 2)
+}
+
+func TestWaitUntilRDSInstanceStopped(t *testing.T) {
+	defer func(previous time.Duration) { rdsPollInterval = previous }(rdsPollInterval)
+	rdsPollInterval = time.Millisecond
+
+	instanceIdentifier := aws.String("postgres-db")
+
+	svc := new(mocks.RDSAPI)
+	svc.On("DescribeDBInstancesWithContext", mock.Anything, mock.AnythingOfType("*rds.DescribeDBInstancesInput")).Return(&rds.DescribeDBInstancesOutput{
+		DBInstances: []*rds.DBInstance{
+			&rds.DBInstance{DBInstanceStatus: aws.String("stopping")},
+		},
+	}, nil).Once()
+	svc.On("DescribeDBInstancesWithContext", mock.Anything, mock.AnythingOfType("*rds.DescribeDBInstancesInput")).Return(&rds.DescribeDBInstancesOutput{
+		DBInstances: []*rds.DBInstance{
+			&rds.DBInstance{DBInstanceStatus: aws.String("stopped")},
+		},
+	}, nil).Once()
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	err := rdsModel.WaitUntilRDSInstanceStopped(context.Background(), instanceIdentifier)
+
+	assert.Nil(t, err, "Expected no error")
+	svc.AssertNumberOfCalls(t, "DescribeDBInstancesWithContext", 2)
+	svc.AssertCalled(t, "DescribeDBInstancesWithContext", mock.Anything, &rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: instanceIdentifier,
+	})
+}
+
+func TestWaitUntilRDSInstanceStoppedCancelled(t *testing.T) {
+	svc := new(mocks.RDSAPI)
+	svc.On("DescribeDBInstancesWithContext", mock.Anything, mock.AnythingOfType("*rds.DescribeDBInstancesInput")).Return(&rds.DescribeDBInstancesOutput{
+		DBInstances: []*rds.DBInstance{
+			&rds.DBInstance{DBInstanceStatus: aws.String("stopping")},
+		},
+	}, nil)
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := rdsModel.WaitUntilRDSInstanceStopped(ctx, aws.String("postgres-db"))
+
+	assert.Equal(t, context.Canceled, err, "Expected context error")
+}
+
+func TestWaitUntilRDSInstanceStoppedError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.RDSAPI)
+	svc.On("DescribeDBInstancesWithContext", mock.Anything, mock.AnythingOfType("*rds.DescribeDBInstancesInput")).Return(nil, errorMsg)
+
+	rdsModel := RDSModel{
+		RDSAPI: svc,
+		tags:   testTagConfig,
+	}
+
+	err := rdsModel.WaitUntilRDSInstanceStopped(context.Background(), aws.String("postgres-db"))
+
+	assert.Equal(t, errorMsg, err, "Error message didn't match the given one")
+}
+
+func TestIsRDSActionRequired(t *testing.T) {
+	assert.True(t, IsRDSActionRequired("stop", "available"), "Expected available resource to require stop")
+	assert.False(t, IsRDSActionRequired("stop", "stopped"), "Expected stopped resource to require no stop")
+	assert.True(t, IsRDSActionRequired("start", "stopped"), "Expected stopped resource to require start")
+	assert.False(t, IsRDSActionRequired("start", "starting"), "Expected starting resource to require no start")
+	assert.False(t, IsRDSActionRequired("restart", "available"), "Expected unknown action to require nothing")
+}
+
+// benchmarkGetRDSClustersForTags creates an account with the given number of Clusters, of which one matches the tags, and reports the number of RDS API calls needed
+// per lookup. If withTaggingAPI is false, the Clusters are found by checking the tag list of every Cluster.
+func benchmarkGetRDSClustersForTags(b *testing.B, clusterCount int, withTaggingAPI bool) {
+	clusters := []*rds.DBCluster{}
+	for i := 0; i < clusterCount; i++ {
+		clusters = append(clusters, &rds.DBCluster{
+			DBClusterArn: aws.String(fmt.Sprintf("arn:aws:rds:eu-west-1:123456789012:cluster:db-%d", i)),
+			Status:       aws.String("available"),
+		})
+	}
+
+	svc := new(mocks.RDSAPI)
+	svc.On("DescribeDBClustersPagesWithContext", mock.Anything, mock.AnythingOfType("*rds.DescribeDBClustersInput"), mock.AnythingOfType("func(*rds.DescribeDBClustersOutput, bool) bool")).Run(func(args mock.Arguments) {
+		input := args.Get(1).(*rds.DescribeDBClustersInput)
+		fn := args.Get(2).(func(*rds.DescribeDBClustersOutput, bool) bool)
+		if len(input.Filters) > 0 {
+			fn(&rds.DescribeDBClustersOutput{DBClusters: clusters[:1]}, true)
+			return
+		}
+		fn(&rds.DescribeDBClustersOutput{DBClusters: clusters}, true)
+	}).Return(nil)
+
+	rdsModel := NewRDSModel(svc, nil, testTagConfig)
+	if withTaggingAPI {
+		taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+		mockGetResourcesPages(taggingSvc, nil, &resourcegroupstaggingapi.GetResourcesOutput{
+			ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+				&resourcegroupstaggingapi.ResourceTagMapping{
+					ResourceARN: clusters[0].DBClusterArn,
+				},
+			},
+		})
+		rdsModel = NewRDSModel(svc, taggingSvc, testTagConfig)
+	}
+
+	log.SetOutput(ioutil.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		svc.Calls = nil
+		_, _, err := rdsModel.GetRDSClustersForTags(context.Background(), "repo", "branch")
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(len(svc.Calls)), "rds-calls/op")
+}
+
+func BenchmarkGetRDSClustersForTagsTaggingAPI(b *testing.B) {
+	benchmarkGetRDSClustersForTags(b, 200, true)
+}
+
+func BenchmarkGetRDSClustersForTagsTagList(b *testing.B) {
+	benchmarkGetRDSClustersForTags(b, 200, false)
+}
+
+func TestGetRDSClustersForTagsTaggingAPIExcluded(t *testing.T) {
+	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db")
+	sharedClusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:shared-db")
+
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+	mockGetResourcesPages(taggingSvc, nil, &resourcegroupstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+			&resourcegroupstaggingapi.ResourceTagMapping{
+				ResourceARN: clusterArn,
+			},
+			&resourcegroupstaggingapi.ResourceTagMapping{
+				ResourceARN: sharedClusterArn,
+				Tags: []*resourcegroupstaggingapi.Tag{
+					&resourcegroupstaggingapi.Tag{
+						Key:   aws.String("auto-staging:schedule"),
+						Value: aws.String("ignore"),
+					},
+				},
+			},
+		},
+	})
+
+	svc := new(mocks.RDSAPI)
+	mockDescribeDBClustersPages(svc, nil, &rds.DescribeDBClustersOutput{
+		DBClusters: []*rds.DBCluster{
+			&rds.DBCluster{DBClusterArn: clusterArn, Status: aws.String("available")},
+			&rds.DBCluster{DBClusterArn: sharedClusterArn, Status: aws.String("available")},
+		},
+	})
+
+	rdsModel := NewRDSModel(svc, taggingSvc, testExcludeTagConfig)
+
+	clusters, excluded, err := rdsModel.GetRDSClustersForTags(context.Background(), "repo", "branch")
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, clusters, 1, "Expected one cluster")
+	assert.Equal(t, clusterArn, clusters[0].DBClusterArn)
+	assert.Len(t, excluded, 1, "Expected one excluded cluster")
+	assert.Equal(t, sharedClusterArn, excluded[0].DBClusterArn)
+}
+
+func TestGetRDSInstancesForTagsExcluded(t *testing.T) {
+	svc := new(mocks.RDSAPI)
+	svc.On("DescribeDBInstancesPagesWithContext", mock.Anything, mock.AnythingOfType("*rds.DescribeDBInstancesInput"), mock.AnythingOfType("func(*rds.DescribeDBInstancesOutput, bool) bool")).Run(func(args mock.Arguments) {
+		fn := args.Get(2).(func(*rds.DescribeDBInstancesOutput, bool) bool)
+		fn(&rds.DescribeDBInstancesOutput{
+			DBInstances: []*rds.DBInstance{
+				&rds.DBInstance{
+					DBInstanceArn:        aws.String("arn:aws:rds:eu-west-1:123456789012:db:shared-instance"),
+					DBInstanceIdentifier: aws.String("shared-instance"),
+					DBInstanceStatus:     aws.String("available"),
+					TagList: []*rds.Tag{
+						&rds.Tag{Key: aws.String("repository"), Value: aws.String("repo")},
+						&rds.Tag{Key: aws.String("branch_raw"), Value: aws.String("branch")},
+						&rds.Tag{Key: aws.String("auto-staging:schedule"), Value: aws.String("ignore")},
+					},
+				},
+			},
+		}, true)
+	}).Return(nil)
+
+	rdsModel := NewRDSModel(svc, nil, testExcludeTagConfig)
+
+	instances, excluded, err := rdsModel.GetRDSInstancesForTags(context.Background(), "repo", "branch")
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, instances, 0, "Expected no instance")
+	assert.Len(t, excluded, 1, "Expected one excluded instance")
+	assert.Equal(t, "shared-instance", *excluded[0].DBInstanceIdentifier)
+}
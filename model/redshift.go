@@ -0,0 +1,159 @@
+package model
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/auto-staging/scheduler/types"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/redshift/redshiftiface"
+)
+
+// RedshiftModelAPI is an interface including all Redshift model functions
+type RedshiftModelAPI interface {
+	GetRedshiftClustersForTags(ctx context.Context, repository, branch string) ([]*redshift.Cluster, []*redshift.Cluster, error)
+	PauseRedshiftCluster(ctx context.Context, clusterIdentifier, clusterStatus *string) (bool, error)
+	ResumeRedshiftCluster(ctx context.Context, clusterIdentifier, clusterStatus *string) (bool, error)
+	WaitUntilRedshiftClusterAvailable(ctx context.Context, clusterIdentifier *string) error
+	WaitUntilRedshiftClusterPaused(ctx context.Context, clusterIdentifier *string) error
+}
+
+// redshiftPollInterval is the time between two status checks while waiting for a Redshift Cluster
+var redshiftPollInterval = 30 * time.Second
+
+// RedshiftModel is a struct including the AWS SDK Redshift interface, all Redshift model functions are called on this struct and the included AWS SDK Redshift service.
+// The Clusters of an Environment are found by the tags configured in the TagConfig.
+type RedshiftModel struct {
+	redshiftiface.RedshiftAPI
+	tags types.TagConfig
+}
+
+// NewRedshiftModel takes the AWS SDK Redshift Interface and the tag config as parameter and returns the pointer to a RedshiftModel struct, on which all Redshift model functions can be called
+func NewRedshiftModel(svc redshiftiface.RedshiftAPI, tags types.TagConfig) *RedshiftModel {
+	return &RedshiftModel{
+		RedshiftAPI: svc,
+		tags:        tags,
+	}
+}
+
+// GetRedshiftClustersForTags returns all Redshift Clusters found for the given repository and branch tag values. Clusters with the exclude tag are returned
+// separately as second value and must not be paused or resumed.
+// All result pages of the DescribeClusters call are processed.
+// If an error occurs, the error gets logged and then returned.
+func (redshiftModel *RedshiftModel) GetRedshiftClustersForTags(ctx context.Context, repository, branch string) ([]*redshift.Cluster, []*redshift.Cluster, error) {
+	clusters := []*redshift.Cluster{}
+	excluded := []*redshift.Cluster{}
+	err := redshiftModel.RedshiftAPI.DescribeClustersPagesWithContext(ctx, &redshift.DescribeClustersInput{}, func(result *redshift.DescribeClustersOutput, lastPage bool) bool {
+		for _, cluster := range result.Clusters {
+			tagMap := map[string]string{}
+			for _, tag := range cluster.Tags {
+				tagMap[*tag.Key] = *tag.Value
+			}
+			if !redshiftModel.tags.MatchesEnvironment(tagMap, repository, branch) {
+				continue
+			}
+			if redshiftModel.tags.IsExcluded(tagMap) {
+				log.Printf("Redshift cluster %s is excluded by tag \n", *cluster.ClusterIdentifier)
+				excluded = append(excluded, cluster)
+				continue
+			}
+			log.Printf("Found Redshift cluster %s matching the tags with status %s \n", *cluster.ClusterIdentifier, *cluster.ClusterStatus)
+			clusters = append(clusters, cluster)
+		}
+		return true
+	})
+	if err != nil {
+		log.Println(err)
+		return []*redshift.Cluster{}, []*redshift.Cluster{}, err
+	}
+
+	if len(clusters) == 0 {
+		log.Println("Found no matching Redshift Cluster")
+	}
+	return clusters, excluded, nil
+}
+
+// PauseRedshiftCluster pauses the Redshift Cluster for the given identifier and status. It returns true, if the state of the Cluster was changed and false if not.
+// If an error occurs, the error gets logged and then returned.
+func (redshiftModel *RedshiftModel) PauseRedshiftCluster(ctx context.Context, clusterIdentifier, clusterStatus *string) (bool, error) {
+	if IsRedshiftActionRequired("stop", *clusterStatus) {
+		log.Println("Pausing REDSHIFT CLUSTER")
+		_, err := redshiftModel.RedshiftAPI.PauseClusterWithContext(ctx, &redshift.PauseClusterInput{
+			ClusterIdentifier: clusterIdentifier,
+		})
+		if err != nil {
+			log.Println(err)
+			return false, err
+		}
+		return true, nil
+	}
+	log.Println("Redshift - No action required")
+	return false, nil
+}
+
+// ResumeRedshiftCluster resumes the Redshift Cluster for the given identifier and status. It returns true, if the state of the Cluster was changed and false if not.
+// If an error occurs, the error gets logged and then returned.
+func (redshiftModel *RedshiftModel) ResumeRedshiftCluster(ctx context.Context, clusterIdentifier, clusterStatus *string) (bool, error) {
+	if IsRedshiftActionRequired("start", *clusterStatus) {
+		log.Println("Resuming REDSHIFT CLUSTER")
+		_, err := redshiftModel.RedshiftAPI.ResumeClusterWithContext(ctx, &redshift.ResumeClusterInput{
+			ClusterIdentifier: clusterIdentifier,
+		})
+		if err != nil {
+			log.Println(err)
+			return false, err
+		}
+		return true, nil
+	}
+	log.Println("Redshift - No action required")
+	return false, nil
+}
+
+// WaitUntilRedshiftClusterAvailable polls the status of the Redshift Cluster for the given identifier until it is available. Waiting is aborted, when the context is done.
+// If an error occurs, the error gets logged and then returned.
+func (redshiftModel *RedshiftModel) WaitUntilRedshiftClusterAvailable(ctx context.Context, clusterIdentifier *string) error {
+	return redshiftModel.waitForRedshiftClusterStatus(ctx, clusterIdentifier, "available")
+}
+
+// WaitUntilRedshiftClusterPaused polls the status of the Redshift Cluster for the given identifier until it is paused. Waiting is aborted, when the context is done.
+// If an error occurs, the error gets logged and then returned.
+func (redshiftModel *RedshiftModel) WaitUntilRedshiftClusterPaused(ctx context.Context, clusterIdentifier *string) error {
+	return redshiftModel.waitForRedshiftClusterStatus(ctx, clusterIdentifier, "paused")
+}
+
+// waitForRedshiftClusterStatus polls the status of the Redshift Cluster for the given identifier every redshiftPollInterval until it matches the given status.
+func (redshiftModel *RedshiftModel) waitForRedshiftClusterStatus(ctx context.Context, clusterIdentifier *string, status string) error {
+	for {
+		result, err := redshiftModel.RedshiftAPI.DescribeClustersWithContext(ctx, &redshift.DescribeClustersInput{
+			ClusterIdentifier: clusterIdentifier,
+		})
+		if err != nil {
+			log.Println(err)
+			return err
+		}
+		if len(result.Clusters) > 0 && *result.Clusters[0].ClusterStatus == status {
+			log.Printf("Redshift cluster %s is %s \n", *clusterIdentifier, status)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Printf("Stopped waiting for Redshift cluster %s, %s \n", *clusterIdentifier, ctx.Err())
+			return ctx.Err()
+		case <-time.After(redshiftPollInterval):
+		}
+	}
+}
+
+// IsRedshiftActionRequired returns true, if a Redshift Cluster with the given status has to be changed for the given action ("start" or "stop").
+// Only available Clusters can be paused and only paused Clusters can be resumed.
+func IsRedshiftActionRequired(action, status string) bool {
+	switch action {
+	case "stop":
+		return status == "available"
+	case "start":
+		return status == "paused"
+	}
+	return false
+}
@@ -0,0 +1,229 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/auto-staging/scheduler/mocks"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewRedshiftModel(t *testing.T) {
+	svc := new(mocks.RedshiftAPI)
+
+	model := NewRedshiftModel(svc, testTagConfig)
+
+	assert.NotEmpty(t, model, "Expected not empty")
+	assert.Equal(t, svc, model.RedshiftAPI, "Redshift service from model is not matching the one used as parameter")
+	assert.Equal(t, testTagConfig, model.tags, "Tag config from model is not matching the one used as parameter")
+}
+
+// mockDescribeClustersPages mocks DescribeClustersPages, the given pages are passed one after another to the callback function
+func mockDescribeClustersPages(svc *mocks.RedshiftAPI, err error, pages ...*redshift.DescribeClustersOutput) {
+	svc.On("DescribeClustersPagesWithContext", mock.Anything, mock.AnythingOfType("*redshift.DescribeClustersInput"), mock.AnythingOfType("func(*redshift.DescribeClustersOutput, bool) bool")).Run(func(args mock.Arguments) {
+		if err != nil {
+			return
+		}
+		fn := args.Get(2).(func(*redshift.DescribeClustersOutput, bool) bool)
+		for i := range pages {
+			if !fn(pages[i], i == len(pages)-1) {
+				return
+			}
+		}
+	}).Return(err)
+}
+
+// newRedshiftCluster returns a Redshift Cluster with the given identifier, status and tags
+func newRedshiftCluster(clusterIdentifier, clusterStatus string, tags map[string]string) *redshift.Cluster {
+	cluster := &redshift.Cluster{
+		ClusterIdentifier: aws.String(clusterIdentifier),
+		ClusterStatus:     aws.String(clusterStatus),
+		Tags:              []*redshift.Tag{},
+	}
+	for key, value := range tags {
+		cluster.Tags = append(cluster.Tags, &redshift.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+	return cluster
+}
+
+func TestGetRedshiftClustersForTags(t *testing.T) {
+	svc := new(mocks.RedshiftAPI)
+	mockDescribeClustersPages(svc, nil, &redshift.DescribeClustersOutput{
+		Clusters: []*redshift.Cluster{
+			newRedshiftCluster("demo-app-feat-branch", "available", map[string]string{"repository": "repo", "branch_raw": "branch"}),
+			newRedshiftCluster("demo-app-other", "available", map[string]string{"repository": "repo", "branch_raw": "other"}),
+		},
+	}, &redshift.DescribeClustersOutput{
+		Clusters: []*redshift.Cluster{
+			newRedshiftCluster("demo-app-feat-branch-2", "paused", map[string]string{"repository": "repo", "branch_raw": "branch"}),
+		},
+	})
+
+	model := NewRedshiftModel(svc, testTagConfig)
+	clusters, excluded, err := model.GetRedshiftClustersForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, clusters, 2)
+	assert.Equal(t, "demo-app-feat-branch", *clusters[0].ClusterIdentifier)
+	assert.Equal(t, "demo-app-feat-branch-2", *clusters[1].ClusterIdentifier)
+	assert.Empty(t, excluded)
+}
+
+func TestGetRedshiftClustersForTagsExcluded(t *testing.T) {
+	svc := new(mocks.RedshiftAPI)
+	mockDescribeClustersPages(svc, nil, &redshift.DescribeClustersOutput{
+		Clusters: []*redshift.Cluster{
+			newRedshiftCluster("demo-app-feat-branch", "available", map[string]string{"repository": "repo", "branch_raw": "branch", "auto-staging:schedule": "ignore"}),
+		},
+	})
+
+	model := NewRedshiftModel(svc, testExcludeTagConfig)
+	clusters, excluded, err := model.GetRedshiftClustersForTags(context.Background(), "repo", "branch")
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Empty(t, clusters)
+	assert.Len(t, excluded, 1)
+}
+
+func TestGetRedshiftClustersForTagsDescribeError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.RedshiftAPI)
+	mockDescribeClustersPages(svc, errorMsg)
+
+	model := NewRedshiftModel(svc, testTagConfig)
+	_, _, err := model.GetRedshiftClustersForTags(context.Background(), "repo", "branch")
+
+	assert.Equal(t, errorMsg, err)
+}
+
+func TestPauseRedshiftCluster(t *testing.T) {
+	clusterIdentifier := aws.String("demo-app-feat-branch")
+
+	svc := new(mocks.RedshiftAPI)
+	svc.On("PauseClusterWithContext", mock.Anything, mock.AnythingOfType("*redshift.PauseClusterInput")).Return(&redshift.PauseClusterOutput{}, nil)
+
+	model := NewRedshiftModel(svc, testTagConfig)
+	changed, err := model.PauseRedshiftCluster(context.Background(), clusterIdentifier, aws.String("available"))
+
+	assert.Nil(t, err, "Expected no error")
+	assert.True(t, changed, "Expected changed to be true")
+	svc.AssertCalled(t, "PauseClusterWithContext", mock.Anything, &redshift.PauseClusterInput{
+		ClusterIdentifier: clusterIdentifier,
+	})
+}
+
+func TestPauseRedshiftClusterWrongStatus(t *testing.T) {
+	svc := new(mocks.RedshiftAPI)
+
+	model := NewRedshiftModel(svc, testTagConfig)
+	changed, err := model.PauseRedshiftCluster(context.Background(), aws.String("demo-app-feat-branch"), aws.String("paused"))
+
+	assert.Nil(t, err, "Expected no error")
+	assert.False(t, changed, "Expected changed to be false")
+	svc.AssertNotCalled(t, "PauseClusterWithContext", mock.Anything, mock.Anything)
+}
+
+func TestPauseRedshiftClusterError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.RedshiftAPI)
+	svc.On("PauseClusterWithContext", mock.Anything, mock.AnythingOfType("*redshift.PauseClusterInput")).Return(nil, errorMsg)
+
+	model := NewRedshiftModel(svc, testTagConfig)
+	changed, err := model.PauseRedshiftCluster(context.Background(), aws.String("demo-app-feat-branch"), aws.String("available"))
+
+	assert.Equal(t, errorMsg, err)
+	assert.False(t, changed, "Expected changed to be false")
+}
+
+func TestResumeRedshiftCluster(t *testing.T) {
+	clusterIdentifier := aws.String("demo-app-feat-branch")
+
+	svc := new(mocks.RedshiftAPI)
+	svc.On("ResumeClusterWithContext", mock.Anything, mock.AnythingOfType("*redshift.ResumeClusterInput")).Return(&redshift.ResumeClusterOutput{}, nil)
+
+	model := NewRedshiftModel(svc, testTagConfig)
+	changed, err := model.ResumeRedshiftCluster(context.Background(), clusterIdentifier, aws.String("paused"))
+
+	assert.Nil(t, err, "Expected no error")
+	assert.True(t, changed, "Expected changed to be true")
+	svc.AssertCalled(t, "ResumeClusterWithContext", mock.Anything, &redshift.ResumeClusterInput{
+		ClusterIdentifier: clusterIdentifier,
+	})
+}
+
+func TestResumeRedshiftClusterWrongStatus(t *testing.T) {
+	svc := new(mocks.RedshiftAPI)
+
+	model := NewRedshiftModel(svc, testTagConfig)
+	changed, err := model.ResumeRedshiftCluster(context.Background(), aws.String("demo-app-feat-branch"), aws.String("pausing"))
+
+	assert.Nil(t, err, "Expected no error")
+	assert.False(t, changed, "Expected changed to be false")
+	svc.AssertNotCalled(t, "ResumeClusterWithContext", mock.Anything, mock.Anything)
+}
+
+func TestIsRedshiftActionRequired(t *testing.T) {
+	assert.True(t, IsRedshiftActionRequired("stop", "available"))
+	assert.False(t, IsRedshiftActionRequired("stop", "paused"))
+	assert.True(t, IsRedshiftActionRequired("start", "paused"))
+	assert.False(t, IsRedshiftActionRequired("start", "resuming"))
+	assert.False(t, IsRedshiftActionRequired("restart", "available"))
+}
+
+func TestWaitUntilRedshiftClusterPaused(t *testing.T) {
+	defer func(previous time.Duration) { redshiftPollInterval = previous }(redshiftPollInterval)
+	redshiftPollInterval = time.Millisecond
+
+	clusterIdentifier := aws.String("demo-app-feat-branch")
+
+	svc := new(mocks.RedshiftAPI)
+	svc.On("DescribeClustersWithContext", mock.Anything, mock.AnythingOfType("*redshift.DescribeClustersInput")).Return(&redshift.DescribeClustersOutput{
+		Clusters: []*redshift.Cluster{newRedshiftCluster(*clusterIdentifier, "pausing", nil)},
+	}, nil).Once()
+	svc.On("DescribeClustersWithContext", mock.Anything, mock.AnythingOfType("*redshift.DescribeClustersInput")).Return(&redshift.DescribeClustersOutput{
+		Clusters: []*redshift.Cluster{newRedshiftCluster(*clusterIdentifier, "paused", nil)},
+	}, nil).Once()
+
+	model := NewRedshiftModel(svc, testTagConfig)
+	err := model.WaitUntilRedshiftClusterPaused(context.Background(), clusterIdentifier)
+
+	assert.Nil(t, err, "Expected no error")
+	svc.AssertNumberOfCalls(t, "DescribeClustersWithContext", 2)
+	svc.AssertCalled(t, "DescribeClustersWithContext", mock.Anything, &redshift.DescribeClustersInput{
+		ClusterIdentifier: clusterIdentifier,
+	})
+}
+
+func TestWaitUntilRedshiftClusterAvailableCancelled(t *testing.T) {
+	svc := new(mocks.RedshiftAPI)
+	svc.On("DescribeClustersWithContext", mock.Anything, mock.AnythingOfType("*redshift.DescribeClustersInput")).Return(&redshift.DescribeClustersOutput{
+		Clusters: []*redshift.Cluster{newRedshiftCluster("demo-app-feat-branch", "resuming", nil)},
+	}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	model := NewRedshiftModel(svc, testTagConfig)
+	err := model.WaitUntilRedshiftClusterAvailable(ctx, aws.String("demo-app-feat-branch"))
+
+	assert.Equal(t, context.Canceled, err, "Expected context error")
+}
+
+func TestWaitUntilRedshiftClusterAvailableError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svc := new(mocks.RedshiftAPI)
+	svc.On("DescribeClustersWithContext", mock.Anything, mock.AnythingOfType("*redshift.DescribeClustersInput")).Return(nil, errorMsg)
+
+	model := NewRedshiftModel(svc, testTagConfig)
+	err := model.WaitUntilRedshiftClusterAvailable(context.Background(), aws.String("demo-app-feat-branch"))
+
+	assert.Equal(t, errorMsg, err)
+}
@@ -2,9 +2,13 @@ package model
 
 import (
 	"log"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/auto-staging/scheduler/types"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
 	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
@@ -13,54 +17,160 @@ import (
 // StatusModelAPI is an interface including all Status model functions
 type StatusModelAPI interface {
 	SetStatusForEnvironment(repository, branch, status string) error
+	SetFailedStatusForEnvironment(repository, branch, status, errorMessage string) error
+	AddHistoryEntry(repository, branch string, entry types.HistoryEntry) error
 }
 
-// StatusModel is a struct including the AWS SDK DynamoDB interface, all status change functions are called on this struct and the included AWS SDK DynamoDB service
+// historyAttribute is the name of the list attribute containing the history of an Environment
+const historyAttribute = "history"
+
+// now returns the current time, it is used as timestamp of the last status transition
+var now = time.Now
+
+// StatusModel is a struct including the AWS SDK DynamoDB interface, all status change functions are called on this struct and the included AWS SDK DynamoDB service.
+// The status gets written to the table configured in the StatusTableConfig.
 type StatusModel struct {
 	dynamodbiface.DynamoDBAPI
+	config types.StatusTableConfig
 }
 
-// NewStatusModel takes the AWS SDK DynamoDB Interface as parameter and returns the pointer to an StatusModel struct, on which status change model functions can be called
-func NewStatusModel(svc dynamodbiface.DynamoDBAPI) *StatusModel {
+// NewStatusModel takes the AWS SDK DynamoDB Interface and the status table config as parameter and returns the pointer to an StatusModel struct,
+// on which status change model functions can be called
+func NewStatusModel(svc dynamodbiface.DynamoDBAPI, config types.StatusTableConfig) *StatusModel {
 	return &StatusModel{
 		DynamoDBAPI: svc,
+		config:      config,
 	}
 }
 
-// SetStatusForEnvironment updates the status for the Environment given in the parameters to the status given in the parameters.
+// SetStatusForEnvironment updates the status for the Environment given in the parameters to the status given in the parameters (e.g. "running" or "starting").
+// The time of the transition gets stored as lastTransition and the error message of a previous failure gets removed.
 // If an error occurs the error gets logged and the returned.
 func (statusModel *StatusModel) SetStatusForEnvironment(repository, branch, status string) error {
-	updateStruct := types.StatusUpdate{
+	return statusModel.updateStatus(repository, branch, types.StatusUpdate{
 		Status: status,
-	}
+	})
+}
+
+// SetFailedStatusForEnvironment updates the status for the Environment given in the parameters to the failure status given in the parameters
+// (e.g. "start failed") and stores the error message as errorMessage. The time of the transition gets stored as lastTransition.
+// If an error occurs the error gets logged and the returned.
+func (statusModel *StatusModel) SetFailedStatusForEnvironment(repository, branch, status, errorMessage string) error {
+	return statusModel.updateStatus(repository, branch, types.StatusUpdate{
+		Status:       status,
+		ErrorMessage: errorMessage,
+	})
+}
+
+// updateStatus writes the given status update for the Environment, the lastTransition timestamp is set to the current time in RFC 3339 format.
+func (statusModel *StatusModel) updateStatus(repository, branch string, updateStruct types.StatusUpdate) error {
+	updateStruct.LastTransition = now().UTC().Format(time.RFC3339)
 	update, err := dynamodbattribute.MarshalMap(updateStruct)
 	if err != nil {
 		log.Println(err)
 		return err
 	}
 
-	input := &dynamodb.UpdateItemInput{
-		TableName: aws.String("auto-staging-environments"),
+	updateExpression := "SET #status = :status, lastTransition = :lastTransition REMOVE errorMessage"
+	if updateStruct.ErrorMessage != "" {
+		updateExpression = "SET #status = :status, lastTransition = :lastTransition, errorMessage = :errorMessage"
+	}
+
+	input := statusModel.newUpdateItemInput(repository, branch)
+	input.ExpressionAttributeNames["#status"] = aws.String("status") // Workaround reserved keywoard issue
+	input.UpdateExpression = aws.String(updateExpression)
+	input.ExpressionAttributeValues = update
+	_, err = statusModel.DynamoDBAPI.UpdateItem(input)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	return nil
+}
+
+// AddHistoryEntry appends the given entry to the history of the Environment, the timestamp of the entry is set to the current time in RFC 3339 format.
+// If the history contains more entries than the configured history size afterwards, the oldest entries get removed. If the history is disabled, nothing is written.
+// If an error occurs the error gets logged and the returned.
+func (statusModel *StatusModel) AddHistoryEntry(repository, branch string, entry types.HistoryEntry) error {
+	if statusModel.config.HistorySize == 0 {
+		return nil
+	}
+
+	entry.Timestamp = now().UTC().Format(time.RFC3339)
+	item, err := dynamodbattribute.MarshalMap(entry)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	input := statusModel.newUpdateItemInput(repository, branch)
+	input.ExpressionAttributeNames["#history"] = aws.String(historyAttribute)
+	input.UpdateExpression = aws.String("SET #history = list_append(if_not_exists(#history, :empty), :entry)")
+	input.ExpressionAttributeValues = map[string]*dynamodb.AttributeValue{
+		":empty": {L: []*dynamodb.AttributeValue{}},
+		":entry": {L: []*dynamodb.AttributeValue{{M: item}}},
+	}
+	input.ReturnValues = aws.String(dynamodb.ReturnValueUpdatedNew)
+	result, err := statusModel.DynamoDBAPI.UpdateItem(input)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	length := 0
+	if history, ok := result.Attributes[historyAttribute]; ok {
+		length = len(history.L)
+	}
+	if length <= statusModel.config.HistorySize {
+		return nil
+	}
+	return statusModel.trimHistory(repository, branch, length)
+}
+
+// trimHistory removes the oldest entries from the history of the Environment, so it contains the configured number of entries.
+// The entries are only removed, if the history still has the given length. Otherwise it was changed in the meantime and gets trimmed by the next update.
+func (statusModel *StatusModel) trimHistory(repository, branch string, length int) error {
+	removals := []string{}
+	for i := 0; i < length-statusModel.config.HistorySize; i++ {
+		removals = append(removals, "#history["+strconv.Itoa(i)+"]")
+	}
+
+	input := statusModel.newUpdateItemInput(repository, branch)
+	input.ExpressionAttributeNames["#history"] = aws.String(historyAttribute)
+	input.UpdateExpression = aws.String("REMOVE " + strings.Join(removals, ", "))
+	input.ExpressionAttributeValues = map[string]*dynamodb.AttributeValue{
+		":length": {N: aws.String(strconv.Itoa(length))},
+	}
+	input.ConditionExpression = aws.String(*input.ConditionExpression + " AND size(#history) = :length")
+	_, err := statusModel.DynamoDBAPI.UpdateItem(input)
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+		log.Println("History was changed in the meantime, skipped trimming")
+		return nil
+	}
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	return nil
+}
+
+// newUpdateItemInput returns an update for the item of the Environment, which only succeeds if the Environment exists
+func (statusModel *StatusModel) newUpdateItemInput(repository, branch string) *dynamodb.UpdateItemInput {
+	return &dynamodb.UpdateItemInput{
+		TableName: aws.String(statusModel.config.TableName),
 		ExpressionAttributeNames: map[string]*string{
-			"#status": aws.String("status"), // Workaround reserved keywoard issue
+			"#repository": aws.String(statusModel.config.RepositoryKey),
+			"#branch":     aws.String(statusModel.config.BranchKey),
 		},
 		Key: map[string]*dynamodb.AttributeValue{
-			"repository": {
+			statusModel.config.RepositoryKey: {
 				S: aws.String(repository),
 			},
-			"branch": {
+			statusModel.config.BranchKey: {
 				S: aws.String(branch),
 			},
 		},
-		UpdateExpression:          aws.String("SET #status = :status"),
-		ExpressionAttributeValues: update,
-		ConditionExpression:       aws.String("attribute_exists(repository) AND attribute_exists(branch)"),
+		ConditionExpression: aws.String("attribute_exists(#repository) AND attribute_exists(#branch)"),
 	}
-	_, err = statusModel.DynamoDBAPI.UpdateItem(input)
-	if err != nil {
-		log.Println(err)
-		return err
-	}
-
-	return nil
 }
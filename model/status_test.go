@@ -2,20 +2,33 @@ package model
 
 import (
 	"errors"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/auto-staging/scheduler/mocks"
+	"github.com/auto-staging/scheduler/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
+var testStatusTableConfig = types.StatusTableConfig{
+	TableName:     "auto-staging-environments",
+	RepositoryKey: "repository",
+	BranchKey:     "branch",
+}
+
 func TestNewStatusModel(t *testing.T) {
 	svc := new(mocks.DynamoDBAPI)
 
-	model := NewStatusModel(svc)
+	model := NewStatusModel(svc, testStatusTableConfig)
 
 	assert.NotEmpty(t, model, "Expected not empty")
 	assert.Equal(t, svc, model.DynamoDBAPI, "DynamoDB service from model is not matching the one used as parameter")
+	assert.Equal(t, testStatusTableConfig, model.config, "Config from model is not matching the one used as parameter")
 }
 
 func TestSetStatusForEnvironment(t *testing.T) {
@@ -24,20 +37,229 @@ func TestSetStatusForEnvironment(t *testing.T) {
 
 	statusHelper := StatusModel{
 		DynamoDBAPI: svc,
+		config:      testStatusTableConfig,
 	}
 
 	err := statusHelper.SetStatusForEnvironment("", "", "running")
 	assert.Nil(t, err, "Expected no error")
 }
 
+func TestSetStatusForEnvironmentTransition(t *testing.T) {
+	defer func(previous func() time.Time) { now = previous }(now)
+	now = func() time.Time {
+		return time.Date(2019, 7, 24, 19, 0, 0, 0, time.UTC)
+	}
+
+	svc := new(mocks.DynamoDBAPI)
+	svc.On("UpdateItem", mock.AnythingOfType("*dynamodb.UpdateItemInput")).Return(nil, nil)
+
+	statusHelper := StatusModel{
+		DynamoDBAPI: svc,
+		config:      testStatusTableConfig,
+	}
+
+	err := statusHelper.SetStatusForEnvironment("repo", "branch", "starting")
+	assert.Nil(t, err, "Expected no error")
+
+	input := svc.Calls[0].Arguments.Get(0).(*dynamodb.UpdateItemInput)
+	assertExpressionAttributesUsed(t, input)
+	assert.Equal(t, "SET #status = :status, lastTransition = :lastTransition REMOVE errorMessage", *input.UpdateExpression)
+	assert.Equal(t, map[string]*dynamodb.AttributeValue{
+		":status":         {S: aws.String("starting")},
+		":lastTransition": {S: aws.String("2019-07-24T19:00:00Z")},
+	}, input.ExpressionAttributeValues)
+}
+
+func TestSetFailedStatusForEnvironment(t *testing.T) {
+	defer func(previous func() time.Time) { now = previous }(now)
+	now = func() time.Time {
+		return time.Date(2019, 7, 24, 19, 0, 0, 0, time.UTC)
+	}
+
+	svc := new(mocks.DynamoDBAPI)
+	svc.On("UpdateItem", mock.AnythingOfType("*dynamodb.UpdateItemInput")).Return(nil, nil)
+
+	statusHelper := StatusModel{
+		DynamoDBAPI: svc,
+		config:      testStatusTableConfig,
+	}
+
+	err := statusHelper.SetFailedStatusForEnvironment("repo", "branch", "stop failed", "Test error")
+	assert.Nil(t, err, "Expected no error")
+
+	input := svc.Calls[0].Arguments.Get(0).(*dynamodb.UpdateItemInput)
+	assertExpressionAttributesUsed(t, input)
+	assert.Equal(t, "SET #status = :status, lastTransition = :lastTransition, errorMessage = :errorMessage", *input.UpdateExpression)
+	assert.Equal(t, map[string]*dynamodb.AttributeValue{
+		":status":         {S: aws.String("stop failed")},
+		":errorMessage":   {S: aws.String("Test error")},
+		":lastTransition": {S: aws.String("2019-07-24T19:00:00Z")},
+	}, input.ExpressionAttributeValues)
+}
+
+func TestSetFailedStatusForEnvironmentError(t *testing.T) {
+	svc := new(mocks.DynamoDBAPI)
+	svc.On("UpdateItem", mock.AnythingOfType("*dynamodb.UpdateItemInput")).Return(nil, errors.New("Test error"))
+
+	statusHelper := StatusModel{
+		DynamoDBAPI: svc,
+		config:      testStatusTableConfig,
+	}
+
+	err := statusHelper.SetFailedStatusForEnvironment("", "", "start failed", "Test error")
+	assert.Error(t, err, "Expected error")
+}
+
 func TestSetStatusForEnvironmentError(t *testing.T) {
 	svc := new(mocks.DynamoDBAPI)
 	svc.On("UpdateItem", mock.AnythingOfType("*dynamodb.UpdateItemInput")).Return(nil, errors.New("Test error"))
 
 	statusHelper := StatusModel{
 		DynamoDBAPI: svc,
+		config:      testStatusTableConfig,
 	}
 
 	err := statusHelper.SetStatusForEnvironment("", "", "running")
 	assert.Error(t, err, "Expected error")
 }
+
+func TestSetStatusForEnvironmentCustomTable(t *testing.T) {
+	svc := new(mocks.DynamoDBAPI)
+	svc.On("UpdateItem", mock.AnythingOfType("*dynamodb.UpdateItemInput")).Return(nil, nil)
+
+	statusHelper := NewStatusModel(svc, types.StatusTableConfig{
+		TableName:     "platform-environments",
+		RepositoryKey: "app",
+		BranchKey:     "git-branch",
+	})
+
+	err := statusHelper.SetStatusForEnvironment("repo", "branch", "running")
+	assert.Nil(t, err, "Expected no error")
+
+	input := svc.Calls[0].Arguments.Get(0).(*dynamodb.UpdateItemInput)
+	assertExpressionAttributesUsed(t, input)
+	assert.Equal(t, "platform-environments", *input.TableName)
+	assert.Equal(t, map[string]*dynamodb.AttributeValue{
+		"app":        {S: aws.String("repo")},
+		"git-branch": {S: aws.String("branch")},
+	}, input.Key)
+	assert.Equal(t, "app", *input.ExpressionAttributeNames["#repository"])
+	assert.Equal(t, "git-branch", *input.ExpressionAttributeNames["#branch"])
+	assert.Equal(t, "attribute_exists(#repository) AND attribute_exists(#branch)", *input.ConditionExpression)
+}
+
+// assertExpressionAttributesUsed asserts that every expression attribute name and value of the input is used in its update or condition expression,
+// because DynamoDB rejects updates with unused expression attributes.
+func assertExpressionAttributesUsed(t *testing.T, input *dynamodb.UpdateItemInput) {
+	expressions := aws.StringValue(input.UpdateExpression) + " " + aws.StringValue(input.ConditionExpression)
+	for name := range input.ExpressionAttributeNames {
+		assert.Regexp(t, regexp.QuoteMeta(name)+`\b`, expressions, "Expected expression attribute name to be used")
+	}
+	for value := range input.ExpressionAttributeValues {
+		assert.Regexp(t, regexp.QuoteMeta(value)+`\b`, expressions, "Expected expression attribute value to be used")
+	}
+}
+
+// newHistoryOutput returns the UpdateItem output containing a history with the given number of entries
+func newHistoryOutput(length int) *dynamodb.UpdateItemOutput {
+	history := []*dynamodb.AttributeValue{}
+	for i := 0; i < length; i++ {
+		history = append(history, &dynamodb.AttributeValue{M: map[string]*dynamodb.AttributeValue{}})
+	}
+	return &dynamodb.UpdateItemOutput{
+		Attributes: map[string]*dynamodb.AttributeValue{
+			"history": {L: history},
+		},
+	}
+}
+
+func TestAddHistoryEntry(t *testing.T) {
+	defer func(previous func() time.Time) { now = previous }(now)
+	now = func() time.Time {
+		return time.Date(2019, 7, 24, 19, 0, 0, 0, time.UTC)
+	}
+
+	svc := new(mocks.DynamoDBAPI)
+	svc.On("UpdateItem", mock.AnythingOfType("*dynamodb.UpdateItemInput")).Return(newHistoryOutput(2), nil)
+
+	config := testStatusTableConfig
+	config.HistorySize = 10
+	statusHelper := NewStatusModel(svc, config)
+
+	err := statusHelper.AddHistoryEntry("repo", "branch", types.HistoryEntry{
+		Action:    "stop",
+		Source:    "cloudwatch",
+		Resources: []string{"i-1234567890abcdef0"},
+		Result:    "stopped",
+	})
+	assert.Nil(t, err, "Expected no error")
+	svc.AssertNumberOfCalls(t, "UpdateItem", 1)
+
+	input := svc.Calls[0].Arguments.Get(0).(*dynamodb.UpdateItemInput)
+	assertExpressionAttributesUsed(t, input)
+	assert.Equal(t, "SET #history = list_append(if_not_exists(#history, :empty), :entry)", *input.UpdateExpression)
+	assert.Equal(t, "history", *input.ExpressionAttributeNames["#history"])
+	assert.Equal(t, map[string]*dynamodb.AttributeValue{
+		"timestamp": {S: aws.String("2019-07-24T19:00:00Z")},
+		"action":    {S: aws.String("stop")},
+		"source":    {S: aws.String("cloudwatch")},
+		"resources": {L: []*dynamodb.AttributeValue{{S: aws.String("i-1234567890abcdef0")}}},
+		"result":    {S: aws.String("stopped")},
+	}, input.ExpressionAttributeValues[":entry"].L[0].M)
+}
+
+func TestAddHistoryEntryTrim(t *testing.T) {
+	svc := new(mocks.DynamoDBAPI)
+	svc.On("UpdateItem", mock.AnythingOfType("*dynamodb.UpdateItemInput")).Return(newHistoryOutput(5), nil)
+
+	config := testStatusTableConfig
+	config.HistorySize = 3
+	statusHelper := NewStatusModel(svc, config)
+
+	err := statusHelper.AddHistoryEntry("repo", "branch", types.HistoryEntry{Action: "start"})
+	assert.Nil(t, err, "Expected no error")
+	svc.AssertNumberOfCalls(t, "UpdateItem", 2)
+
+	input := svc.Calls[1].Arguments.Get(0).(*dynamodb.UpdateItemInput)
+	assertExpressionAttributesUsed(t, input)
+	assert.Equal(t, "REMOVE #history[0], #history[1]", *input.UpdateExpression)
+	assert.Equal(t, "attribute_exists(#repository) AND attribute_exists(#branch) AND size(#history) = :length", *input.ConditionExpression)
+	assert.Equal(t, "5", *input.ExpressionAttributeValues[":length"].N)
+}
+
+func TestAddHistoryEntryTrimConcurrentUpdate(t *testing.T) {
+	svc := new(mocks.DynamoDBAPI)
+	svc.On("UpdateItem", mock.MatchedBy(func(input *dynamodb.UpdateItemInput) bool {
+		return input.ReturnValues != nil
+	})).Return(newHistoryOutput(4), nil)
+	svc.On("UpdateItem", mock.AnythingOfType("*dynamodb.UpdateItemInput")).Return(nil, awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "condition failed", nil))
+
+	config := testStatusTableConfig
+	config.HistorySize = 3
+	statusHelper := NewStatusModel(svc, config)
+
+	err := statusHelper.AddHistoryEntry("repo", "branch", types.HistoryEntry{Action: "start"})
+	assert.Nil(t, err, "Expected no error, the history gets trimmed by the next update")
+}
+
+func TestAddHistoryEntryError(t *testing.T) {
+	svc := new(mocks.DynamoDBAPI)
+	svc.On("UpdateItem", mock.AnythingOfType("*dynamodb.UpdateItemInput")).Return(nil, errors.New("aws-error"))
+
+	config := testStatusTableConfig
+	config.HistorySize = 3
+	statusHelper := NewStatusModel(svc, config)
+
+	err := statusHelper.AddHistoryEntry("repo", "branch", types.HistoryEntry{Action: "start"})
+	assert.Equal(t, errors.New("aws-error"), err)
+}
+
+func TestAddHistoryEntryDisabled(t *testing.T) {
+	svc := new(mocks.DynamoDBAPI)
+
+	statusHelper := NewStatusModel(svc, testStatusTableConfig)
+
+	err := statusHelper.AddHistoryEntry("repo", "branch", types.HistoryEntry{Action: "start"})
+	assert.Nil(t, err, "Expected no error")
+	svc.AssertNotCalled(t, "UpdateItem", mock.Anything)
+}
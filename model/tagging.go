@@ -0,0 +1,67 @@
+package model
+
+import (
+	"context"
+	"log"
+
+	"github.com/auto-staging/scheduler/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+)
+
+// getResourceARNsForTags returns the ARNs of all resources of the given type (e.g. "rds:cluster") tagged with the given repository, branch and the required tags
+// of the tag config by using the Resource Groups Tagging API. The ARNs of the resources with the exclude tag are additionally returned as set.
+func getResourceARNsForTags(ctx context.Context, svc resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI, tagConfig types.TagConfig, resourceType, repository, branch string) ([]*string, map[string]bool, error) {
+	resources, err := getResourceTagsForTags(ctx, svc, tagConfig, resourceType, repository, branch)
+	if err != nil {
+		return nil, nil, err
+	}
+	resourceARNs := []*string{}
+	excludedARNs := map[string]bool{}
+	for _, resource := range resources {
+		resourceARNs = append(resourceARNs, resource.ARN)
+		if tagConfig.IsExcluded(resource.Tags) {
+			excludedARNs[*resource.ARN] = true
+		}
+	}
+	return resourceARNs, excludedARNs, nil
+}
+
+// resourceTags is the ARN of a resource found by the Resource Groups Tagging API with all its tags
+type resourceTags struct {
+	ARN  *string
+	Tags map[string]string
+}
+
+// getResourceTagsForTags returns the ARNs and tags of all resources of the given type (e.g. "elasticache:snapshot") tagged with the given repository, branch
+// and the required tags of the tag config by using the Resource Groups Tagging API.
+func getResourceTagsForTags(ctx context.Context, svc resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI, tagConfig types.TagConfig, resourceType, repository, branch string) ([]resourceTags, error) {
+	resources := []resourceTags{}
+	tags := tagConfig.TagsForEnvironment(repository, branch)
+	tagFilters := []*resourcegroupstaggingapi.TagFilter{}
+	for _, key := range tagConfig.TagKeysForEnvironment() {
+		tagFilters = append(tagFilters, &resourcegroupstaggingapi.TagFilter{
+			Key:    aws.String(key),
+			Values: []*string{aws.String(tags[key])},
+		})
+	}
+	err := svc.GetResourcesPagesWithContext(ctx, &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []*string{aws.String(resourceType)},
+		TagFilters:          tagFilters,
+	}, func(result *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+		for _, resource := range result.ResourceTagMappingList {
+			tagMap := map[string]string{}
+			for _, tag := range resource.Tags {
+				tagMap[*tag.Key] = *tag.Value
+			}
+			resources = append(resources, resourceTags{ARN: resource.ResourceARN, Tags: tagMap})
+		}
+		return true
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return resources, nil
+}
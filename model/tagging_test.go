@@ -0,0 +1,58 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-staging/scheduler/mocks"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockGetResourcesPages mocks GetResourcesPages, the given pages are passed one after another to the callback function
+func mockGetResourcesPages(svc *mocks.ResourceGroupsTaggingAPIAPI, err error, pages ...*resourcegroupstaggingapi.GetResourcesOutput) {
+	svc.On("GetResourcesPagesWithContext", mock.Anything, mock.AnythingOfType("*resourcegroupstaggingapi.GetResourcesInput"), mock.AnythingOfType("func(*resourcegroupstaggingapi.GetResourcesOutput, bool) bool")).Run(func(args mock.Arguments) {
+		if err != nil {
+			return
+		}
+		fn := args.Get(2).(func(*resourcegroupstaggingapi.GetResourcesOutput, bool) bool)
+		for i := range pages {
+			if !fn(pages[i], i == len(pages)-1) {
+				return
+			}
+		}
+	}).Return(err)
+}
+
+func TestGetResourceARNsForTagsExcluded(t *testing.T) {
+	taggingSvc := new(mocks.ResourceGroupsTaggingAPIAPI)
+	mockGetResourcesPages(taggingSvc, nil, &resourcegroupstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{
+			{ResourceARN: aws.String("arn:aws:ecs:eu-central-1:123456789012:service/test-cluster/test-service")},
+			{
+				ResourceARN: aws.String("arn:aws:ecs:eu-central-1:123456789012:service/test-cluster/ignored-service"),
+				Tags: []*resourcegroupstaggingapi.Tag{
+					{Key: aws.String("auto-staging:schedule"), Value: aws.String("ignore")},
+				},
+			},
+		},
+	})
+
+	resourceARNs, excludedARNs, err := getResourceARNsForTags(context.Background(), taggingSvc, testExcludeTagConfig, "ecs:service", "repo", "branch")
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, aws.StringSlice([]string{
+		"arn:aws:ecs:eu-central-1:123456789012:service/test-cluster/test-service",
+		"arn:aws:ecs:eu-central-1:123456789012:service/test-cluster/ignored-service",
+	}), resourceARNs)
+	assert.Equal(t, map[string]bool{"arn:aws:ecs:eu-central-1:123456789012:service/test-cluster/ignored-service": true}, excludedARNs)
+	taggingSvc.AssertCalled(t, "GetResourcesPagesWithContext", mock.Anything, &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []*string{aws.String("ecs:service")},
+		TagFilters: []*resourcegroupstaggingapi.TagFilter{
+			{Key: aws.String("repository"), Values: []*string{aws.String("repo")}},
+			{Key: aws.String("branch_raw"), Values: []*string{aws.String("branch")}},
+		},
+	}, mock.Anything)
+}
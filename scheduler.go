@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+
+	"github.com/auto-staging/scheduler/types"
+)
+
+// ResourceScheduler starts and stops all resources of one resource type of an Environment. A new resource type is added by implementing this interface
+// and adding the constructor of the implementation to the schedulerRegistry. The context given to the methods is passed to all AWS calls, so they are
+// cancelled once the invocation runs out of time.
+type ResourceScheduler interface {
+	// Describe returns the resource type of the scheduler, which is used in the phases of the event (e.g. "ec2").
+	Describe() string
+	// Discover returns the resources of the Environment with their current state without changing them, excluded resources are listed as skipped.
+	Discover(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error)
+	// Start starts the resources of the Environment and returns the state change of every resource. If dryRun is set in the event, no resource gets
+	// changed and the changes which would be made are returned instead.
+	Start(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error)
+	// Stop stops the resources of the Environment and returns the state change of every resource. If dryRun is set in the event, no resource gets
+	// changed and the changes which would be made are returned instead.
+	Stop(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error)
+	// Wait waits until the changed resources of the scheduler reached their final state for the given action and updates their new state in the given
+	// changes. Changes of other resource types are ignored.
+	Wait(ctx context.Context, action string, changes []types.ResourceChange) error
+}
+
+// schedulerFactory returns the ResourceScheduler of one resource type with clients using the given AWS config
+type schedulerFactory func(sess *session.Session, config *aws.Config) ResourceScheduler
+
+// schedulerRegistry contains the constructors of the schedulers of all resource types. Resource types which aren't part of any phase are changed in the
+// last phase in the order of the registry.
+var schedulerRegistry = []schedulerFactory{
+	newASGScheduler,
+	newEC2Scheduler,
+	newECSScheduler,
+	newEKSScheduler,
+	newRDSScheduler,
+	newRedshiftScheduler,
+	newDocDBScheduler,
+	newElastiCacheScheduler,
+}
+
+// newSchedulers returns the schedulers of all registered resource types with clients using the given AWS config
+func newSchedulers(sess *session.Session, config *aws.Config) []ResourceScheduler {
+	schedulers := []ResourceScheduler{}
+	for _, newScheduler := range schedulerRegistry {
+		schedulers = append(schedulers, newScheduler(sess, config))
+	}
+	return schedulers
+}
+
+// handlerForAction returns the resource handler of the scheduler for the given action. For other actions than start and stop, the resources of the
+// Environment are only discovered and listed as skipped.
+func handlerForAction(scheduler ResourceScheduler, action string) resourceHandler {
+	switch action {
+	case "start":
+		return scheduler.Start
+	case "stop":
+		return scheduler.Stop
+	}
+	return skipHandler(scheduler, fmt.Sprintf("unknown action %q", action))
+}
+
+// skipHandler returns a resource handler, which only discovers the resources of the scheduler and lists them as skipped with the given reason.
+func skipHandler(scheduler ResourceScheduler, reason string) resourceHandler {
+	return func(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+		changes, err := scheduler.Discover(ctx, cwEvent)
+		for i := range changes {
+			if !changes[i].Skipped() {
+				changes[i].SkippedReason = reason
+			}
+		}
+		return changes, err
+	}
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+
+	"github.com/auto-staging/scheduler/model"
+	"github.com/auto-staging/scheduler/types"
+)
+
+// asgScheduler scales the autoscaling groups of an Environment to zero on stop and back to their previous size on start
+type asgScheduler struct {
+	model.ASGModelAPI
+}
+
+// newASGScheduler returns the scheduler of the "asg" resource type with an autoscaling client using the given AWS config
+func newASGScheduler(sess *session.Session, config *aws.Config) ResourceScheduler {
+	return &asgScheduler{
+		ASGModelAPI: model.NewASGModel(autoscaling.New(sess, config), tagConfig),
+	}
+}
+
+// Describe returns the resource type "asg"
+func (scheduler *asgScheduler) Describe() string {
+	return "asg"
+}
+
+// Discover returns the autoscaling groups of the Environment with their current size
+func (scheduler *asgScheduler) Discover(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	changes := []types.ResourceChange{}
+	autoscalingGroups, excluded, err := scheduler.ASGModelAPI.DescribeAutoScalingGroupsForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, err
+	}
+	for _, autoscalingGroup := range excluded {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "autoscaling-group",
+			Identifier:    *autoscalingGroup.AutoScalingGroupName,
+			Action:        cwEvent.Action,
+			PreviousState: asgSize(autoscalingGroup).String(),
+			SkippedReason: excludedReason,
+		})
+	}
+	for _, autoscalingGroup := range autoscalingGroups {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "autoscaling-group",
+			Identifier:    *autoscalingGroup.AutoScalingGroupName,
+			Action:        cwEvent.Action,
+			PreviousState: asgSize(autoscalingGroup).String(),
+		})
+	}
+	return changes, nil
+}
+
+// Start scales the autoscaling groups of the Environment back to the size stored in their tags
+func (scheduler *asgScheduler) Start(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	cwEvent.Action = "start"
+	return scheduler.changeState(ctx, cwEvent)
+}
+
+// Stop scales the autoscaling groups of the Environment to zero and stores their size in their tags
+func (scheduler *asgScheduler) Stop(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	cwEvent.Action = "stop"
+	return scheduler.changeState(ctx, cwEvent)
+}
+
+// Wait waits until all desired instances of the changed autoscaling groups are in service
+func (scheduler *asgScheduler) Wait(ctx context.Context, action string, changes []types.ResourceChange) error {
+	var errs types.MultiError
+	for _, change := range changes {
+		if change.Kind != "autoscaling-group" || change.Skipped() {
+			continue
+		}
+		errs = errs.Append(scheduler.ASGModelAPI.WaitUntilASGInService(ctx, aws.String(change.Identifier)))
+	}
+	return errs.ErrorOrNil()
+}
+
+func (scheduler *asgScheduler) changeState(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	changes := []types.ResourceChange{}
+	var errs types.MultiError
+	autoscalingGroups, excluded, err := scheduler.ASGModelAPI.DescribeAutoScalingGroupsForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, err
+	}
+	for _, autoscalingGroup := range excluded {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "autoscaling-group",
+			Identifier:    *autoscalingGroup.AutoScalingGroupName,
+			Action:        cwEvent.Action,
+			PreviousState: asgSize(autoscalingGroup).String(),
+			SkippedReason: excludedReason,
+		})
+	}
+
+	changed := false
+	for _, autoscalingGroup := range autoscalingGroups {
+		change := types.ResourceChange{
+			Kind:          "autoscaling-group",
+			Identifier:    *autoscalingGroup.AutoScalingGroupName,
+			Action:        cwEvent.Action,
+			PreviousState: asgSize(autoscalingGroup).String(),
+		}
+		if !model.IsASGActionRequired(cwEvent.Action, autoscalingGroup) {
+			change.SkippedReason = asgSkippedReason(cwEvent.Action, autoscalingGroup)
+			changes = append(changes, change)
+			continue
+		}
+
+		var size *types.ASGSize
+		switch {
+		case cwEvent.Action == "stop" && cwEvent.DryRun:
+			size = &types.ASGSize{}
+		case cwEvent.Action == "stop":
+			_, err = scheduler.ASGModelAPI.SetASGSizeToZero(ctx, autoscalingGroup.AutoScalingGroupName)
+			size = &types.ASGSize{}
+		case cwEvent.Action == "start" && cwEvent.DryRun:
+			size, err = scheduler.ASGModelAPI.GetPreviousSizeOfASG(ctx, autoscalingGroup.AutoScalingGroupName)
+		case cwEvent.Action == "start":
+			size, err = scheduler.ASGModelAPI.SetASGSizeToPreviousValue(ctx, autoscalingGroup.AutoScalingGroupName)
+		}
+		if err != nil {
+			log.Printf("ASG - Failed to %s autoscaling group %s \n", cwEvent.Action, *autoscalingGroup.AutoScalingGroupName)
+			change.Error = err.Error()
+			changes = append(changes, change)
+			errs = errs.Append(err)
+			continue
+		}
+		change.NewState = size.String()
+		changes = append(changes, change)
+		changed = true
+	}
+
+	if !changed {
+		log.Println("ASG - No action required")
+	}
+	return changes, errs.ErrorOrNil()
+}
+
+// asgSkippedReason returns the reason why the given autoscaling group isn't changed for the given action
+func asgSkippedReason(action string, autoscalingGroup *autoscaling.Group) string {
+	if action == "start" && *autoscalingGroup.MaxSize == 0 {
+		return "no previous size stored"
+	}
+	if action == "stop" && *autoscalingGroup.MaxSize != 0 {
+		return "min size and desired capacity are 0"
+	}
+	return "max size is " + strconv.FormatInt(*autoscalingGroup.MaxSize, 10)
+}
+
+// asgSize returns the current size of the given autoscaling group used in the result
+func asgSize(autoscalingGroup *autoscaling.Group) types.ASGSize {
+	return types.ASGSize{
+		MinSize:         *autoscalingGroup.MinSize,
+		MaxSize:         *autoscalingGroup.MaxSize,
+		DesiredCapacity: *autoscalingGroup.DesiredCapacity,
+	}
+}
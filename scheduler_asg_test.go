@@ -0,0 +1,428 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+
+	"github.com/auto-staging/scheduler/mocks"
+	"github.com/auto-staging/scheduler/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newAutoscalingGroup returns a running autoscaling group with the given min size and a max size of 4. A min size of 0 returns a group stopped by the
+// scheduler, which has a max size of 0 and its previous size stored in the tags.
+func newAutoscalingGroup(name *string, minSize int64) *autoscaling.Group {
+	if minSize == 0 {
+		return &autoscaling.Group{
+			AutoScalingGroupName: name,
+			MinSize:              aws.Int64(0),
+			MaxSize:              aws.Int64(0),
+			DesiredCapacity:      aws.Int64(0),
+			Tags: []*autoscaling.TagDescription{
+				{Key: aws.String("minSize"), Value: aws.String("2")},
+				{Key: aws.String("maxSize"), Value: aws.String("4")},
+				{Key: aws.String("desiredCapacity"), Value: aws.String("2")},
+			},
+		}
+	}
+	return &autoscaling.Group{
+		AutoScalingGroupName: name,
+		MinSize:              aws.Int64(minSize),
+		MaxSize:              aws.Int64(4),
+		DesiredCapacity:      aws.Int64(minSize),
+	}
+}
+
+func TestASGSchedulerStart(t *testing.T) {
+	autoscalingGroupName := aws.String("test-asg")
+
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{newAutoscalingGroup(autoscalingGroupName, 0)}, []*autoscaling.Group{}, nil)
+
+	svcASGModelAPI.On("SetASGSizeToPreviousValue", mock.Anything, mock.AnythingOfType("*string")).Return(&types.ASGSize{MinSize: 2, MaxSize: 4, DesiredCapacity: 2}, nil)
+
+	scheduler := asgScheduler{
+		ASGModelAPI: svcASGModelAPI,
+	}
+
+	_, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	svcASGModelAPI.AssertCalled(t, "SetASGSizeToPreviousValue", mock.Anything, autoscalingGroupName)
+}
+
+func TestASGSchedulerStop(t *testing.T) {
+	autoscalingGroupName := aws.String("test-asg")
+
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{newAutoscalingGroup(autoscalingGroupName, 2)}, []*autoscaling.Group{}, nil)
+
+	svcASGModelAPI.On("SetASGSizeToZero", mock.Anything, mock.AnythingOfType("*string")).Return(&types.ASGSize{MinSize: 2, MaxSize: 4, DesiredCapacity: 2}, nil)
+
+	scheduler := asgScheduler{
+		ASGModelAPI: svcASGModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	svcASGModelAPI.AssertCalled(t, "SetASGSizeToZero", mock.Anything, autoscalingGroupName)
+}
+
+func TestASGSchedulerStartMultipleGroups(t *testing.T) {
+	autoscalingGroupNames := []*string{
+		aws.String("test-asg-web"),
+		aws.String("test-asg-worker"),
+	}
+
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{
+		newAutoscalingGroup(autoscalingGroupNames[0], 0),
+		newAutoscalingGroup(autoscalingGroupNames[1], 0),
+	}, []*autoscaling.Group{}, nil)
+
+	svcASGModelAPI.On("SetASGSizeToPreviousValue", mock.Anything, mock.AnythingOfType("*string")).Return(&types.ASGSize{MinSize: 2, MaxSize: 4, DesiredCapacity: 2}, nil)
+
+	scheduler := asgScheduler{
+		ASGModelAPI: svcASGModelAPI,
+	}
+
+	_, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	svcASGModelAPI.AssertCalled(t, "SetASGSizeToPreviousValue", mock.Anything, autoscalingGroupNames[0])
+	svcASGModelAPI.AssertCalled(t, "SetASGSizeToPreviousValue", mock.Anything, autoscalingGroupNames[1])
+}
+
+func TestASGSchedulerStopMultipleGroups(t *testing.T) {
+	autoscalingGroupNames := []*string{
+		aws.String("test-asg-web"),
+		aws.String("test-asg-worker"),
+	}
+
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{
+		newAutoscalingGroup(autoscalingGroupNames[0], 2),
+		newAutoscalingGroup(autoscalingGroupNames[1], 2),
+	}, []*autoscaling.Group{}, nil)
+
+	svcASGModelAPI.On("SetASGSizeToZero", mock.Anything, mock.AnythingOfType("*string")).Return(&types.ASGSize{MinSize: 2, MaxSize: 4, DesiredCapacity: 2}, nil)
+
+	scheduler := asgScheduler{
+		ASGModelAPI: svcASGModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	svcASGModelAPI.AssertCalled(t, "SetASGSizeToZero", mock.Anything, autoscalingGroupNames[0])
+	svcASGModelAPI.AssertCalled(t, "SetASGSizeToZero", mock.Anything, autoscalingGroupNames[1])
+}
+
+func TestASGSchedulerDryRun(t *testing.T) {
+	autoscalingGroupName := aws.String("test-asg")
+
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+		DryRun:     true,
+	}
+
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{newAutoscalingGroup(autoscalingGroupName, 2)}, []*autoscaling.Group{}, nil)
+
+	scheduler := asgScheduler{
+		ASGModelAPI: svcASGModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "autoscaling-group", Identifier: "test-asg", Action: "stop", PreviousState: "min=2 max=4 desired=2", NewState: "min=0 max=0 desired=0"},
+	}, changes)
+	svcASGModelAPI.AssertNotCalled(t, "SetASGSizeToZero", mock.Anything, mock.Anything)
+}
+
+func TestASGSchedulerNoGroupFound(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{}, []*autoscaling.Group{}, nil)
+
+	scheduler := asgScheduler{
+		ASGModelAPI: svcASGModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	svcASGModelAPI.AssertCalled(t, "DescribeAutoScalingGroupsForTags", mock.Anything, cwEvent.Repository, cwEvent.Branch)
+}
+
+func TestASGSchedulerDescribeError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{}, []*autoscaling.Group{}, errorMsg)
+
+	scheduler := asgScheduler{
+		ASGModelAPI: svcASGModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), types.Event{})
+
+	assert.Error(t, err, "Expected error")
+	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+}
+
+func TestASGSchedulerStopError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	autoscalingGroupName := aws.String("test-asg")
+
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{newAutoscalingGroup(autoscalingGroupName, 2)}, []*autoscaling.Group{}, nil)
+
+	svcASGModelAPI.On("SetASGSizeToZero", mock.Anything, mock.AnythingOfType("*string")).Return(nil, errorMsg)
+
+	scheduler := asgScheduler{
+		ASGModelAPI: svcASGModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Error(t, err, "Expected error")
+	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+}
+
+func TestASGSchedulerStopContinuesAfterError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	failingName := aws.String("failing-asg")
+	autoscalingGroupName := aws.String("test-asg")
+
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{
+		newAutoscalingGroup(failingName, 2),
+		newAutoscalingGroup(autoscalingGroupName, 2),
+	}, []*autoscaling.Group{}, nil)
+
+	svcASGModelAPI.On("SetASGSizeToZero", mock.Anything, failingName).Return(nil, errorMsg)
+	svcASGModelAPI.On("SetASGSizeToZero", mock.Anything, autoscalingGroupName).Return(&types.ASGSize{MinSize: 2, MaxSize: 4, DesiredCapacity: 2}, nil)
+
+	scheduler := asgScheduler{
+		ASGModelAPI: svcASGModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "autoscaling-group", Identifier: "failing-asg", Action: "stop", PreviousState: "min=2 max=4 desired=2", Error: "Test error"},
+		{Kind: "autoscaling-group", Identifier: "test-asg", Action: "stop", PreviousState: "min=2 max=4 desired=2", NewState: "min=0 max=0 desired=0"},
+	}, changes)
+	svcASGModelAPI.AssertCalled(t, "SetASGSizeToZero", mock.Anything, autoscalingGroupName)
+}
+
+func TestASGSchedulerStartError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	autoscalingGroupName := aws.String("test-asg")
+
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{newAutoscalingGroup(autoscalingGroupName, 0)}, []*autoscaling.Group{}, nil)
+
+	svcASGModelAPI.On("SetASGSizeToPreviousValue", mock.Anything, mock.AnythingOfType("*string")).Return(nil, errorMsg)
+
+	scheduler := asgScheduler{
+		ASGModelAPI: svcASGModelAPI,
+	}
+
+	_, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Error(t, err, "Expected error")
+	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+}
+
+func TestASGSchedulerExcluded(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{}, []*autoscaling.Group{
+		newAutoscalingGroup(aws.String("cacheASG"), 1),
+	}, nil)
+
+	scheduler := asgScheduler{
+		ASGModelAPI: svcASGModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	svcASGModelAPI.AssertNotCalled(t, "SetASGSizeToZero", mock.Anything, mock.Anything)
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "autoscaling-group", Identifier: "cacheASG", Action: "stop", PreviousState: "min=1 max=4 desired=1", SkippedReason: "excluded by tag"},
+	}, changes)
+}
+
+func TestASGSchedulerDiscover(t *testing.T) {
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{
+		newAutoscalingGroup(aws.String("test-asg"), 2),
+	}, []*autoscaling.Group{}, nil)
+
+	scheduler := asgScheduler{
+		ASGModelAPI: svcASGModelAPI,
+	}
+
+	changes, err := scheduler.Discover(context.Background(), types.Event{Action: "stop"})
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "autoscaling-group", Identifier: "test-asg", Action: "stop", PreviousState: "min=2 max=4 desired=2"},
+	}, changes)
+	svcASGModelAPI.AssertNotCalled(t, "SetASGSizeToZero", mock.Anything, mock.Anything)
+}
+
+// newASGStoppedByPreviousVersion returns an autoscaling group stopped by a previous version of the scheduler, which kept the max size and only
+// stored the min size in the tags
+func newASGStoppedByPreviousVersion(name *string) *autoscaling.Group {
+	return &autoscaling.Group{
+		AutoScalingGroupName: name,
+		MinSize:              aws.Int64(0),
+		MaxSize:              aws.Int64(4),
+		DesiredCapacity:      aws.Int64(0),
+		Tags: []*autoscaling.TagDescription{
+			{Key: aws.String("minSize"), Value: aws.String("2")},
+		},
+	}
+}
+
+func TestASGSchedulerStartStoppedByPreviousVersion(t *testing.T) {
+	autoscalingGroupName := aws.String("test-asg")
+
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{newASGStoppedByPreviousVersion(autoscalingGroupName)}, []*autoscaling.Group{}, nil)
+	svcASGModelAPI.On("SetASGSizeToPreviousValue", mock.Anything, mock.AnythingOfType("*string")).Return(&types.ASGSize{MinSize: 2, MaxSize: 4, DesiredCapacity: 2}, nil)
+
+	scheduler := asgScheduler{
+		ASGModelAPI: svcASGModelAPI,
+	}
+
+	changes, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "autoscaling-group", Identifier: "test-asg", Action: "start", PreviousState: "min=0 max=4 desired=0", NewState: "min=2 max=4 desired=2"},
+	}, changes)
+	svcASGModelAPI.AssertCalled(t, "SetASGSizeToPreviousValue", mock.Anything, autoscalingGroupName)
+}
+
+func TestASGSchedulerStopStoppedByPreviousVersion(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{newASGStoppedByPreviousVersion(aws.String("test-asg"))}, []*autoscaling.Group{}, nil)
+
+	scheduler := asgScheduler{
+		ASGModelAPI: svcASGModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "autoscaling-group", Identifier: "test-asg", Action: "stop", PreviousState: "min=0 max=4 desired=0", SkippedReason: "min size and desired capacity are 0"},
+	}, changes)
+	svcASGModelAPI.AssertNotCalled(t, "SetASGSizeToZero", mock.Anything, mock.Anything)
+}
+
+func TestASGSchedulerStartRunningWithMinSizeZero(t *testing.T) {
+	autoscalingGroup := &autoscaling.Group{
+		AutoScalingGroupName: aws.String("worker-asg"),
+		MinSize:              aws.Int64(0),
+		MaxSize:              aws.Int64(4),
+		DesiredCapacity:      aws.Int64(1),
+	}
+
+	svcASGModelAPI := new(mocks.ASGModelAPI)
+	svcASGModelAPI.On("DescribeAutoScalingGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*autoscaling.Group{autoscalingGroup}, []*autoscaling.Group{}, nil)
+
+	scheduler := asgScheduler{
+		ASGModelAPI: svcASGModelAPI,
+	}
+
+	changes, err := scheduler.Start(context.Background(), types.Event{Action: "start"})
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "autoscaling-group", Identifier: "worker-asg", Action: "start", PreviousState: "min=0 max=4 desired=1", SkippedReason: "max size is 4"},
+	}, changes)
+	svcASGModelAPI.AssertNotCalled(t, "SetASGSizeToPreviousValue", mock.Anything, mock.Anything)
+}
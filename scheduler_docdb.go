@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+
+	"github.com/auto-staging/scheduler/model"
+	"github.com/auto-staging/scheduler/types"
+)
+
+// docdbScheduler starts and stops the DocumentDB Clusters of an Environment
+type docdbScheduler struct {
+	model.DocDBModelAPI
+}
+
+// newDocDBScheduler returns the scheduler of the "docdb" resource type with DocumentDB and tagging clients using the given AWS config
+func newDocDBScheduler(sess *session.Session, config *aws.Config) ResourceScheduler {
+	return &docdbScheduler{
+		DocDBModelAPI: model.NewDocDBModel(docdb.New(sess, config), resourcegroupstaggingapi.New(sess, config), tagConfig),
+	}
+}
+
+// Describe returns the resource type "docdb"
+func (scheduler *docdbScheduler) Describe() string {
+	return "docdb"
+}
+
+// Discover returns the DocumentDB Clusters of the Environment with their current status
+func (scheduler *docdbScheduler) Discover(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	changes := []types.ResourceChange{}
+	clusters, excluded, err := scheduler.DocDBModelAPI.GetDocDBClustersForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, err
+	}
+	for _, cluster := range excluded {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "docdb-cluster",
+			Identifier:    *cluster.DBClusterArn,
+			Action:        cwEvent.Action,
+			PreviousState: *cluster.Status,
+			SkippedReason: excludedReason,
+		})
+	}
+	for _, cluster := range clusters {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "docdb-cluster",
+			Identifier:    *cluster.DBClusterArn,
+			Action:        cwEvent.Action,
+			PreviousState: *cluster.Status,
+		})
+	}
+	return changes, nil
+}
+
+// Start starts the stopped DocumentDB Clusters of the Environment
+func (scheduler *docdbScheduler) Start(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	cwEvent.Action = "start"
+	return scheduler.changeState(ctx, cwEvent)
+}
+
+// Stop stops the available DocumentDB Clusters of the Environment
+func (scheduler *docdbScheduler) Stop(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	cwEvent.Action = "stop"
+	return scheduler.changeState(ctx, cwEvent)
+}
+
+// Wait waits until the changed DocumentDB Clusters are available / stopped
+func (scheduler *docdbScheduler) Wait(ctx context.Context, action string, changes []types.ResourceChange) error {
+	var errs types.MultiError
+	for i, change := range changes {
+		if change.Kind != "docdb-cluster" || change.Skipped() {
+			continue
+		}
+
+		var err error
+		finalState := ""
+		switch action {
+		case "start":
+			finalState = "available"
+			err = scheduler.DocDBModelAPI.WaitUntilDocDBClusterAvailable(ctx, aws.String(change.Identifier))
+		case "stop":
+			finalState = "stopped"
+			err = scheduler.DocDBModelAPI.WaitUntilDocDBClusterStopped(ctx, aws.String(change.Identifier))
+		}
+		if err != nil {
+			errs = errs.Append(err)
+			continue
+		}
+		if finalState != "" {
+			changes[i].NewState = finalState
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+func (scheduler *docdbScheduler) changeState(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	changes := []types.ResourceChange{}
+	var errs types.MultiError
+	clusters, excluded, err := scheduler.DocDBModelAPI.GetDocDBClustersForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, err
+	}
+	for _, cluster := range excluded {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "docdb-cluster",
+			Identifier:    *cluster.DBClusterArn,
+			Action:        cwEvent.Action,
+			PreviousState: *cluster.Status,
+			SkippedReason: excludedReason,
+		})
+	}
+
+	for _, cluster := range clusters {
+		change := types.ResourceChange{
+			Kind:          "docdb-cluster",
+			Identifier:    *cluster.DBClusterArn,
+			Action:        cwEvent.Action,
+			PreviousState: *cluster.Status,
+		}
+
+		changed := model.IsRDSActionRequired(cwEvent.Action, *cluster.Status)
+		if !cwEvent.DryRun {
+			switch cwEvent.Action {
+			case "stop":
+				changed, err = scheduler.DocDBModelAPI.StopDocDBCluster(ctx, cluster.DBClusterArn, cluster.Status)
+			case "start":
+				changed, err = scheduler.DocDBModelAPI.StartDocDBCluster(ctx, cluster.DBClusterArn, cluster.Status)
+			}
+			if err != nil {
+				log.Printf("DocumentDB - Failed to %s cluster %s \n", cwEvent.Action, *cluster.DBClusterArn)
+				change.Error = err.Error()
+				changes = append(changes, change)
+				errs = errs.Append(err)
+				continue
+			}
+		}
+
+		if !changed {
+			change.SkippedReason = "cluster status is " + *cluster.Status
+			changes = append(changes, change)
+			continue
+		}
+		change.NewState = transitionStateForAction(cwEvent.Action)
+		changes = append(changes, change)
+		if !cwEvent.DryRun {
+			log.Printf("DocumentDB - Changed state of cluster %s to %s \n", *cluster.DBClusterArn, change.NewState)
+		}
+	}
+
+	return changes, errs.ErrorOrNil()
+}
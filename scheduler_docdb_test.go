@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdb"
+
+	"github.com/auto-staging/scheduler/mocks"
+	"github.com/auto-staging/scheduler/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDocDBSchedulerStop(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+	clusterARN := aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:docdb")
+	clusterStatus := aws.String("available")
+
+	svcDocDBModelAPI := new(mocks.DocDBModelAPI)
+	svcDocDBModelAPI.On("GetDocDBClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*docdb.DBCluster{
+		{DBClusterArn: clusterARN, Status: clusterStatus},
+	}, []*docdb.DBCluster{
+		{DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:shared"), Status: aws.String("available")},
+	}, nil)
+	svcDocDBModelAPI.On("StopDocDBCluster", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(true, nil)
+
+	scheduler := docdbScheduler{
+		DocDBModelAPI: svcDocDBModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "docdb-cluster", Identifier: "arn:aws:rds:eu-west-1:123456789012:cluster:shared", Action: "stop", PreviousState: "available", SkippedReason: "excluded by tag"},
+		{Kind: "docdb-cluster", Identifier: *clusterARN, Action: "stop", PreviousState: "available", NewState: "stopping"},
+	}, changes)
+	svcDocDBModelAPI.AssertCalled(t, "StopDocDBCluster", mock.Anything, clusterARN, clusterStatus)
+}
+
+func TestDocDBSchedulerStartNotChanged(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcDocDBModelAPI := new(mocks.DocDBModelAPI)
+	svcDocDBModelAPI.On("GetDocDBClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*docdb.DBCluster{
+		{DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:docdb"), Status: aws.String("available")},
+	}, []*docdb.DBCluster{}, nil)
+	svcDocDBModelAPI.On("StartDocDBCluster", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(false, nil)
+
+	scheduler := docdbScheduler{
+		DocDBModelAPI: svcDocDBModelAPI,
+	}
+
+	changes, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, "cluster status is available", changes[0].SkippedReason)
+}
+
+func TestDocDBSchedulerStartError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcDocDBModelAPI := new(mocks.DocDBModelAPI)
+	svcDocDBModelAPI.On("GetDocDBClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*docdb.DBCluster{
+		{DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:docdb"), Status: aws.String("stopped")},
+	}, []*docdb.DBCluster{}, nil)
+	svcDocDBModelAPI.On("StartDocDBCluster", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(false, errorMsg)
+
+	scheduler := docdbScheduler{
+		DocDBModelAPI: svcDocDBModelAPI,
+	}
+
+	_, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Equal(t, errorMsg, err)
+}
+
+func TestDocDBSchedulerStopContinuesAfterError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+	failingARN := aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:failing")
+	clusterARN := aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:docdb")
+
+	svcDocDBModelAPI := new(mocks.DocDBModelAPI)
+	svcDocDBModelAPI.On("GetDocDBClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*docdb.DBCluster{
+		{DBClusterArn: failingARN, Status: aws.String("available")},
+		{DBClusterArn: clusterARN, Status: aws.String("available")},
+	}, []*docdb.DBCluster{}, nil)
+	svcDocDBModelAPI.On("StopDocDBCluster", mock.Anything, failingARN, mock.AnythingOfType("*string")).Return(false, errorMsg)
+	svcDocDBModelAPI.On("StopDocDBCluster", mock.Anything, clusterARN, mock.AnythingOfType("*string")).Return(true, nil)
+
+	scheduler := docdbScheduler{
+		DocDBModelAPI: svcDocDBModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Equal(t, errorMsg, err)
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "docdb-cluster", Identifier: *failingARN, Action: "stop", PreviousState: "available", Error: "Test error"},
+		{Kind: "docdb-cluster", Identifier: *clusterARN, Action: "stop", PreviousState: "available", NewState: "stopping"},
+	}, changes)
+	svcDocDBModelAPI.AssertCalled(t, "StopDocDBCluster", mock.Anything, clusterARN, mock.AnythingOfType("*string"))
+}
+
+func TestDocDBSchedulerWait(t *testing.T) {
+	svcDocDBModelAPI := new(mocks.DocDBModelAPI)
+	svcDocDBModelAPI.On("WaitUntilDocDBClusterAvailable", mock.Anything, mock.AnythingOfType("*string")).Return(nil)
+
+	scheduler := docdbScheduler{
+		DocDBModelAPI: svcDocDBModelAPI,
+	}
+	changes := []types.ResourceChange{
+		{Kind: "docdb-cluster", Identifier: "arn:aws:rds:eu-west-1:123456789012:cluster:docdb", Action: "start", PreviousState: "stopped", NewState: "starting"},
+	}
+
+	err := scheduler.Wait(context.Background(), "start", changes)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, "available", changes[0].NewState)
+	svcDocDBModelAPI.AssertCalled(t, "WaitUntilDocDBClusterAvailable", mock.Anything, aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:docdb"))
+}
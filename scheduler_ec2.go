@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/auto-staging/scheduler/model"
+	"github.com/auto-staging/scheduler/types"
+)
+
+// ec2Scheduler starts and stops the EC2 Instances of an Environment
+type ec2Scheduler struct {
+	model.EC2ModelAPI
+}
+
+// newEC2Scheduler returns the scheduler of the "ec2" resource type with an EC2 client using the given AWS config
+func newEC2Scheduler(sess *session.Session, config *aws.Config) ResourceScheduler {
+	return &ec2Scheduler{
+		EC2ModelAPI: model.NewEC2Model(ec2.New(sess, config), tagConfig),
+	}
+}
+
+// Describe returns the resource type "ec2"
+func (scheduler *ec2Scheduler) Describe() string {
+	return "ec2"
+}
+
+// Discover returns the EC2 Instances of the Environment with their current state
+func (scheduler *ec2Scheduler) Discover(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	changes := []types.ResourceChange{}
+	instances, excluded, err := scheduler.EC2ModelAPI.DescribeInstancesForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, err
+	}
+	for _, instance := range excluded {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "ec2-instance",
+			Identifier:    *instance.InstanceId,
+			Action:        cwEvent.Action,
+			PreviousState: *instance.State.Name,
+			SkippedReason: excludedReason,
+		})
+	}
+	for _, instance := range instances {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "ec2-instance",
+			Identifier:    *instance.InstanceId,
+			Action:        cwEvent.Action,
+			PreviousState: *instance.State.Name,
+		})
+	}
+	return changes, nil
+}
+
+// Start starts the stopped EC2 Instances of the Environment
+func (scheduler *ec2Scheduler) Start(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	cwEvent.Action = "start"
+	return scheduler.changeState(ctx, cwEvent)
+}
+
+// Stop stops the running EC2 Instances of the Environment
+func (scheduler *ec2Scheduler) Stop(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	cwEvent.Action = "stop"
+	return scheduler.changeState(ctx, cwEvent)
+}
+
+// Wait waits until all changed EC2 Instances are running / stopped, the Instances are polled together
+func (scheduler *ec2Scheduler) Wait(ctx context.Context, action string, changes []types.ResourceChange) error {
+	instanceIndexes := []int{}
+	instanceIDs := []*string{}
+	for i, change := range changes {
+		if change.Kind != "ec2-instance" || change.Skipped() {
+			continue
+		}
+		instanceIndexes = append(instanceIndexes, i)
+		instanceIDs = append(instanceIDs, aws.String(change.Identifier))
+	}
+	if len(instanceIDs) == 0 {
+		return nil
+	}
+
+	var err error
+	finalState := "running"
+	if action == "stop" {
+		finalState = "stopped"
+		err = scheduler.EC2ModelAPI.WaitUntilEC2InstancesStopped(ctx, instanceIDs)
+	} else {
+		err = scheduler.EC2ModelAPI.WaitUntilEC2InstancesRunning(ctx, instanceIDs)
+	}
+	if err != nil {
+		return err
+	}
+	for _, i := range instanceIndexes {
+		changes[i].NewState = finalState
+	}
+	return nil
+}
+
+func (scheduler *ec2Scheduler) changeState(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	changes := []types.ResourceChange{}
+	instances, excluded, err := scheduler.EC2ModelAPI.DescribeInstancesForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, err
+	}
+	for _, instance := range excluded {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "ec2-instance",
+			Identifier:    *instance.InstanceId,
+			Action:        cwEvent.Action,
+			PreviousState: *instance.State.Name,
+			SkippedReason: excludedReason,
+		})
+	}
+
+	instanceIDs := []*string{}
+	previousStates := map[string]string{}
+	for _, instance := range instances {
+		if !model.IsEC2ActionRequired(cwEvent.Action, *instance.State.Name) {
+			changes = append(changes, types.ResourceChange{
+				Kind:          "ec2-instance",
+				Identifier:    *instance.InstanceId,
+				Action:        cwEvent.Action,
+				PreviousState: *instance.State.Name,
+				SkippedReason: "instance is " + *instance.State.Name,
+			})
+			continue
+		}
+		instanceIDs = append(instanceIDs, instance.InstanceId)
+		previousStates[*instance.InstanceId] = *instance.State.Name
+		if cwEvent.DryRun {
+			changes = append(changes, types.ResourceChange{
+				Kind:          "ec2-instance",
+				Identifier:    *instance.InstanceId,
+				Action:        cwEvent.Action,
+				PreviousState: *instance.State.Name,
+				NewState:      transitionStateForAction(cwEvent.Action),
+			})
+		}
+	}
+
+	if len(instanceIDs) == 0 {
+		log.Println("EC2 - No action required")
+		return changes, nil
+	}
+	if cwEvent.DryRun {
+		return changes, nil
+	}
+
+	var stateChanges []*ec2.InstanceStateChange
+	switch cwEvent.Action {
+	case "stop":
+		stateChanges, err = scheduler.EC2ModelAPI.StopEC2Instances(ctx, instanceIDs)
+	case "start":
+		stateChanges, err = scheduler.EC2ModelAPI.StartEC2Instances(ctx, instanceIDs)
+	}
+	if err != nil {
+		// The instances are changed with a single call, so none of them was changed
+		log.Printf("EC2 - Failed to %s instances \n", cwEvent.Action)
+		for _, instanceID := range instanceIDs {
+			changes = append(changes, types.ResourceChange{
+				Kind:          "ec2-instance",
+				Identifier:    *instanceID,
+				Action:        cwEvent.Action,
+				PreviousState: previousStates[*instanceID],
+				Error:         err.Error(),
+			})
+		}
+		return changes, err
+	}
+	for _, stateChange := range stateChanges {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "ec2-instance",
+			Identifier:    *stateChange.InstanceId,
+			Action:        cwEvent.Action,
+			PreviousState: *stateChange.PreviousState.Name,
+			NewState:      *stateChange.CurrentState.Name,
+		})
+	}
+
+	return changes, nil
+}
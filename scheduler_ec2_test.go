@@ -0,0 +1,278 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/auto-staging/scheduler/mocks"
+	"github.com/auto-staging/scheduler/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newEC2Instances(state string, instanceIDs []*string) []*ec2.Instance {
+	instances := []*ec2.Instance{}
+	for _, instanceID := range instanceIDs {
+		instances = append(instances, &ec2.Instance{
+			InstanceId: instanceID,
+			State: &ec2.InstanceState{
+				Name: aws.String(state),
+			},
+		})
+	}
+	return instances
+}
+
+func newInstanceStateChanges(previousState, currentState string, instanceIDs []*string) []*ec2.InstanceStateChange {
+	stateChanges := []*ec2.InstanceStateChange{}
+	for _, instanceID := range instanceIDs {
+		stateChanges = append(stateChanges, &ec2.InstanceStateChange{
+			InstanceId: instanceID,
+			PreviousState: &ec2.InstanceState{
+				Name: aws.String(previousState),
+			},
+			CurrentState: &ec2.InstanceState{
+				Name: aws.String(currentState),
+			},
+		})
+	}
+	return stateChanges
+}
+
+func TestEC2SchedulerStart(t *testing.T) {
+	instanceIDs := []*string{
+		aws.String("i-1234567890abcdef0"),
+		aws.String("i-1234567890abcdef1"),
+	}
+
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(newEC2Instances("stopped", instanceIDs), []*ec2.Instance{}, nil)
+
+	svcEC2ModelAPI.On("StartEC2Instances", mock.Anything, mock.AnythingOfType("[]*string")).Return(newInstanceStateChanges("stopped", "pending", instanceIDs), nil)
+
+	scheduler := ec2Scheduler{
+		EC2ModelAPI: svcEC2ModelAPI,
+	}
+
+	_, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	svcEC2ModelAPI.AssertCalled(t, "StartEC2Instances", mock.Anything, instanceIDs)
+}
+
+func TestEC2SchedulerStop(t *testing.T) {
+	instanceIDs := []*string{
+		aws.String("i-1234567890abcdef0"),
+		aws.String("i-1234567890abcdef1"),
+	}
+
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(newEC2Instances("running", instanceIDs), []*ec2.Instance{}, nil)
+
+	svcEC2ModelAPI.On("StopEC2Instances", mock.Anything, mock.AnythingOfType("[]*string")).Return(newInstanceStateChanges("running", "stopping", instanceIDs), nil)
+
+	scheduler := ec2Scheduler{
+		EC2ModelAPI: svcEC2ModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	svcEC2ModelAPI.AssertCalled(t, "StopEC2Instances", mock.Anything, instanceIDs)
+}
+
+func TestEC2SchedulerNoInstances(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ec2.Instance{}, []*ec2.Instance{}, nil)
+
+	scheduler := ec2Scheduler{
+		EC2ModelAPI: svcEC2ModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	svcEC2ModelAPI.AssertCalled(t, "DescribeInstancesForTags", mock.Anything, cwEvent.Repository, cwEvent.Branch)
+}
+
+func TestEC2SchedulerDescribeError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ec2.Instance{}, []*ec2.Instance{}, errorMsg)
+
+	scheduler := ec2Scheduler{
+		EC2ModelAPI: svcEC2ModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), types.Event{})
+
+	assert.Error(t, err, "Expected error")
+	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+}
+
+func TestEC2SchedulerStopError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	instanceIDs := []*string{
+		aws.String("i-1234567890abcdef0"),
+		aws.String("i-1234567890abcdef1"),
+	}
+
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(newEC2Instances("running", instanceIDs), []*ec2.Instance{}, nil)
+
+	svcEC2ModelAPI.On("StopEC2Instances", mock.Anything, mock.AnythingOfType("[]*string")).Return([]*ec2.InstanceStateChange{}, errorMsg)
+
+	scheduler := ec2Scheduler{
+		EC2ModelAPI: svcEC2ModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Error(t, err, "Expected error")
+	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "ec2-instance", Identifier: "i-1234567890abcdef0", Action: "stop", PreviousState: "running", Error: "Test error"},
+		{Kind: "ec2-instance", Identifier: "i-1234567890abcdef1", Action: "stop", PreviousState: "running", Error: "Test error"},
+	}, changes, "Expected every instance to be listed with the error")
+}
+
+func TestEC2SchedulerStartError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	instanceIDs := []*string{
+		aws.String("i-1234567890abcdef0"),
+		aws.String("i-1234567890abcdef1"),
+	}
+
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(newEC2Instances("stopped", instanceIDs), []*ec2.Instance{}, nil)
+
+	svcEC2ModelAPI.On("StartEC2Instances", mock.Anything, mock.AnythingOfType("[]*string")).Return([]*ec2.InstanceStateChange{}, errorMsg)
+
+	scheduler := ec2Scheduler{
+		EC2ModelAPI: svcEC2ModelAPI,
+	}
+
+	changes, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Error(t, err, "Expected error")
+	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "ec2-instance", Identifier: "i-1234567890abcdef0", Action: "start", PreviousState: "stopped", Error: "Test error"},
+		{Kind: "ec2-instance", Identifier: "i-1234567890abcdef1", Action: "start", PreviousState: "stopped", Error: "Test error"},
+	}, changes, "Expected every instance to be listed with the error")
+}
+
+func TestEC2SchedulerDryRun(t *testing.T) {
+	instanceIDs := []*string{
+		aws.String("i-1234567890abcdef0"),
+		aws.String("i-1234567890abcdef1"),
+	}
+
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+		DryRun:     true,
+	}
+
+	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(newEC2Instances("stopped", instanceIDs), []*ec2.Instance{}, nil)
+
+	scheduler := ec2Scheduler{
+		EC2ModelAPI: svcEC2ModelAPI,
+	}
+
+	changes, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "ec2-instance", Identifier: "i-1234567890abcdef0", Action: "start", PreviousState: "stopped", NewState: "starting"},
+		{Kind: "ec2-instance", Identifier: "i-1234567890abcdef1", Action: "start", PreviousState: "stopped", NewState: "starting"},
+	}, changes)
+	svcEC2ModelAPI.AssertNotCalled(t, "StartEC2Instances", mock.Anything, mock.Anything)
+}
+
+func TestEC2SchedulerExcluded(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+	instanceIDs := []*string{aws.String("i-1234567890abcdef0")}
+	bastionIDs := []*string{aws.String("i-1234567890abcdef1")}
+
+	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(newEC2Instances("running", instanceIDs), newEC2Instances("running", bastionIDs), nil)
+	svcEC2ModelAPI.On("StopEC2Instances", mock.Anything, mock.AnythingOfType("[]*string")).Return(newInstanceStateChanges("running", "stopping", instanceIDs), nil)
+
+	scheduler := ec2Scheduler{
+		EC2ModelAPI: svcEC2ModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	svcEC2ModelAPI.AssertCalled(t, "StopEC2Instances", mock.Anything, instanceIDs)
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "ec2-instance", Identifier: "i-1234567890abcdef1", Action: "stop", PreviousState: "running", SkippedReason: "excluded by tag"},
+		{Kind: "ec2-instance", Identifier: "i-1234567890abcdef0", Action: "stop", PreviousState: "running", NewState: "stopping"},
+	}, changes)
+}
+
+func TestEC2SchedulerDiscover(t *testing.T) {
+	instanceIDs := []*string{aws.String("i-1234567890abcdef0")}
+	bastionIDs := []*string{aws.String("i-bastion")}
+
+	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(newEC2Instances("running", instanceIDs), newEC2Instances("stopped", bastionIDs), nil)
+
+	scheduler := ec2Scheduler{
+		EC2ModelAPI: svcEC2ModelAPI,
+	}
+
+	changes, err := scheduler.Discover(context.Background(), types.Event{Action: "stop", Repository: "repo", Branch: "branch"})
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "ec2-instance", Identifier: "i-bastion", Action: "stop", PreviousState: "stopped", SkippedReason: excludedReason},
+		{Kind: "ec2-instance", Identifier: "i-1234567890abcdef0", Action: "stop", PreviousState: "running"},
+	}, changes)
+	svcEC2ModelAPI.AssertCalled(t, "DescribeInstancesForTags", mock.Anything, "repo", "branch")
+	svcEC2ModelAPI.AssertNotCalled(t, "StopEC2Instances", mock.Anything, mock.Anything)
+}
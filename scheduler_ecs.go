@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+
+	"github.com/auto-staging/scheduler/model"
+	"github.com/auto-staging/scheduler/types"
+)
+
+// ecsScheduler sets the desired count of the ECS services of an Environment to zero on stop and back to the previous desired count on start
+type ecsScheduler struct {
+	model.ECSModelAPI
+}
+
+// newECSScheduler returns the scheduler of the "ecs" resource type with ECS and tagging clients using the given AWS config
+func newECSScheduler(sess *session.Session, config *aws.Config) ResourceScheduler {
+	return &ecsScheduler{
+		ECSModelAPI: model.NewECSModel(ecs.New(sess, config), resourcegroupstaggingapi.New(sess, config), tagConfig),
+	}
+}
+
+// Describe returns the resource type "ecs"
+func (scheduler *ecsScheduler) Describe() string {
+	return "ecs"
+}
+
+// Discover returns the ECS services of the Environment with their current desired count
+func (scheduler *ecsScheduler) Discover(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	changes := []types.ResourceChange{}
+	ecsServices, excluded, err := scheduler.ECSModelAPI.DescribeServicesForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, err
+	}
+	for _, service := range excluded {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "ecs-service",
+			Identifier:    *service.ServiceArn,
+			Action:        cwEvent.Action,
+			PreviousState: ecsDesiredCountState(*service.DesiredCount),
+			SkippedReason: excludedReason,
+		})
+	}
+	for _, service := range ecsServices {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "ecs-service",
+			Identifier:    *service.ServiceArn,
+			Action:        cwEvent.Action,
+			PreviousState: ecsDesiredCountState(*service.DesiredCount),
+		})
+	}
+	return changes, nil
+}
+
+// Start sets the desired count of the ECS services of the Environment back to the value stored in their tags
+func (scheduler *ecsScheduler) Start(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	cwEvent.Action = "start"
+	return scheduler.changeState(ctx, cwEvent)
+}
+
+// Stop sets the desired count of the ECS services of the Environment to zero and stores it in their tags
+func (scheduler *ecsScheduler) Stop(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	cwEvent.Action = "stop"
+	return scheduler.changeState(ctx, cwEvent)
+}
+
+// Wait waits until the changed ECS services run as many tasks as desired
+func (scheduler *ecsScheduler) Wait(ctx context.Context, action string, changes []types.ResourceChange) error {
+	var errs types.MultiError
+	for _, change := range changes {
+		if change.Kind != "ecs-service" || change.Skipped() {
+			continue
+		}
+		errs = errs.Append(scheduler.ECSModelAPI.WaitUntilECSServiceStable(ctx, aws.String(change.Identifier)))
+	}
+	return errs.ErrorOrNil()
+}
+
+func (scheduler *ecsScheduler) changeState(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	changes := []types.ResourceChange{}
+	var errs types.MultiError
+	ecsServices, excluded, err := scheduler.ECSModelAPI.DescribeServicesForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, err
+	}
+	for _, service := range excluded {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "ecs-service",
+			Identifier:    *service.ServiceArn,
+			Action:        cwEvent.Action,
+			PreviousState: ecsDesiredCountState(*service.DesiredCount),
+			SkippedReason: excludedReason,
+		})
+	}
+
+	changed := false
+	for _, service := range ecsServices {
+		change := types.ResourceChange{
+			Kind:          "ecs-service",
+			Identifier:    *service.ServiceArn,
+			Action:        cwEvent.Action,
+			PreviousState: ecsDesiredCountState(*service.DesiredCount),
+		}
+		if !model.IsECSActionRequired(cwEvent.Action, service) {
+			change.SkippedReason = ecsSkippedReason(cwEvent.Action, *service.DesiredCount)
+			changes = append(changes, change)
+			continue
+		}
+
+		var desiredCount int64
+		switch {
+		case cwEvent.Action == "stop" && cwEvent.DryRun:
+		case cwEvent.Action == "stop":
+			_, err = scheduler.ECSModelAPI.SetECSServiceDesiredCountToZero(ctx, service.ServiceArn)
+		case cwEvent.Action == "start" && cwEvent.DryRun:
+			desiredCount, err = scheduler.ECSModelAPI.GetPreviousDesiredCountOfECSService(ctx, service.ServiceArn)
+		case cwEvent.Action == "start":
+			desiredCount, err = scheduler.ECSModelAPI.SetECSServiceDesiredCountToPreviousValue(ctx, service.ServiceArn)
+		}
+		if err != nil {
+			log.Printf("ECS - Failed to %s service %s \n", cwEvent.Action, *service.ServiceArn)
+			change.Error = err.Error()
+			changes = append(changes, change)
+			errs = errs.Append(err)
+			continue
+		}
+		change.NewState = ecsDesiredCountState(desiredCount)
+		changes = append(changes, change)
+		changed = true
+	}
+
+	if !changed {
+		log.Println("ECS - No action required")
+	}
+	return changes, errs.ErrorOrNil()
+}
+
+// ecsSkippedReason returns the reason why a service with the given desired count isn't changed for the given action
+func ecsSkippedReason(action string, desiredCount int64) string {
+	if action == "start" && desiredCount == 0 {
+		return "no previous desired count stored"
+	}
+	return "desired count is " + strconv.FormatInt(desiredCount, 10)
+}
+
+// ecsDesiredCountState returns the state of an ECS service with the given desired count used in the result
+func ecsDesiredCountState(desiredCount int64) string {
+	return "desired=" + strconv.FormatInt(desiredCount, 10)
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+
+	"github.com/auto-staging/scheduler/mocks"
+	"github.com/auto-staging/scheduler/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newECSService returns a service with the given desired count, services with a desired count of 0 were stopped by the scheduler and have the
+// "desiredCount" tag
+func newECSService(serviceARN string, desiredCount int64) *ecs.Service {
+	service := &ecs.Service{
+		ServiceArn:   aws.String(serviceARN),
+		DesiredCount: aws.Int64(desiredCount),
+	}
+	if desiredCount == 0 {
+		service.Tags = []*ecs.Tag{{Key: aws.String("desiredCount"), Value: aws.String("2")}}
+	}
+	return service
+}
+
+func TestECSSchedulerStart(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{newECSService(testECSServiceARN, 0)}, []*ecs.Service{}, nil)
+	svcECSModelAPI.On("SetECSServiceDesiredCountToPreviousValue", mock.Anything, mock.AnythingOfType("*string")).Return(int64(2), nil)
+
+	scheduler := ecsScheduler{
+		ECSModelAPI: svcECSModelAPI,
+	}
+
+	changes, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "ecs-service", Identifier: testECSServiceARN, Action: "start", PreviousState: "desired=0", NewState: "desired=2"},
+	}, changes)
+}
+
+func TestECSSchedulerStartWithoutPreviousDesiredCount(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	service := newECSService(testECSServiceARN, 0)
+	service.Tags = nil
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{service}, []*ecs.Service{}, nil)
+
+	scheduler := ecsScheduler{
+		ECSModelAPI: svcECSModelAPI,
+	}
+
+	changes, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "ecs-service", Identifier: testECSServiceARN, Action: "start", PreviousState: "desired=0", SkippedReason: "no previous desired count stored"},
+	}, changes)
+	svcECSModelAPI.AssertNotCalled(t, "SetECSServiceDesiredCountToPreviousValue", mock.Anything, mock.Anything)
+}
+
+func TestECSSchedulerStop(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{newECSService(testECSServiceARN, 2)}, []*ecs.Service{}, nil)
+	svcECSModelAPI.On("SetECSServiceDesiredCountToZero", mock.Anything, mock.AnythingOfType("*string")).Return(int64(2), nil)
+
+	scheduler := ecsScheduler{
+		ECSModelAPI: svcECSModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "ecs-service", Identifier: testECSServiceARN, Action: "stop", PreviousState: "desired=2", NewState: "desired=0"},
+	}, changes)
+	svcECSModelAPI.AssertCalled(t, "SetECSServiceDesiredCountToZero", mock.Anything, aws.String(testECSServiceARN))
+}
+
+func TestECSSchedulerDryRun(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+		DryRun:     true,
+	}
+
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{newECSService(testECSServiceARN, 0)}, []*ecs.Service{}, nil)
+	svcECSModelAPI.On("GetPreviousDesiredCountOfECSService", mock.Anything, mock.AnythingOfType("*string")).Return(int64(3), nil)
+
+	scheduler := ecsScheduler{
+		ECSModelAPI: svcECSModelAPI,
+	}
+
+	changes, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "ecs-service", Identifier: testECSServiceARN, Action: "start", PreviousState: "desired=0", NewState: "desired=3"},
+	}, changes)
+	svcECSModelAPI.AssertNotCalled(t, "SetECSServiceDesiredCountToPreviousValue", mock.Anything, mock.Anything)
+}
+
+func TestECSSchedulerSkippedAndExcluded(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	excludedARN := "arn:aws:ecs:eu-central-1:123456789012:service/test-cluster/cache"
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{newECSService(testECSServiceARN, 0)}, []*ecs.Service{newECSService(excludedARN, 1)}, nil)
+
+	scheduler := ecsScheduler{
+		ECSModelAPI: svcECSModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "ecs-service", Identifier: excludedARN, Action: "stop", PreviousState: "desired=1", SkippedReason: "excluded by tag"},
+		{Kind: "ecs-service", Identifier: testECSServiceARN, Action: "stop", PreviousState: "desired=0", SkippedReason: "desired count is 0"},
+	}, changes)
+	svcECSModelAPI.AssertNotCalled(t, "SetECSServiceDesiredCountToZero", mock.Anything, mock.Anything)
+}
+
+func TestECSSchedulerStartError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{newECSService(testECSServiceARN, 0)}, []*ecs.Service{}, nil)
+	svcECSModelAPI.On("SetECSServiceDesiredCountToPreviousValue", mock.Anything, mock.AnythingOfType("*string")).Return(int64(0), errorMsg)
+
+	scheduler := ecsScheduler{
+		ECSModelAPI: svcECSModelAPI,
+	}
+
+	_, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Equal(t, errorMsg, err)
+}
+
+func TestECSSchedulerStopContinuesAfterError(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	failingARN := "arn:aws:ecs:eu-central-1:123456789012:service/test-cluster/failing-service"
+	errorMsg := errors.New("Test error")
+
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{
+		newECSService(failingARN, 2),
+		newECSService(testECSServiceARN, 1),
+	}, []*ecs.Service{}, nil)
+	svcECSModelAPI.On("SetECSServiceDesiredCountToZero", mock.Anything, aws.String(failingARN)).Return(int64(0), errorMsg)
+	svcECSModelAPI.On("SetECSServiceDesiredCountToZero", mock.Anything, aws.String(testECSServiceARN)).Return(int64(1), nil)
+
+	scheduler := ecsScheduler{
+		ECSModelAPI: svcECSModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "ecs-service", Identifier: failingARN, Action: "stop", PreviousState: "desired=2", Error: "Test error"},
+		{Kind: "ecs-service", Identifier: testECSServiceARN, Action: "stop", PreviousState: "desired=1", NewState: "desired=0"},
+	}, changes)
+}
+
+func TestECSSchedulerDescribeError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{}, []*ecs.Service{}, errorMsg)
+
+	scheduler := ecsScheduler{
+		ECSModelAPI: svcECSModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), types.Event{Action: "stop"})
+
+	assert.Equal(t, errorMsg, err)
+}
+
+func TestECSSchedulerDiscover(t *testing.T) {
+	svcECSModelAPI := new(mocks.ECSModelAPI)
+	svcECSModelAPI.On("DescribeServicesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*ecs.Service{newECSService(testECSServiceARN, 2)}, []*ecs.Service{}, nil)
+
+	scheduler := ecsScheduler{
+		ECSModelAPI: svcECSModelAPI,
+	}
+
+	changes, err := scheduler.Discover(context.Background(), types.Event{Action: "start"})
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Len(t, changes, 1)
+	assert.Equal(t, testECSServiceARN, changes[0].Identifier)
+	assert.False(t, changes[0].Skipped(), "Expected discovered service not to be skipped")
+	svcECSModelAPI.AssertNotCalled(t, "SetECSServiceDesiredCountToPreviousValue", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+
+	"github.com/auto-staging/scheduler/model"
+	"github.com/auto-staging/scheduler/types"
+)
+
+// eksScheduler scales the EKS managed node groups of an Environment to zero on stop and back to their previous size on start
+type eksScheduler struct {
+	model.EKSModelAPI
+}
+
+// newEKSScheduler returns the scheduler of the "eks" resource type with EKS and tagging clients using the given AWS config.
+// The parameter validation of the EKS client is disabled, because the SDK still rejects node group sizes of 0, which the EKS API accepts.
+func newEKSScheduler(sess *session.Session, config *aws.Config) ResourceScheduler {
+	return &eksScheduler{
+		EKSModelAPI: model.NewEKSModel(eks.New(sess, config, &aws.Config{DisableParamValidation: aws.Bool(true)}), resourcegroupstaggingapi.New(sess, config), tagConfig),
+	}
+}
+
+// Describe returns the resource type "eks"
+func (scheduler *eksScheduler) Describe() string {
+	return "eks"
+}
+
+// Discover returns the node groups of the Environment with their current size
+func (scheduler *eksScheduler) Discover(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	changes := []types.ResourceChange{}
+	nodegroups, excluded, err := scheduler.EKSModelAPI.DescribeNodegroupsForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, err
+	}
+	for _, nodegroup := range excluded {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "eks-nodegroup",
+			Identifier:    *nodegroup.NodegroupArn,
+			Action:        cwEvent.Action,
+			PreviousState: nodegroupSize(nodegroup).String(),
+			SkippedReason: excludedReason,
+		})
+	}
+	for _, nodegroup := range nodegroups {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "eks-nodegroup",
+			Identifier:    *nodegroup.NodegroupArn,
+			Action:        cwEvent.Action,
+			PreviousState: nodegroupSize(nodegroup).String(),
+		})
+	}
+	return changes, nil
+}
+
+// Start scales the node groups of the Environment back to the size stored in their tags
+func (scheduler *eksScheduler) Start(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	cwEvent.Action = "start"
+	return scheduler.changeState(ctx, cwEvent)
+}
+
+// Stop scales the node groups of the Environment to zero and stores their size in their tags
+func (scheduler *eksScheduler) Stop(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	cwEvent.Action = "stop"
+	return scheduler.changeState(ctx, cwEvent)
+}
+
+// Wait waits until the changed node groups are active again after the scaling update
+func (scheduler *eksScheduler) Wait(ctx context.Context, action string, changes []types.ResourceChange) error {
+	var errs types.MultiError
+	for _, change := range changes {
+		if change.Kind != "eks-nodegroup" || change.Skipped() {
+			continue
+		}
+		errs = errs.Append(scheduler.EKSModelAPI.WaitUntilNodegroupActive(ctx, aws.String(change.Identifier)))
+	}
+	return errs.ErrorOrNil()
+}
+
+func (scheduler *eksScheduler) changeState(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	changes := []types.ResourceChange{}
+	var errs types.MultiError
+	nodegroups, excluded, err := scheduler.EKSModelAPI.DescribeNodegroupsForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, err
+	}
+	for _, nodegroup := range excluded {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "eks-nodegroup",
+			Identifier:    *nodegroup.NodegroupArn,
+			Action:        cwEvent.Action,
+			PreviousState: nodegroupSize(nodegroup).String(),
+			SkippedReason: excludedReason,
+		})
+	}
+
+	changed := false
+	for _, nodegroup := range nodegroups {
+		previousSize := nodegroupSize(nodegroup)
+		change := types.ResourceChange{
+			Kind:          "eks-nodegroup",
+			Identifier:    *nodegroup.NodegroupArn,
+			Action:        cwEvent.Action,
+			PreviousState: previousSize.String(),
+		}
+		if !model.IsNodegroupActionRequired(cwEvent.Action, nodegroup) {
+			change.SkippedReason = nodegroupSkippedReason(cwEvent.Action, previousSize)
+			changes = append(changes, change)
+			continue
+		}
+
+		size := &types.NodegroupSize{MaxSize: previousSize.MaxSize}
+		switch {
+		case cwEvent.Action == "stop" && cwEvent.DryRun:
+		case cwEvent.Action == "stop":
+			_, err = scheduler.EKSModelAPI.SetNodegroupSizeToZero(ctx, nodegroup.NodegroupArn)
+		case cwEvent.Action == "start" && cwEvent.DryRun:
+			size, err = scheduler.EKSModelAPI.GetPreviousSizeOfNodegroup(ctx, nodegroup.NodegroupArn)
+		case cwEvent.Action == "start":
+			size, err = scheduler.EKSModelAPI.SetNodegroupSizeToPreviousValue(ctx, nodegroup.NodegroupArn)
+		}
+		if err != nil {
+			log.Printf("EKS - Failed to %s node group %s \n", cwEvent.Action, *nodegroup.NodegroupArn)
+			change.Error = err.Error()
+			changes = append(changes, change)
+			errs = errs.Append(err)
+			continue
+		}
+		change.NewState = size.String()
+		changes = append(changes, change)
+		changed = true
+	}
+
+	if !changed {
+		log.Println("EKS - No action required")
+	}
+	return changes, errs.ErrorOrNil()
+}
+
+// nodegroupSkippedReason returns the reason why a node group of the given size isn't changed for the given action
+func nodegroupSkippedReason(action string, size types.NodegroupSize) string {
+	if action == "start" && size.DesiredSize == 0 {
+		return "no previous size stored"
+	}
+	return "desired size is " + strconv.FormatInt(size.DesiredSize, 10)
+}
+
+// nodegroupSize returns the current size of the given node group used in the result
+func nodegroupSize(nodegroup *eks.Nodegroup) types.NodegroupSize {
+	return types.NodegroupSize{
+		MinSize:     *nodegroup.ScalingConfig.MinSize,
+		MaxSize:     *nodegroup.ScalingConfig.MaxSize,
+		DesiredSize: *nodegroup.ScalingConfig.DesiredSize,
+	}
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+
+	"github.com/auto-staging/scheduler/mocks"
+	"github.com/auto-staging/scheduler/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newNodegroup returns a node group with the given size, node groups with a desired size of 0 were stopped by the scheduler and have the size tags
+func newNodegroup(nodegroupARN string, minSize, maxSize, desiredSize int64) *eks.Nodegroup {
+	nodegroup := &eks.Nodegroup{
+		NodegroupArn: aws.String(nodegroupARN),
+		ScalingConfig: &eks.NodegroupScalingConfig{
+			MinSize:     aws.Int64(minSize),
+			MaxSize:     aws.Int64(maxSize),
+			DesiredSize: aws.Int64(desiredSize),
+		},
+	}
+	if desiredSize == 0 {
+		nodegroup.Tags = aws.StringMap(map[string]string{"minSize": "1", "desiredSize": "2"})
+	}
+	return nodegroup
+}
+
+func TestEKSSchedulerStart(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcEKSModelAPI.On("DescribeNodegroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*eks.Nodegroup{newNodegroup(testNodegroupARN, 0, 4, 0)}, []*eks.Nodegroup{}, nil)
+	svcEKSModelAPI.On("SetNodegroupSizeToPreviousValue", mock.Anything, mock.AnythingOfType("*string")).Return(&types.NodegroupSize{MinSize: 1, MaxSize: 4, DesiredSize: 2}, nil)
+
+	scheduler := eksScheduler{
+		EKSModelAPI: svcEKSModelAPI,
+	}
+
+	changes, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "eks-nodegroup", Identifier: testNodegroupARN, Action: "start", PreviousState: "min=0 max=4 desired=0", NewState: "min=1 max=4 desired=2"},
+	}, changes)
+}
+
+func TestEKSSchedulerStop(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcEKSModelAPI.On("DescribeNodegroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*eks.Nodegroup{newNodegroup(testNodegroupARN, 1, 4, 2)}, []*eks.Nodegroup{}, nil)
+	svcEKSModelAPI.On("SetNodegroupSizeToZero", mock.Anything, mock.AnythingOfType("*string")).Return(&types.NodegroupSize{MinSize: 1, MaxSize: 4, DesiredSize: 2}, nil)
+
+	scheduler := eksScheduler{
+		EKSModelAPI: svcEKSModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "eks-nodegroup", Identifier: testNodegroupARN, Action: "stop", PreviousState: "min=1 max=4 desired=2", NewState: "min=0 max=4 desired=0"},
+	}, changes)
+	svcEKSModelAPI.AssertCalled(t, "SetNodegroupSizeToZero", mock.Anything, aws.String(testNodegroupARN))
+}
+
+func TestEKSSchedulerDryRun(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+		DryRun:     true,
+	}
+
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcEKSModelAPI.On("DescribeNodegroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*eks.Nodegroup{newNodegroup(testNodegroupARN, 1, 4, 2)}, []*eks.Nodegroup{}, nil)
+
+	scheduler := eksScheduler{
+		EKSModelAPI: svcEKSModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "eks-nodegroup", Identifier: testNodegroupARN, Action: "stop", PreviousState: "min=1 max=4 desired=2", NewState: "min=0 max=4 desired=0"},
+	}, changes)
+	svcEKSModelAPI.AssertNotCalled(t, "SetNodegroupSizeToZero", mock.Anything, mock.Anything)
+}
+
+func TestEKSSchedulerSkippedAndExcluded(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	excludedARN := "arn:aws:eks:eu-central-1:123456789012:nodegroup/test-cluster/monitoring/6d5c4b3a-2f1e-0d9c-8b7a-6f5e4d3c2b1a"
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcEKSModelAPI.On("DescribeNodegroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*eks.Nodegroup{newNodegroup(testNodegroupARN, 1, 4, 2)}, []*eks.Nodegroup{newNodegroup(excludedARN, 0, 2, 0)}, nil)
+
+	scheduler := eksScheduler{
+		EKSModelAPI: svcEKSModelAPI,
+	}
+
+	changes, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "eks-nodegroup", Identifier: excludedARN, Action: "start", PreviousState: "min=0 max=2 desired=0", SkippedReason: "excluded by tag"},
+		{Kind: "eks-nodegroup", Identifier: testNodegroupARN, Action: "start", PreviousState: "min=1 max=4 desired=2", SkippedReason: "desired size is 2"},
+	}, changes)
+	svcEKSModelAPI.AssertNotCalled(t, "SetNodegroupSizeToPreviousValue", mock.Anything, mock.Anything)
+}
+
+func TestEKSSchedulerStartWithoutPreviousSize(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	nodegroup := newNodegroup(testNodegroupARN, 0, 4, 0)
+	nodegroup.Tags = nil
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcEKSModelAPI.On("DescribeNodegroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*eks.Nodegroup{nodegroup}, []*eks.Nodegroup{}, nil)
+
+	scheduler := eksScheduler{
+		EKSModelAPI: svcEKSModelAPI,
+	}
+
+	changes, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "eks-nodegroup", Identifier: testNodegroupARN, Action: "start", PreviousState: "min=0 max=4 desired=0", SkippedReason: "no previous size stored"},
+	}, changes)
+	svcEKSModelAPI.AssertNotCalled(t, "SetNodegroupSizeToPreviousValue", mock.Anything, mock.Anything)
+}
+
+func TestEKSSchedulerStopError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcEKSModelAPI.On("DescribeNodegroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*eks.Nodegroup{newNodegroup(testNodegroupARN, 1, 4, 2)}, []*eks.Nodegroup{}, nil)
+	svcEKSModelAPI.On("SetNodegroupSizeToZero", mock.Anything, mock.AnythingOfType("*string")).Return(nil, errorMsg)
+
+	scheduler := eksScheduler{
+		EKSModelAPI: svcEKSModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Equal(t, errorMsg, err)
+}
+
+func TestEKSSchedulerStopContinuesAfterError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+	failingARN := "arn:aws:eks:eu-central-1:123456789012:nodegroup/test-cluster/failing-nodegroup/1ab2c3d4-5e6f-7a8b-9c0d-1e2f3a4b5c6d"
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcEKSModelAPI := new(mocks.EKSModelAPI)
+	svcEKSModelAPI.On("DescribeNodegroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*eks.Nodegroup{
+		newNodegroup(failingARN, 1, 4, 2),
+		newNodegroup(testNodegroupARN, 1, 4, 2),
+	}, []*eks.Nodegroup{}, nil)
+	svcEKSModelAPI.On("SetNodegroupSizeToZero", mock.Anything, aws.String(failingARN)).Return(nil, errorMsg)
+	svcEKSModelAPI.On("SetNodegroupSizeToZero", mock.Anything, aws.String(testNodegroupARN)).Return(&types.NodegroupSize{MinSize: 1, MaxSize: 4, DesiredSize: 2}, nil)
+
+	scheduler := eksScheduler{
+		EKSModelAPI: svcEKSModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "eks-nodegroup", Identifier: failingARN, Action: "stop", PreviousState: "min=1 max=4 desired=2", Error: "Test error"},
+		{Kind: "eks-nodegroup", Identifier: testNodegroupARN, Action: "stop", PreviousState: "min=1 max=4 desired=2", NewState: "min=0 max=4 desired=0"},
+	}, changes)
+	svcEKSModelAPI.AssertCalled(t, "SetNodegroupSizeToZero", mock.Anything, aws.String(testNodegroupARN))
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+
+	"github.com/auto-staging/scheduler/model"
+	"github.com/auto-staging/scheduler/types"
+)
+
+// elastiCacheScheduler deletes the ElastiCache replication groups of an Environment after a snapshot on stop and restores them from their latest
+// snapshot on start. Only replication groups with the snapshot tag are changed.
+type elastiCacheScheduler struct {
+	model.ElastiCacheModelAPI
+}
+
+// noSnapshotTagReason is the skipped reason of replication groups without the snapshot tag, which are never deleted
+const noSnapshotTagReason = "no snapshot tag"
+
+// newElastiCacheScheduler returns the scheduler of the "elasticache" resource type with ElastiCache and tagging clients using the given AWS config
+func newElastiCacheScheduler(sess *session.Session, config *aws.Config) ResourceScheduler {
+	return &elastiCacheScheduler{
+		ElastiCacheModelAPI: model.NewElastiCacheModel(elasticache.New(sess, config), resourcegroupstaggingapi.New(sess, config), tagConfig),
+	}
+}
+
+// Describe returns the resource type "elasticache"
+func (scheduler *elastiCacheScheduler) Describe() string {
+	return "elasticache"
+}
+
+// Discover returns the existing replication groups of the Environment with their current status, deleted replication groups aren't listed
+func (scheduler *elastiCacheScheduler) Discover(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	changes := []types.ResourceChange{}
+	groups, excluded, withoutSnapshotTag, err := scheduler.ElastiCacheModelAPI.GetReplicationGroupsForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, err
+	}
+	for _, group := range excluded {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "elasticache-replication-group",
+			Identifier:    *group.ReplicationGroupId,
+			Action:        cwEvent.Action,
+			PreviousState: *group.Status,
+			SkippedReason: excludedReason,
+		})
+	}
+	for _, group := range withoutSnapshotTag {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "elasticache-replication-group",
+			Identifier:    *group.ReplicationGroupId,
+			Action:        cwEvent.Action,
+			PreviousState: *group.Status,
+			SkippedReason: noSnapshotTagReason,
+		})
+	}
+	for _, group := range groups {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "elasticache-replication-group",
+			Identifier:    *group.ReplicationGroupId,
+			Action:        cwEvent.Action,
+			PreviousState: *group.Status,
+		})
+	}
+	return changes, nil
+}
+
+// Start restores the deleted replication groups of the Environment from their latest snapshot
+func (scheduler *elastiCacheScheduler) Start(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	cwEvent.Action = "start"
+	return scheduler.changeState(ctx, cwEvent)
+}
+
+// Stop deletes the available replication groups of the Environment once a snapshot of them is available
+func (scheduler *elastiCacheScheduler) Stop(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	cwEvent.Action = "stop"
+	return scheduler.changeState(ctx, cwEvent)
+}
+
+// Wait waits until the changed replication groups are available / deleted
+func (scheduler *elastiCacheScheduler) Wait(ctx context.Context, action string, changes []types.ResourceChange) error {
+	var errs types.MultiError
+	for i, change := range changes {
+		if change.Kind != "elasticache-replication-group" || change.Skipped() {
+			continue
+		}
+
+		var err error
+		finalState := ""
+		switch action {
+		case "start":
+			finalState = "available"
+			err = scheduler.ElastiCacheModelAPI.WaitUntilReplicationGroupAvailable(ctx, aws.String(change.Identifier))
+		case "stop":
+			finalState = "deleted"
+			err = scheduler.ElastiCacheModelAPI.WaitUntilReplicationGroupDeleted(ctx, aws.String(change.Identifier))
+		}
+		if err != nil {
+			errs = errs.Append(err)
+			continue
+		}
+		if finalState != "" {
+			changes[i].NewState = finalState
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+// changeState deletes the replication groups of the Environment after a snapshot on stop and restores them from their latest snapshot on start.
+// Only replication groups with the snapshot tag are changed.
+func (scheduler *elastiCacheScheduler) changeState(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	changes := []types.ResourceChange{}
+	var errs types.MultiError
+	groups, excluded, withoutSnapshotTag, err := scheduler.ElastiCacheModelAPI.GetReplicationGroupsForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, err
+	}
+	// Every existing replication group of the Environment is recorded, so no snapshot gets restored over an existing replication group
+	existing := map[string]bool{}
+	for _, group := range excluded {
+		existing[*group.ReplicationGroupId] = true
+		changes = append(changes, types.ResourceChange{
+			Kind:          "elasticache-replication-group",
+			Identifier:    *group.ReplicationGroupId,
+			Action:        cwEvent.Action,
+			PreviousState: *group.Status,
+			SkippedReason: excludedReason,
+		})
+	}
+	for _, group := range withoutSnapshotTag {
+		existing[*group.ReplicationGroupId] = true
+		changes = append(changes, types.ResourceChange{
+			Kind:          "elasticache-replication-group",
+			Identifier:    *group.ReplicationGroupId,
+			Action:        cwEvent.Action,
+			PreviousState: *group.Status,
+			SkippedReason: noSnapshotTagReason,
+		})
+	}
+
+	for _, group := range groups {
+		existing[*group.ReplicationGroupId] = true
+		change := types.ResourceChange{
+			Kind:          "elasticache-replication-group",
+			Identifier:    *group.ReplicationGroupId,
+			Action:        cwEvent.Action,
+			PreviousState: *group.Status,
+		}
+
+		if cwEvent.Action != "stop" || *group.Status != "available" {
+			change.SkippedReason = "replication group status is " + *group.Status
+			changes = append(changes, change)
+			continue
+		}
+		if !model.IsReplicationGroupRestorable(group) {
+			change.SkippedReason = "replication group uses an AUTH token"
+			changes = append(changes, change)
+			continue
+		}
+		if !cwEvent.DryRun {
+			snapshotName, err := scheduler.ElastiCacheModelAPI.DeleteReplicationGroupWithSnapshot(ctx, group.ReplicationGroupId)
+			if err != nil {
+				log.Printf("ElastiCache - Failed to delete replication group %s \n", *group.ReplicationGroupId)
+				change.Error = err.Error()
+				changes = append(changes, change)
+				errs = errs.Append(err)
+				continue
+			}
+			log.Printf("ElastiCache - Deleting replication group %s with snapshot %s \n", *group.ReplicationGroupId, *snapshotName)
+		}
+		change.NewState = "deleting"
+		changes = append(changes, change)
+	}
+
+	if cwEvent.Action != "start" {
+		return changes, errs.ErrorOrNil()
+	}
+
+	snapshots, err := scheduler.ElastiCacheModelAPI.GetReplicationGroupSnapshotsForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, errs.Append(err).ErrorOrNil()
+	}
+	for _, snapshot := range snapshots {
+		if existing[*snapshot.ReplicationGroupId] {
+			continue
+		}
+		change := types.ResourceChange{
+			Kind:          "elasticache-replication-group",
+			Identifier:    *snapshot.ReplicationGroupId,
+			Action:        cwEvent.Action,
+			PreviousState: "deleted",
+		}
+
+		if *snapshot.SnapshotStatus != "available" {
+			change.SkippedReason = "snapshot status is " + *snapshot.SnapshotStatus
+			changes = append(changes, change)
+			continue
+		}
+		if !cwEvent.DryRun {
+			err = scheduler.ElastiCacheModelAPI.RestoreReplicationGroupFromSnapshot(ctx, snapshot.SnapshotName)
+			if err != nil {
+				log.Printf("ElastiCache - Failed to restore replication group %s \n", *snapshot.ReplicationGroupId)
+				change.Error = err.Error()
+				changes = append(changes, change)
+				errs = errs.Append(err)
+				continue
+			}
+			log.Printf("ElastiCache - Restoring replication group %s from snapshot %s \n", *snapshot.ReplicationGroupId, *snapshot.SnapshotName)
+		}
+		change.NewState = "creating"
+		changes = append(changes, change)
+	}
+
+	return changes, errs.ErrorOrNil()
+}
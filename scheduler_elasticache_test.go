@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+
+	"github.com/auto-staging/scheduler/mocks"
+	"github.com/auto-staging/scheduler/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestElastiCacheSchedulerStop(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+	groupID := aws.String("demo-app-feat-branch")
+
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
+	svcElastiCacheModelAPI.On("GetReplicationGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.ReplicationGroup{
+		{ReplicationGroupId: groupID, Status: aws.String("available")},
+		{ReplicationGroupId: aws.String("demo-app-feat-branch-2"), Status: aws.String("modifying")},
+		{ReplicationGroupId: aws.String("demo-app-feat-branch-3"), Status: aws.String("available"), AuthTokenEnabled: aws.Bool(true)},
+	}, []*elasticache.ReplicationGroup{
+		{ReplicationGroupId: aws.String("demo-app-shared"), Status: aws.String("available")},
+	}, []*elasticache.ReplicationGroup{}, nil)
+	svcElastiCacheModelAPI.On("DeleteReplicationGroupWithSnapshot", mock.Anything, mock.AnythingOfType("*string")).Return(aws.String("auto-staging-demo-app-feat-branch-20261018080000"), nil)
+
+	scheduler := elastiCacheScheduler{
+		ElastiCacheModelAPI: svcElastiCacheModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "elasticache-replication-group", Identifier: "demo-app-shared", Action: "stop", PreviousState: "available", SkippedReason: "excluded by tag"},
+		{Kind: "elasticache-replication-group", Identifier: "demo-app-feat-branch", Action: "stop", PreviousState: "available", NewState: "deleting"},
+		{Kind: "elasticache-replication-group", Identifier: "demo-app-feat-branch-2", Action: "stop", PreviousState: "modifying", SkippedReason: "replication group status is modifying"},
+		{Kind: "elasticache-replication-group", Identifier: "demo-app-feat-branch-3", Action: "stop", PreviousState: "available", SkippedReason: "replication group uses an AUTH token"},
+	}, changes)
+	svcElastiCacheModelAPI.AssertNumberOfCalls(t, "DeleteReplicationGroupWithSnapshot", 1)
+	svcElastiCacheModelAPI.AssertCalled(t, "DeleteReplicationGroupWithSnapshot", mock.Anything, groupID)
+	svcElastiCacheModelAPI.AssertNotCalled(t, "GetReplicationGroupSnapshotsForTags", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestElastiCacheSchedulerStart(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+	snapshotName := aws.String("auto-staging-demo-app-feat-branch-20261018080000")
+
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
+	svcElastiCacheModelAPI.On("GetReplicationGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.ReplicationGroup{
+		{ReplicationGroupId: aws.String("demo-app-feat-branch-2"), Status: aws.String("available")},
+	}, []*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{
+		{ReplicationGroupId: aws.String("demo-app-feat-branch-4"), Status: aws.String("available")},
+	}, nil)
+	svcElastiCacheModelAPI.On("GetReplicationGroupSnapshotsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.Snapshot{
+		{SnapshotName: snapshotName, ReplicationGroupId: aws.String("demo-app-feat-branch"), SnapshotStatus: aws.String("available")},
+		{SnapshotName: aws.String("auto-staging-demo-app-feat-branch-2-20261018080000"), ReplicationGroupId: aws.String("demo-app-feat-branch-2"), SnapshotStatus: aws.String("available")},
+		{SnapshotName: aws.String("auto-staging-demo-app-feat-branch-3-20261018080000"), ReplicationGroupId: aws.String("demo-app-feat-branch-3"), SnapshotStatus: aws.String("creating")},
+		{SnapshotName: aws.String("auto-staging-demo-app-feat-branch-4-20261018080000"), ReplicationGroupId: aws.String("demo-app-feat-branch-4"), SnapshotStatus: aws.String("available")},
+	}, nil)
+	svcElastiCacheModelAPI.On("RestoreReplicationGroupFromSnapshot", mock.Anything, mock.AnythingOfType("*string")).Return(nil)
+
+	scheduler := elastiCacheScheduler{
+		ElastiCacheModelAPI: svcElastiCacheModelAPI,
+	}
+
+	changes, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "elasticache-replication-group", Identifier: "demo-app-feat-branch-4", Action: "start", PreviousState: "available", SkippedReason: "no snapshot tag"},
+		{Kind: "elasticache-replication-group", Identifier: "demo-app-feat-branch-2", Action: "start", PreviousState: "available", SkippedReason: "replication group status is available"},
+		{Kind: "elasticache-replication-group", Identifier: "demo-app-feat-branch", Action: "start", PreviousState: "deleted", NewState: "creating"},
+		{Kind: "elasticache-replication-group", Identifier: "demo-app-feat-branch-3", Action: "start", PreviousState: "deleted", SkippedReason: "snapshot status is creating"},
+	}, changes, "Expected no snapshot to be restored over an existing replication group")
+	svcElastiCacheModelAPI.AssertNumberOfCalls(t, "RestoreReplicationGroupFromSnapshot", 1)
+	svcElastiCacheModelAPI.AssertCalled(t, "RestoreReplicationGroupFromSnapshot", mock.Anything, snapshotName)
+}
+
+func TestElastiCacheSchedulerDryRun(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+		DryRun:     true,
+	}
+
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
+	svcElastiCacheModelAPI.On("GetReplicationGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.ReplicationGroup{
+		{ReplicationGroupId: aws.String("demo-app-feat-branch"), Status: aws.String("available")},
+	}, []*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, nil)
+
+	scheduler := elastiCacheScheduler{
+		ElastiCacheModelAPI: svcElastiCacheModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, "deleting", changes[0].NewState)
+	svcElastiCacheModelAPI.AssertNotCalled(t, "DeleteReplicationGroupWithSnapshot", mock.Anything, mock.Anything)
+}
+
+func TestElastiCacheSchedulerRestoreError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
+	svcElastiCacheModelAPI.On("GetReplicationGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, nil)
+	svcElastiCacheModelAPI.On("GetReplicationGroupSnapshotsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.Snapshot{
+		{SnapshotName: aws.String("auto-staging-demo-app-feat-branch-20261018080000"), ReplicationGroupId: aws.String("demo-app-feat-branch"), SnapshotStatus: aws.String("available")},
+	}, nil)
+	svcElastiCacheModelAPI.On("RestoreReplicationGroupFromSnapshot", mock.Anything, mock.AnythingOfType("*string")).Return(errorMsg)
+
+	scheduler := elastiCacheScheduler{
+		ElastiCacheModelAPI: svcElastiCacheModelAPI,
+	}
+
+	_, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Equal(t, errorMsg, err)
+}
+
+func TestElastiCacheSchedulerStopContinuesAfterError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+	failingID := aws.String("demo-app-feat-branch")
+	groupID := aws.String("demo-app-feat-branch-2")
+
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
+	svcElastiCacheModelAPI.On("GetReplicationGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.ReplicationGroup{
+		{ReplicationGroupId: failingID, Status: aws.String("available")},
+		{ReplicationGroupId: groupID, Status: aws.String("available")},
+	}, []*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, nil)
+	svcElastiCacheModelAPI.On("DeleteReplicationGroupWithSnapshot", mock.Anything, failingID).Return(nil, errorMsg)
+	svcElastiCacheModelAPI.On("DeleteReplicationGroupWithSnapshot", mock.Anything, groupID).Return(aws.String("auto-staging-demo-app-feat-branch-2-20261018080000"), nil)
+
+	scheduler := elastiCacheScheduler{
+		ElastiCacheModelAPI: svcElastiCacheModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Equal(t, errorMsg, err)
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "elasticache-replication-group", Identifier: "demo-app-feat-branch", Action: "stop", PreviousState: "available", Error: "Test error"},
+		{Kind: "elasticache-replication-group", Identifier: "demo-app-feat-branch-2", Action: "stop", PreviousState: "available", NewState: "deleting"},
+	}, changes)
+	svcElastiCacheModelAPI.AssertCalled(t, "DeleteReplicationGroupWithSnapshot", mock.Anything, groupID)
+}
+
+func TestElastiCacheSchedulerStartContinuesAfterError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+	failingSnapshotName := aws.String("auto-staging-demo-app-feat-branch-20261018080000")
+	snapshotName := aws.String("auto-staging-demo-app-feat-branch-2-20261018080000")
+
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
+	svcElastiCacheModelAPI.On("GetReplicationGroupsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, []*elasticache.ReplicationGroup{}, nil)
+	svcElastiCacheModelAPI.On("GetReplicationGroupSnapshotsForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*elasticache.Snapshot{
+		{SnapshotName: failingSnapshotName, ReplicationGroupId: aws.String("demo-app-feat-branch"), SnapshotStatus: aws.String("available")},
+		{SnapshotName: snapshotName, ReplicationGroupId: aws.String("demo-app-feat-branch-2"), SnapshotStatus: aws.String("available")},
+	}, nil)
+	svcElastiCacheModelAPI.On("RestoreReplicationGroupFromSnapshot", mock.Anything, failingSnapshotName).Return(errorMsg)
+	svcElastiCacheModelAPI.On("RestoreReplicationGroupFromSnapshot", mock.Anything, snapshotName).Return(nil)
+
+	scheduler := elastiCacheScheduler{
+		ElastiCacheModelAPI: svcElastiCacheModelAPI,
+	}
+
+	changes, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Equal(t, errorMsg, err)
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "elasticache-replication-group", Identifier: "demo-app-feat-branch", Action: "start", PreviousState: "deleted", Error: "Test error"},
+		{Kind: "elasticache-replication-group", Identifier: "demo-app-feat-branch-2", Action: "start", PreviousState: "deleted", NewState: "creating"},
+	}, changes)
+	svcElastiCacheModelAPI.AssertCalled(t, "RestoreReplicationGroupFromSnapshot", mock.Anything, snapshotName)
+}
+
+func TestElastiCacheSchedulerWait(t *testing.T) {
+	svcElastiCacheModelAPI := new(mocks.ElastiCacheModelAPI)
+	svcElastiCacheModelAPI.On("WaitUntilReplicationGroupDeleted", mock.Anything, mock.AnythingOfType("*string")).Return(nil)
+
+	scheduler := elastiCacheScheduler{
+		ElastiCacheModelAPI: svcElastiCacheModelAPI,
+	}
+	changes := []types.ResourceChange{
+		{Kind: "elasticache-replication-group", Identifier: "demo-app-feat-branch", Action: "stop", PreviousState: "available", NewState: "deleting"},
+	}
+
+	err := scheduler.Wait(context.Background(), "stop", changes)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, "deleted", changes[0].NewState)
+	svcElastiCacheModelAPI.AssertCalled(t, "WaitUntilReplicationGroupDeleted", mock.Anything, aws.String("demo-app-feat-branch"))
+}
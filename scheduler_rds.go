@@ -0,0 +1,250 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+
+	"github.com/auto-staging/scheduler/model"
+	"github.com/auto-staging/scheduler/types"
+)
+
+// rdsScheduler starts and stops the RDS Clusters and RDS Instances of an Environment
+type rdsScheduler struct {
+	model.RDSModelAPI
+}
+
+// newRDSScheduler returns the scheduler of the "rds" resource type with RDS and tagging clients using the given AWS config
+func newRDSScheduler(sess *session.Session, config *aws.Config) ResourceScheduler {
+	return &rdsScheduler{
+		RDSModelAPI: model.NewRDSModel(rds.New(sess, config), resourcegroupstaggingapi.New(sess, config), tagConfig),
+	}
+}
+
+// Describe returns the resource type "rds"
+func (scheduler *rdsScheduler) Describe() string {
+	return "rds"
+}
+
+// Discover returns the RDS Clusters and RDS Instances of the Environment with their current status
+func (scheduler *rdsScheduler) Discover(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	changes := []types.ResourceChange{}
+	clusters, excludedClusters, err := scheduler.RDSModelAPI.GetRDSClustersForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, err
+	}
+	instances, excludedInstances, err := scheduler.RDSModelAPI.GetRDSInstancesForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, err
+	}
+
+	for _, cluster := range excludedClusters {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "rds-cluster",
+			Identifier:    *cluster.DBClusterArn,
+			Action:        cwEvent.Action,
+			PreviousState: *cluster.Status,
+			SkippedReason: excludedReason,
+		})
+	}
+	for _, cluster := range clusters {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "rds-cluster",
+			Identifier:    *cluster.DBClusterArn,
+			Action:        cwEvent.Action,
+			PreviousState: *cluster.Status,
+		})
+	}
+	for _, instance := range excludedInstances {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "rds-instance",
+			Identifier:    *instance.DBInstanceIdentifier,
+			Action:        cwEvent.Action,
+			PreviousState: *instance.DBInstanceStatus,
+			SkippedReason: excludedReason,
+		})
+	}
+	for _, instance := range instances {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "rds-instance",
+			Identifier:    *instance.DBInstanceIdentifier,
+			Action:        cwEvent.Action,
+			PreviousState: *instance.DBInstanceStatus,
+		})
+	}
+	return changes, nil
+}
+
+// Start starts the stopped RDS Clusters and RDS Instances of the Environment
+func (scheduler *rdsScheduler) Start(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	cwEvent.Action = "start"
+	return scheduler.changeState(ctx, cwEvent)
+}
+
+// Stop stops the available RDS Clusters and RDS Instances of the Environment
+func (scheduler *rdsScheduler) Stop(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	cwEvent.Action = "stop"
+	return scheduler.changeState(ctx, cwEvent)
+}
+
+// Wait waits until the changed RDS Clusters and RDS Instances are available / stopped
+func (scheduler *rdsScheduler) Wait(ctx context.Context, action string, changes []types.ResourceChange) error {
+	var errs types.MultiError
+	for i, change := range changes {
+		if change.Skipped() {
+			continue
+		}
+
+		var err error
+		finalState := ""
+		switch {
+		case change.Kind == "rds-cluster" && action == "start":
+			finalState = "available"
+			err = scheduler.RDSModelAPI.WaitUntilRDSClusterAvailable(ctx, aws.String(change.Identifier))
+		case change.Kind == "rds-cluster" && action == "stop":
+			finalState = "stopped"
+			err = scheduler.RDSModelAPI.WaitUntilRDSClusterStopped(ctx, aws.String(change.Identifier))
+		case change.Kind == "rds-instance" && action == "start":
+			finalState = "available"
+			err = scheduler.RDSModelAPI.WaitUntilRDSInstanceAvailable(ctx, aws.String(change.Identifier))
+		case change.Kind == "rds-instance" && action == "stop":
+			finalState = "stopped"
+			err = scheduler.RDSModelAPI.WaitUntilRDSInstanceStopped(ctx, aws.String(change.Identifier))
+		}
+		if err != nil {
+			errs = errs.Append(err)
+			continue
+		}
+		if finalState != "" {
+			changes[i].NewState = finalState
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+func (scheduler *rdsScheduler) changeState(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	var errs types.MultiError
+
+	changes, err := scheduler.changeClusterState(ctx, cwEvent)
+	errs = errs.Append(err)
+
+	instanceChanges, err := scheduler.changeInstanceState(ctx, cwEvent)
+	errs = errs.Append(err)
+
+	return append(changes, instanceChanges...), errs.ErrorOrNil()
+}
+
+func (scheduler *rdsScheduler) changeClusterState(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	changes := []types.ResourceChange{}
+	var errs types.MultiError
+	clusters, excluded, err := scheduler.RDSModelAPI.GetRDSClustersForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, err
+	}
+	for _, cluster := range excluded {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "rds-cluster",
+			Identifier:    *cluster.DBClusterArn,
+			Action:        cwEvent.Action,
+			PreviousState: *cluster.Status,
+			SkippedReason: excludedReason,
+		})
+	}
+
+	for _, cluster := range clusters {
+		change := types.ResourceChange{
+			Kind:          "rds-cluster",
+			Identifier:    *cluster.DBClusterArn,
+			Action:        cwEvent.Action,
+			PreviousState: *cluster.Status,
+		}
+
+		changed := model.IsRDSActionRequired(cwEvent.Action, *cluster.Status)
+		if !cwEvent.DryRun {
+			switch cwEvent.Action {
+			case "stop":
+				changed, err = scheduler.RDSModelAPI.StopRDSCluster(ctx, cluster.DBClusterArn, cluster.Status)
+			case "start":
+				changed, err = scheduler.RDSModelAPI.StartRDSCluster(ctx, cluster.DBClusterArn, cluster.Status)
+			}
+			if err != nil {
+				log.Printf("RDS - Failed to %s cluster %s \n", cwEvent.Action, *cluster.DBClusterArn)
+				change.Error = err.Error()
+				changes = append(changes, change)
+				errs = errs.Append(err)
+				continue
+			}
+		}
+
+		if !changed {
+			change.SkippedReason = "cluster status is " + *cluster.Status
+			changes = append(changes, change)
+			continue
+		}
+		change.NewState = transitionStateForAction(cwEvent.Action)
+		changes = append(changes, change)
+		if !cwEvent.DryRun {
+			log.Printf("RDS - Changed state of cluster %s to %s \n", *cluster.DBClusterArn, change.NewState)
+		}
+	}
+
+	return changes, errs.ErrorOrNil()
+}
+
+func (scheduler *rdsScheduler) changeInstanceState(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	changes := []types.ResourceChange{}
+	var errs types.MultiError
+	instances, excluded, err := scheduler.RDSModelAPI.GetRDSInstancesForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, err
+	}
+	for _, instance := range excluded {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "rds-instance",
+			Identifier:    *instance.DBInstanceIdentifier,
+			Action:        cwEvent.Action,
+			PreviousState: *instance.DBInstanceStatus,
+			SkippedReason: excludedReason,
+		})
+	}
+
+	for _, instance := range instances {
+		change := types.ResourceChange{
+			Kind:          "rds-instance",
+			Identifier:    *instance.DBInstanceIdentifier,
+			Action:        cwEvent.Action,
+			PreviousState: *instance.DBInstanceStatus,
+		}
+
+		changed := model.IsRDSActionRequired(cwEvent.Action, *instance.DBInstanceStatus)
+		if !cwEvent.DryRun {
+			switch cwEvent.Action {
+			case "stop":
+				changed, err = scheduler.RDSModelAPI.StopRDSInstance(ctx, instance.DBInstanceIdentifier, instance.DBInstanceStatus)
+			case "start":
+				changed, err = scheduler.RDSModelAPI.StartRDSInstance(ctx, instance.DBInstanceIdentifier, instance.DBInstanceStatus)
+			}
+			if err != nil {
+				log.Printf("RDS - Failed to %s instance %s \n", cwEvent.Action, *instance.DBInstanceIdentifier)
+				change.Error = err.Error()
+				changes = append(changes, change)
+				errs = errs.Append(err)
+				continue
+			}
+		}
+
+		if !changed {
+			change.SkippedReason = "instance status is " + *instance.DBInstanceStatus
+			changes = append(changes, change)
+			continue
+		}
+		change.NewState = transitionStateForAction(cwEvent.Action)
+		changes = append(changes, change)
+	}
+
+	return changes, errs.ErrorOrNil()
+}
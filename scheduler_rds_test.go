@@ -0,0 +1,517 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+
+	"github.com/auto-staging/scheduler/mocks"
+	"github.com/auto-staging/scheduler/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRDSSchedulerStart(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:db:mysql-db")
+	clusterStauts := aws.String("available")
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{
+		&rds.DBCluster{
+			DBClusterArn: clusterArn,
+			Status:       clusterStauts,
+		},
+	}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, nil)
+	svcRDSModelAPI.On("StartRDSCluster", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(true, nil)
+
+	scheduler := rdsScheduler{
+		RDSModelAPI: svcRDSModelAPI,
+	}
+
+	_, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	svcRDSModelAPI.AssertCalled(t, "GetRDSClustersForTags", mock.Anything, cwEvent.Repository, cwEvent.Branch)
+	svcRDSModelAPI.AssertCalled(t, "StartRDSCluster", mock.Anything, clusterArn, clusterStauts)
+}
+
+func TestRDSSchedulerStop(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:db:mysql-db")
+	clusterStauts := aws.String("stopped")
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{
+		&rds.DBCluster{
+			DBClusterArn: clusterArn,
+			Status:       clusterStauts,
+		},
+	}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, nil)
+	svcRDSModelAPI.On("StopRDSCluster", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(true, nil)
+
+	scheduler := rdsScheduler{
+		RDSModelAPI: svcRDSModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	svcRDSModelAPI.AssertCalled(t, "GetRDSClustersForTags", mock.Anything, cwEvent.Repository, cwEvent.Branch)
+	svcRDSModelAPI.AssertCalled(t, "StopRDSCluster", mock.Anything, clusterArn, clusterStauts)
+}
+
+func TestRDSSchedulerStopMultipleClusters(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+	clusters := []*rds.DBCluster{
+		&rds.DBCluster{
+			DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:primary-db"),
+			Status:       aws.String("available"),
+		},
+		&rds.DBCluster{
+			DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:reporting-db"),
+			Status:       aws.String("available"),
+		},
+	}
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(clusters, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, nil)
+	svcRDSModelAPI.On("StopRDSCluster", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(true, nil)
+
+	scheduler := rdsScheduler{
+		RDSModelAPI: svcRDSModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	svcRDSModelAPI.AssertCalled(t, "StopRDSCluster", mock.Anything, clusters[0].DBClusterArn, clusters[0].Status)
+	svcRDSModelAPI.AssertCalled(t, "StopRDSCluster", mock.Anything, clusters[1].DBClusterArn, clusters[1].Status)
+}
+
+func TestRDSSchedulerNoClusterFound(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, nil)
+
+	scheduler := rdsScheduler{
+		RDSModelAPI: svcRDSModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	svcRDSModelAPI.AssertCalled(t, "GetRDSClustersForTags", mock.Anything, cwEvent.Repository, cwEvent.Branch)
+}
+
+func TestRDSSchedulerGetClusterError(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	errorMsg := errors.New("Test error")
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{}, []*rds.DBCluster{}, errorMsg)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, nil)
+
+	scheduler := rdsScheduler{
+		RDSModelAPI: svcRDSModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Error(t, err, "Expected error")
+	svcRDSModelAPI.AssertCalled(t, "GetRDSClustersForTags", mock.Anything, cwEvent.Repository, cwEvent.Branch)
+	svcRDSModelAPI.AssertCalled(t, "GetRDSInstancesForTags", mock.Anything, cwEvent.Repository, cwEvent.Branch)
+	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+}
+
+func TestRDSSchedulerClusterAndInstanceError(t *testing.T) {
+	clusterErrorMsg := errors.New("Cluster error")
+	instanceErrorMsg := errors.New("Instance error")
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{}, []*rds.DBCluster{}, clusterErrorMsg)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, instanceErrorMsg)
+
+	scheduler := rdsScheduler{
+		RDSModelAPI: svcRDSModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), types.Event{Action: "stop"})
+
+	assert.Equal(t, types.MultiError{clusterErrorMsg, instanceErrorMsg}, err, "Expected both errors")
+	assert.Equal(t, "Cluster error; Instance error", err.Error())
+}
+
+func TestRDSSchedulerStopClusterError(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:db:mysql-db")
+	clusterStauts := aws.String("stopped")
+	errorMsg := errors.New("Test error")
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{
+		&rds.DBCluster{
+			DBClusterArn: clusterArn,
+			Status:       clusterStauts,
+		},
+	}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("StopRDSCluster", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(false, errorMsg)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, nil)
+
+	scheduler := rdsScheduler{
+		RDSModelAPI: svcRDSModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Error(t, err, "Expected error")
+	svcRDSModelAPI.AssertCalled(t, "GetRDSClustersForTags", mock.Anything, cwEvent.Repository, cwEvent.Branch)
+	svcRDSModelAPI.AssertCalled(t, "StopRDSCluster", mock.Anything, clusterArn, clusterStauts)
+	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+}
+
+func TestRDSSchedulerStopContinuesAfterClusterError(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	failingArn := aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:failing-db")
+	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db")
+	errorMsg := errors.New("Test error")
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{
+		&rds.DBCluster{
+			DBClusterArn: failingArn,
+			Status:       aws.String("available"),
+		},
+		&rds.DBCluster{
+			DBClusterArn: clusterArn,
+			Status:       aws.String("available"),
+		},
+	}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("StopRDSCluster", mock.Anything, failingArn, mock.AnythingOfType("*string")).Return(false, errorMsg)
+	svcRDSModelAPI.On("StopRDSCluster", mock.Anything, clusterArn, mock.AnythingOfType("*string")).Return(true, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{
+		&rds.DBInstance{
+			DBInstanceIdentifier: aws.String("mysql-instance"),
+			DBInstanceStatus:     aws.String("available"),
+		},
+	}, []*rds.DBInstance{}, nil)
+	svcRDSModelAPI.On("StopRDSInstance", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(true, nil)
+
+	scheduler := rdsScheduler{
+		RDSModelAPI: svcRDSModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "rds-cluster", Identifier: *failingArn, Action: "stop", PreviousState: "available", Error: "Test error"},
+		{Kind: "rds-cluster", Identifier: *clusterArn, Action: "stop", PreviousState: "available", NewState: "stopping"},
+		{Kind: "rds-instance", Identifier: "mysql-instance", Action: "stop", PreviousState: "available", NewState: "stopping"},
+	}, changes)
+	assert.True(t, changes[0].Skipped(), "Expected failed cluster to count as skipped")
+}
+
+func TestRDSSchedulerStartClusterError(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	clusterArn := aws.String("arn:aws:rds:eu-west-1:123456789012:db:mysql-db")
+	clusterStauts := aws.String("stopped")
+	errorMsg := errors.New("Test error")
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{
+		&rds.DBCluster{
+			DBClusterArn: clusterArn,
+			Status:       clusterStauts,
+		},
+	}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("StartRDSCluster", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(false, errorMsg)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, nil)
+
+	scheduler := rdsScheduler{
+		RDSModelAPI: svcRDSModelAPI,
+	}
+
+	_, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Error(t, err, "Expected error")
+	svcRDSModelAPI.AssertCalled(t, "GetRDSClustersForTags", mock.Anything, cwEvent.Repository, cwEvent.Branch)
+	svcRDSModelAPI.AssertCalled(t, "StartRDSCluster", mock.Anything, clusterArn, clusterStauts)
+	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+}
+
+func TestRDSSchedulerStartInstances(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+	instances := []*rds.DBInstance{
+		&rds.DBInstance{
+			DBInstanceIdentifier: aws.String("postgres-db"),
+			DBInstanceStatus:     aws.String("stopped"),
+		},
+		&rds.DBInstance{
+			DBInstanceIdentifier: aws.String("mysql-db"),
+			DBInstanceStatus:     aws.String("available"),
+		},
+	}
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(instances, []*rds.DBInstance{}, nil)
+	svcRDSModelAPI.On("StartRDSInstance", mock.Anything, instances[0].DBInstanceIdentifier, instances[0].DBInstanceStatus).Return(true, nil)
+	svcRDSModelAPI.On("StartRDSInstance", mock.Anything, instances[1].DBInstanceIdentifier, instances[1].DBInstanceStatus).Return(false, nil)
+
+	scheduler := rdsScheduler{
+		RDSModelAPI: svcRDSModelAPI,
+	}
+
+	_, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	svcRDSModelAPI.AssertCalled(t, "GetRDSInstancesForTags", mock.Anything, cwEvent.Repository, cwEvent.Branch)
+	svcRDSModelAPI.AssertNumberOfCalls(t, "StartRDSInstance", 2)
+}
+
+func TestRDSSchedulerStopInstances(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+	instances := []*rds.DBInstance{
+		&rds.DBInstance{
+			DBInstanceIdentifier: aws.String("postgres-db"),
+			DBInstanceStatus:     aws.String("available"),
+		},
+	}
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(instances, []*rds.DBInstance{}, nil)
+	svcRDSModelAPI.On("StopRDSInstance", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(true, nil)
+
+	scheduler := rdsScheduler{
+		RDSModelAPI: svcRDSModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	svcRDSModelAPI.AssertCalled(t, "StopRDSInstance", mock.Anything, instances[0].DBInstanceIdentifier, instances[0].DBInstanceStatus)
+}
+
+func TestRDSSchedulerGetInstancesError(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	errorMsg := errors.New("Test error")
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{}, errorMsg)
+
+	scheduler := rdsScheduler{
+		RDSModelAPI: svcRDSModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Error(t, err, "Expected error")
+	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+}
+
+func TestRDSSchedulerStopInstanceError(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+	instances := []*rds.DBInstance{
+		&rds.DBInstance{
+			DBInstanceIdentifier: aws.String("postgres-db"),
+			DBInstanceStatus:     aws.String("available"),
+		},
+	}
+	errorMsg := errors.New("Test error")
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(instances, []*rds.DBInstance{}, nil)
+	svcRDSModelAPI.On("StopRDSInstance", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(false, errorMsg)
+
+	scheduler := rdsScheduler{
+		RDSModelAPI: svcRDSModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Error(t, err, "Expected error")
+	assert.Equal(t, errorMsg, err, "Error didn't match given error")
+}
+
+func TestRDSSchedulerDryRun(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+		DryRun:     true,
+	}
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{
+		&rds.DBCluster{
+			DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db"),
+			Status:       aws.String("stopped"),
+		},
+	}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{
+		&rds.DBInstance{
+			DBInstanceIdentifier: aws.String("postgres-db"),
+			DBInstanceStatus:     aws.String("available"),
+		},
+	}, []*rds.DBInstance{}, nil)
+
+	scheduler := rdsScheduler{
+		RDSModelAPI: svcRDSModelAPI,
+	}
+
+	changes, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "rds-cluster", Identifier: "arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db", Action: "start", PreviousState: "stopped", NewState: "starting"},
+		{Kind: "rds-instance", Identifier: "postgres-db", Action: "start", PreviousState: "available", SkippedReason: "instance status is available"},
+	}, changes)
+	svcRDSModelAPI.AssertNotCalled(t, "StartRDSCluster", mock.Anything, mock.Anything, mock.Anything)
+	svcRDSModelAPI.AssertNotCalled(t, "StartRDSInstance", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRDSSchedulerExcluded(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{}, []*rds.DBCluster{
+		&rds.DBCluster{
+			DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:shared-db"),
+			Status:       aws.String("available"),
+		},
+	}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{
+		&rds.DBInstance{
+			DBInstanceIdentifier: aws.String("shared-instance"),
+			DBInstanceStatus:     aws.String("available"),
+		},
+	}, nil)
+
+	scheduler := rdsScheduler{
+		RDSModelAPI: svcRDSModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	svcRDSModelAPI.AssertNotCalled(t, "StopRDSCluster", mock.Anything, mock.Anything, mock.Anything)
+	svcRDSModelAPI.AssertNotCalled(t, "StopRDSInstance", mock.Anything, mock.Anything, mock.Anything)
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "rds-cluster", Identifier: "arn:aws:rds:eu-west-1:123456789012:cluster:shared-db", Action: "stop", PreviousState: "available", SkippedReason: "excluded by tag"},
+		{Kind: "rds-instance", Identifier: "shared-instance", Action: "stop", PreviousState: "available", SkippedReason: "excluded by tag"},
+	}, changes)
+}
+
+func TestRDSSchedulerDiscover(t *testing.T) {
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{
+		&rds.DBCluster{
+			DBClusterArn: aws.String("arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db"),
+			Status:       aws.String("available"),
+		},
+	}, []*rds.DBCluster{}, nil)
+	svcRDSModelAPI.On("GetRDSInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBInstance{}, []*rds.DBInstance{
+		&rds.DBInstance{
+			DBInstanceIdentifier: aws.String("cache-db"),
+			DBInstanceStatus:     aws.String("available"),
+		},
+	}, nil)
+
+	scheduler := rdsScheduler{
+		RDSModelAPI: svcRDSModelAPI,
+	}
+
+	changes, err := scheduler.Discover(context.Background(), types.Event{Action: "stop"})
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "rds-cluster", Identifier: "arn:aws:rds:eu-west-1:123456789012:cluster:mysql-db", Action: "stop", PreviousState: "available"},
+		{Kind: "rds-instance", Identifier: "cache-db", Action: "stop", PreviousState: "available", SkippedReason: excludedReason},
+	}, changes)
+	svcRDSModelAPI.AssertNotCalled(t, "StopRDSCluster", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRDSSchedulerDiscoverError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+
+	svcRDSModelAPI := new(mocks.RDSModelAPI)
+	svcRDSModelAPI.On("GetRDSClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*rds.DBCluster{}, []*rds.DBCluster{}, errorMsg)
+
+	scheduler := rdsScheduler{
+		RDSModelAPI: svcRDSModelAPI,
+	}
+
+	changes, err := scheduler.Discover(context.Background(), types.Event{Action: "stop"})
+
+	assert.Equal(t, errorMsg, err)
+	assert.Empty(t, changes)
+}
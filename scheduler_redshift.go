@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/redshift"
+
+	"github.com/auto-staging/scheduler/model"
+	"github.com/auto-staging/scheduler/types"
+)
+
+// redshiftScheduler pauses the Redshift Clusters of an Environment on stop and resumes them on start
+type redshiftScheduler struct {
+	model.RedshiftModelAPI
+}
+
+// newRedshiftScheduler returns the scheduler of the "redshift" resource type with a Redshift client using the given AWS config
+func newRedshiftScheduler(sess *session.Session, config *aws.Config) ResourceScheduler {
+	return &redshiftScheduler{
+		RedshiftModelAPI: model.NewRedshiftModel(redshift.New(sess, config), tagConfig),
+	}
+}
+
+// Describe returns the resource type "redshift"
+func (scheduler *redshiftScheduler) Describe() string {
+	return "redshift"
+}
+
+// Discover returns the Redshift Clusters of the Environment with their current status
+func (scheduler *redshiftScheduler) Discover(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	changes := []types.ResourceChange{}
+	clusters, excluded, err := scheduler.RedshiftModelAPI.GetRedshiftClustersForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, err
+	}
+	for _, cluster := range excluded {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "redshift-cluster",
+			Identifier:    *cluster.ClusterIdentifier,
+			Action:        cwEvent.Action,
+			PreviousState: *cluster.ClusterStatus,
+			SkippedReason: excludedReason,
+		})
+	}
+	for _, cluster := range clusters {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "redshift-cluster",
+			Identifier:    *cluster.ClusterIdentifier,
+			Action:        cwEvent.Action,
+			PreviousState: *cluster.ClusterStatus,
+		})
+	}
+	return changes, nil
+}
+
+// Start resumes the paused Redshift Clusters of the Environment
+func (scheduler *redshiftScheduler) Start(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	cwEvent.Action = "start"
+	return scheduler.changeState(ctx, cwEvent)
+}
+
+// Stop pauses the available Redshift Clusters of the Environment
+func (scheduler *redshiftScheduler) Stop(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	cwEvent.Action = "stop"
+	return scheduler.changeState(ctx, cwEvent)
+}
+
+// Wait waits until the changed Redshift Clusters are available / paused
+func (scheduler *redshiftScheduler) Wait(ctx context.Context, action string, changes []types.ResourceChange) error {
+	var errs types.MultiError
+	for i, change := range changes {
+		if change.Kind != "redshift-cluster" || change.Skipped() {
+			continue
+		}
+
+		var err error
+		finalState := ""
+		switch action {
+		case "start":
+			finalState = "available"
+			err = scheduler.RedshiftModelAPI.WaitUntilRedshiftClusterAvailable(ctx, aws.String(change.Identifier))
+		case "stop":
+			finalState = "paused"
+			err = scheduler.RedshiftModelAPI.WaitUntilRedshiftClusterPaused(ctx, aws.String(change.Identifier))
+		}
+		if err != nil {
+			errs = errs.Append(err)
+			continue
+		}
+		if finalState != "" {
+			changes[i].NewState = finalState
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+func (scheduler *redshiftScheduler) changeState(ctx context.Context, cwEvent types.Event) ([]types.ResourceChange, error) {
+	changes := []types.ResourceChange{}
+	var errs types.MultiError
+	clusters, excluded, err := scheduler.RedshiftModelAPI.GetRedshiftClustersForTags(ctx, cwEvent.Repository, cwEvent.Branch)
+	if err != nil {
+		return changes, err
+	}
+	for _, cluster := range excluded {
+		changes = append(changes, types.ResourceChange{
+			Kind:          "redshift-cluster",
+			Identifier:    *cluster.ClusterIdentifier,
+			Action:        cwEvent.Action,
+			PreviousState: *cluster.ClusterStatus,
+			SkippedReason: excludedReason,
+		})
+	}
+
+	for _, cluster := range clusters {
+		change := types.ResourceChange{
+			Kind:          "redshift-cluster",
+			Identifier:    *cluster.ClusterIdentifier,
+			Action:        cwEvent.Action,
+			PreviousState: *cluster.ClusterStatus,
+		}
+
+		changed := model.IsRedshiftActionRequired(cwEvent.Action, *cluster.ClusterStatus)
+		if !cwEvent.DryRun {
+			switch cwEvent.Action {
+			case "stop":
+				changed, err = scheduler.RedshiftModelAPI.PauseRedshiftCluster(ctx, cluster.ClusterIdentifier, cluster.ClusterStatus)
+			case "start":
+				changed, err = scheduler.RedshiftModelAPI.ResumeRedshiftCluster(ctx, cluster.ClusterIdentifier, cluster.ClusterStatus)
+			}
+			if err != nil {
+				log.Printf("Redshift - Failed to %s cluster %s \n", cwEvent.Action, *cluster.ClusterIdentifier)
+				change.Error = err.Error()
+				changes = append(changes, change)
+				errs = errs.Append(err)
+				continue
+			}
+		}
+
+		if !changed {
+			change.SkippedReason = "cluster status is " + *cluster.ClusterStatus
+			changes = append(changes, change)
+			continue
+		}
+		change.NewState = "resuming"
+		if cwEvent.Action == "stop" {
+			change.NewState = "pausing"
+		}
+		changes = append(changes, change)
+		if !cwEvent.DryRun {
+			log.Printf("Redshift - Changed state of cluster %s to %s \n", *cluster.ClusterIdentifier, change.NewState)
+		}
+	}
+
+	return changes, errs.ErrorOrNil()
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+
+	"github.com/auto-staging/scheduler/mocks"
+	"github.com/auto-staging/scheduler/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRedshiftSchedulerStop(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+	clusterIdentifier := aws.String("demo-app-feat-branch")
+	clusterStatus := aws.String("available")
+
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcRedshiftModelAPI.On("GetRedshiftClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*redshift.Cluster{
+		{ClusterIdentifier: clusterIdentifier, ClusterStatus: clusterStatus},
+	}, []*redshift.Cluster{}, nil)
+	svcRedshiftModelAPI.On("PauseRedshiftCluster", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(true, nil)
+
+	scheduler := redshiftScheduler{
+		RedshiftModelAPI: svcRedshiftModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "redshift-cluster", Identifier: "demo-app-feat-branch", Action: "stop", PreviousState: "available", NewState: "pausing"},
+	}, changes)
+	svcRedshiftModelAPI.AssertCalled(t, "PauseRedshiftCluster", mock.Anything, clusterIdentifier, clusterStatus)
+}
+
+func TestRedshiftSchedulerStart(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+	clusterIdentifier := aws.String("demo-app-feat-branch")
+	clusterStatus := aws.String("paused")
+
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcRedshiftModelAPI.On("GetRedshiftClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*redshift.Cluster{
+		{ClusterIdentifier: clusterIdentifier, ClusterStatus: clusterStatus},
+	}, []*redshift.Cluster{}, nil)
+	svcRedshiftModelAPI.On("ResumeRedshiftCluster", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(true, nil)
+
+	scheduler := redshiftScheduler{
+		RedshiftModelAPI: svcRedshiftModelAPI,
+	}
+
+	changes, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "redshift-cluster", Identifier: "demo-app-feat-branch", Action: "start", PreviousState: "paused", NewState: "resuming"},
+	}, changes)
+	svcRedshiftModelAPI.AssertCalled(t, "ResumeRedshiftCluster", mock.Anything, clusterIdentifier, clusterStatus)
+}
+
+func TestRedshiftSchedulerNotChanged(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcRedshiftModelAPI.On("GetRedshiftClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*redshift.Cluster{
+		{ClusterIdentifier: aws.String("demo-app-feat-branch"), ClusterStatus: aws.String("resuming")},
+	}, []*redshift.Cluster{
+		{ClusterIdentifier: aws.String("demo-app-shared"), ClusterStatus: aws.String("available")},
+	}, nil)
+	svcRedshiftModelAPI.On("PauseRedshiftCluster", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(false, nil)
+
+	scheduler := redshiftScheduler{
+		RedshiftModelAPI: svcRedshiftModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "redshift-cluster", Identifier: "demo-app-shared", Action: "stop", PreviousState: "available", SkippedReason: "excluded by tag"},
+		{Kind: "redshift-cluster", Identifier: "demo-app-feat-branch", Action: "stop", PreviousState: "resuming", SkippedReason: "cluster status is resuming"},
+	}, changes)
+}
+
+func TestRedshiftSchedulerDryRun(t *testing.T) {
+	cwEvent := types.Event{
+		Action:     "start",
+		Branch:     "branch",
+		Repository: "repo",
+		DryRun:     true,
+	}
+
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcRedshiftModelAPI.On("GetRedshiftClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*redshift.Cluster{
+		{ClusterIdentifier: aws.String("demo-app-feat-branch"), ClusterStatus: aws.String("paused")},
+	}, []*redshift.Cluster{}, nil)
+
+	scheduler := redshiftScheduler{
+		RedshiftModelAPI: svcRedshiftModelAPI,
+	}
+
+	changes, err := scheduler.Start(context.Background(), cwEvent)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, "resuming", changes[0].NewState)
+	svcRedshiftModelAPI.AssertNotCalled(t, "ResumeRedshiftCluster", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRedshiftSchedulerStopError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcRedshiftModelAPI.On("GetRedshiftClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*redshift.Cluster{
+		{ClusterIdentifier: aws.String("demo-app-feat-branch"), ClusterStatus: aws.String("available")},
+	}, []*redshift.Cluster{}, nil)
+	svcRedshiftModelAPI.On("PauseRedshiftCluster", mock.Anything, mock.AnythingOfType("*string"), mock.AnythingOfType("*string")).Return(false, errorMsg)
+
+	scheduler := redshiftScheduler{
+		RedshiftModelAPI: svcRedshiftModelAPI,
+	}
+
+	_, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Equal(t, errorMsg, err)
+}
+
+func TestRedshiftSchedulerStopContinuesAfterError(t *testing.T) {
+	errorMsg := errors.New("Test error")
+	cwEvent := types.Event{
+		Action:     "stop",
+		Branch:     "branch",
+		Repository: "repo",
+	}
+	failingID := aws.String("demo-app-feat-branch")
+	clusterID := aws.String("demo-app-feat-branch-2")
+
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcRedshiftModelAPI.On("GetRedshiftClustersForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return([]*redshift.Cluster{
+		{ClusterIdentifier: failingID, ClusterStatus: aws.String("available")},
+		{ClusterIdentifier: clusterID, ClusterStatus: aws.String("available")},
+	}, []*redshift.Cluster{}, nil)
+	svcRedshiftModelAPI.On("PauseRedshiftCluster", mock.Anything, failingID, mock.AnythingOfType("*string")).Return(false, errorMsg)
+	svcRedshiftModelAPI.On("PauseRedshiftCluster", mock.Anything, clusterID, mock.AnythingOfType("*string")).Return(true, nil)
+
+	scheduler := redshiftScheduler{
+		RedshiftModelAPI: svcRedshiftModelAPI,
+	}
+
+	changes, err := scheduler.Stop(context.Background(), cwEvent)
+
+	assert.Equal(t, errorMsg, err)
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "redshift-cluster", Identifier: "demo-app-feat-branch", Action: "stop", PreviousState: "available", Error: "Test error"},
+		{Kind: "redshift-cluster", Identifier: "demo-app-feat-branch-2", Action: "stop", PreviousState: "available", NewState: "pausing"},
+	}, changes)
+	svcRedshiftModelAPI.AssertCalled(t, "PauseRedshiftCluster", mock.Anything, clusterID, mock.AnythingOfType("*string"))
+}
+
+func TestRedshiftSchedulerWait(t *testing.T) {
+	svcRedshiftModelAPI := new(mocks.RedshiftModelAPI)
+	svcRedshiftModelAPI.On("WaitUntilRedshiftClusterPaused", mock.Anything, mock.AnythingOfType("*string")).Return(nil)
+
+	scheduler := redshiftScheduler{
+		RedshiftModelAPI: svcRedshiftModelAPI,
+	}
+	changes := []types.ResourceChange{
+		{Kind: "redshift-cluster", Identifier: "demo-app-feat-branch", Action: "stop", PreviousState: "available", NewState: "pausing"},
+	}
+
+	err := scheduler.Wait(context.Background(), "stop", changes)
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, "paused", changes[0].NewState)
+	svcRedshiftModelAPI.AssertCalled(t, "WaitUntilRedshiftClusterPaused", mock.Anything, aws.String("demo-app-feat-branch"))
+}
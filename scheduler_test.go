@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/auto-staging/scheduler/mocks"
+	"github.com/auto-staging/scheduler/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewSchedulers(t *testing.T) {
+	schedulers := newSchedulers(session.Must(session.NewSession()), aws.NewConfig())
+
+	resourceTypes := []string{}
+	for _, scheduler := range schedulers {
+		resourceTypes = append(resourceTypes, scheduler.Describe())
+	}
+	assert.Equal(t, []string{"asg", "ec2", "ecs", "eks", "rds", "redshift", "docdb", "elasticache"}, resourceTypes)
+}
+
+func TestDefaultPhasesMatchRegistry(t *testing.T) {
+	registered := map[string]bool{}
+	for _, scheduler := range newSchedulers(session.Must(session.NewSession()), aws.NewConfig()) {
+		registered[scheduler.Describe()] = true
+	}
+
+	for _, phase := range defaultPhases {
+		for _, resourceType := range phase {
+			assert.True(t, registered[resourceType], "Expected resource type %s of the default phases to be registered", resourceType)
+		}
+	}
+}
+
+func TestHandlerForAction(t *testing.T) {
+	instanceIDs := []*string{aws.String("i-1234567890abcdef0")}
+
+	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(newEC2Instances("stopped", instanceIDs), []*ec2.Instance{}, nil)
+	svcEC2ModelAPI.On("StartEC2Instances", mock.Anything, mock.AnythingOfType("[]*string")).Return(newInstanceStateChanges("stopped", "pending", instanceIDs), nil)
+
+	scheduler := &ec2Scheduler{
+		EC2ModelAPI: svcEC2ModelAPI,
+	}
+
+	changes, err := handlerForAction(scheduler, "start")(context.Background(), types.Event{Action: "start"})
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "ec2-instance", Identifier: "i-1234567890abcdef0", Action: "start", PreviousState: "stopped", NewState: "pending"},
+	}, changes)
+	svcEC2ModelAPI.AssertCalled(t, "StartEC2Instances", mock.Anything, instanceIDs)
+}
+
+func TestHandlerForActionUnknownAction(t *testing.T) {
+	instanceIDs := []*string{aws.String("i-1234567890abcdef0")}
+	bastionIDs := []*string{aws.String("i-bastion")}
+
+	svcEC2ModelAPI := new(mocks.EC2ModelAPI)
+	svcEC2ModelAPI.On("DescribeInstancesForTags", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(newEC2Instances("running", instanceIDs), newEC2Instances("running", bastionIDs), nil)
+
+	scheduler := &ec2Scheduler{
+		EC2ModelAPI: svcEC2ModelAPI,
+	}
+
+	changes, err := handlerForAction(scheduler, "restart")(context.Background(), types.Event{Action: "restart"})
+
+	assert.Nil(t, err, "Expected no error")
+	assert.Equal(t, []types.ResourceChange{
+		{Kind: "ec2-instance", Identifier: "i-bastion", Action: "restart", PreviousState: "running", SkippedReason: excludedReason},
+		{Kind: "ec2-instance", Identifier: "i-1234567890abcdef0", Action: "restart", PreviousState: "running", SkippedReason: `unknown action "restart"`},
+	}, changes)
+	svcEC2ModelAPI.AssertNotCalled(t, "StartEC2Instances", mock.Anything, mock.Anything)
+	svcEC2ModelAPI.AssertNotCalled(t, "StopEC2Instances", mock.Anything, mock.Anything)
+}
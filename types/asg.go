@@ -0,0 +1,15 @@
+package types
+
+import "fmt"
+
+// ASGSize contains the size settings of an autoscaling group, which get stored before the group is scaled to zero and restored on start
+type ASGSize struct {
+	MinSize         int64
+	MaxSize         int64
+	DesiredCapacity int64
+}
+
+// String returns the size in the format "min=1 max=3 desired=2", it is used as state of autoscaling groups in the scheduler result
+func (size ASGSize) String() string {
+	return fmt.Sprintf("min=%d max=%d desired=%d", size.MinSize, size.MaxSize, size.DesiredCapacity)
+}
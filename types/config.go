@@ -0,0 +1,131 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// tableNamePattern matches valid DynamoDB table names
+var tableNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_.-]{3,255}$`)
+
+// StatusTableConfig contains the name of the DynamoDB table storing the Environment status and the names of its key attributes.
+// HistorySize is the maximum number of transitions kept in the history of an Environment, 0 disables the history.
+type StatusTableConfig struct {
+	TableName     string
+	RepositoryKey string
+	BranchKey     string
+	HistorySize   int
+}
+
+// Validate returns an error, if the table name isn't a valid DynamoDB table name, the key attribute names are empty or equal or the history size is negative
+func (config StatusTableConfig) Validate() error {
+	if !tableNamePattern.MatchString(config.TableName) {
+		return fmt.Errorf("invalid status table name %q", config.TableName)
+	}
+	if config.RepositoryKey == "" || config.BranchKey == "" {
+		return errors.New("status table key attribute names must not be empty")
+	}
+	if config.RepositoryKey == config.BranchKey {
+		return fmt.Errorf("status table key attribute names must differ, both are %q", config.RepositoryKey)
+	}
+	if config.HistorySize < 0 {
+		return fmt.Errorf("status history size must not be negative, got %d", config.HistorySize)
+	}
+	return nil
+}
+
+// TagConfig contains the tag keys used to find the resources of an Environment. Besides the repository and branch tag,
+// a resource must have all RequiredTags (e.g. environment=staging) to be part of the Environment.
+// Resources tagged with ExcludeTagKey=ExcludeTagValue (e.g. auto-staging:schedule=ignore) are part of the Environment, but never started or stopped.
+// If ExcludeTagKey is empty, no resource is excluded.
+// Resources tagged with SnapshotTagKey=SnapshotTagValue (e.g. auto-staging:schedule=snapshot) allow to be deleted after a snapshot on stop and
+// restored on start, resources which support this without the tag are never deleted. If SnapshotTagKey is empty, no resource is deleted.
+type TagConfig struct {
+	RepositoryKey    string
+	BranchKey        string
+	RequiredTags     map[string]string
+	ExcludeTagKey    string
+	ExcludeTagValue  string
+	SnapshotTagKey   string
+	SnapshotTagValue string
+}
+
+// Validate returns an error, if the repository or branch tag key is empty, both are equal or a required tag overrides one of them
+func (config TagConfig) Validate() error {
+	if config.RepositoryKey == "" || config.BranchKey == "" {
+		return errors.New("repository and branch tag keys must not be empty")
+	}
+	if config.RepositoryKey == config.BranchKey {
+		return fmt.Errorf("repository and branch tag keys must differ, both are %q", config.RepositoryKey)
+	}
+	for key := range config.RequiredTags {
+		if key == "" {
+			return errors.New("required tag keys must not be empty")
+		}
+		if key == config.RepositoryKey || key == config.BranchKey {
+			return fmt.Errorf("required tag %q conflicts with the repository or branch tag key", key)
+		}
+	}
+	if _, ok := config.RequiredTags[config.ExcludeTagKey]; ok || config.ExcludeTagKey == config.RepositoryKey || config.ExcludeTagKey == config.BranchKey {
+		return fmt.Errorf("exclude tag %q conflicts with the environment tags", config.ExcludeTagKey)
+	}
+	if _, ok := config.RequiredTags[config.SnapshotTagKey]; ok || config.SnapshotTagKey == config.RepositoryKey || config.SnapshotTagKey == config.BranchKey {
+		return fmt.Errorf("snapshot tag %q conflicts with the environment tags", config.SnapshotTagKey)
+	}
+	if config.SnapshotTagKey != "" && config.SnapshotTagKey == config.ExcludeTagKey && config.SnapshotTagValue == config.ExcludeTagValue {
+		return errors.New("snapshot tag and exclude tag must differ")
+	}
+	return nil
+}
+
+// TagsForEnvironment returns all tags, which a resource of the Environment with the given repository and branch must have
+func (config TagConfig) TagsForEnvironment(repository, branch string) map[string]string {
+	tags := map[string]string{
+		config.RepositoryKey: repository,
+		config.BranchKey:     branch,
+	}
+	for key, value := range config.RequiredTags {
+		tags[key] = value
+	}
+	return tags
+}
+
+// TagKeysForEnvironment returns the keys of all tags returned by TagsForEnvironment, the repository and branch key first followed by the sorted required tag keys
+func (config TagConfig) TagKeysForEnvironment() []string {
+	requiredKeys := []string{}
+	for key := range config.RequiredTags {
+		requiredKeys = append(requiredKeys, key)
+	}
+	sort.Strings(requiredKeys)
+	return append([]string{config.RepositoryKey, config.BranchKey}, requiredKeys...)
+}
+
+// MatchesEnvironment returns true, if the given resource tags contain all tags of the Environment with the given repository and branch
+func (config TagConfig) MatchesEnvironment(tags map[string]string, repository, branch string) bool {
+	for key, value := range config.TagsForEnvironment(repository, branch) {
+		if tagValue, ok := tags[key]; !ok || tagValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// IsExcluded returns true, if the given resource tags contain the exclude tag
+func (config TagConfig) IsExcluded(tags map[string]string) bool {
+	if config.ExcludeTagKey == "" {
+		return false
+	}
+	value, ok := tags[config.ExcludeTagKey]
+	return ok && value == config.ExcludeTagValue
+}
+
+// AllowsSnapshot returns true, if the given resource tags contain the snapshot tag
+func (config TagConfig) AllowsSnapshot(tags map[string]string) bool {
+	if config.SnapshotTagKey == "" {
+		return false
+	}
+	value, ok := tags[config.SnapshotTagKey]
+	return ok && value == config.SnapshotTagValue
+}
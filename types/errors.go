@@ -0,0 +1,42 @@
+package types
+
+import "strings"
+
+// MultiError collects the errors of multiple resource types, so a failure of one resource type doesn't stop the others from being changed
+type MultiError []error
+
+// Append adds the error to the MultiError, nil errors are ignored
+func (errs MultiError) Append(err error) MultiError {
+	if err == nil {
+		return errs
+	}
+	if nested, ok := err.(MultiError); ok {
+		return append(errs, nested...)
+	}
+	return append(errs, err)
+}
+
+// ErrorOrNil returns nil if no error was collected and the error itself if only one was collected, otherwise it returns the MultiError
+func (errs MultiError) ErrorOrNil() error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	}
+	return errs
+}
+
+// Strings returns the messages of all collected errors
+func (errs MultiError) Strings() []string {
+	messages := []string{}
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	return messages
+}
+
+// Error joins the messages of all collected errors
+func (errs MultiError) Error() string {
+	return strings.Join(errs.Strings(), "; ")
+}
@@ -1,9 +1,24 @@
 package types
 
-// Event contains the event body used in the invokation of the Lambda
+// Event contains the event body used in the invokation of the Lambda.
+// Phases lists the resource types ("asg", "ec2", "ecs", "eks", "rds", "redshift", "docdb", "elasticache") in the order they get started, stopping uses the reverse order.
+// If Wait is set, the scheduler waits until all resources reached their final state, WaitTimeout limits the total waiting time of all phases in seconds.
+// Source is the trigger of the invocation recorded in the history of the Environment (e.g. "tower"), it defaults to "cloudwatch".
+// For Environments in another AWS account, RoleArn is the role assumed to change the resources. Instead of the role, the Account can be given,
+// its role is then looked up in the configured account roles. The status is always written to the status table of the scheduler account.
+// Region and Regions list the AWS regions of the Environment, if neither is set the region of the scheduler is used.
 type Event struct {
-	Operation  string `json:"operation"`
-	Repository string `json:"repository"`
-	Branch     string `json:"branch"`
-	Action     string `json:"action"`
+	Operation   string     `json:"operation"`
+	Repository  string     `json:"repository"`
+	Branch      string     `json:"branch"`
+	Action      string     `json:"action"`
+	DryRun      bool       `json:"dryRun"`
+	Phases      [][]string `json:"phases,omitempty"`
+	Wait        bool       `json:"wait,omitempty"`
+	WaitTimeout int64      `json:"waitTimeout,omitempty"`
+	Source      string     `json:"source,omitempty"`
+	RoleArn     string     `json:"roleArn,omitempty"`
+	Account     string     `json:"account,omitempty"`
+	Region      string     `json:"region,omitempty"`
+	Regions     []string   `json:"regions,omitempty"`
 }
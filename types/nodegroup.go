@@ -0,0 +1,16 @@
+package types
+
+import "fmt"
+
+// NodegroupSize contains the scaling config of an EKS managed node group, the min size and desired size get stored before the node group is scaled to
+// zero and restored on start. The max size can't be 0 and stays unchanged.
+type NodegroupSize struct {
+	MinSize     int64
+	MaxSize     int64
+	DesiredSize int64
+}
+
+// String returns the size in the format "min=1 max=3 desired=2", it is used as state of node groups in the scheduler result
+func (size NodegroupSize) String() string {
+	return fmt.Sprintf("min=%d max=%d desired=%d", size.MinSize, size.MaxSize, size.DesiredSize)
+}
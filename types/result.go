@@ -0,0 +1,30 @@
+package types
+
+// Result is returned by the scheduler, it contains all resources of the Environment with the state change made by the scheduler and the status written for the Environment.
+// For dry runs it contains the state changes which would be made and the status which would be written.
+// If changing a resource type failed, Errors contains the error messages and Resources the state changes which succeeded together with the failed resources.
+type Result struct {
+	DryRun    bool             `json:"dryRun"`
+	Action    string           `json:"action"`
+	Resources []ResourceChange `json:"resources"`
+	Status    string           `json:"status,omitempty"`
+	Errors    []string         `json:"errors,omitempty"`
+}
+
+// ResourceChange describes the state change of a single resource. If the resource was left untouched, SkippedReason contains the reason.
+// If changing the resource failed, Error contains the error message.
+type ResourceChange struct {
+	Kind          string `json:"kind"`
+	Identifier    string `json:"identifier"`
+	Action        string `json:"action"`
+	PreviousState string `json:"previousState,omitempty"`
+	NewState      string `json:"newState,omitempty"`
+	SkippedReason string `json:"skippedReason,omitempty"`
+	Error         string `json:"error,omitempty"`
+	Region        string `json:"region,omitempty"`
+}
+
+// Skipped returns true, if the resource was left untouched, either because it didn't need to be changed or because changing it failed
+func (change ResourceChange) Skipped() bool {
+	return change.SkippedReason != "" || change.Error != ""
+}
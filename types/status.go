@@ -2,5 +2,19 @@ package types
 
 // StatusUpdate struct is used for DynamoDB updates, because the update command requires all json keys to start with ":"
 type StatusUpdate struct {
-	Status string `json:":status"`
+	Status         string `json:":status"`
+	ErrorMessage   string `json:":errorMessage,omitempty"`
+	LastTransition string `json:":lastTransition"`
+}
+
+// HistoryEntry describes one start or stop of an Environment, the entries are appended to the history attribute of the Environment.
+// Source is the trigger of the transition (e.g. "cloudwatch" or "tower"), Resources lists the identifiers of the changed resources and
+// Result is the final status of the Environment.
+type HistoryEntry struct {
+	Timestamp    string   `json:"timestamp"`
+	Action       string   `json:"action"`
+	Source       string   `json:"source"`
+	Resources    []string `json:"resources"`
+	Result       string   `json:"result"`
+	ErrorMessage string   `json:"errorMessage,omitempty"`
 }